@@ -2,10 +2,14 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
-	"time"
+	"sort"
+	"strings"
 
 	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
 	"github.com/scookdev/groovekit-cli/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -20,23 +24,43 @@ var accountCmd = &cobra.Command{
 var accountShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show account details",
-	Long:  "Display your account information, plan limits, and current usage",
+	Long: `Display your account information, plan limits, current usage, and how
+long check and ping history is kept.
+
+With --check-limits, show only job and monitor usage against the plan's
+limits, with upgrade hints for any that are full or nearly so. It exits 5
+when a limit is full, so scripts can check for room before creating
+resources.
+
+Examples:
+  groovekit account show
+  groovekit account show --check-limits`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		checkLimits, _ := cmd.Flags().GetBool("check-limits")
 
 		// Start spinner
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
 		account, err := client.GetAccount()
+		if err != nil {
+			err = fmt.Errorf("failed to get account: %w", err)
+		}
+		var retention *api.RetentionPolicy
+		if err == nil && !checkLimits {
+			retention, err = getRetentionPolicy(client)
+		}
 
 		// Stop spinner
 		if s != nil {
@@ -44,29 +68,43 @@ var accountShowCmd = &cobra.Command{
 		}
 
 		if err != nil {
-			return fmt.Errorf("failed to get account: %w", err)
+			return err
+		}
+
+		if checkLimits {
+			if jsonOutput {
+				err = cmdutil.OutputJSON(out, limitsReport{Plan: planName(account), Limits: planLimits(account)})
+			} else {
+				printLimits(out, account)
+			}
+			if err != nil || !limitsFull(account) {
+				return err
+			}
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return &exitCodeError{code: exitRateLimited}
 		}
 
 		if jsonOutput {
-			return outputJSON(account)
+			return cmdutil.OutputJSON(out, accountDetails{Account: account, Retention: retention})
 		}
 
 		// Print account details
-		fmt.Printf("%s\n\n", output.Bold("Account Information"))
-		fmt.Printf("Email:            %s\n", account.Email)
-		fmt.Printf("Name:             %s\n", account.FullName)
+		fmt.Fprintf(out, "%s\n\n", output.Bold("Account Information"))
+		fmt.Fprintf(out, "Email:            %s\n", account.Email)
+		fmt.Fprintf(out, "Name:             %s\n", account.FullName)
 
 		if account.Subscription != nil {
-			fmt.Printf("\n%s\n\n", output.Bold("Subscription"))
-			fmt.Printf("Plan:             %s\n", output.Cyan(account.Subscription.PlanName))
-			fmt.Printf("Status:           %s\n", formatStatus(account.Subscription.Status))
+			fmt.Fprintf(out, "\n%s\n\n", output.Bold("Subscription"))
+			fmt.Fprintf(out, "Plan:             %s\n", output.Cyan(account.Subscription.PlanName))
+			fmt.Fprintf(out, "Status:           %s\n", formatStatus(account.Subscription.Status))
 
 			if account.Subscription.CurrentPeriodEnd != nil {
-				fmt.Printf("Renews:           %s\n", *account.Subscription.CurrentPeriodEnd)
+				fmt.Fprintf(out, "Renews:           %s\n", *account.Subscription.CurrentPeriodEnd)
 			}
 
 			// Usage and Limits
-			fmt.Printf("\n%s\n\n", output.Bold("Usage & Limits"))
+			fmt.Fprintf(out, "\n%s\n\n", output.Bold("Usage & Limits"))
 
 			// Jobs
 			jobUsage := fmt.Sprintf("%d / %d", account.JobCount, account.Subscription.MaxJobs)
@@ -74,7 +112,7 @@ var accountShowCmd = &cobra.Command{
 			if account.Subscription.MaxJobs > 0 {
 				jobPercent = float64(account.JobCount) / float64(account.Subscription.MaxJobs) * 100
 			}
-			fmt.Printf("Jobs:             %s %s\n", jobUsage, formatUsageBar(jobPercent))
+			fmt.Fprintf(out, "Jobs:             %s %s\n", jobUsage, formatUsageBar(jobPercent))
 
 			// Monitors
 			monitorUsage := fmt.Sprintf("%d / %d", account.MonitorCount, account.Subscription.MaxMonitors)
@@ -82,7 +120,7 @@ var accountShowCmd = &cobra.Command{
 			if account.Subscription.MaxMonitors > 0 {
 				monitorPercent = float64(account.MonitorCount) / float64(account.Subscription.MaxMonitors) * 100
 			}
-			fmt.Printf("Monitors:         %s %s\n", monitorUsage, formatUsageBar(monitorPercent))
+			fmt.Fprintf(out, "Monitors:         %s %s\n", monitorUsage, formatUsageBar(monitorPercent))
 
 			// SMS
 			if account.Subscription.SMSLimit > 0 {
@@ -91,21 +129,251 @@ var accountShowCmd = &cobra.Command{
 				if account.Subscription.SMSLimit > 0 {
 					smsPercent = float64(account.SMSUsed) / float64(account.Subscription.SMSLimit) * 100
 				}
-				fmt.Printf("SMS this month:   %s %s\n", smsUsage, formatUsageBar(smsPercent))
+				fmt.Fprintf(out, "SMS this month:   %s %s\n", smsUsage, formatUsageBar(smsPercent))
 			} else {
-				fmt.Printf("SMS this month:   %s\n", output.Yellow("Not available on this plan"))
+				fmt.Fprintf(out, "SMS this month:   %s\n", output.Yellow("Not available on this plan"))
 			}
 
 			// Check interval
-			fmt.Printf("Min check interval: %s\n", output.FormatDuration(account.Subscription.MinCheckInterval))
+			fmt.Fprintf(out, "Min check interval: %s\n", output.FormatDuration(account.Subscription.MinCheckInterval))
 		} else {
-			fmt.Printf("\n%s\n", output.Yellow("No active subscription"))
+			fmt.Fprintf(out, "\n%s\n", output.Yellow("No active subscription"))
+		}
+
+		if retention != nil {
+			fmt.Fprintf(out, "\n%s\n\n", output.Bold("Data Retention"))
+			fmt.Fprintf(out, "Check history:    %s (%s stored)\n", countNoun(retention.CheckDays, "day", "days"), countNoun(retention.StoredChecks, "check", "checks"))
+			fmt.Fprintf(out, "Ping history:     %s (%s stored)\n", countNoun(retention.PingDays, "day", "days"), countNoun(retention.StoredPings, "ping", "pings"))
 		}
 
 		return nil
 	},
 }
 
+// accountDetails is account show's JSON output: the account with its
+// retention policy, when the API has one
+type accountDetails struct {
+	*api.Account
+	Retention *api.RetentionPolicy `json:"retention,omitempty"`
+}
+
+// getRetentionPolicy returns the account's retention policy, or nil from
+// an API that doesn't report one
+func getRetentionPolicy(client api.APIClient) (*api.RetentionPolicy, error) {
+	retention, err := client.GetRetentionPolicy()
+	if errors.Is(err, api.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention policy: %w", err)
+	}
+	return retention, nil
+}
+
+// quotaGroup is one group's share of the plan's job and monitor slots
+type quotaGroup struct {
+	Group        string   `json:"group"`
+	Jobs         int      `json:"jobs"`
+	Monitors     int      `json:"monitors"`
+	JobShare     *float64 `json:"job_share,omitempty"`
+	MonitorShare *float64 `json:"monitor_share,omitempty"`
+}
+
+// quotaReport breaks plan usage down by group
+type quotaReport struct {
+	GroupBy     string       `json:"group_by"`
+	Jobs        int          `json:"jobs"`
+	Monitors    int          `json:"monitors"`
+	MaxJobs     int          `json:"max_jobs"`
+	MaxMonitors int          `json:"max_monitors"`
+	Groups      []quotaGroup `json:"groups"`
+}
+
+// untaggedGroup collects resources without tags when grouping by tag
+const untaggedGroup = "(untagged)"
+
+// account quota
+var accountQuotaCmd = &cobra.Command{
+	Use:   "quota",
+	Short: "Break down plan usage by tag or type",
+	Long: `Show how many of the plan's job and monitor slots each tag (or resource
+type) consumes, for internal chargeback and to spot what is using up the
+quota. Monitor slots are shared by API, SSL, domain, and DNS monitors.
+
+A resource with several tags counts toward each of them, so shares can add
+up to more than the total.
+
+Examples:
+  groovekit account quota --group-by tag
+  groovekit account quota --group-by type --json`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		if groupBy != "tag" && groupBy != "type" {
+			return usageErrorf("invalid --group-by %q: must be tag or type", groupBy)
+		}
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		account, err := client.GetAccount()
+		var res *accountResources
+		var errs map[string]error
+		if err == nil {
+			res, errs = fetchAccountResources(cmd.Context(), client, nil)
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get account: %w", err)
+		}
+		for _, kind := range statusKinds {
+			if err := errs[kind]; err != nil {
+				return err
+			}
+		}
+
+		report := buildQuotaReport(res, account.Subscription, groupBy)
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, report)
+		}
+
+		if len(report.Groups) == 0 {
+			output.InfoMessage(out, "No jobs or monitors yet")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{strings.ToUpper(groupBy), "JOBS", "JOB SLOTS", "MONITORS", "MONITOR SLOTS"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for _, group := range report.Groups {
+			table.AppendWithValues([]string{
+				group.Group,
+				fmt.Sprintf("%d", group.Jobs),
+				formatShare(group.JobShare),
+				fmt.Sprintf("%d", group.Monitors),
+				formatShare(group.MonitorShare),
+			}, []interface{}{nil, nil, group.JobShare, nil, group.MonitorShare})
+		}
+		table.Flush()
+
+		fmt.Fprintf(out, "\n%s\n", output.Bold("Total"))
+		fmt.Fprintf(out, "Jobs:             %s\n", formatQuotaUsage(report.Jobs, report.MaxJobs))
+		fmt.Fprintf(out, "Monitors:         %s\n", formatQuotaUsage(report.Monitors, report.MaxMonitors))
+		return nil
+	},
+}
+
+// buildQuotaReport counts jobs and monitors per tag or resource type, with
+// each group's share of the plan limits. Groups are ordered by the number of
+// slots they use.
+func buildQuotaReport(res *accountResources, sub *api.AccountSubscription, groupBy string) *quotaReport {
+	report := &quotaReport{GroupBy: groupBy, Groups: []quotaGroup{}}
+	if sub != nil {
+		report.MaxJobs = sub.MaxJobs
+		report.MaxMonitors = sub.MaxMonitors
+	}
+
+	groups := map[string]*quotaGroup{}
+	count := func(kind string, tags []string, job bool) {
+		keys := []string{kind}
+		if groupBy == "tag" {
+			keys = tags
+			if len(keys) == 0 {
+				keys = []string{untaggedGroup}
+			}
+		}
+		for _, key := range keys {
+			group, ok := groups[key]
+			if !ok {
+				group = &quotaGroup{Group: key}
+				groups[key] = group
+			}
+			if job {
+				group.Jobs++
+			} else {
+				group.Monitors++
+			}
+		}
+		if job {
+			report.Jobs++
+		} else {
+			report.Monitors++
+		}
+	}
+
+	for _, job := range res.jobs.Jobs {
+		count("jobs", job.Tags, true)
+	}
+	for _, monitor := range res.apis.APIMonitors {
+		count("apis", monitor.Tags, false)
+	}
+	for _, cert := range res.certs.SslMonitors {
+		count("certs", cert.Tags, false)
+	}
+	for _, domain := range res.domains.DomainMonitors {
+		count("domains", domain.Tags, false)
+	}
+	for _, dns := range res.dns.DnsMonitors {
+		count("dns", dns.Tags, false)
+	}
+
+	for _, group := range groups {
+		group.JobShare = share(group.Jobs, report.MaxJobs)
+		group.MonitorShare = share(group.Monitors, report.MaxMonitors)
+		report.Groups = append(report.Groups, *group)
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		a, b := report.Groups[i], report.Groups[j]
+		if a.Jobs+a.Monitors != b.Jobs+b.Monitors {
+			return a.Jobs+a.Monitors > b.Jobs+b.Monitors
+		}
+		return a.Group < b.Group
+	})
+	return report
+}
+
+// share returns used as a percentage of max, or nil when there is no limit
+func share(used, max int) *float64 {
+	if max <= 0 {
+		return nil
+	}
+	percent := float64(used) / float64(max) * 100
+	return &percent
+}
+
+// formatShare formats a slot share, or "-" without a plan limit
+func formatShare(percent *float64) string {
+	if percent == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *percent)
+}
+
+// formatQuotaUsage formats used / max with a usage bar
+func formatQuotaUsage(used, max int) string {
+	if max <= 0 {
+		return fmt.Sprintf("%d", used)
+	}
+	return fmt.Sprintf("%d / %d %s", used, max, formatUsageBar(*share(used, max)))
+}
+
 // Helper function to format status with color
 func formatStatus(status string) string {
 	switch status {
@@ -148,9 +416,16 @@ func formatUsageBar(percent float64) string {
 func init() {
 	// Add flags to show command
 	accountShowCmd.Flags().Bool("json", false, "Output as JSON")
+	accountShowCmd.Flags().Bool("check-limits", false, "Show usage against plan limits and exit 5 when one is full")
+
+	// Add flags to quota command
+	accountQuotaCmd.Flags().String("group-by", "tag", "Group usage by tag or type")
+	accountQuotaCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(accountQuotaCmd)
 
 	// Add subcommands
 	accountCmd.AddCommand(accountShowCmd)
+	accountCmd.AddCommand(accountQuotaCmd)
 
 	// Add account command to root
 	rootCmd.AddCommand(accountCmd)
@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
+	"net/http"
 	"testing"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestAccountCommand tests the basic structure of the account command
@@ -20,13 +24,96 @@ func TestAccountCommandHasSubcommands(t *testing.T) {
 	// Should have at least 1 subcommand (show)
 	assert.GreaterOrEqual(t, len(commands), 1)
 
-	// Find show command
-	var hasShow bool
+	// Find show and quota commands
+	var hasShow, hasQuota, hasNotifications bool
 	for _, cmd := range commands {
-		if cmd.Use == "show" {
+		switch cmd.Use {
+		case "show":
 			hasShow = true
+		case "quota":
+			hasQuota = true
+		case "notifications":
+			hasNotifications = true
 		}
 	}
 
 	assert.True(t, hasShow, "account command should have show subcommand")
+	assert.True(t, hasQuota, "account command should have quota subcommand")
+	assert.True(t, hasNotifications, "account command should have notifications subcommand")
+}
+
+// TestAccountShowCommand tests showing the logged-in account
+func TestAccountShowCommand(t *testing.T) {
+	startAPI(t)
+
+	out := mustRun(t, "account", "show")
+	assert.Contains(t, out, "Email:            test@example.com")
+	assert.Contains(t, out, "Name:             Test User")
+	assert.Contains(t, out, "Check history:    90 days (0 checks stored)")
+}
+
+// TestAccountShowCommand_Retention tests showing the retention policy, and
+// leaving it out for an API without one
+func TestAccountShowCommand_Retention(t *testing.T) {
+	srv := startAPI(t)
+	srv.Fake.Retention = api.RetentionPolicy{CheckDays: 30, PingDays: 14}
+	srv.Fake.Pings["job-1"] = []api.Ping{{ID: "p1"}}
+
+	out := mustRun(t, "account", "show", "--json")
+	var details struct {
+		Email     string               `json:"email"`
+		Retention *api.RetentionPolicy `json:"retention"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &details))
+	assert.Equal(t, "test@example.com", details.Email)
+	require.NotNil(t, details.Retention)
+	assert.Equal(t, 30, details.Retention.CheckDays)
+	assert.Equal(t, 1, details.Retention.StoredPings)
+
+	srv.Fake.Errors["GetRetentionPolicy"] = &api.Error{StatusCode: http.StatusNotFound, Message: "not found"}
+	out = mustRun(t, "account", "show")
+	assert.NotContains(t, out, "Data Retention")
+}
+
+// TestBuildQuotaReport tests grouping plan usage by tag and by type
+func TestBuildQuotaReport(t *testing.T) {
+	res := &accountResources{
+		jobs: &api.JobsResponse{Jobs: []api.Job{
+			{ID: "job-1", Tags: []string{"payments"}},
+			{ID: "job-2", Tags: []string{"payments", "search"}},
+			{ID: "job-3"},
+		}},
+		apis: &api.ApisResponse{APIMonitors: []api.ApiMonitor{
+			{ID: "api-1", Tags: []string{"search"}},
+		}},
+		certs:   &api.SslMonitorsResponse{SslMonitors: []api.SslMonitor{{ID: "cert-1", Tags: []string{"payments"}}}},
+		domains: &api.DomainMonitorsResponse{},
+		dns:     &api.DnsMonitorsResponse{DnsMonitors: []api.DnsMonitor{{ID: "dns-1"}}},
+	}
+	sub := &api.AccountSubscription{MaxJobs: 10, MaxMonitors: 4}
+
+	report := buildQuotaReport(res, sub, "tag")
+	assert.Equal(t, 3, report.Jobs)
+	assert.Equal(t, 3, report.Monitors)
+	require.Len(t, report.Groups, 3)
+
+	// Resources with several tags count toward each of them
+	assert.Equal(t, "payments", report.Groups[0].Group)
+	assert.Equal(t, 2, report.Groups[0].Jobs)
+	assert.Equal(t, 1, report.Groups[0].Monitors)
+	require.NotNil(t, report.Groups[0].JobShare)
+	assert.InDelta(t, 20.0, *report.Groups[0].JobShare, 0.001)
+	require.NotNil(t, report.Groups[0].MonitorShare)
+	assert.InDelta(t, 25.0, *report.Groups[0].MonitorShare, 0.001)
+
+	assert.Equal(t, untaggedGroup, report.Groups[1].Group)
+	assert.Equal(t, 1, report.Groups[1].Jobs)
+	assert.Equal(t, 1, report.Groups[1].Monitors)
+	assert.Equal(t, "search", report.Groups[2].Group)
+
+	byType := buildQuotaReport(res, nil, "type")
+	require.Len(t, byType.Groups, 4)
+	assert.Equal(t, "jobs", byType.Groups[0].Group)
+	assert.Equal(t, 3, byType.Groups[0].Jobs)
+	assert.Nil(t, byType.Groups[0].JobShare, "no share without a plan limit")
 }
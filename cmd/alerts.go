@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// alertTypes are the channels accepted by --type
+var alertTypes = []string{api.AlertSMS, api.AlertEmail, api.AlertWebhook}
+
+// alertView renders sent alerts
+var alertView = historyView[api.Alert]{
+	noun:       "alert",
+	headers:    []string{"ID", "TIME", "TYPE", "EVENT", "RESOURCE", "RECIPIENT", "RESULT"},
+	hidden:     []string{"id"},
+	groupLabel: "Channels",
+	entry: func(alert api.Alert) historyEntry {
+		outcome := historyOK
+		result := output.Green("Delivered")
+		if !alert.Delivered {
+			outcome = historyFailed
+			result = "Failed"
+			if alert.ErrorMessage != nil && *alert.ErrorMessage != "" {
+				result = *alert.ErrorMessage
+			}
+			result = output.Red(result)
+		}
+
+		resource := alert.ResourceName
+		if resource == "" {
+			resource = cmdutil.ShortID(alert.ResourceID)
+		}
+		if alert.ResourceType != "" {
+			resource = fmt.Sprintf("%s (%s)", resource, alert.ResourceType)
+		}
+
+		return historyEntry{
+			createdAt: alert.SentAt,
+			outcome:   outcome,
+			duration:  -1,
+			group:     alert.AlertType,
+			cells: []string{
+				output.Cyan(cmdutil.ShortID(alert.ID)),
+				output.FormatTime(alert.SentAt),
+				alert.AlertType,
+				alert.Event,
+				resource,
+				alert.Recipient,
+				result,
+			},
+		}
+	},
+}
+
+var alertsCmd = &cobra.Command{
+	Use:   "alerts",
+	Short: "Review alerts sent for your resources",
+	Long:  `Review the SMS, email, and webhook alerts GrooveKit has sent about your resources.`,
+}
+
+// alerts list
+var alertsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List sent alerts",
+	Long: `List alerts sent for your resources, newest first: when each was sent,
+over which channel, to whom, for which resource, and whether it was
+delivered. Use it to confirm the right people were told about an outage.
+
+Examples:
+  groovekit alerts list
+  groovekit alerts list --since 7d
+  groovekit alerts list --type sms --failed`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		filter, err := parseHistoryFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		alertType, _ := cmd.Flags().GetString("type")
+		if alertType != "" && !slices.Contains(alertTypes, alertType) {
+			return usageErrorf("invalid --type %q: must be sms, email, or webhook", alertType)
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		opts := filter.listOptions()
+		opts.Type = alertType
+		alerts, err := client.ListAllAlerts(opts, filter.fetchLimit(alertType != ""))
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to list alerts: %w", err)
+		}
+
+		// The API filters by type too; this covers servers that ignore it
+		if alertType != "" {
+			alerts = filterItems(alerts, func(alert api.Alert) bool {
+				return alert.AlertType == alertType
+			})
+		}
+
+		return renderHistory(cmd, filter, alerts, alertView)
+	},
+}
+
+func init() {
+	// Add flags to list command
+	addHistoryFlags(alertsListCmd)
+	alertsListCmd.Flags().String("type", "", "Only show alerts sent over this channel: sms, email, or webhook")
+	registerFlagCompletion(alertsListCmd, "type", completeValues(alertTypes...))
+
+	// Add subcommands
+	alertsCmd.AddCommand(alertsListCmd)
+
+	// Add alerts command to root
+	rootCmd.AddCommand(alertsCmd)
+}
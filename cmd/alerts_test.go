@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAlertsCommand tests that the alerts command has its subcommands
+func TestAlertsCommand(t *testing.T) {
+	assert.Equal(t, "alerts", alertsCmd.Use)
+
+	list, _, err := alertsCmd.Find([]string{"list"})
+	assert.NoError(t, err)
+	for _, flag := range []string{"json", "since", "until", "failed", "limit", "type"} {
+		assert.NotNil(t, list.Flags().Lookup(flag), "list should have --%s", flag)
+	}
+}
+
+// TestAlertsListCommand tests listing sent alerts with delivery stats
+func TestAlertsListCommand(t *testing.T) {
+	srv := startAPI(t)
+	srv.Fake.Alerts = []api.Alert{
+		{ID: "alert-2", AlertType: "sms", Event: "recovered", Recipient: "+15550100", ResourceType: "job", ResourceName: "Backup", SentAt: "2026-10-15T09:00:00Z"},
+		{ID: "alert-1", AlertType: "email", Event: "down", Recipient: "ops@example.com", ResourceType: "job", ResourceName: "Backup", Delivered: true, SentAt: "2026-10-15T08:01:00Z"},
+	}
+
+	out := mustRun(t, "alerts", "list", "--since", "2026-10-01")
+	assert.Contains(t, out, "Backup (job)")
+	assert.Contains(t, out, "ops@example.com")
+	assert.Contains(t, out, "Total: 2 alert(s)")
+	assert.Contains(t, out, "Success rate: 50.0% (1 failed)")
+
+	out = mustRun(t, "alerts", "list", "--since", "2026-10-01", "--failed")
+	assert.NotContains(t, out, "ops@example.com")
+}
+
+// TestAlertView tests rendering delivered and failed alerts
+func TestAlertView(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	useTimezone(t, "UTC")
+
+	delivered := alertView.entry(api.Alert{
+		ID: "1a2b3c4d-0000", AlertType: api.AlertEmail, Event: "down", Recipient: "ops@example.com",
+		ResourceType: "job", ResourceName: "Backup", Delivered: true, SentAt: "2026-03-09T10:00:00Z",
+	})
+	assert.Equal(t, historyOK, delivered.outcome)
+	assert.Equal(t, "email", delivered.group)
+	assert.Equal(t, "2026-03-09T10:00:00Z", delivered.createdAt)
+	assert.Equal(t, []string{"1a2b3c4d", "2026-03-09 10:00:00 UTC", "email", "down", "Backup (job)", "ops@example.com", "Delivered"}, delivered.cells)
+
+	msg := "carrier rejected message"
+	failed := alertView.entry(api.Alert{
+		ID: "5e6f7a8b-0000", AlertType: api.AlertSMS, ResourceID: "9c8d7e6f-1111", ErrorMessage: &msg,
+	})
+	assert.Equal(t, historyFailed, failed.outcome)
+	assert.Equal(t, "9c8d7e6f", failed.cells[4])
+	assert.Equal(t, msg, failed.cells[6])
+}
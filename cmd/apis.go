@@ -2,11 +2,26 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/curl"
+	"github.com/scookdev/groovekit-cli/internal/diff"
+	"github.com/scookdev/groovekit-cli/internal/har"
+	"github.com/scookdev/groovekit-cli/internal/jsonschema"
+	"github.com/scookdev/groovekit-cli/internal/openapi"
 	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/probe"
 	"github.com/spf13/cobra"
 )
 
@@ -22,21 +37,31 @@ var apisListCmd = &cobra.Command{
 	Short: "List all api monitors",
 	Long:  "List all API endpoint monitors for your account",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		filter := getListFilter(cmd)
+		if err := filter.resolveProject(client); err != nil {
+			return err
+		}
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
-		result, err := client.ListApis()
+		list := client.ListApis
+		if filter.all {
+			list = client.ListAllApis
+		}
+		result, err := list(filter.options())
 
 		if s != nil {
 			s.Stop()
@@ -45,51 +70,83 @@ var apisListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to list API monitors: %w", err)
 		}
+
+		// Filter client-side too, in case the API ignored any of the options
+		if filter.active() {
+			result.APIMonitors = filterItems(result.APIMonitors, func(monitor api.ApiMonitor) bool {
+				return filter.match(monitor.Status, monitor.Name, monitor.Down, monitor.Tags, monitor.ProjectID)
+			})
+		}
+
+		result.APIMonitors = limitItems(result.APIMonitors, filter.limit)
+
 		if jsonOutput {
-			return outputJSON(result)
+			return cmdutil.OutputJSON(out, result)
 		}
 
 		if len(result.APIMonitors) == 0 {
-			output.InfoMessage("No API monitors found")
-			fmt.Println("\nCreate your first API monitor:")
-			fmt.Println("  groovekit apis create --name 'Production API' --url https://api.example.com/health --interval 60")
+			if filter.active() {
+				output.InfoMessage(out, filter.emptyMessage("API monitors"))
+				return nil
+			}
+			output.InfoMessage(out, "No API monitors found")
+			fmt.Fprintln(out, "\nCreate your first API monitor:")
+			fmt.Fprintln(out, "  groovekit apis create --name 'Production API' --url https://api.example.com/health --interval 60")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"ID", "NAME", "URL", "INTERVAL", "STATUS", "HEALTH"})
+		table, err := newListTable(cmd, []string{"ID", "NAME", "URL", "INTERVAL", "REGIONS", "STATUS", "HEALTH", "UPTIME", "RESPONSE TIME", "METHOD", "EXPECTED STATUS", "TIMEOUT", "MAX RESPONSE", "CHECK TOKEN"},
+			"uptime", "response-time", "method", "expected-status", "timeout", "max-response", "check-token")
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
 		for _, monitor := range result.APIMonitors {
-			status := monitor.Status
-			if monitor.Status == "active" {
-				status = output.Green(status)
-			}
+			status := formatListStatus(monitor.Status, monitor.SnoozedUntil, time.Now())
 
 			health := output.Green("✓ Up")
 			if monitor.Down {
 				health = output.Red("✗ Down")
 			}
 
-			// Truncate ID to first 8 chars (like Docker)
-			shortID := monitor.ID
-			if len(shortID) > 8 {
-				shortID = shortID[:8]
+			shortID := cmdutil.ShortID(monitor.ID)
+
+			uptime := "-"
+			if monitor.UptimePercentage != nil {
+				uptime = fmt.Sprintf("%.2f%%", *monitor.UptimePercentage)
+			}
+			responseTime := "-"
+			if monitor.AverageResponseTime != nil {
+				responseTime = fmt.Sprintf("%.0fms", *monitor.AverageResponseTime)
 			}
 
-			table.Append([]string{
+			// Sort intervals by minutes rather than their display text
+			table.AppendWithValues([]string{
 				output.Cyan(shortID),
 				monitor.Name,
-				truncate(monitor.URL, 40),
+				monitor.URL,
 				output.FormatDuration(monitor.Interval),
+				formatRegions(monitor.Regions),
 				status,
 				health,
-			})
+				uptime,
+				responseTime,
+				monitor.HTTPMethod,
+				formatStatusCodes(monitor.ExpectedStatusCodes),
+				fmt.Sprintf("%ds", monitor.Timeout),
+				formatMaxResponseTime(monitor.MaxResponseTime),
+				valueOrDash(monitor.APICheckToken),
+			}, []interface{}{nil, nil, nil, monitor.Interval, nil, nil, nil, monitor.UptimePercentage, monitor.AverageResponseTime, nil, nil, monitor.Timeout, monitor.MaxResponseTime})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d API monitor(s)", len(result.APIMonitors))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d API monitor(s)", len(result.APIMonitors)))
+		if result.HasMore && !filter.all {
+			output.InfoMessage(out, "More results are available, use --all to fetch every page")
+		}
 		return nil
 	},
 }
@@ -98,16 +155,21 @@ var apisListCmd = &cobra.Command{
 var apisShowCmd = &cobra.Command{
 	Use:   "show <id>",
 	Short: "Show API monitor details",
-	Long:  "Display detailed information about a specific API monitor",
-	Args:  cobra.ExactArgs(1),
+	Long: `Display detailed information about a specific API monitor.
+
+Request header values often carry credentials, so they are shown as
+[redacted] unless --show-secrets is passed, in JSON output as well.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -117,7 +179,7 @@ var apisShowCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -130,43 +192,74 @@ var apisShowCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to get API monitor: %w", err)
 		}
+		showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+		headers := monitorHeaders(monitor.Headers)
+		if !showSecrets && headers != nil {
+			headers = redactHeaders(headers)
+			monitor.Headers = headers
+		}
+
 		if jsonOutput {
-			return outputJSON(monitor)
+			return cmdutil.OutputJSON(out, monitor)
 		}
 
 		// Print monitor details
-		fmt.Printf("ID:               %s\n", output.Cyan(monitor.ID))
-		fmt.Printf("Name:             %s\n", output.Bold(monitor.Name))
-		fmt.Printf("URL:              %s\n", monitor.URL)
-		fmt.Printf("HTTP Method:      %s\n", monitor.HTTPMethod)
-		fmt.Printf("Status:           %s\n", monitor.Status)
-		fmt.Printf("Interval:         %s\n", output.FormatDuration(monitor.Interval))
-		fmt.Printf("Timeout:          %d seconds\n", monitor.Timeout)
-		fmt.Printf("Grace Period:     %s\n", output.FormatDuration(monitor.GracePeriod))
-		fmt.Printf("Down:             %t\n", monitor.Down)
+		fmt.Fprintf(out, "ID:               %s\n", output.Cyan(monitor.ID))
+		fmt.Fprintf(out, "Name:             %s\n", output.Bold(monitor.Name))
+		fmt.Fprintf(out, "URL:              %s\n", monitor.URL)
+		fmt.Fprintf(out, "HTTP Method:      %s\n", monitor.HTTPMethod)
+		fmt.Fprintf(out, "Status:           %s\n", monitor.Status)
+		if snoozed, ok := formatSnoozedUntil(monitor.SnoozedUntil, time.Now()); ok {
+			fmt.Fprintf(out, "Snoozed Until:    %s\n", output.Yellow(snoozed))
+		}
+		fmt.Fprintf(out, "Interval:         %s\n", output.FormatDuration(monitor.Interval))
+		fmt.Fprintf(out, "Regions:          %s\n", formatRegions(monitor.Regions))
+		fmt.Fprintf(out, "Timeout:          %d seconds\n", monitor.Timeout)
+		fmt.Fprintf(out, "Max Response:     %s\n", formatMaxResponseTime(monitor.MaxResponseTime))
+		fmt.Fprintf(out, "Grace Period:     %s\n", output.FormatDuration(monitor.GracePeriod))
+		fmt.Fprintf(out, "Alerts:           %s\n", formatEscalation(monitor.AlertAfter, monitor.RealertEvery))
+		fmt.Fprintf(out, "Down:             %t\n", monitor.Down)
+		fmt.Fprintf(out, "Tags:             %s\n", formatTags(monitor.Tags))
+		fmt.Fprintf(out, "Notifications:    %s\n", formatChannels(monitor.ChannelIDs))
 
 		if len(monitor.ExpectedStatusCodes) > 0 {
-			fmt.Printf("Expected Status:  %v\n", monitor.ExpectedStatusCodes)
+			fmt.Fprintf(out, "Expected Status:  %v\n", monitor.ExpectedStatusCodes)
 		}
 
 		if monitor.LastCheckAt != nil {
-			fmt.Printf("Last Check:       %s\n", *monitor.LastCheckAt)
+			fmt.Fprintf(out, "Last Check:       %s\n", output.FormatTime(*monitor.LastCheckAt))
 		} else {
-			fmt.Printf("Last Check:       Never\n")
+			fmt.Fprintf(out, "Last Check:       Never\n")
 		}
 
 		if monitor.UptimePercentage != nil {
-			fmt.Printf("Uptime (30d):     %.2f%%\n", *monitor.UptimePercentage)
+			fmt.Fprintf(out, "Uptime (30d):     %.2f%%\n", *monitor.UptimePercentage)
 		}
 
 		if monitor.AverageResponseTime != nil {
-			fmt.Printf("Avg Response:     %.0fms\n", *monitor.AverageResponseTime)
+			fmt.Fprintf(out, "Avg Response:     %.0fms\n", *monitor.AverageResponseTime)
+		}
+
+		if len(headers) > 0 {
+			fmt.Fprintf(out, "\nHeaders:\n")
+			for _, name := range slices.Sorted(maps.Keys(headers)) {
+				fmt.Fprintf(out, "  %s: %s\n", name, headers[name])
+			}
+		} else if monitor.HasAuthHeaders {
+			fmt.Fprintf(out, "\nHeaders:          auth headers set (values not returned by the API)\n")
 		}
 
 		if len(monitor.ValidateResponsePaths) > 0 {
-			fmt.Printf("\nJSON Path Validation:\n")
+			fmt.Fprintf(out, "\nJSON Path Validation:\n")
 			for _, path := range monitor.ValidateResponsePaths {
-				fmt.Printf("  - %s\n", path)
+				fmt.Fprintf(out, "  - %s\n", path)
+			}
+		}
+
+		if assertions := formatAssertions(monitor); len(assertions) > 0 {
+			fmt.Fprintf(out, "\nBody Assertions:\n")
+			for _, assertion := range assertions {
+				fmt.Fprintf(out, "  - %s\n", assertion)
 			}
 		}
 
@@ -174,31 +267,89 @@ var apisShowCmd = &cobra.Command{
 	},
 }
 
+// httpMethods are offered by the apis create wizard
+var httpMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// apisCreateWizard prompts for the common apis create settings
+var apisCreateWizard = []wizardField{
+	{flag: "url", prompt: "URL to monitor", required: true, validate: validateMonitorURL},
+	{flag: "name", prompt: "Monitor name", required: true, def: defaultToHost("url")},
+	{flag: "method", prompt: "HTTP method", options: httpMethods},
+	{flag: "body", prompt: "Request body", skip: func(cmd *cobra.Command) bool {
+		method, _ := cmd.Flags().GetString("method")
+		return method == "GET" || method == "HEAD"
+	}},
+	{flag: "header", prompt: `Request header as "Name: value"`, repeat: true, validate: func(answer string) error {
+		if name, _, ok := strings.Cut(answer, ":"); !ok || strings.TrimSpace(name) == "" {
+			return fmt.Errorf(`use "Name: value"`)
+		}
+		return nil
+	}},
+	{flag: "interval", prompt: "Check interval"},
+	{flag: "timeout", prompt: "Request timeout (blank for the API default)"},
+	{flag: "expected-status-codes", prompt: "Expected status codes, comma-separated (blank for the API default)"},
+	tagsWizardField,
+}
+
 // apis create
 var apisCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new API monitor",
-	Long:  "Create a new API endpoint monitor",
+	Long: `Create a new API endpoint monitor.
+
+Besides the URL and method, a monitor can send headers and a request body,
+and check the response's status code, JSON paths, and JSON schema. Body
+assertions check the response content: --assert-contains for a substring,
+--assert-regex for a regular expression, and --assert-jsonpath path=value
+for a JSON value. Checks run from the default regions unless --regions
+picks others; list them with "groovekit regions list". With
+--max-response-time, responses slower than it count as failing even when
+the status code is fine, and open a "slow" incident.
+Try the settings first with "groovekit apis test".
+
+--from-curl takes a curl command, e.g. from a browser's "Copy as cURL", and
+uses its method, URL, headers, body, and --max-time for the flags that
+aren't given. The name defaults to the URL's host and path.
+
+Examples:
+  groovekit apis create --name "Health" --url https://api.example.com/health --interval 5m
+  groovekit apis create --from-curl 'curl -H "Authorization: Bearer x" https://api.example.com/health'
+  groovekit apis create --name "Orders API" --url https://api.example.com/v1/orders \
+    --method POST --header "Authorization: Bearer $TOKEN" --body '{"dry_run":true}' \
+    --expected-status-codes 200,201 --timeout 10s --validate-path data.id \
+    --json-schema-file order.schema.json
+  groovekit apis create --name "Status" --url https://api.example.com/status \
+    --assert-contains '"healthy"' --assert-jsonpath status=ok
+  groovekit apis create --name "Checkout" --url https://shop.example.com/health --regions us-east,eu-west`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
+		if err := applyFromCurl(cmd); err != nil {
+			return err
+		}
+		if err := runCreateWizard(cmd, apisCreateWizard); err != nil {
+			return err
+		}
+
 		// Get flag values
 		name, _ := cmd.Flags().GetString("name")
 		url, _ := cmd.Flags().GetString("url")
-		interval, _ := cmd.Flags().GetInt("interval")
+		interval := getDurationFlag(cmd, "interval")
 		method, _ := cmd.Flags().GetString("method")
 
 		if name == "" {
-			return fmt.Errorf("--name is required")
+			return usageErrorf("--name is required")
 		}
 		if url == "" {
-			return fmt.Errorf("--url is required")
+			return usageErrorf("--url is required")
 		}
 		if interval <= 0 {
-			return fmt.Errorf("--interval must be greater than 0")
+			return usageErrorf("--interval must be greater than 0")
 		}
 
 		req := &api.CreateApiRequest{
@@ -206,393 +357,1753 @@ var apisCreateCmd = &cobra.Command{
 			URL:        url,
 			Interval:   interval,
 			HTTPMethod: method,
+			Timeout:    getDurationFlag(cmd, "timeout"),
 		}
+		req.MaxResponseTime = getDurationFlag(cmd, "max-response-time")
+		req.RequestBody, _ = cmd.Flags().GetString("body")
+		req.ExpectedStatusCodes, _ = cmd.Flags().GetIntSlice("expected-status-codes")
+		req.ValidateResponsePaths, _ = cmd.Flags().GetStringSlice("validate-path")
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		monitor, err := client.CreateApi(req)
-		s.Stop()
-
-		if err != nil {
-			return fmt.Errorf("failed to create API monitor: %w", err)
-		}
-
-		output.SuccessMessage("API monitor created successfully\n")
-		fmt.Printf("ID:          %s\n", output.Cyan(monitor.ID))
-		fmt.Printf("Name:        %s\n", output.Bold(monitor.Name))
-		fmt.Printf("URL:         %s\n", monitor.URL)
-		fmt.Printf("Interval:    %s\n", fmt.Sprintf("%d minutes", monitor.Interval))
-
-		return nil
-	},
-}
-
-// apis update <id>
-var apisUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
-	Short: "Update an API monitor",
-	Long:  "Update an existing API endpoint monitor",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
+		if req.Headers, err = getHeaders(cmd); err != nil {
 			return err
 		}
 
-		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
-		if err != nil {
+		req.Regions = getRegions(cmd)
+		req.AssertContains, _ = cmd.Flags().GetStringArray("assert-contains")
+		if req.AssertRegex, err = getAssertRegex(cmd); err != nil {
 			return err
 		}
-
-		// Build update request with only provided flags
-		req := &api.UpdateApiRequest{}
-		hasUpdates := false
-
-		if cmd.Flags().Changed("name") {
-			name, _ := cmd.Flags().GetString("name")
-			req.Name = &name
-			hasUpdates = true
-		}
-
-		if cmd.Flags().Changed("url") {
-			url, _ := cmd.Flags().GetString("url")
-			req.URL = &url
-			hasUpdates = true
+		if req.AssertJSONPaths, err = getPathAssertions(cmd); err != nil {
+			return err
 		}
 
-		if cmd.Flags().Changed("http-method") {
-			method, _ := cmd.Flags().GetString("http-method")
-			req.HTTPMethod = &method
-			hasUpdates = true
+		if schemaFile, _ := cmd.Flags().GetString("json-schema-file"); schemaFile != "" {
+			if req.JSONSchema, _, err = readJSONSchema(schemaFile); err != nil {
+				return err
+			}
 		}
 
-		if cmd.Flags().Changed("interval") {
-			interval, _ := cmd.Flags().GetInt("interval")
-			req.Interval = &interval
-			hasUpdates = true
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
 		}
-
-		if cmd.Flags().Changed("timeout") {
-			timeout, _ := cmd.Flags().GetInt("timeout")
-			req.Timeout = &timeout
-			hasUpdates = true
+		if alertAfter != nil {
+			req.AlertAfter = *alertAfter
 		}
-
-		if cmd.Flags().Changed("grace-period") {
-			gracePeriod, _ := cmd.Flags().GetInt("grace-period")
-			req.GracePeriod = &gracePeriod
-			hasUpdates = true
+		if realertEvery != nil {
+			req.RealertEvery = *realertEvery
 		}
 
-		if cmd.Flags().Changed("status") {
-			status, _ := cmd.Flags().GetString("status")
-			req.Status = &status
-			hasUpdates = true
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
 		}
+		req.Tags = tags
 
-		if cmd.Flags().Changed("expected-status-codes") {
-			codes, _ := cmd.Flags().GetIntSlice("expected-status-codes")
-			req.ExpectedStatusCodes = &codes
-			hasUpdates = true
+		if req.ProjectID, err = getProject(cmd, client); err != nil {
+			return err
 		}
 
-		if !hasUpdates {
-			return fmt.Errorf("no fields to update. Use --name, --url, --http-method, --interval, --timeout, --grace-period, --status, or --expected-status-codes")
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
-		monitor, err := client.UpdateApi(fullID, req)
+		monitor, err := client.CreateApi(req)
 		s.Stop()
 
 		if err != nil {
-			return fmt.Errorf("failed to update API monitor: %w", err)
+			return fmt.Errorf("failed to create API monitor: %w", err)
 		}
 
-		output.SuccessMessage("API monitor updated successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(monitor.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(monitor.Name))
-		fmt.Printf("URL:      %s\n", monitor.URL)
-		fmt.Printf("Method:   %s\n", monitor.HTTPMethod)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(monitor.Interval))
-		fmt.Printf("Status:   %s\n", monitor.Status)
+		output.SuccessMessage(out, "API monitor created successfully\n")
+		fmt.Fprintf(out, "ID:          %s\n", output.Cyan(monitor.ID))
+		fmt.Fprintf(out, "Name:        %s\n", output.Bold(monitor.Name))
+		fmt.Fprintf(out, "URL:         %s\n", monitor.URL)
+		fmt.Fprintf(out, "Method:      %s\n", monitor.HTTPMethod)
+		fmt.Fprintf(out, "Interval:    %s\n", fmt.Sprintf("%d minutes", monitor.Interval))
 
 		return nil
 	},
 }
 
-// apis pause <id>
-var apisPauseCmd = &cobra.Command{
-	Use:   "pause <id>",
-	Short: "Pause an API monitor",
-	Long:  "Pause an API endpoint monitor (sets status to paused)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+// apis clone <id>
+var apisCloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of an API monitor",
+	Long: `Create a new API endpoint monitor with the same request, checks, and schedule
+as an existing one, optionally pointed at another URL.` + cloneLongHelp + `
+
+Auth headers aren't returned by the API, so they can't be copied; set them
+on the copy with "groovekit apis update <id> --header".
+
+Examples:
+  groovekit apis clone abc12345 --name "Staging copy" --url https://staging.example.com/health
+  groovekit apis clone abc12345 --tag env=staging`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
 
-		// Update status to paused
-		status := "paused"
-		req := &api.UpdateApiRequest{Status: &status}
-
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
-		_, err = client.UpdateApi(fullID, req)
+		monitor, err := client.GetApi(fullID)
 		s.Stop()
 
 		if err != nil {
-			return fmt.Errorf("failed to pause API monitor: %w", err)
+			return fmt.Errorf("failed to get API monitor: %w", err)
 		}
 
-		output.SuccessMessage(fmt.Sprintf("API monitor %s paused successfully", args[0]))
-		return nil
-	},
-}
-
-// apis resume <id>
-var apisResumeCmd = &cobra.Command{
-	Use:   "resume <id>",
-	Short: "Resume an API monitor",
-	Long:  "Resume a paused API endpoint monitor (sets status to active)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		tags, err := cloneTags(cmd, monitor.Tags)
 		if err != nil {
 			return err
 		}
 
-		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
-		if err != nil {
-			return err
+		req := &api.CreateApiRequest{
+			Name:                  cloneName(cmd, monitor.Name),
+			URL:                   monitor.URL,
+			HTTPMethod:            monitor.HTTPMethod,
+			Headers:               monitorHeaders(monitor.Headers),
+			Interval:              monitor.Interval,
+			ExpectedStatusCodes:   monitor.ExpectedStatusCodes,
+			Timeout:               monitor.Timeout,
+			GracePeriod:           monitor.GracePeriod,
+			AlertAfter:            monitor.AlertAfter,
+			RealertEvery:          monitor.RealertEvery,
+			Status:                monitor.Status,
+			ValidateResponsePaths: monitor.ValidateResponsePaths,
+			AssertContains:        monitor.AssertContains,
+			AssertRegex:           monitor.AssertRegex,
+			AssertJSONPaths:       monitor.AssertJSONPaths,
+			Regions:               monitor.Regions,
+			MaxResponseTime:       monitor.MaxResponseTime,
+			Tags:                  tags,
+			ProjectID:             monitor.ProjectID,
+		}
+		if url, _ := cmd.Flags().GetString("url"); url != "" {
+			req.URL = url
+		}
+		if monitor.RequestBody != nil {
+			req.RequestBody = *monitor.RequestBody
+		}
+		if monitor.JSONSchema != nil {
+			req.JSONSchema = *monitor.JSONSchema
 		}
 
-		// Update status to active
-		status := "active"
-		req := &api.UpdateApiRequest{Status: &status}
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s = newSpinner(cmd)
 		s.Start()
-		_, err = client.UpdateApi(fullID, req)
+		clone, err := client.CreateApi(req)
 		s.Stop()
 
 		if err != nil {
-			return fmt.Errorf("failed to resume API monitor: %w", err)
+			return fmt.Errorf("failed to create API monitor: %w", err)
 		}
 
-		output.SuccessMessage(fmt.Sprintf("API monitor %s resumed successfully", args[0]))
+		if err := finishClone(cmd, client, apisNotifyTarget, monitor.Name, clone.Name, clone.ID, monitor.ChannelIDs); err != nil {
+			return err
+		}
+		if monitor.HasAuthHeaders && len(req.Headers) == 0 {
+			output.InfoMessage(out, fmt.Sprintf("Auth headers weren't copied; set them with: groovekit apis update %s --header \"Name: value\"", cmdutil.ShortID(clone.ID)))
+		}
 		return nil
 	},
 }
 
-// apis incidents <id>
-var apisIncidentsCmd = &cobra.Command{
-	Use:   "incidents <id>",
-	Short: "Show incident history",
-	Long:  "Display incident history (downtime periods) for an API monitor",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+// apis generate --openapi <file>
+var apisGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Create API monitors from an OpenAPI spec",
+	Long: `Read an OpenAPI 3 or Swagger 2 document and create an API monitor for each
+selected GET endpoint, expecting the 2xx status codes the spec documents.
+
+Endpoints with path parameters or required query parameters are skipped, as
+are URLs that are already monitored. You're asked which endpoints to
+monitor, with health and status endpoints suggested; --paths selects
+endpoints by path or glob instead, and --yes takes the suggestions without
+asking.
+
+Examples:
+  groovekit apis generate --openapi openapi.yaml
+  groovekit apis generate --openapi openapi.yaml --paths /health,/v1/status --interval 5
+  groovekit apis generate --openapi swagger.json --base-url https://staging.example.com --yes --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		file, _ := cmd.Flags().GetString("openapi")
+		baseURL, _ := cmd.Flags().GetString("base-url")
+		paths, _ := cmd.Flags().GetStringSlice("paths")
+		interval := getDurationFlag(cmd, "interval")
+		yes, _ := cmd.Flags().GetBool("yes")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if file == "" {
+			return usageErrorf("--openapi is required")
+		}
+		if interval <= 0 {
+			return usageErrorf("--interval must be greater than 0")
+		}
+		tags, err := getTags(cmd)
 		if err != nil {
 			return err
 		}
 
-		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read OpenAPI document: %w", err)
+		}
+		doc, err := openapi.Parse(data)
 		if err != nil {
 			return err
 		}
 
-		// Check for --json flag
-		jsonOutput, _ := cmd.Flags().GetBool("json")
-
-		var s *spinner.Spinner
-		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-			s.Start()
+		if baseURL == "" {
+			baseURL = doc.BaseURL()
+		}
+		baseURL = strings.TrimSuffix(baseURL, "/")
+		if !strings.HasPrefix(baseURL, "http://") && !strings.HasPrefix(baseURL, "https://") {
+			return fmt.Errorf("the spec has no absolute server URL; pass --base-url, e.g. --base-url https://api.example.com")
 		}
 
-		incidents, err := client.ListApiIncidents(fullID)
-
-		if s != nil {
-			s.Stop()
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
 		}
 
+		s := newSpinner(cmd)
+		s.Start()
+		items, err := resolverFor(client, apisBulkTarget).Items()
+		s.Stop()
+
 		if err != nil {
-			return fmt.Errorf("failed to get incidents: %w", err)
+			return err
 		}
 
-		if jsonOutput {
-			return outputJSON(incidents)
+		existing := map[string]bool{}
+		for _, item := range items {
+			existing[item.fields["url"]] = true
 		}
 
-		if len(incidents) == 0 {
-			output.InfoMessage("No incidents found - this API monitor has been running smoothly!")
+		monitors, needsInput, monitored := generateMonitors(doc, baseURL, existing)
+		if needsInput > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s with path or required query parameters", countNoun(needsInput, "endpoint", "endpoints")))
+		}
+		if monitored > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s already monitored", countNoun(monitored, "endpoint", "endpoints")))
+		}
+		if len(monitors) == 0 {
+			output.InfoMessage(out, "No GET endpoints to monitor")
 			return nil
 		}
 
-		// Create table
-		table := output.NewTable([]string{"STARTED", "ENDED", "DURATION", "STATUS", "ERROR"})
-		table.Render()
+		switch {
+		case len(paths) > 0:
+			monitors, err = filterMonitorPaths(monitors, paths)
+		case yes:
+			monitors = suggestedMonitors(monitors)
+		default:
+			monitors, err = promptMonitors(cmd, monitors)
+		}
+		if err != nil {
+			return err
+		}
 
-		// Add rows
-		for _, incident := range incidents {
-			status := output.Red("Ongoing")
-			ended := output.Yellow("Still down")
+		if len(monitors) == 0 {
+			output.InfoMessage(out, "No endpoints selected")
+			return nil
+		}
 
-			if incident.EndedAt != nil {
-				status = output.Green("Recovered")
-				ended = *incident.EndedAt
+		if dryRun {
+			fmt.Fprintf(out, "Would create %s:\n", countNoun(len(monitors), "API monitor", "API monitors"))
+			for _, m := range monitors {
+				fmt.Fprintf(out, "  %s  GET %s\n", m.name, m.url)
 			}
+			return nil
+		}
 
-			// Format duration
-			duration := formatIncidentDuration(incident.Duration)
+		if err := preflightLimit(cmd, client, limitMonitors, len(monitors)); err != nil {
+			return err
+		}
 
-			// Truncate error message
-			errorMsg := "-"
-			if incident.ErrorMessage != nil {
-				errorMsg = truncate(*incident.ErrorMessage, 40)
-			}
+		paceBulk(cmd, client)
 
-			table.Append([]string{
-				incident.StartedAt,
-				ended,
-				duration,
-				status,
-				errorMsg,
+		failed := 0
+		for _, m := range monitors {
+			s := newSpinner(cmd)
+			s.Start()
+			monitor, err := client.CreateApi(&api.CreateApiRequest{
+				Name:                m.name,
+				URL:                 m.url,
+				HTTPMethod:          "GET",
+				Interval:            interval,
+				ExpectedStatusCodes: m.endpoint.StatusCodes,
+				Tags:                tags,
 			})
+			s.Stop()
+
+			if err != nil {
+				failed++
+				output.ErrorMessage(out, fmt.Sprintf("Failed to create API monitor for %s: %v", m.url, err))
+				continue
+			}
+			output.SuccessMessage(out, fmt.Sprintf("Created API monitor %s (%s) for %s", m.name, cmdutil.ShortID(monitor.ID), m.url))
 		}
 
-		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d incident(s)", len(incidents))))
+		if failed > 0 {
+			return fmt.Errorf("failed to create %d of %s", failed, countNoun(len(monitors), "API monitor", "API monitors"))
+		}
 		return nil
 	},
 }
 
-// apis delete <id>
-var apisDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete an API monitor",
-	Long:  "Delete an API endpoint monitor",
-	Args:  cobra.ExactArgs(1),
+// apis import-har <file>
+var apisImportHARCmd = &cobra.Command{
+	Use:   "import-har <file>",
+	Short: "Create API monitors from a browser HAR export",
+	Long: `Read a HAR file exported from a browser's developer tools (Network tab →
+"Save all as HAR") and create an API monitor for each selected request,
+replaying its method, headers, and body, and expecting the status the
+browser saw when it was a success or redirect.
+
+Images, scripts, stylesheets, and other page assets are skipped unless
+--include-assets is set, as are repeated requests and URLs that are already
+monitored. You're asked which requests to monitor; --select picks them by
+number instead. Headers the browser or HTTP client manages, such as Host,
+Content-Length, and Sec-Fetch-*, aren't copied.
+
+HAR files can hold cookies and tokens, which are copied into the monitors'
+headers; check the list before creating them.
+
+Examples:
+  groovekit apis import-har requests.har
+  groovekit apis import-har requests.har --select 1,3-5 --interval 5
+  groovekit apis import-har requests.har --include-assets --dry-run`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		selection, _ := cmd.Flags().GetString("select")
+		includeAssets, _ := cmd.Flags().GetBool("include-assets")
+		interval := getDurationFlag(cmd, "interval")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if interval <= 0 {
+			return usageErrorf("--interval must be greater than 0")
+		}
+		tags, err := getTags(cmd)
 		if err != nil {
 			return err
 		}
 
-		// Resolve short ID to full ID
-		fullID, err := resolveMonitorID(client, args[0])
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read HAR file: %w", err)
+		}
+		log, err := har.Parse(data)
 		if err != nil {
 			return err
 		}
 
-		// Confirm deletion
-		confirm, _ := cmd.Flags().GetBool("force")
-		if !confirm {
-			fmt.Printf("Are you sure you want to delete API monitor %s? (y/N): ", args[0])
-			var response string
-			_, _ = fmt.Scanln(&response)
-			if response != "y" && response != "Y" {
-				fmt.Println("Cancelled")
-				return nil
-			}
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
-		err = client.DeleteApi(fullID)
+		items, err := resolverFor(client, apisBulkTarget).Items()
 		s.Stop()
 
 		if err != nil {
-			return fmt.Errorf("failed to delete API monitor: %w", err)
+			return err
 		}
 
-		output.SuccessMessage(fmt.Sprintf("API monitor %s deleted successfully", args[0]))
-		return nil
-	},
-}
+		existing := map[string]bool{}
+		for _, item := range items {
+			existing[item.fields["url"]] = true
+		}
 
-// Helper function to resolve a short monitor ID to a full ID
-func resolveMonitorID(client *api.Client, shortID string) (string, error) {
-	// If it looks like a full UUID, use it as-is
-	if len(shortID) >= 32 {
-		return shortID, nil
-	}
+		monitors, skipped := harMonitors(log, includeAssets, existing)
+		if skipped.assets > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s for page assets (use --include-assets to list them)", countNoun(skipped.assets, "request", "requests")))
+		}
+		if skipped.unsupported > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s to non-HTTP URLs", countNoun(skipped.unsupported, "request", "requests")))
+		}
+		if skipped.duplicates > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s", countNoun(skipped.duplicates, "repeated request", "repeated requests")))
+		}
+		if skipped.monitored > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s already monitored", countNoun(skipped.monitored, "request", "requests")))
+		}
+		if len(monitors) == 0 {
+			output.InfoMessage(out, "No requests to monitor")
+			return nil
+		}
 
-	// Otherwise, fetch all monitors and match by prefix
-	result, err := client.ListApis()
-	if err != nil {
-		return "", fmt.Errorf("failed to list API monitors: %w", err)
-	}
+		if selection != "" {
+			monitors, err = selectHARMonitors(monitors, selection)
+			if err != nil {
+				err = usageErrorf("--select: %w", err)
+			}
+		} else {
+			monitors, err = promptHARMonitors(cmd, monitors)
+		}
+		if err != nil {
+			return err
+		}
 
-	var matches []string
-	for _, monitor := range result.APIMonitors {
-		if len(monitor.ID) >= len(shortID) && monitor.ID[:len(shortID)] == shortID {
-			matches = append(matches, monitor.ID)
+		if len(monitors) == 0 {
+			output.InfoMessage(out, "No requests selected")
+			return nil
 		}
-	}
 
-	if len(matches) == 0 {
-		return "", fmt.Errorf("no API monitor found with ID prefix '%s'", shortID)
-	}
+		if dryRun {
+			fmt.Fprintf(out, "Would create %s:\n", countNoun(len(monitors), "API monitor", "API monitors"))
+			for _, m := range monitors {
+				fmt.Fprintf(out, "  %s  %s %s%s\n", m.name, m.method, m.url, describeHARMonitor(m))
+			}
+			return nil
+		}
 
-	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID prefix '%s' matches multiple API monitors", shortID)
-	}
+		if err := preflightLimit(cmd, client, limitMonitors, len(monitors)); err != nil {
+			return err
+		}
+
+		paceBulk(cmd, client)
 
-	return matches[0], nil
+		failed := 0
+		for _, m := range monitors {
+			s := newSpinner(cmd)
+			s.Start()
+			monitor, err := client.CreateApi(&api.CreateApiRequest{
+				Name:                m.name,
+				URL:                 m.url,
+				HTTPMethod:          m.method,
+				Headers:             m.headers,
+				RequestBody:         m.body,
+				Interval:            interval,
+				ExpectedStatusCodes: m.expectedStatusCodes(),
+				Tags:                tags,
+			})
+			s.Stop()
+
+			if err != nil {
+				failed++
+				output.ErrorMessage(out, fmt.Sprintf("Failed to create API monitor for %s %s: %v", m.method, m.url, err))
+				continue
+			}
+			output.SuccessMessage(out, fmt.Sprintf("Created API monitor %s (%s) for %s %s", m.name, cmdutil.ShortID(monitor.ID), m.method, m.url))
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to create %d of %s", failed, countNoun(len(monitors), "API monitor", "API monitors"))
+		}
+		return nil
+	},
+}
+
+// apis update <id>
+var apisUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update an API monitor",
+	Long:  "Update an existing API endpoint monitor",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		// Build update request with only provided flags
+		req := &api.UpdateApiRequest{}
+		hasUpdates := false
+
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			req.Name = &name
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("url") {
+			url, _ := cmd.Flags().GetString("url")
+			req.URL = &url
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("http-method") {
+			method, _ := cmd.Flags().GetString("http-method")
+			req.HTTPMethod = &method
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("header") || cmd.Flags().Changed("clear-headers") {
+			headers, err := getHeaders(cmd)
+			if err != nil {
+				return err
+			}
+			if headers == nil {
+				headers = map[string]string{}
+			}
+			req.Headers = &headers
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("interval") {
+			interval := getDurationFlag(cmd, "interval")
+			req.Interval = &interval
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("timeout") {
+			timeout := getDurationFlag(cmd, "timeout")
+			req.Timeout = &timeout
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("grace-period") {
+			gracePeriod := getDurationFlag(cmd, "grace-period")
+			req.GracePeriod = &gracePeriod
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("status") {
+			status, _ := cmd.Flags().GetString("status")
+			req.Status = &status
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("expected-status-codes") {
+			codes, _ := cmd.Flags().GetIntSlice("expected-status-codes")
+			req.ExpectedStatusCodes = &codes
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("max-response-time") {
+			maxResponseTime := getDurationFlag(cmd, "max-response-time")
+			req.MaxResponseTime = &maxResponseTime
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("regions") {
+			regions := getRegions(cmd)
+			req.Regions = &regions
+			hasUpdates = true
+		}
+
+		if clearAssertions, _ := cmd.Flags().GetBool("clear-assertions"); clearAssertions {
+			req.AssertContains = &[]string{}
+			req.AssertRegex = &[]string{}
+			req.AssertJSONPaths = &[]api.PathAssertion{}
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("assert-contains") {
+			contains, _ := cmd.Flags().GetStringArray("assert-contains")
+			req.AssertContains = &contains
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("assert-regex") {
+			regexes, err := getAssertRegex(cmd)
+			if err != nil {
+				return err
+			}
+			req.AssertRegex = &regexes
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("assert-jsonpath") {
+			paths, err := getPathAssertions(cmd)
+			if err != nil {
+				return err
+			}
+			req.AssertJSONPaths = &paths
+			hasUpdates = true
+		}
+
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil || realertEvery != nil {
+			req.AlertAfter, req.RealertEvery = alertAfter, realertEvery
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("tag") {
+			tags, err := getTags(cmd)
+			if err != nil {
+				return err
+			}
+			req.Tags = &tags
+			hasUpdates = true
+		}
+
+		if !hasUpdates {
+			return fmt.Errorf("no fields to update. Use --name, --url, --http-method, --header, --clear-headers, --interval, --timeout, --grace-period, --status, --expected-status-codes, --max-response-time, --regions, --assert-contains, --assert-regex, --assert-jsonpath, --clear-assertions, --alert-after, --realert-every, or --tag")
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		monitor, err := client.UpdateApi(fullID, req)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to update API monitor: %w", err)
+		}
+
+		output.SuccessMessage(out, "API monitor updated successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(monitor.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(monitor.Name))
+		fmt.Fprintf(out, "URL:      %s\n", monitor.URL)
+		fmt.Fprintf(out, "Method:   %s\n", monitor.HTTPMethod)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(monitor.Interval))
+		fmt.Fprintf(out, "Status:   %s\n", monitor.Status)
+
+		return nil
+	},
+}
+
+// apis pause [id...]
+var apisPauseCmd = &cobra.Command{
+	Use:   "pause [id...]",
+	Short: "Pause one or more API monitors",
+	Long: `Pause one or more API endpoint monitors (sets status to paused).` + bulkLongHelp + `
+
+Examples:
+  groovekit apis pause abc12345 def67890
+  groovekit apis pause --tag env=staging --dry-run
+  groovekit apis pause --match 'name~staging'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, apisBulkTarget, statusAction(apisBulkTarget, "paused"))
+	},
+}
+
+// apis resume [id...]
+var apisResumeCmd = &cobra.Command{
+	Use:   "resume [id...]",
+	Short: "Resume one or more API monitors",
+	Long: `Resume one or more paused API endpoint monitors (sets status to active).` + bulkLongHelp + `
+
+Examples:
+  groovekit apis resume abc12345 def67890
+  groovekit apis resume --tag env=staging
+  groovekit apis resume --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, apisBulkTarget, statusAction(apisBulkTarget, "active"))
+	},
+}
+
+// apis notify <id>
+var apisNotifyCmd = &cobra.Command{
+	Use:   "notify <id>",
+	Short: "Manage where API monitor alerts go",
+	Long: `Add or remove the notification channels that an API monitor's alerts are
+routed to.` + notifyLongHelp + `
+
+Examples:
+  groovekit apis notify abc12345
+  groovekit apis notify abc12345 --add-channel ch_oncall --remove-channel ch_email`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runNotify(cmd, args[0], apisNotifyTarget)
+	},
+}
+
+// apisNotifyTarget reads and updates API monitor notification channels
+var apisNotifyTarget = notifyTarget{
+	noun:    "API monitor",
+	resolve: cmdutil.ResolveAPIMonitorID,
+	channels: func(client api.APIClient, id string) ([]string, error) {
+		monitor, err := client.GetApi(id)
+		if err != nil {
+			return nil, err
+		}
+		return monitor.ChannelIDs, nil
+	},
+	update: func(client api.APIClient, id string, channels []string) error {
+		_, err := client.UpdateApi(id, &api.UpdateApiRequest{ChannelIDs: &channels})
+		return err
+	},
+}
+
+// apis incidents <id>
+var apisIncidentsCmd = &cobra.Command{
+	Use:   "incidents <id>",
+	Short: "Show incident history",
+	Long:  "Display incident history (downtime periods) for an API monitor",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		// Check for --json flag
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		incidents, err := client.ListApiIncidents(fullID)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get incidents: %w", err)
+		}
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, incidents)
+		}
+
+		if len(incidents) == 0 {
+			output.InfoMessage(out, "No incidents found - this API monitor has been running smoothly!")
+			return nil
+		}
+
+		// Create table
+		table, err := newListTable(cmd, []string{"ID", "STARTED", "ENDED", "DURATION", "STATUS", "ERROR", "ACK", "NOTE"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+
+		// Add rows
+		for _, incident := range incidents {
+			status, ended := incidentStatus(incident)
+
+			// Format duration
+			duration := cmdutil.FormatIncidentDuration(incident.Duration)
+
+			errorMsg := "-"
+			if incident.ErrorMessage != nil {
+				errorMsg = *incident.ErrorMessage
+			}
+
+			table.Append([]string{
+				output.Cyan(cmdutil.ShortID(incident.ID)),
+				output.FormatTime(incident.StartedAt),
+				ended,
+				duration,
+				status,
+				errorMsg,
+				incidentAck(incident),
+				incidentNote(incident),
+			})
+		}
+
+		table.Flush()
+		output.TotalMessage(out, fmt.Sprintf("Total: %d incident(s)", len(incidents)))
+		return nil
+	},
+}
+
+// apis checks <id>
+var apisChecksCmd = &cobra.Command{
+	Use:   "checks <id>",
+	Short: "Show check history",
+	Long: `Display recent health check results for an API monitor, with success rate
+and response time stats.
+
+Examples:
+  groovekit apis checks abc123 --failed
+  groovekit apis checks abc123 --since 24h --sort -response
+  groovekit apis checks abc123 --status-code 500,502,503`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listMonitorChecks(cmd, args[0])
+	},
+}
+
+// apis uptime <id>
+var apisUptimeCmd = &cobra.Command{
+	Use:   "uptime <id>",
+	Short: "Show uptime over a period",
+	Long: `Summarise an API monitor's availability over the last 7, 30, or 90 days from
+its check history: uptime percentage, mean and 95th percentile response
+time, the number of incidents, and a bar per day. Days without checks are
+shown as gaps.
+
+Examples:
+  groovekit apis uptime abc12345
+  groovekit apis uptime abc12345 --period 90d
+  groovekit apis uptime abc12345 --period 7d --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		period, _ := cmd.Flags().GetString("period")
+		days, err := parseUptimePeriod(period)
+		if err != nil {
+			return err
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		now := time.Now()
+		monitor, checks, incidents, err := fetchUptimeHistory(cmd.Context(), client, fullID, uptimeStart(days, now))
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		report := buildUptimeReport(monitor, period, days, checks, incidents, now)
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, report)
+		}
+
+		printUptimeReport(out, report)
+		return nil
+	},
+}
+
+// apis diff <id> -f <file>
+var apisDiffCmd = &cobra.Command{
+	Use:   "diff <id>",
+	Short: "Compare a local definition to an API monitor",
+	Long: `Compare an API monitor definition in a YAML or JSON file to the monitor's
+current settings and print what differs, field by field, without changing
+anything.
+
+The file uses the API's field names (name, url, http_method, interval,
+expected_status_codes, timeout, grace_period, status, tags, ...). Only the
+fields it sets are compared, and read-only fields such as id or created_at
+are ignored, so the output of 'apis show --json' works as a starting point.
+
+Examples:
+  groovekit apis diff abc12345 -f monitor.yaml
+  groovekit apis diff abc12345 -f monitor.json --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		file, _ := cmd.Flags().GetString("file")
+		if file == "" {
+			return fmt.Errorf("specify a definition file with --file")
+		}
+		def, err := loadMonitorDefinition(file)
+		if err != nil {
+			return err
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		monitor, err := client.GetApi(fullID)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get API monitor: %w", err)
+		}
+
+		changes := diffMonitorDefinition(monitor, def)
+
+		if jsonOutput {
+			if changes == nil {
+				changes = []diff.Change{}
+			}
+			return cmdutil.OutputJSON(out, map[string]interface{}{
+				"id":      fullID,
+				"file":    file,
+				"changes": changes,
+			})
+		}
+
+		fmt.Fprintf(out, "%s %s (%s) -> %s\n\n", output.Bold("Comparing"), monitor.Name, output.Cyan(cmdutil.ShortID(fullID)), file)
+
+		if len(changes) == 0 {
+			output.InfoMessage(out, "No differences")
+			return nil
+		}
+		printChanges(out, changes)
+		fmt.Fprintf(out, "\n%s %d\n", output.Bold("Changes:"), len(changes))
+		return nil
+	},
+}
+
+// apis schema
+var apisSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Manage an API monitor's JSON schema",
+	Long: `Show, set, or clear the JSON schema an API monitor's responses must match,
+and validate example payloads against a schema locally before enforcing it.
+
+Examples:
+  groovekit apis schema show abc12345
+  groovekit apis schema validate --file order.schema.json --against response.json
+  groovekit apis schema set abc12345 --file order.schema.json`,
+}
+
+// apis schema show <id>
+var apisSchemaShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show an API monitor's JSON schema",
+	Long:  "Print the JSON schema an API monitor's responses must match",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		monitor, err := client.GetApi(fullID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get API monitor: %w", err)
+		}
+
+		if monitor.JSONSchema == nil || *monitor.JSONSchema == "" {
+			output.InfoMessage(out, fmt.Sprintf("%s has no JSON schema", monitor.Name))
+			return nil
+		}
+		fmt.Fprintln(out, formatSchema(*monitor.JSONSchema))
+		return nil
+	},
+}
+
+// apis schema set <id>
+var apisSchemaSetCmd = &cobra.Command{
+	Use:   "set <id>",
+	Short: "Set or clear an API monitor's JSON schema",
+	Long: `Set the JSON schema an API monitor's responses must match, or remove it with
+--clear. The schema is checked before it's saved.
+
+Examples:
+  groovekit apis schema set abc12345 --file order.schema.json
+  groovekit apis schema set abc12345 --clear`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		file, _ := cmd.Flags().GetString("file")
+		clearSchema, _ := cmd.Flags().GetBool("clear")
+		if file == "" && !clearSchema {
+			return usageErrorf("--file or --clear is required")
+		}
+
+		var schema string
+		if !clearSchema {
+			var err error
+			if schema, _, err = readJSONSchema(file); err != nil {
+				return err
+			}
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		monitor, err := client.UpdateApi(fullID, &api.UpdateApiRequest{JSONSchema: &schema})
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to update API monitor: %w", err)
+		}
+
+		if clearSchema {
+			output.SuccessMessage(out, fmt.Sprintf("Removed the JSON schema from %s", monitor.Name))
+		} else {
+			output.SuccessMessage(out, fmt.Sprintf("Set the JSON schema for %s from %s", monitor.Name, file))
+		}
+		return nil
+	},
+}
+
+// apis schema validate [id]
+var apisSchemaValidateCmd = &cobra.Command{
+	Use:   "validate [id] --against <response.json>",
+	Short: "Validate a JSON payload against a schema",
+	Long: `Check an example response against an API monitor's JSON schema, or against a
+local schema file with --file, without calling the endpoint. Every mismatch
+is listed with its path. Exits with status 6 when the payload doesn't match,
+like a failing check.
+
+Use --against - to read the payload from stdin.
+
+Examples:
+  groovekit apis schema validate abc12345 --against response.json
+  groovekit apis schema validate --file order.schema.json --against response.json
+  curl -s https://api.example.com/orders/1 | groovekit apis schema validate abc12345 --against -`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		file, _ := cmd.Flags().GetString("file")
+		against, _ := cmd.Flags().GetString("against")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		if against == "" {
+			return usageErrorf("--against is required")
+		}
+		if (len(args) == 0) == (file == "") {
+			return fmt.Errorf("give either a monitor ID or --file, but not both")
+		}
+
+		doc, err := readJSONDocument(against, cmd.InOrStdin())
+		if err != nil {
+			return err
+		}
+
+		var schema *jsonschema.Schema
+		if file != "" {
+			if _, schema, err = readJSONSchema(file); err != nil {
+				return err
+			}
+		} else {
+			client, err := cmdutil.AuthenticatedClient()
+			if err != nil {
+				return err
+			}
+
+			// Resolve short ID to full ID
+			fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+			if err != nil {
+				return err
+			}
+
+			var s *spinner.Spinner
+			if !jsonOutput {
+				s = newSpinner(cmd)
+				s.Start()
+			}
+
+			monitor, err := client.GetApi(fullID)
+
+			if s != nil {
+				s.Stop()
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to get API monitor: %w", err)
+			}
+			if monitor.JSONSchema == nil || *monitor.JSONSchema == "" {
+				return fmt.Errorf("%s has no JSON schema; set one with: groovekit apis schema set %s --file <schema.json>", monitor.Name, cmdutil.ShortID(monitor.ID))
+			}
+			if schema, err = jsonschema.Compile([]byte(*monitor.JSONSchema)); err != nil {
+				return fmt.Errorf("%s's schema can't be used: %w", monitor.Name, err)
+			}
+		}
+
+		errs := schema.Validate(doc)
+		if jsonOutput {
+			if err := cmdutil.OutputJSON(out, schemaValidation{Valid: len(errs) == 0, Errors: errs}); err != nil {
+				return err
+			}
+		} else {
+			name := against
+			if against == "-" {
+				name = "Payload"
+			}
+			printSchemaValidation(out, name, errs)
+		}
+
+		if len(errs) > 0 && failOn(cmd, failLevelDown) {
+			return resourceDown(cmd)
+		}
+		return nil
+	},
+}
+
+// apis test [id]
+var apisTestCmd = &cobra.Command{
+	Use:   "test [id]",
+	Short: "Run an API check locally",
+	Long: `Perform an API monitor's HTTP check from this machine, using its method,
+headers, request body, timeout, expected status codes, max response time, JSON path
+validations, and body assertions, and print a pass/fail breakdown. Useful for debugging why the
+hosted check is failing.
+
+Pass --url instead of an ID to try a configuration before saving it. Flags
+override the saved monitor's settings. Exits with status 6 when the check
+fails.
+
+Examples:
+  groovekit apis test abc12345
+  groovekit apis test abc12345 --header "Authorization: Bearer $TOKEN"
+  groovekit apis test --url https://api.example.com/health --validate-path data.status`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		urlFlag, _ := cmd.Flags().GetString("url")
+		if len(args) == 0 && urlFlag == "" {
+			return fmt.Errorf("specify a monitor ID or --url")
+		}
+
+		check := probe.HTTPCheck{Method: "GET", Timeout: 30 * time.Second}
+		var notes []string
+
+		if len(args) == 1 {
+			client, err := cmdutil.AuthenticatedClient()
+			if err != nil {
+				return err
+			}
+
+			// Resolve short ID to full ID
+			fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+			if err != nil {
+				return err
+			}
+
+			monitor, err := client.GetApi(fullID)
+			if err != nil {
+				return fmt.Errorf("failed to get API monitor: %w", err)
+			}
+
+			check.URL = monitor.URL
+			if monitor.HTTPMethod != "" {
+				check.Method = monitor.HTTPMethod
+			}
+			check.Headers = monitorHeaders(monitor.Headers)
+			if monitor.RequestBody != nil {
+				check.Body = *monitor.RequestBody
+			}
+			if monitor.Timeout > 0 {
+				check.Timeout = time.Duration(monitor.Timeout) * time.Second
+			}
+			check.ExpectedStatusCodes = monitor.ExpectedStatusCodes
+			check.MaxResponseTime = time.Duration(monitor.MaxResponseTime) * time.Millisecond
+			check.ValidatePaths = monitor.ValidateResponsePaths
+			check.Contains = monitor.AssertContains
+			check.Regexes = monitor.AssertRegex
+			check.PathValues = probePathValues(monitor.AssertJSONPaths)
+
+			if monitor.HasAuthHeaders {
+				notes = append(notes, "This monitor has auth headers, which the API does not return. Pass them with --header.")
+			}
+			if len(monitor.Regions) > 0 {
+				notes = append(notes, fmt.Sprintf("The hosted check runs from %s; this one runs from this machine.", formatRegions(monitor.Regions)))
+			}
+			if monitor.JSONSchema != nil && *monitor.JSONSchema != "" {
+				notes = append(notes, "JSON schema validation is only run by the hosted check.")
+			}
+		}
+
+		// Flags override the saved monitor
+		if urlFlag != "" {
+			check.URL = urlFlag
+		}
+		if cmd.Flags().Changed("http-method") {
+			check.Method, _ = cmd.Flags().GetString("http-method")
+		}
+		if cmd.Flags().Changed("body") {
+			check.Body, _ = cmd.Flags().GetString("body")
+		}
+		if cmd.Flags().Changed("timeout") {
+			timeout := getDurationFlag(cmd, "timeout")
+			check.Timeout = time.Duration(timeout) * time.Second
+		}
+		if cmd.Flags().Changed("expected-status-codes") {
+			check.ExpectedStatusCodes, _ = cmd.Flags().GetIntSlice("expected-status-codes")
+		}
+		if cmd.Flags().Changed("max-response-time") {
+			check.MaxResponseTime = time.Duration(getDurationFlag(cmd, "max-response-time")) * time.Millisecond
+		}
+		if cmd.Flags().Changed("validate-path") {
+			check.ValidatePaths, _ = cmd.Flags().GetStringSlice("validate-path")
+		}
+		headers, err := getHeaders(cmd)
+		if err != nil {
+			return err
+		}
+		if len(headers) > 0 {
+			if check.Headers == nil {
+				check.Headers = map[string]string{}
+			}
+			maps.Copy(check.Headers, headers)
+		}
+		if cmd.Flags().Changed("assert-contains") {
+			check.Contains, _ = cmd.Flags().GetStringArray("assert-contains")
+		}
+		if cmd.Flags().Changed("assert-regex") {
+			if check.Regexes, err = getAssertRegex(cmd); err != nil {
+				return err
+			}
+		}
+		if cmd.Flags().Changed("assert-jsonpath") {
+			paths, err := getPathAssertions(cmd)
+			if err != nil {
+				return err
+			}
+			check.PathValues = probePathValues(paths)
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		result, err := probe.RunHTTP(nil, check)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if jsonOutput {
+			if err := cmdutil.OutputJSON(out, result); err != nil {
+				return err
+			}
+		} else {
+			printHTTPResult(out, result, notes)
+		}
+
+		if !result.Passed && failOn(cmd, failLevelDown) {
+			return resourceDown(cmd)
+		}
+		return nil
+	},
+}
+
+// applyFromCurl fills the apis create flags that weren't given from the
+// request in --from-curl
+func applyFromCurl(cmd *cobra.Command) error {
+	command, _ := cmd.Flags().GetString("from-curl")
+	if command == "" {
+		return nil
+	}
+	req, err := curl.Parse(command)
+	if err != nil {
+		return usageErrorf("invalid --from-curl: %v", err)
+	}
+
+	values := map[string]string{
+		"url":    req.URL,
+		"name":   curlMonitorName(req.URL),
+		"method": req.Method,
+		"body":   req.Body,
+	}
+	if req.Timeout > 0 {
+		values["timeout"] = fmt.Sprintf("%ds", int(math.Ceil(req.Timeout.Seconds())))
+	}
+	for name, value := range values {
+		if value == "" || cmd.Flags().Changed(name) {
+			continue
+		}
+		if err := cmd.Flags().Set(name, value); err != nil {
+			return usageErrorf("invalid --from-curl: %v", err)
+		}
+	}
+	if !cmd.Flags().Changed("header") {
+		for _, header := range req.Headers {
+			_ = cmd.Flags().Set("header", header.Name+": "+header.Value)
+		}
+	}
+	return nil
+}
+
+// curlMonitorName names a monitor created from a curl command after its
+// URL's host and path, e.g. api.example.com/health
+func curlMonitorName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(u.Host+u.Path, "/")
+}
+
+// getHeaders reads the --header flags, given as "Name: value"
+func getHeaders(cmd *cobra.Command) (map[string]string, error) {
+	values, _ := cmd.Flags().GetStringArray("header")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	headers := make(map[string]string, len(values))
+	for _, header := range values {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, usageErrorf("invalid --header %q: use \"Name: value\"", header)
+		}
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
+
+// addAssertionFlags registers the response body assertion flags
+func addAssertionFlags(c *cobra.Command) {
+	c.Flags().StringArray("assert-contains", nil, "Text the response body must contain (repeatable)")
+	c.Flags().StringArray("assert-regex", nil, "Regular expression the response body must match (repeatable)")
+	c.Flags().StringArray("assert-jsonpath", nil, "JSON path and the value it must have as path=value, e.g. status=ok (repeatable)")
+}
+
+// getAssertRegex reads the --assert-regex flags, checking each compiles
+func getAssertRegex(cmd *cobra.Command) ([]string, error) {
+	patterns, _ := cmd.Flags().GetStringArray("assert-regex")
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, usageErrorf("invalid --assert-regex %q: %v", pattern, err)
+		}
+	}
+	return patterns, nil
+}
+
+// getPathAssertions reads the --assert-jsonpath flags, given as "path=value"
+func getPathAssertions(cmd *cobra.Command) ([]api.PathAssertion, error) {
+	values, _ := cmd.Flags().GetStringArray("assert-jsonpath")
+	var assertions []api.PathAssertion
+	for _, value := range values {
+		path, want, ok := strings.Cut(value, "=")
+		if !ok || strings.TrimSpace(path) == "" {
+			return nil, usageErrorf("invalid --assert-jsonpath %q: use \"path=value\", e.g. status=ok", value)
+		}
+		assertions = append(assertions, api.PathAssertion{Path: strings.TrimSpace(path), Value: want})
+	}
+	return assertions, nil
+}
+
+// probePathValues converts a monitor's JSON path assertions for a local check
+func probePathValues(assertions []api.PathAssertion) []probe.PathValue {
+	var values []probe.PathValue
+	for _, assertion := range assertions {
+		values = append(values, probe.PathValue{Path: assertion.Path, Value: assertion.Value})
+	}
+	return values
+}
+
+// formatMaxResponseTime renders a monitor's response time limit in
+// milliseconds, or "none"
+func formatMaxResponseTime(ms int) string {
+	if ms <= 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%dms", ms)
+}
+
+// formatStatusCodes lists expected status codes, e.g. "200, 204", or "-"
+// when the API's default applies
+func formatStatusCodes(codes []int) string {
+	if len(codes) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(codes))
+	for i, code := range codes {
+		parts[i] = fmt.Sprintf("%d", code)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatAssertions describes a monitor's body assertions, one per line
+func formatAssertions(monitor *api.ApiMonitor) []string {
+	var lines []string
+	for _, want := range monitor.AssertContains {
+		lines = append(lines, fmt.Sprintf("contains %q", want))
+	}
+	for _, pattern := range monitor.AssertRegex {
+		lines = append(lines, fmt.Sprintf("matches /%s/", pattern))
+	}
+	for _, assertion := range monitor.AssertJSONPaths {
+		lines = append(lines, fmt.Sprintf("%s = %s", assertion.Path, assertion.Value))
+	}
+	return lines
+}
+
+// monitorHeaders converts the headers returned for a monitor into a map
+func monitorHeaders(raw interface{}) map[string]string {
+	values, ok := raw.(map[string]interface{})
+	if !ok || len(values) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(values))
+	for name, value := range values {
+		headers[name] = fmt.Sprint(value)
+	}
+	return headers
+}
+
+// redactedValue stands in for header values in output
+const redactedValue = "[redacted]"
+
+// redactHeaders replaces every header value, since headers often carry
+// credentials
+func redactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name := range headers {
+		redacted[name] = redactedValue
+	}
+	return redacted
+}
+
+// printHTTPResult prints each step of a local check and the overall result
+func printHTTPResult(w io.Writer, result *probe.HTTPResult, notes []string) {
+	fmt.Fprintf(w, "%s %s\n\n", output.Bold(result.Method), result.URL)
+
+	for _, step := range result.Steps {
+		mark := output.Green("✓")
+		if !step.Passed {
+			mark = output.Red("✗")
+		}
+		fmt.Fprintf(w, "%s %-24s %s\n", mark, step.Name, step.Detail)
+	}
+
+	for _, note := range notes {
+		fmt.Fprintf(w, "\n%s\n", output.Yellow(note))
+	}
+
+	verdict := output.Green("PASS")
+	if !result.Passed {
+		verdict = output.Red("FAIL")
+	}
+	fmt.Fprintf(w, "\n%s %s\n", output.Bold("Result:"), verdict)
+}
+
+// apis check <id>
+var apisCheckCmd = &cobra.Command{
+	Use:   "check <id>",
+	Short: "Check API monitor health",
+	Long:  "Query the current health of an API endpoint monitor for use in scripts and CI gates" + checkLongHelp,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, args[0])
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		monitor, err := client.GetApi(fullID)
+		if err != nil {
+			return checkFailed(cmd, fmt.Errorf("failed to get API monitor: %w", err))
+		}
+
+		if state, ok := statusHealth(monitor.Status); ok {
+			return reportHealth(cmd, "API monitor", monitor.Name, state, monitor.Status)
+		}
+		if monitor.Down {
+			return reportHealth(cmd, "API monitor", monitor.Name, healthDown, fmt.Sprintf("%d consecutive failure(s)", monitor.ConsecutiveFailures))
+		}
+		if monitor.LastCheckAt == nil {
+			return reportHealth(cmd, "API monitor", monitor.Name, healthUnknown, "not checked yet")
+		}
+		return reportHealth(cmd, "API monitor", monitor.Name, healthUp, "")
+	},
+}
+
+// apis move [id...]
+var apisMoveCmd = &cobra.Command{
+	Use:   "move [id...]",
+	Short: "Move one or more API monitors to a project",
+	Long: `Move one or more API endpoint monitors to a project. Their check history,
+incidents, and settings are kept.` + bulkLongHelp + `
+
+Examples:
+  groovekit apis move abc12345 --project def67890
+  groovekit apis move --match 'name~checkout-*' --project def67890 --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMove(cmd, args, apisBulkTarget)
+	},
+}
+
+// apis delete [id...]
+var apisDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more API monitors",
+	Long: `Delete one or more API endpoint monitors. Everything that will be deleted is listed
+for confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit apis delete abc12345 def67890
+  groovekit apis delete --match 'name~tmp-*' --dry-run
+  groovekit apis delete --tag env=preview --force`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, apisBulkTarget, deleteAction(apisBulkTarget))
+	},
+}
+
+// apisBulkTarget lists API monitors for bulk actions and ID resolution
+var apisBulkTarget = bulkTarget{
+	noun:    "API monitor",
+	plural:  "API monitors",
+	command: "apis",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		result, err := client.ListAllApis(nil)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(result.APIMonitors))
+		for _, monitor := range result.APIMonitors {
+			items = append(items, bulkItem{id: monitor.ID, name: monitor.Name, status: monitor.Status, tags: monitor.Tags, fields: map[string]string{"url": monitor.URL, "method": monitor.HTTPMethod, "project": monitor.ProjectID}})
+		}
+		return items, nil
+	},
+	setStatus: func(client api.APIClient, id, status string) error {
+		_, err := client.UpdateApi(id, &api.UpdateApiRequest{Status: &status})
+		return err
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteApi(id)
+	},
+	move: func(client api.APIClient, id, projectID string) error {
+		return client.MoveApi(id, projectID)
+	},
+	snooze: func(client api.APIClient, id string, until time.Time) error {
+		return client.SnoozeApi(id, until)
+	},
 }
 
 func init() {
 	// Add flags to list command
 	apisListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(apisListCmd)
+	addListFilterFlags(apisListCmd)
+	addPageFlags(apisListCmd)
 
 	// Add flags to show command
 	apisShowCmd.Flags().Bool("json", false, "Output as JSON")
+	apisShowCmd.Flags().Bool("show-secrets", false, "Show request header values instead of redacting them")
 
 	// Add flags to create command
 	apisCreateCmd.Flags().String("name", "", "Monitor name (required)")
 	apisCreateCmd.Flags().String("url", "", "URL to monitor (required)")
-	apisCreateCmd.Flags().Int("interval", 60, "Check interval in minutes")
+	addDurationFlag(apisCreateCmd, "interval", 60, time.Minute, "Check interval")
 	apisCreateCmd.Flags().String("method", "GET", "HTTP method")
-	_ = apisCreateCmd.MarkFlagRequired("name")
-	_ = apisCreateCmd.MarkFlagRequired("url")
+	apisCreateCmd.Flags().String("from-curl", "", "curl command to take the method, URL, headers, and body from")
+	registerFlagCompletion(apisCreateCmd, "method", completeValues(httpMethods...))
+	apisCreateCmd.Flags().StringArrayP("header", "H", nil, "Request header as \"Name: value\" (repeatable)")
+	apisCreateCmd.Flags().String("body", "", "Request body")
+	addDurationFlag(apisCreateCmd, "timeout", 0, time.Second, "Request timeout")
+	apisCreateCmd.Flags().IntSlice("expected-status-codes", nil, "Expected HTTP status codes (comma-separated, default from the API)")
+	addDurationFlag(apisCreateCmd, "max-response-time", 0, time.Millisecond, "Slowest response that still passes")
+	apisCreateCmd.Flags().StringSlice("validate-path", nil, "JSON path that must be present in the response, e.g. data.status (repeatable)")
+	apisCreateCmd.Flags().String("json-schema-file", "", "JSON schema file the response must match")
+	addAssertionFlags(apisCreateCmd)
+	apisCreateCmd.Flags().StringSlice("regions", nil, "Probe regions to check from, comma-separated, e.g. us-east,eu-west (default the account's default regions)")
+	registerFlagCompletion(apisCreateCmd, "regions", completeRegions)
+	addEscalationFlags(apisCreateCmd)
+	addTagFlag(apisCreateCmd)
+	addProjectFlag(apisCreateCmd)
+	addInteractiveFlag(apisCreateCmd)
+
+	// Add flags to clone command
+	addCloneFlags(apisCloneCmd)
+	apisCloneCmd.Flags().String("url", "", "URL for the copy to monitor (default the original's)")
+
+	// Add flags to generate command
+	apisGenerateCmd.Flags().String("openapi", "", "OpenAPI 3 or Swagger 2 document, YAML or JSON (required)")
+	apisGenerateCmd.Flags().String("base-url", "", "Base URL for the endpoints (default from the spec's servers)")
+	apisGenerateCmd.Flags().StringSlice("paths", nil, "Endpoint paths or globs to monitor, e.g. /health,/v1/*/status (skips the prompt)")
+	addDurationFlag(apisGenerateCmd, "interval", 60, time.Minute, "Check interval")
+	apisGenerateCmd.Flags().BoolP("yes", "y", false, "Create monitors for the suggested health endpoints without asking")
+	apisGenerateCmd.Flags().Bool("dry-run", false, "Show what would be created without creating anything")
+	addTagFlag(apisGenerateCmd)
+
+	// Add flags to import-har command
+	apisImportHARCmd.Flags().String("select", "", "Requests to monitor by number, e.g. 1,3-5 or all (skips the prompt)")
+	apisImportHARCmd.Flags().Bool("include-assets", false, "List images, scripts, stylesheets, and other page assets too")
+	addDurationFlag(apisImportHARCmd, "interval", 60, time.Minute, "Check interval")
+	apisImportHARCmd.Flags().Bool("dry-run", false, "Show what would be created without creating anything")
+	addTagFlag(apisImportHARCmd)
 
 	// Add flags to update command
 	apisUpdateCmd.Flags().String("name", "", "Monitor name")
 	apisUpdateCmd.Flags().String("url", "", "URL to monitor")
 	apisUpdateCmd.Flags().String("http-method", "", "HTTP method (GET, POST, etc)")
-	apisUpdateCmd.Flags().Int("interval", 0, "Check interval in minutes")
-	apisUpdateCmd.Flags().Int("timeout", 0, "Request timeout in seconds")
-	apisUpdateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	registerFlagCompletion(apisUpdateCmd, "http-method", completeValues(httpMethods...))
+	apisUpdateCmd.Flags().StringArrayP("header", "H", nil, "Request header as \"Name: value\" (repeatable; replaces all headers)")
+	apisUpdateCmd.Flags().Bool("clear-headers", false, "Remove all request headers")
+	apisUpdateCmd.MarkFlagsMutuallyExclusive("header", "clear-headers")
+	addDurationFlag(apisUpdateCmd, "interval", 0, time.Minute, "Check interval")
+	addDurationFlag(apisUpdateCmd, "timeout", 0, time.Second, "Request timeout")
+	addDurationFlag(apisUpdateCmd, "grace-period", 0, time.Minute, "Grace period")
 	apisUpdateCmd.Flags().String("status", "", "Monitor status (active, inactive, paused)")
+	registerFlagCompletion(apisUpdateCmd, "status", completeValues(monitorStatuses...))
 	apisUpdateCmd.Flags().IntSlice("expected-status-codes", nil, "Expected HTTP status codes (comma-separated)")
+	addDurationFlag(apisUpdateCmd, "max-response-time", 0, time.Millisecond, "Slowest response that still passes, 0 for no limit")
+	addAssertionFlags(apisUpdateCmd)
+	apisUpdateCmd.Flags().StringSlice("regions", nil, "Probe regions to check from, comma-separated (replaces the current ones; empty for the default regions)")
+	registerFlagCompletion(apisUpdateCmd, "regions", completeRegions)
+	apisUpdateCmd.Flags().Bool("clear-assertions", false, "Remove all body assertions")
+	apisUpdateCmd.MarkFlagsMutuallyExclusive("assert-contains", "clear-assertions")
+	apisUpdateCmd.MarkFlagsMutuallyExclusive("assert-regex", "clear-assertions")
+	apisUpdateCmd.MarkFlagsMutuallyExclusive("assert-jsonpath", "clear-assertions")
+	addEscalationFlags(apisUpdateCmd)
+	addTagFlag(apisUpdateCmd)
+
+	// Add flags to notify command
+	addNotifyFlags(apisNotifyCmd)
+
+	// Add flags to pause and resume commands
+	addBulkFlags(apisPauseCmd)
+	addBulkFlags(apisResumeCmd)
 
 	// Add flags to incidents command
 	apisIncidentsCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(apisIncidentsCmd)
+
+	// Add flags to checks command
+	addHistoryFlags(apisChecksCmd)
+	apisChecksCmd.Flags().IntSlice("status-code", nil, "Only show checks with these HTTP status codes (comma-separated)")
+
+	// Add flags to uptime command
+	apisUptimeCmd.Flags().String("period", "30d", "Period to summarise: 7d, 30d, or 90d")
+	apisUptimeCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to diff command
+	apisDiffCmd.Flags().StringP("file", "f", "", "YAML or JSON file with the monitor definition")
+	apisDiffCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to schema commands
+	apisSchemaSetCmd.Flags().StringP("file", "f", "", "JSON schema file")
+	apisSchemaSetCmd.Flags().Bool("clear", false, "Remove the monitor's JSON schema")
+	apisSchemaSetCmd.MarkFlagsMutuallyExclusive("file", "clear")
+	apisSchemaValidateCmd.Flags().StringP("file", "f", "", "Validate against this schema file instead of a monitor's schema")
+	apisSchemaValidateCmd.Flags().String("against", "", "JSON payload to validate, or - for stdin (required)")
+	apisSchemaValidateCmd.Flags().Bool("json", false, "Output as JSON")
+	apisSchemaCmd.AddCommand(apisSchemaShowCmd)
+	apisSchemaCmd.AddCommand(apisSchemaSetCmd)
+	apisSchemaCmd.AddCommand(apisSchemaValidateCmd)
+
+	// Add flags to test command
+	apisTestCmd.Flags().Bool("json", false, "Output as JSON")
+	apisTestCmd.Flags().String("url", "", "URL to test (required without a monitor ID)")
+	apisTestCmd.Flags().String("http-method", "", "HTTP method (GET, POST, etc)")
+	apisTestCmd.Flags().StringArrayP("header", "H", nil, "Request header as \"Name: value\" (repeatable)")
+	apisTestCmd.Flags().String("body", "", "Request body")
+	addDurationFlag(apisTestCmd, "timeout", 30, time.Second, "Request timeout")
+	apisTestCmd.Flags().IntSlice("expected-status-codes", nil, "Expected HTTP status codes (comma-separated, default any 2xx)")
+	addDurationFlag(apisTestCmd, "max-response-time", 0, time.Millisecond, "Slowest response that still passes")
+	apisTestCmd.Flags().StringSlice("validate-path", nil, "JSON path that must be present in the response, e.g. data.status (repeatable)")
+	addAssertionFlags(apisTestCmd)
+
+	// Add flags to check command
+	apisCheckCmd.Flags().BoolP("verbose", "v", false, "Print the health result")
+	addFormatFlag(apisCheckCmd)
 
 	// Add flags to delete command
 	apisDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(apisDeleteCmd)
+	addProjectFlag(apisMoveCmd)
+	addBulkFlags(apisMoveCmd)
 
 	// Add subcommands
 	apisCmd.AddCommand(apisListCmd)
 	apisCmd.AddCommand(apisShowCmd)
 	apisCmd.AddCommand(apisCreateCmd)
+	apisCmd.AddCommand(apisCloneCmd)
+	apisCmd.AddCommand(apisGenerateCmd)
+	apisCmd.AddCommand(apisImportHARCmd)
 	apisCmd.AddCommand(apisUpdateCmd)
 	apisCmd.AddCommand(apisPauseCmd)
 	apisCmd.AddCommand(apisResumeCmd)
 	apisCmd.AddCommand(apisIncidentsCmd)
+	apisCmd.AddCommand(apisNotifyCmd)
+	apisCmd.AddCommand(apisChecksCmd)
+	apisCmd.AddCommand(apisUptimeCmd)
+	apisCmd.AddCommand(apisDiffCmd)
+	apisCmd.AddCommand(apisSchemaCmd)
+	apisCmd.AddCommand(apisCheckCmd)
+	apisCmd.AddCommand(apisTestCmd)
 	apisCmd.AddCommand(apisDeleteCmd)
+	apisCmd.AddCommand(apisMoveCmd)
 
 	// Add apis command to root
 	rootCmd.AddCommand(apisCmd)
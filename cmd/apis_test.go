@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,96 +21,162 @@ func TestApisCommand(t *testing.T) {
 	assert.NotEmpty(t, apisCmd.Long)
 }
 
-// TestApisListCommand tests the apis list command
-func TestApisListCommand(t *testing.T) {
-	assert.Equal(t, "list", apisListCmd.Use)
-	assert.Equal(t, "List all api monitors", apisListCmd.Short)
-	assert.NotEmpty(t, apisListCmd.Long)
-	require.NotNil(t, apisListCmd.RunE, "apis list command should have a RunE function")
-
-	// Verify --json flag exists
-	jsonFlag := apisListCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "apis list command should have --json flag")
-	assert.Equal(t, "bool", jsonFlag.Value.Type())
+// seedApis adds an API monitor to the fake API, returning its ID
+func seedApis(srv *apitest.Server) string {
+	id := srv.Fake.NewID()
+	srv.Fake.Apis = []api.ApiMonitor{
+		{ID: id, Name: "Checkout", URL: "https://shop.example.com/health", HTTPMethod: "GET", Status: "active", Interval: 5, Timeout: 10, ExpectedStatusCodes: []int{200}},
+	}
+	return id
 }
 
-// TestApisShowCommand tests the apis show command
-func TestApisShowCommand(t *testing.T) {
-	assert.Equal(t, "show <id>", apisShowCmd.Use)
-	assert.Equal(t, "Show API monitor details", apisShowCmd.Short)
-	assert.NotEmpty(t, apisShowCmd.Long)
-	require.NotNil(t, apisShowCmd.RunE, "apis show command should have a RunE function")
-
-	// Verify --json flag exists
-	jsonFlag := apisShowCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "apis show command should have --json flag")
-}
+// TestApisListCommand tests listing API monitors as a table and as JSON
+func TestApisListCommand(t *testing.T) {
+	srv := startAPI(t)
+	seedApis(srv)
 
-// TestApisCreateCommand tests the apis create command
-func TestApisCreateCommand(t *testing.T) {
-	assert.Equal(t, "create", apisCreateCmd.Use)
-	assert.Equal(t, "Create a new API monitor", apisCreateCmd.Short)
-	assert.NotEmpty(t, apisCreateCmd.Long)
-	require.NotNil(t, apisCreateCmd.RunE, "apis create command should have a RunE function")
+	out := mustRun(t, "apis", "list")
+	assert.Contains(t, out, "Checkout")
+	assert.Contains(t, out, "https://shop.example.com/health")
+	assert.Contains(t, out, "Total: 1 API monitor(s)")
 
-	// Verify required flags
-	nameFlag := apisCreateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "apis create command should have --name flag")
-	assert.Equal(t, "string", nameFlag.Value.Type())
+	assert.NotContains(t, out, "TIMEOUT")
 
-	urlFlag := apisCreateCmd.Flags().Lookup("url")
-	require.NotNil(t, urlFlag, "apis create command should have --url flag")
-	assert.Equal(t, "string", urlFlag.Value.Type())
+	out = mustRun(t, "apis", "list", "--wide")
+	assert.Contains(t, out, "EXPECTED STATUS")
+	assert.Contains(t, out, "10s")
+	assert.Contains(t, out, "RESPONSE TIME")
 
-	// Verify optional flags
-	intervalFlag := apisCreateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "apis create command should have --interval flag")
+	var result api.ApisResponse
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "apis", "list", "--json")), &result))
+	require.Len(t, result.APIMonitors, 1)
+	assert.Equal(t, "Checkout", result.APIMonitors[0].Name)
+}
 
-	methodFlag := apisCreateCmd.Flags().Lookup("method")
-	require.NotNil(t, methodFlag, "apis create command should have --method flag")
+// TestApisShowCommand tests showing an API monitor by short ID
+func TestApisShowCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedApis(srv)
+
+	out := mustRun(t, "apis", "show", id[:8])
+	assert.Contains(t, out, "ID:               "+id)
+	assert.Contains(t, out, "URL:              https://shop.example.com/health")
+	assert.Contains(t, out, "Interval:         5 minutes")
+	assert.Contains(t, out, "Expected Status:  [200]")
 }
 
-// TestApisUpdateCommand tests the apis update command
-func TestApisUpdateCommand(t *testing.T) {
-	assert.Equal(t, "update <id>", apisUpdateCmd.Use)
-	assert.Equal(t, "Update an API monitor", apisUpdateCmd.Short)
-	assert.NotEmpty(t, apisUpdateCmd.Long)
-	require.NotNil(t, apisUpdateCmd.RunE, "apis update command should have a RunE function")
+// TestApisCreateCommand tests creating an API monitor and the request it
+// sends
+func TestApisCreateCommand(t *testing.T) {
+	srv := startAPI(t)
 
-	// Verify flags exist
-	nameFlag := apisUpdateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "apis update command should have --name flag")
+	out := mustRun(t, "apis", "create", "--name", "Search", "--url", "https://shop.example.com/search", "--method", "POST", "--interval", "1m", "--expected-status-codes", "200,201")
+	assert.Contains(t, out, "API monitor created successfully")
+	assert.Contains(t, out, "Method:      POST")
 
-	urlFlag := apisUpdateCmd.Flags().Lookup("url")
-	require.NotNil(t, urlFlag, "apis update command should have --url flag")
+	require.Len(t, srv.Fake.Apis, 1)
+	monitor := srv.Fake.Apis[0]
+	assert.Equal(t, "https://shop.example.com/search", monitor.URL)
+	assert.Equal(t, 1, monitor.Interval)
+	assert.Equal(t, []int{200, 201}, monitor.ExpectedStatusCodes)
 
-	httpMethodFlag := apisUpdateCmd.Flags().Lookup("http-method")
-	require.NotNil(t, httpMethodFlag, "apis update command should have --http-method flag")
+	_, _, err := runCommand(t, "apis", "create", "--name", "Search")
+	assert.Error(t, err)
+	assert.Len(t, srv.Fake.Apis, 1)
+}
 
-	intervalFlag := apisUpdateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "apis update command should have --interval flag")
+// TestApisCreateCommand_FromCurl tests creating a monitor from a pasted
+// curl command, with flags taking precedence
+func TestApisCreateCommand_FromCurl(t *testing.T) {
+	srv := startAPI(t)
+
+	mustRun(t, "apis", "create", "--from-curl", `curl -X POST https://api.example.com/v1/orders/ \
+  -H 'Authorization: Bearer abc' -H "Content-Type: application/json" \
+  --data-raw '{"dry_run":true}' --max-time 2.5`)
+	require.Len(t, srv.Fake.Apis, 1)
+	monitor := srv.Fake.Apis[0]
+	assert.Equal(t, "api.example.com/v1/orders", monitor.Name)
+	assert.Equal(t, "https://api.example.com/v1/orders/", monitor.URL)
+	assert.Equal(t, "POST", monitor.HTTPMethod)
+	require.NotNil(t, monitor.RequestBody)
+	assert.Equal(t, `{"dry_run":true}`, *monitor.RequestBody)
+	assert.Equal(t, 3, monitor.Timeout)
+	assert.Equal(t, map[string]interface{}{"Authorization": "Bearer abc", "Content-Type": "application/json"}, monitor.Headers)
+
+	mustRun(t, "apis", "create", "--name", "Health", "--method", "HEAD", "--from-curl", "curl https://api.example.com/health")
+	require.Len(t, srv.Fake.Apis, 2)
+	assert.Equal(t, "Health", srv.Fake.Apis[1].Name)
+	assert.Equal(t, "HEAD", srv.Fake.Apis[1].HTTPMethod)
+
+	_, _, err := runCommand(t, "apis", "create", "--from-curl", "curl --cacert ca.pem https://api.example.com")
+	assert.Equal(t, exitUsage, exitCode(err))
+	assert.ErrorContains(t, err, "unsupported curl option --cacert")
+}
 
-	timeoutFlag := apisUpdateCmd.Flags().Lookup("timeout")
-	require.NotNil(t, timeoutFlag, "apis update command should have --timeout flag")
+// TestApisImportHARCommand tests creating monitors from the requests
+// selected in a HAR file
+func TestApisImportHARCommand(t *testing.T) {
+	srv := startAPI(t)
+	seedApis(srv)
+
+	path := filepath.Join(t.TempDir(), "shop.har")
+	require.NoError(t, os.WriteFile(path, []byte(`{"log":{"entries":[
+		{"_resourceType":"document","request":{"method":"GET","url":"https://shop.example.com/health","headers":[]},"response":{"status":200}},
+		{"_resourceType":"image","request":{"method":"GET","url":"https://shop.example.com/logo.png","headers":[]},"response":{"status":200}},
+		{"_resourceType":"fetch","request":{"method":"POST","url":"https://api.example.com/cart","headers":[
+			{"name":":authority","value":"api.example.com"},{"name":"Authorization","value":"Bearer abc"}],
+			"postData":{"mimeType":"application/json","text":"{\"sku\":1}"}},"response":{"status":201}},
+		{"_resourceType":"fetch","request":{"method":"GET","url":"https://api.example.com/me","headers":[]},"response":{"status":401}}
+	]}}`), 0o600))
+
+	out, _, err := runCommandWithInput(t, "1\n", "apis", "import-har", path, "--interval", "5")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Skipping 1 request for page assets")
+	assert.Contains(t, out, "Skipping 1 request already monitored")
+	assert.Contains(t, out, "  1 POST https://api.example.com/cart  (201 · 1 header · body)")
+	assert.Contains(t, out, "Created API monitor POST api.example.com/cart")
+
+	require.Len(t, srv.Fake.Apis, 2)
+	monitor := srv.Fake.Apis[1]
+	assert.Equal(t, "POST", monitor.HTTPMethod)
+	assert.Equal(t, map[string]interface{}{"Authorization": "Bearer abc"}, monitor.Headers)
+	require.NotNil(t, monitor.RequestBody)
+	assert.Equal(t, `{"sku":1}`, *monitor.RequestBody)
+	assert.Equal(t, []int{201}, monitor.ExpectedStatusCodes)
+	assert.Equal(t, 5, monitor.Interval)
+
+	out = mustRun(t, "apis", "import-har", path, "--select", "all", "--dry-run")
+	assert.Contains(t, out, "Skipping 2 requests already monitored")
+	assert.Contains(t, out, "Would create 1 API monitor:")
+	assert.Contains(t, out, "api.example.com/me  GET https://api.example.com/me  (401)")
+	assert.Len(t, srv.Fake.Apis, 2)
+
+	_, _, err = runCommand(t, "apis", "import-har", path, "--select", "7")
+	assert.Equal(t, exitUsage, exitCode(err))
+}
 
-	statusFlag := apisUpdateCmd.Flags().Lookup("status")
-	require.NotNil(t, statusFlag, "apis update command should have --status flag")
+// TestApisUpdateCommand tests updating only the fields given
+func TestApisUpdateCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedApis(srv)
+
+	out := mustRun(t, "apis", "update", id[:8], "--interval", "10m")
+	assert.Contains(t, out, "API monitor updated successfully")
+	assert.Contains(t, out, "Interval: 10 minutes")
+	assert.Equal(t, 10, srv.Fake.Apis[0].Interval)
+	assert.Equal(t, "https://shop.example.com/health", srv.Fake.Apis[0].URL)
 }
 
-// TestApisPauseCommand tests the apis pause command
+// TestApisPauseCommand tests pausing and resuming an API monitor
 func TestApisPauseCommand(t *testing.T) {
-	assert.Equal(t, "pause <id>", apisPauseCmd.Use)
-	assert.Equal(t, "Pause an API monitor", apisPauseCmd.Short)
-	assert.NotEmpty(t, apisPauseCmd.Long)
-	require.NotNil(t, apisPauseCmd.RunE, "apis pause command should have a RunE function")
-}
+	srv := startAPI(t)
+	id := seedApis(srv)
+
+	mustRun(t, "apis", "pause", id[:8])
+	assert.Equal(t, "paused", srv.Fake.Apis[0].Status)
 
-// TestApisResumeCommand tests the apis resume command
-func TestApisResumeCommand(t *testing.T) {
-	assert.Equal(t, "resume <id>", apisResumeCmd.Use)
-	assert.Equal(t, "Resume an API monitor", apisResumeCmd.Short)
-	assert.NotEmpty(t, apisResumeCmd.Long)
-	require.NotNil(t, apisResumeCmd.RunE, "apis resume command should have a RunE function")
+	mustRun(t, "apis", "resume", id[:8])
+	assert.Equal(t, "active", srv.Fake.Apis[0].Status)
 }
 
 // TestApisIncidentsCommand tests the apis incidents command
@@ -118,17 +191,14 @@ func TestApisIncidentsCommand(t *testing.T) {
 	require.NotNil(t, jsonFlag, "apis incidents command should have --json flag")
 }
 
-// TestApisDeleteCommand tests the apis delete command
+// TestApisDeleteCommand tests deleting an API monitor without a prompt
 func TestApisDeleteCommand(t *testing.T) {
-	assert.Equal(t, "delete <id>", apisDeleteCmd.Use)
-	assert.Equal(t, "Delete an API monitor", apisDeleteCmd.Short)
-	assert.NotEmpty(t, apisDeleteCmd.Long)
-	require.NotNil(t, apisDeleteCmd.RunE, "apis delete command should have a RunE function")
+	srv := startAPI(t)
+	id := seedApis(srv)
 
-	// Verify --force flag exists
-	forceFlag := apisDeleteCmd.Flags().Lookup("force")
-	require.NotNil(t, forceFlag, "apis delete command should have --force flag")
-	assert.Equal(t, "bool", forceFlag.Value.Type())
+	out := mustRun(t, "apis", "delete", id[:8], "--force")
+	assert.Contains(t, out, "API monitor Checkout ("+id[:8]+") deleted successfully")
+	assert.Empty(t, srv.Fake.Apis)
 }
 
 // TestApisCommandHasSubcommands verifies all subcommands are registered
@@ -136,7 +206,7 @@ func TestApisCommandHasSubcommands(t *testing.T) {
 	commands := apisCmd.Commands()
 
 	// Should have 8 subcommands
-	expectedSubcommands := []string{"list", "show", "create", "update", "pause", "resume", "incidents", "delete"}
+	expectedSubcommands := []string{"list", "show", "create", "clone", "generate", "import-har", "update", "pause", "resume", "incidents", "notify", "checks", "uptime", "diff", "schema", "test", "delete", "move"}
 	assert.GreaterOrEqual(t, len(commands), len(expectedSubcommands))
 
 	// Verify all expected subcommands exist
@@ -164,41 +234,120 @@ func TestResolveMonitorID(t *testing.T) {
 	// In a real scenario, you'd mock the API client
 	// For now, we just verify the function exists by checking if it's referenced
 	// A full integration test would require a mock API server
-	assert.NotNil(t, apisShowCmd.RunE, "resolveMonitorID is used by show command")
-}
-
-// TestTruncateHelper tests the truncate helper function
-func TestTruncateHelper(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		maxLen   int
-		expected string
-	}{
-		{
-			name:     "short string",
-			input:    "hello",
-			maxLen:   10,
-			expected: "hello",
-		},
-		{
-			name:     "exact length",
-			input:    "hello",
-			maxLen:   5,
-			expected: "hello",
-		},
-		{
-			name:     "long string",
-			input:    "this is a very long string",
-			maxLen:   10,
-			expected: "this is...",
-		},
+	assert.NotNil(t, apisShowCmd.RunE, "cmdutil.ResolveAPIMonitorID is used by show command")
+}
+
+// TestApisTestCommand tests the apis test command
+func TestApisTestCommand(t *testing.T) {
+	assert.Equal(t, "test [id]", apisTestCmd.Use)
+	assert.NotEmpty(t, apisTestCmd.Long)
+	require.NotNil(t, apisTestCmd.RunE, "apis test command should have a RunE function")
+
+	for _, name := range []string{"url", "http-method", "header", "body", "timeout", "expected-status-codes", "validate-path", "json"} {
+		assert.NotNil(t, apisTestCmd.Flags().Lookup(name), "apis test command should have --%s flag", name)
 	}
+}
+
+// TestMonitorHeaders tests converting a monitor's headers into a map
+func TestMonitorHeaders(t *testing.T) {
+	assert.Nil(t, monitorHeaders(nil))
+	assert.Nil(t, monitorHeaders("not a map"))
+	assert.Equal(t, map[string]string{"Accept": "application/json", "X-Retries": "3"},
+		monitorHeaders(map[string]interface{}{"Accept": "application/json", "X-Retries": 3.0}))
+}
+
+// TestGetHeaders tests parsing repeatable --header flags
+func TestGetHeaders(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	c.Flags().StringArrayP("header", "H", nil, "")
+
+	headers, err := getHeaders(c)
+	require.NoError(t, err)
+	assert.Nil(t, headers)
+
+	require.NoError(t, c.ParseFlags([]string{"-H", "Authorization: Bearer abc:def", "--header", "X-Env:prod"}))
+	headers, err = getHeaders(c)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer abc:def", "X-Env": "prod"}, headers)
+
+	require.NoError(t, c.ParseFlags([]string{"-H", "no-colon"}))
+	_, err = getHeaders(c)
+	assert.EqualError(t, err, `invalid --header "no-colon": use "Name: value"`)
+}
+
+// TestGetAssertions tests parsing the body assertion flags
+func TestGetAssertions(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	addAssertionFlags(c)
+
+	require.NoError(t, c.ParseFlags([]string{"--assert-regex", `"version":"1\.\d+"`, "--assert-jsonpath", "status=ok", "--assert-jsonpath", "data.count = 3", "--assert-jsonpath", "note=a=b"}))
+	regexes, err := getAssertRegex(c)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`"version":"1\.\d+"`}, regexes)
+
+	paths, err := getPathAssertions(c)
+	require.NoError(t, err)
+	assert.Equal(t, []api.PathAssertion{{Path: "status", Value: "ok"}, {Path: "data.count", Value: " 3"}, {Path: "note", Value: "a=b"}}, paths)
+
+	c = &cobra.Command{Use: "test"}
+	addAssertionFlags(c)
+	require.NoError(t, c.ParseFlags([]string{"--assert-regex", "(", "--assert-jsonpath", "status"}))
+	_, err = getAssertRegex(c)
+	assert.Equal(t, exitUsage, exitCode(err))
+	_, err = getPathAssertions(c)
+	assert.EqualError(t, err, `invalid --assert-jsonpath "status": use "path=value", e.g. status=ok`)
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := truncate(tt.input, tt.maxLen)
-			assert.Equal(t, tt.expected, result)
-		})
+// TestMaxResponseTimeFlag tests the millisecond --max-response-time flag
+func TestMaxResponseTimeFlag(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	addDurationFlag(c, "max-response-time", 0, time.Millisecond, "")
+	require.NoError(t, c.ParseFlags([]string{"--max-response-time", "1.2s"}))
+	assert.Equal(t, 1200, getDurationFlag(c, "max-response-time"))
+
+	assert.Equal(t, "800ms", formatMaxResponseTime(800))
+	assert.Equal(t, "none", formatMaxResponseTime(0))
+	assert.NotNil(t, apisUpdateCmd.Flags().Lookup("max-response-time"))
+	assert.NotNil(t, apisTestCmd.Flags().Lookup("max-response-time"))
+}
+
+// TestFormatAssertions tests describing a monitor's body assertions
+func TestFormatAssertions(t *testing.T) {
+	monitor := &api.ApiMonitor{
+		AssertContains:  []string{"healthy"},
+		AssertRegex:     []string{`v\d+`},
+		AssertJSONPaths: []api.PathAssertion{{Path: "status", Value: "ok"}},
 	}
+	assert.Equal(t, []string{`contains "healthy"`, `matches /v\d+/`, "status = ok"}, formatAssertions(monitor))
+	assert.Empty(t, formatAssertions(&api.ApiMonitor{}))
+
+	assert.NotNil(t, apisUpdateCmd.Flags().Lookup("clear-assertions"))
+	assert.NotNil(t, apisTestCmd.Flags().Lookup("assert-jsonpath"))
+}
+
+// TestRedactHeaders tests hiding header values
+func TestRedactHeaders(t *testing.T) {
+	headers := map[string]string{"Authorization": "Bearer abc", "X-Env": "prod"}
+	assert.Equal(t, map[string]string{"Authorization": "[redacted]", "X-Env": "[redacted]"}, redactHeaders(headers))
+	assert.Equal(t, "Bearer abc", headers["Authorization"])
+
+	assert.NotNil(t, apisShowCmd.Flags().Lookup("show-secrets"))
+	assert.NotNil(t, apisUpdateCmd.Flags().Lookup("header"))
+	assert.NotNil(t, apisUpdateCmd.Flags().Lookup("clear-headers"))
+}
+
+// TestApisIncidentsWide tests that --wide shows error messages and notes in
+// full rather than cutting them short
+func TestApisIncidentsWide(t *testing.T) {
+	srv := startAPI(t)
+	checkout := seedApis(srv)
+	message := "connection refused while dialing shop.example.com:443 after 3 attempts"
+	srv.Fake.Incidents[checkout] = []api.Incident{{ID: "inc-1", StartedAt: "2026-10-15T08:00:00Z", ErrorMessage: &message}}
+
+	out := mustRun(t, "apis", "incidents", checkout[:8], "--wide")
+	assert.Contains(t, out, message)
+
+	_, _, err := runCommand(t, "apis", "incidents", checkout[:8], "--columns", "bogus")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
 }
@@ -1,12 +1,13 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
-	"syscall"
-	"time"
+	"strings"
 
-	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
 	"github.com/scookdev/groovekit-cli/internal/config"
 	"github.com/scookdev/groovekit-cli/internal/output"
@@ -24,21 +25,21 @@ var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to GrooveKit",
 	Long:  "Authenticate with your GrooveKit account and save credentials locally",
-	RunE: func(_ *cobra.Command, _ []string) error {
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
 		// Prompt for email
-		fmt.Print("Email: ")
+		fmt.Fprint(out, "Email: ")
 		var email string
-		_, _ = fmt.Scanln(&email)
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &email)
 
 		// Prompt for password (hidden)
-		fmt.Print("Password: ")
-		//nolint:unconvert // int() conversion needed for Windows compatibility
-		passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
+		fmt.Fprint(out, "Password: ")
+		password, err := readPassword(cmd)
 		if err != nil {
 			return fmt.Errorf("failed to read password: %w", err)
 		}
-		fmt.Println() // New line after password input
-		password := string(passwordBytes)
+		fmt.Fprintln(out) // New line after password input
 
 		// Load config
 		cfg, err := config.Load()
@@ -49,7 +50,7 @@ var loginCmd = &cobra.Command{
 		// Create API client and login
 		client := api.NewClient(cfg)
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
 		token, err := client.Login(email, password)
 		s.Stop()
@@ -59,36 +60,69 @@ var loginCmd = &cobra.Command{
 		}
 
 		// Save credentials
+		insecureStorage, _ := cmd.Flags().GetBool("insecure-storage")
 		cfg.AccessToken = token
 		cfg.Email = email
+		cfg.InsecureStorage = insecureStorage
 		if err := cfg.Save(); err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
 
-		output.SuccessMessage(fmt.Sprintf("Logged in successfully as %s", output.Bold(email)))
+		msg := fmt.Sprintf("Logged in successfully as %s", output.Bold(email))
+		if cfg.Profile() != config.DefaultProfile {
+			msg += fmt.Sprintf(" (profile %s)", output.Bold(cfg.Profile()))
+		}
+		output.SuccessMessage(out, msg)
+		if !cfg.TokenInKeyring() {
+			if !insecureStorage {
+				fmt.Fprintln(out, output.Yellow("No OS keyring available, token stored in plaintext in "+config.Path()))
+			} else {
+				fmt.Fprintln(out, "Token stored in plaintext in "+config.Path())
+			}
+		}
 		return nil
 	},
 }
 
+// readPassword reads a password without echoing it when stdin is a terminal,
+// and reads a plain line otherwise so it can be piped in
+func readPassword(cmd *cobra.Command) (string, error) {
+	in := cmd.InOrStdin()
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		password, err := term.ReadPassword(int(f.Fd()))
+		return string(password), err
+	}
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !(errors.Is(err, io.EOF) && line != "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from GrooveKit",
 	Long:  "Remove locally stored credentials",
-	RunE: func(_ *cobra.Command, _ []string) error {
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
 		if err := config.Clear(); err != nil {
 			if os.IsNotExist(err) {
-				fmt.Println("Not currently logged in")
+				fmt.Fprintln(out, "Not currently logged in")
 				return nil
 			}
 			return fmt.Errorf("failed to logout: %w", err)
 		}
 
-		output.SuccessMessage("Logged out successfully")
+		output.SuccessMessage(out, "Logged out successfully")
 		return nil
 	},
 }
 
 func init() {
+	loginCmd.Flags().Bool("insecure-storage", false, "Store the access token in plaintext instead of the OS keyring")
+
 	authCmd.AddCommand(loginCmd)
 	authCmd.AddCommand(logoutCmd)
 	rootCmd.AddCommand(authCmd)
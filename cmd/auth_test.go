@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +24,11 @@ func TestLoginCommand(t *testing.T) {
 
 	// Verify RunE function is set
 	require.NotNil(t, loginCmd.RunE, "login command should have a RunE function")
+
+	// Verify --insecure-storage flag exists
+	insecureFlag := loginCmd.Flags().Lookup("insecure-storage")
+	require.NotNil(t, insecureFlag, "login command should have --insecure-storage flag")
+	assert.Equal(t, "bool", insecureFlag.Value.Type())
 }
 
 // TestLogoutCommand tests the basic structure of the logout command
@@ -55,3 +62,19 @@ func TestAuthCommandHasSubcommands(t *testing.T) {
 	assert.True(t, hasLogin, "auth command should have login subcommand")
 	assert.True(t, hasLogout, "auth command should have logout subcommand")
 }
+
+// TestReadPasswordFromPipe tests reading a password from non-terminal input
+func TestReadPasswordFromPipe(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader("s3cret\r\n"))
+
+	password, err := readPassword(cmd)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", password)
+
+	// A final line without a newline is still accepted
+	cmd.SetIn(strings.NewReader("s3cret"))
+	password, err = readPassword(cmd)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", password)
+}
@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
+	"github.com/spf13/cobra"
+)
+
+// bulkItem is a resource that a bulk action can select
+type bulkItem struct {
+	id     string
+	name   string
+	status string
+	tags   []string
+	// fields are extra values --match can test, e.g. "url" or "domain"
+	fields map[string]string
+}
+
+// ID returns the resource's full ID
+func (item bulkItem) ID() string { return item.id }
+
+// Name returns the resource's name
+func (item bulkItem) Name() string { return item.name }
+
+// bulkTarget adapts a resource type for bulk actions
+type bulkTarget struct {
+	// noun is the singular name used in messages, e.g. "API monitor"
+	noun   string
+	plural string
+	// command is the CLI command for the resource type, e.g. "apis"
+	command   string
+	list      func(client api.APIClient) ([]bulkItem, error)
+	setStatus func(client api.APIClient, id, status string) error
+	remove    func(client api.APIClient, id string) error
+	move      func(client api.APIClient, id, projectID string) error
+	// snooze holds back alerts until the given time, or ends a snooze
+	// when until is zero
+	snooze func(client api.APIClient, id string, until time.Time) error
+}
+
+// bulkAction is something done to each selected resource
+type bulkAction struct {
+	// verb and past describe the action, e.g. "pause" and "paused"
+	verb string
+	past string
+	// done reports that a resource is already in state and needs no change
+	done  func(item bulkItem) bool
+	state string
+	apply func(client api.APIClient, id string) error
+	// confirm asks before applying unless --force is given
+	confirm bool
+}
+
+// bulkLongHelp documents the selection flags on bulk commands
+const bulkLongHelp = `
+
+Select resources by ID (several can be given), or with --all, --tag, or
+--match. --match takes field~pattern to match a glob (or any substring when
+the pattern has no wildcards) or field=value for an exact match, ignoring
+case; the fields are id, name, status, project, and the resource's own
+fields such as url or domain. Repeated --tag and --match flags must all match. Use
+--dry-run to preview what would change.`
+
+// addBulkFlags registers the selection flags for bulk commands
+func addBulkFlags(c *cobra.Command) {
+	c.Flags().Bool("all", false, "Select every resource")
+	c.Flags().StringArray("tag", nil, "Select resources with this tag: key=value, or key for any value (repeatable)")
+	c.Flags().StringArray("match", nil, "Select resources matching field~glob or field=value, e.g. name~staging (repeatable)")
+	c.Flags().Bool("dry-run", false, "Show what would change without changing anything")
+}
+
+// bulkMatcher tests one field of a resource against a --match expression
+type bulkMatcher struct {
+	field string
+	exact string
+	re    *regexp.Regexp
+}
+
+// parseMatch parses a --match expression: field~pattern or field=value
+func parseMatch(expr string) (bulkMatcher, error) {
+	i := strings.IndexAny(expr, "~=")
+	if i <= 0 {
+		return bulkMatcher{}, usageErrorf("invalid --match %q: expected field~pattern or field=value", expr)
+	}
+	field := strings.ToLower(strings.TrimSpace(expr[:i]))
+	value := strings.TrimSpace(expr[i+1:])
+
+	if expr[i] == '=' {
+		return bulkMatcher{field: field, exact: value}, nil
+	}
+	pattern := regexp.QuoteMeta(value)
+	if strings.ContainsAny(value, "*?") {
+		pattern = "^" + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(pattern) + "$"
+	}
+	return bulkMatcher{field: field, re: regexp.MustCompile("(?i)" + pattern)}, nil
+}
+
+// match reports whether the item's field satisfies the matcher
+func (m bulkMatcher) match(item bulkItem) (bool, error) {
+	value, ok := item.field(m.field)
+	if !ok {
+		return false, fmt.Errorf("unknown --match field %q (available: %s)", m.field, strings.Join(item.fieldNames(), ", "))
+	}
+	if m.re != nil {
+		return m.re.MatchString(value), nil
+	}
+	return strings.EqualFold(value, m.exact), nil
+}
+
+// field returns a value for --match
+func (item bulkItem) field(name string) (string, bool) {
+	switch name {
+	case "id":
+		return item.id, true
+	case "name":
+		return item.name, true
+	case "status":
+		return item.status, true
+	}
+	value, ok := item.fields[name]
+	return value, ok
+}
+
+// fieldNames lists the fields --match can test
+func (item bulkItem) fieldNames() []string {
+	names := []string{"id", "name", "status"}
+	extra := make([]string, 0, len(item.fields))
+	for name := range item.fields {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	return append(names, extra...)
+}
+
+// selectBulkItems picks resources by ID arguments or by the selection flags
+func selectBulkItems(cmd *cobra.Command, items []bulkItem, args []string, target bulkTarget) ([]bulkItem, error) {
+	all, _ := cmd.Flags().GetBool("all")
+	tags, _ := cmd.Flags().GetStringArray("tag")
+	exprs, _ := cmd.Flags().GetStringArray("match")
+	selecting := all || len(tags) > 0 || len(exprs) > 0
+
+	switch {
+	case len(args) > 0 && selecting:
+		return nil, fmt.Errorf("give %s IDs or --all/--tag/--match, not both", target.noun)
+	case len(args) == 0 && !selecting:
+		return nil, fmt.Errorf("give one or more %s IDs, or select %s with --all, --tag, or --match", target.noun, target.plural)
+	case len(args) > 0:
+		return selectByID(items, args, target)
+	}
+
+	matchers := make([]bulkMatcher, 0, len(exprs))
+	for _, expr := range exprs {
+		m, err := parseMatch(expr)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	selected := []bulkItem{}
+	for _, item := range items {
+		if !hasTags(item.tags, tags) {
+			continue
+		}
+		keep := true
+		for _, m := range matchers {
+			ok, err := m.match(item)
+			if err != nil {
+				return nil, err
+			}
+			keep = keep && ok
+		}
+		if keep {
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+// selectByID resolves ID prefixes against the listed resources, in order
+func selectByID(items []bulkItem, ids []string, target bulkTarget) ([]bulkItem, error) {
+	selected := []bulkItem{}
+	seen := map[string]bool{}
+	for _, id := range ids {
+		item, err := resolve.Match(items, id, target.noun, target.plural)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[item.id] {
+			seen[item.id] = true
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+// runBulk applies an action to every selected resource, reporting each one.
+// It keeps going after a failure and returns an error if any failed.
+func runBulk(cmd *cobra.Command, args []string, target bulkTarget, action bulkAction) error {
+	out := cmd.OutOrStdout()
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	s := newSpinner(cmd)
+	s.Start()
+	items, err := resolverFor(client, target).Items()
+	s.Stop()
+
+	if err != nil {
+		return err
+	}
+
+	selected, err := selectBulkItems(cmd, items, args, target)
+	if err != nil {
+		return err
+	}
+
+	var pending []bulkItem
+	for _, item := range selected {
+		if action.done != nil && action.done(item) {
+			fmt.Fprintf(out, "%s %s is already %s\n", capitalize(target.noun), describeBulkItem(item), action.state)
+			continue
+		}
+		pending = append(pending, item)
+	}
+
+	if len(pending) == 0 {
+		if len(selected) == 0 {
+			output.InfoMessage(out, fmt.Sprintf("No %s match", target.plural))
+		}
+		return nil
+	}
+
+	if dryRun {
+		fmt.Fprintf(out, "Would %s %s:\n", action.verb, countNoun(len(pending), target.noun, target.plural))
+		for _, item := range pending {
+			fmt.Fprintf(out, "  %s\n", describeBulkItem(item))
+		}
+		return nil
+	}
+
+	if action.confirm {
+		force, _ := cmd.Flags().GetBool("force")
+		if !force && !confirmBulk(cmd, action, target, pending) {
+			fmt.Fprintln(out, "Cancelled")
+			return nil
+		}
+	}
+
+	paceBulk(cmd, client)
+	failed := 0
+	for _, item := range pending {
+		s := newSpinner(cmd)
+		s.Start()
+		err := action.apply(client, item.id)
+		s.Stop()
+
+		if err != nil {
+			failed++
+			output.ErrorMessage(out, fmt.Sprintf("Failed to %s %s %s: %v", action.verb, target.noun, describeBulkItem(item), err))
+			continue
+		}
+		output.SuccessMessage(out, fmt.Sprintf("%s %s %s successfully", capitalize(target.noun), describeBulkItem(item), action.past))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %s", action.verb, failed, countNoun(len(pending), target.noun, target.plural))
+	}
+	return nil
+}
+
+// confirmBulk lists what an action will change and asks to go ahead
+func confirmBulk(cmd *cobra.Command, action bulkAction, target bulkTarget, items []bulkItem) bool {
+	out := cmd.OutOrStdout()
+	if len(items) == 1 {
+		fmt.Fprintf(out, "Are you sure you want to %s %s %s? (y/N): ", action.verb, target.noun, describeBulkItem(items[0]))
+	} else {
+		fmt.Fprintf(out, "This will %s %s:\n", action.verb, countNoun(len(items), target.noun, target.plural))
+		for _, item := range items {
+			fmt.Fprintf(out, "  %s\n", describeBulkItem(item))
+		}
+		fmt.Fprintf(out, "Are you sure? (y/N): ")
+	}
+
+	var response string
+	_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+	return response == "y" || response == "Y"
+}
+
+// describeBulkItem names a resource in bulk output
+func describeBulkItem(item bulkItem) string {
+	if item.name == "" {
+		return cmdutil.ShortID(item.id)
+	}
+	return fmt.Sprintf("%s (%s)", item.name, cmdutil.ShortID(item.id))
+}
+
+// countNoun formats a count with the singular or plural noun
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// statusAction pauses or resumes resources by setting their status
+func statusAction(target bulkTarget, status string) bulkAction {
+	action := bulkAction{verb: "pause", past: "paused", state: status}
+	if status == "active" {
+		action.verb, action.past = "resume", "resumed"
+	}
+	action.done = func(item bulkItem) bool {
+		return strings.EqualFold(item.status, status)
+	}
+	action.apply = func(client api.APIClient, id string) error {
+		return target.setStatus(client, id, status)
+	}
+	return action
+}
+
+// moveAction moves resources to a project. Listed items carry their
+// project in the "project" field.
+func moveAction(target bulkTarget, projectID string) bulkAction {
+	return bulkAction{
+		verb:  "move",
+		past:  "moved",
+		state: "in project " + cmdutil.ShortID(projectID),
+		done: func(item bulkItem) bool {
+			return item.fields["project"] == projectID
+		},
+		apply: func(client api.APIClient, id string) error {
+			return target.move(client, id, projectID)
+		},
+	}
+}
+
+// deleteAction deletes resources after confirmation
+func deleteAction(target bulkTarget) bulkAction {
+	return bulkAction{
+		verb:    "delete",
+		past:    "deleted",
+		apply:   target.remove,
+		confirm: true,
+	}
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var bulkTestTarget = bulkTarget{noun: "job", plural: "jobs"}
+
+var bulkTestItems = []bulkItem{
+	{id: "aaaa1111", name: "staging-backup", status: "active", tags: []string{"env=staging"}},
+	{id: "aaaa2222", name: "tmp-migrate", status: "paused", tags: []string{"env=staging", "team=data"}},
+	{id: "bbbb3333", name: "prod-backup", status: "active", tags: []string{"env=prod"}, fields: map[string]string{"url": "https://x.io"}},
+}
+
+// newBulkTestCmd returns a command with the bulk flags set from args
+func newBulkTestCmd(t *testing.T, flags ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "test"}
+	addBulkFlags(cmd)
+	require.NoError(t, cmd.ParseFlags(flags))
+	return cmd
+}
+
+// selectedIDs runs selection and returns the selected IDs
+func selectedIDs(t *testing.T, args []string, flags ...string) []string {
+	t.Helper()
+	items, err := selectBulkItems(newBulkTestCmd(t, flags...), bulkTestItems, args, bulkTestTarget)
+	require.NoError(t, err)
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.id)
+	}
+	return ids
+}
+
+// TestSelectBulkItems tests selecting resources by ID, tag, and match
+func TestSelectBulkItems(t *testing.T) {
+	assert.Equal(t, []string{"bbbb3333", "aaaa1111"}, selectedIDs(t, []string{"bbbb", "aaaa1", "bbbb3333"}))
+	assert.Equal(t, []string{"aaaa1111", "aaaa2222", "bbbb3333"}, selectedIDs(t, nil, "--all"))
+	assert.Equal(t, []string{"aaaa1111", "aaaa2222"}, selectedIDs(t, nil, "--tag", "env=staging"))
+	assert.Equal(t, []string{"aaaa2222"}, selectedIDs(t, nil, "--tag", "env=staging", "--tag", "team"))
+	assert.Equal(t, []string{"aaaa1111"}, selectedIDs(t, nil, "--match", "name~STAGING"))
+	assert.Equal(t, []string{"aaaa2222"}, selectedIDs(t, nil, "--match", "name~tmp-*"))
+	assert.Equal(t, []string{"aaaa1111", "bbbb3333"}, selectedIDs(t, nil, "--match", "name~*-backup", "--match", "status=active"))
+	assert.Empty(t, selectedIDs(t, nil, "--match", "name~backup-*"))
+}
+
+// TestSelectBulkItems_Errors tests invalid selections
+func TestSelectBulkItems_Errors(t *testing.T) {
+	_, err := selectBulkItems(newBulkTestCmd(t), bulkTestItems, nil, bulkTestTarget)
+	assert.ErrorContains(t, err, "give one or more job IDs")
+
+	_, err = selectBulkItems(newBulkTestCmd(t, "--all"), bulkTestItems, []string{"aaaa1"}, bulkTestTarget)
+	assert.ErrorContains(t, err, "not both")
+
+	_, err = selectBulkItems(newBulkTestCmd(t), bulkTestItems, []string{"aaaa"}, bulkTestTarget)
+	assert.ErrorContains(t, err, "ambiguous ID prefix 'aaaa'")
+
+	_, err = selectBulkItems(newBulkTestCmd(t), bulkTestItems, []string{"cccc"}, bulkTestTarget)
+	assert.ErrorContains(t, err, "no job found")
+
+	_, err = selectBulkItems(newBulkTestCmd(t, "--match", "owner~me"), bulkTestItems, nil, bulkTestTarget)
+	assert.ErrorContains(t, err, "unknown --match field \"owner\"")
+
+	_, err = selectBulkItems(newBulkTestCmd(t, "--match", "staging"), bulkTestItems, nil, bulkTestTarget)
+	assert.ErrorContains(t, err, "expected field~pattern or field=value")
+}
+
+// TestParseMatch tests glob and exact --match expressions
+func TestParseMatch(t *testing.T) {
+	m, err := parseMatch("url~x.io")
+	require.NoError(t, err)
+	ok, err := m.match(bulkTestItems[2])
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Globs match the whole value
+	m, err = parseMatch("url~x?io")
+	require.NoError(t, err)
+	ok, _ = m.match(bulkTestItems[2])
+	assert.False(t, ok)
+
+	m, err = parseMatch("url~https://x?io")
+	require.NoError(t, err)
+	ok, _ = m.match(bulkTestItems[2])
+	assert.True(t, ok)
+
+	m, err = parseMatch("name=Prod-Backup")
+	require.NoError(t, err)
+	ok, _ = m.match(bulkTestItems[2])
+	assert.True(t, ok)
+}
+
+// TestConfirmBulk tests the confirmation summary and prompt
+func TestConfirmBulk(t *testing.T) {
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	cmd.SetIn(strings.NewReader("y\n"))
+
+	assert.True(t, confirmBulk(cmd, deleteAction(bulkTestTarget), bulkTestTarget, bulkTestItems[:2]))
+	assert.Equal(t, "This will delete 2 jobs:\n  staging-backup (aaaa1111)\n  tmp-migrate (aaaa2222)\nAre you sure? (y/N): ", out.String())
+
+	out.Reset()
+	cmd.SetIn(strings.NewReader("\n"))
+	assert.False(t, confirmBulk(cmd, deleteAction(bulkTestTarget), bulkTestTarget, bulkTestItems[:1]))
+	assert.Equal(t, "Are you sure you want to delete job staging-backup (aaaa1111)? (y/N): ", out.String())
+}
+
+// TestMoveAction tests that resources already in the project are skipped
+func TestMoveAction(t *testing.T) {
+	var moved []string
+	target := bulkTarget{noun: "job", plural: "jobs", move: func(_ api.APIClient, id, projectID string) error {
+		moved = append(moved, id+">"+projectID)
+		return nil
+	}}
+	action := moveAction(target, "proj-1")
+
+	assert.True(t, action.done(bulkItem{fields: map[string]string{"project": "proj-1"}}))
+	assert.False(t, action.done(bulkItem{fields: map[string]string{"project": "proj-2"}}))
+	assert.False(t, action.done(bulkItem{}))
+	assert.False(t, action.confirm)
+
+	require.NoError(t, action.apply(nil, "aaaa1111"))
+	assert.Equal(t, []string{"aaaa1111>proj-1"}, moved)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cache"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// listCacheTTL is how long resource listings are reused for ID resolution
+const listCacheTTL = 2 * time.Minute
+
+// listCacheEnabled is set for commands run without --no-cache. It is off
+// until a command starts, so tests never touch the real cache.
+var listCacheEnabled bool
+
+// liveIDsAnnotation marks commands that delete or move resources. They
+// resolve short IDs against live listings only: a cached listing can
+// predate a newer resource sharing the prefix, and acting on the wrong one
+// can't be undone.
+const liveIDsAnnotation = "groovekit:live-ids"
+
+// resolveLive marks commands with liveIDsAnnotation
+func resolveLive(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		if c.Annotations == nil {
+			c.Annotations = map[string]string{}
+		}
+		c.Annotations[liveIDsAnnotation] = "true"
+	}
+}
+
+// usesListCache reports whether a command may resolve IDs from cached
+// listings: not with --no-cache, or for commands marked with resolveLive
+func usesListCache(cmd *cobra.Command) bool {
+	noCache, _ := cmd.Flags().GetBool("no-cache")
+	return !noCache && cmd.Annotations[liveIDsAnnotation] == ""
+}
+
+// cacheDir is where listings are cached, ~/.groovekit/cache
+func cacheDir() string {
+	return filepath.Join(config.Dir(), "cache")
+}
+
+// listCacheFor returns the listing cache for the client's account, or nil
+// when caching is off
+func listCacheFor(client api.APIClient) *cache.Store {
+	if !listCacheEnabled {
+		return nil
+	}
+	baseURL, token := client.Identity()
+	return cache.New(cacheDir(), listCacheTTL, baseURL, token)
+}
+
+// cachedItem is a bulkItem as stored in the listing cache
+type cachedItem struct {
+	ID     string            `json:"id"`
+	Name   string            `json:"name"`
+	Status string            `json:"status,omitempty"`
+	Tags   []string          `json:"tags,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// storeListing caches a resource type's listing. Failures only cost the
+// next command a request, so they are ignored.
+func storeListing(store *cache.Store, target bulkTarget, items []bulkItem) {
+	cached := make([]cachedItem, len(items))
+	for i, item := range items {
+		cached[i] = cachedItem{ID: item.id, Name: item.name, Status: item.status, Tags: item.tags, Fields: item.fields}
+	}
+	_ = store.Put(target.plural, cached)
+}
+
+// loadListing returns a resource type's cached listing, if it is fresh
+func loadListing(store *cache.Store, target bulkTarget) ([]bulkItem, bool) {
+	var cached []cachedItem
+	if !store.Get(target.plural, &cached) {
+		return nil, false
+	}
+	items := make([]bulkItem, len(cached))
+	for i, item := range cached {
+		items[i] = bulkItem{id: item.ID, name: item.Name, status: item.Status, tags: item.Tags, fields: item.Fields}
+	}
+	return items, true
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the local response cache",
+	Long: `Manage the local cache of resource listings.
+
+To resolve short IDs, commands list the resource type they need. The
+listing is cached in ~/.groovekit/cache for two minutes and reused by later
+commands, falling back to the API when a short ID isn't found in it. Pass
+--no-cache to any command to skip the cache.`,
+}
+
+// cache clear
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete all cached responses",
+	Long: `Delete all cached resource listings, for every profile.
+
+Examples:
+  groovekit cache clear`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		removed, err := cache.Clear(cacheDir())
+		if err != nil {
+			return fmt.Errorf("failed to clear cache: %w", err)
+		}
+		output.SuccessMessage(cmd.OutOrStdout(), fmt.Sprintf("Cleared %s", countNoun(removed, "cached listing", "cached listings")))
+		return nil
+	},
+}
+
+func init() {
+	// Commands that delete or move what they resolve skip the cache
+	resolveLive(
+		jobsDeleteCmd, jobsMoveCmd, apisDeleteCmd, apisMoveCmd, certsDeleteCmd, certsMoveCmd,
+		domainsDeleteCmd, domainsMoveCmd, dnsDeleteCmd, dnsMoveCmd, projectsDeleteCmd, checksPruneCmd,
+	)
+
+	// Add subcommands
+	cacheCmd.AddCommand(cacheClearCmd)
+
+	// Add cache command to root
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cache"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestListingCache tests storing and loading a resource listing
+func TestListingCache(t *testing.T) {
+	store := cache.New(t.TempDir(), time.Minute, "test")
+
+	_, ok := loadListing(store, jobsBulkTarget)
+	assert.False(t, ok)
+
+	items := []bulkItem{
+		{id: "11111111-aaaa", name: "Backup", status: "active", tags: []string{"env:prod"}},
+		{id: "22222222-bbbb", name: "Sync", fields: map[string]string{"url": "https://example.com"}},
+	}
+	storeListing(store, jobsBulkTarget, items)
+
+	loaded, ok := loadListing(store, jobsBulkTarget)
+	assert.True(t, ok)
+	assert.Equal(t, items, loaded)
+
+	_, ok = loadListing(store, apisBulkTarget)
+	assert.False(t, ok, "listings are cached per resource type")
+}
+
+// TestListCacheFor tests that the cache is only used once enabled
+func TestListCacheFor(t *testing.T) {
+	client := &api.Client{BaseURL: "https://api.groovekit.io", Token: "t"}
+	assert.Nil(t, listCacheFor(client))
+
+	listCacheEnabled = true
+	defer func() { listCacheEnabled = false }()
+	assert.NotNil(t, listCacheFor(client))
+}
+
+// TestUsesListCache tests that commands deleting or moving resources never
+// resolve IDs from a cached listing
+func TestUsesListCache(t *testing.T) {
+	assert.True(t, usesListCache(jobsListCmd))
+	assert.True(t, usesListCache(apisPauseCmd))
+	for _, c := range []*cobra.Command{jobsDeleteCmd, apisMoveCmd, projectsDeleteCmd, checksPruneCmd} {
+		assert.False(t, usesListCache(c), "%s should resolve IDs live", c.CommandPath())
+	}
+}
+
+// TestCacheCommand tests that the cache command has its subcommands
+func TestCacheCommand(t *testing.T) {
+	cmd, _, err := rootCmd.Find([]string{"cache", "clear"})
+	assert.NoError(t, err)
+	assert.Equal(t, cacheClearCmd, cmd)
+	assert.NotNil(t, rootCmd.PersistentFlags().Lookup("no-cache"))
+}
@@ -2,11 +2,18 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
 	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/probe"
 	"github.com/spf13/cobra"
 )
 
@@ -22,21 +29,31 @@ var certsListCmd = &cobra.Command{
 	Short: "List all certs",
 	Long:  "List all API endpoint certs for your account",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		filter := getListFilter(cmd)
+		if err := filter.resolveProject(client); err != nil {
+			return err
+		}
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
-		result, err := client.ListCerts()
+		list := client.ListCerts
+		if filter.all {
+			list = client.ListAllCerts
+		}
+		result, err := list(filter.options())
 
 		if s != nil {
 			s.Stop()
@@ -45,33 +62,44 @@ var certsListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to list certs: %w", err)
 		}
+
+		// Filter client-side too, in case the API ignored any of the options
+		if filter.active() {
+			result.SslMonitors = filterItems(result.SslMonitors, func(cert api.SslMonitor) bool {
+				return filter.match(cert.Status, cert.Name, certExpiry(cert).down(), cert.Tags, cert.ProjectID)
+			})
+			result.TotalCount = len(result.SslMonitors)
+		}
+
+		result.SslMonitors = limitItems(result.SslMonitors, filter.limit)
+
 		if jsonOutput {
-			return outputJSON(result)
+			return cmdutil.OutputJSON(out, result)
 		}
 
 		if len(result.SslMonitors) == 0 {
-			output.InfoMessage("No SSL certificate monitors found")
-			fmt.Println("\nCreate your first SSL certificate monitor:")
-			fmt.Println("  groovekit certs create --name 'example.com SSL' --domain example.com")
+			if filter.active() {
+				output.InfoMessage(out, filter.emptyMessage("SSL certificate monitors"))
+				return nil
+			}
+			output.InfoMessage(out, "No SSL certificate monitors found")
+			fmt.Fprintln(out, "\nCreate your first SSL certificate monitor:")
+			fmt.Fprintln(out, "  groovekit certs create --name 'example.com SSL' --domain example.com")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"ID", "NAME", "DOMAIN", "PORT", "DAYS LEFT", "STATUS"})
+		table, err := newListTable(cmd, []string{"ID", "NAME", "DOMAIN", "PORT", "DAYS LEFT", "STATUS", "EXPIRES", "ISSUER", "THRESHOLDS"}, "expires", "issuer", "thresholds")
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
 		for _, cert := range result.SslMonitors {
-			status := cert.Status
-			if cert.Status == "active" {
-				status = output.Green(status)
-			}
+			status := formatListStatus(cert.Status, cert.SnoozedUntil, time.Now())
 
-			// Truncate ID to first 8 chars (like Docker)
-			shortID := cert.ID
-			if len(shortID) > 8 {
-				shortID = shortID[:8]
-			}
+			shortID := cmdutil.ShortID(cert.ID)
 
 			// Format days until expiration with color coding
 			daysLeft := fmt.Sprintf("%d", cert.DaysUntilExpiration)
@@ -92,11 +120,17 @@ var certsListCmd = &cobra.Command{
 				fmt.Sprintf("%d", cert.Port),
 				daysLeft,
 				status,
+				valueOrDash(output.FormatTime(cert.CertificateExpiresAt)),
+				valueOrDash(cert.CertificateIssuer),
+				formatThresholds(cert.WarningThreshold, cert.UrgentThreshold, cert.CriticalThreshold),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d SSL certificate monitor(s)", len(result.SslMonitors))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d SSL certificate monitor(s)", len(result.SslMonitors)))
+		if result.HasMore && !filter.all {
+			output.InfoMessage(out, "More results are available, use --all to fetch every page")
+		}
 		return nil
 	},
 }
@@ -105,26 +139,38 @@ var certsListCmd = &cobra.Command{
 var certsShowCmd = &cobra.Command{
 	Use:   "show <id>",
 	Short: "Show cert details",
-	Long:  "Display detailed information about a specific SSL certificate monitor",
-	Args:  cobra.ExactArgs(1),
+	Long: `Display detailed information about a specific SSL certificate monitor.
+
+When the API provides them, the certificate's SANs, key, the TLS protocol
+versions the server accepts, an SSL Labs-style grade, and the full
+certificate chain are shown too. --chain prints the chain as PEM instead,
+leaf first, e.g. to save it to a file.
+
+Examples:
+  groovekit certs show abc12345
+  groovekit certs show abc12345 --chain > chain.pem`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
+		fullID, err := cmdutil.ResolveCertID(client, args[0])
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		chain, _ := cmd.Flags().GetBool("chain")
 
 		var s *spinner.Spinner
-		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		if !jsonOutput && !chain {
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -138,56 +184,109 @@ var certsShowCmd = &cobra.Command{
 			return fmt.Errorf("failed to get cert: %w", err)
 		}
 		if jsonOutput {
-			return outputJSON(cert)
+			return cmdutil.OutputJSON(out, cert)
+		}
+		if chain {
+			return printCertChainPEM(out, cert)
 		}
 
 		// Print cert details
-		fmt.Printf("ID:                       %s\n", output.Cyan(cert.ID))
-		fmt.Printf("Name:                     %s\n", output.Bold(cert.Name))
-		fmt.Printf("Domain:                   %s\n", cert.Domain)
-		fmt.Printf("Port:                     %d\n", cert.Port)
-		fmt.Printf("Status:                   %s\n", cert.Status)
-		fmt.Printf("Check Interval:           %s\n", output.FormatDuration(cert.Interval))
-		fmt.Printf("Grace Period:             %s\n", output.FormatDuration(cert.GracePeriod))
-		fmt.Printf("Warning Threshold:        %d days\n", cert.WarningThreshold)
-		fmt.Printf("Urgent Threshold:         %d days\n", cert.UrgentThreshold)
-		fmt.Printf("Critical Threshold:       %d days\n", cert.CriticalThreshold)
-		fmt.Printf("Days Until Expiration:    %d\n", cert.DaysUntilExpiration)
-		fmt.Printf("Certificate Expires At:   %s\n", cert.CertificateExpiresAt)
-		fmt.Printf("Certificate Issuer:       %s\n", cert.CertificateIssuer)
-		fmt.Printf("Certificate Subject:      %s\n", cert.CertificateSubject)
-		fmt.Printf("Last Check At:            %s\n", cert.LastCheckAt)
-		fmt.Printf("Last Successful Check:    %s\n", cert.LastSuccessfulCheckAt)
-		fmt.Printf("Consecutive Failures:     %d\n", cert.ConsecutiveFailures)
-		fmt.Printf("Created At:               %s\n", cert.CreatedAt)
-		fmt.Printf("Updated At:               %s\n", cert.UpdatedAt)
-
+		fmt.Fprintf(out, "ID:                       %s\n", output.Cyan(cert.ID))
+		fmt.Fprintf(out, "Name:                     %s\n", output.Bold(cert.Name))
+		fmt.Fprintf(out, "Domain:                   %s\n", cert.Domain)
+		fmt.Fprintf(out, "Port:                     %d\n", cert.Port)
+		if cert.ResolveIP != "" {
+			fmt.Fprintf(out, "Resolve:                  %s\n", formatResolve(cert.Domain, cert.Port, cert.ResolveIP))
+		}
+		if cert.SNI != "" {
+			fmt.Fprintf(out, "SNI:                      %s\n", cert.SNI)
+		}
+		fmt.Fprintf(out, "Status:                   %s\n", cert.Status)
+		if snoozed, ok := formatSnoozedUntil(cert.SnoozedUntil, time.Now()); ok {
+			fmt.Fprintf(out, "Snoozed Until:            %s\n", output.Yellow(snoozed))
+		}
+		fmt.Fprintf(out, "Check Interval:           %s\n", output.FormatDuration(cert.Interval))
+		fmt.Fprintf(out, "Grace Period:             %s\n", output.FormatDuration(cert.GracePeriod))
+		fmt.Fprintf(out, "Alerts:                   %s\n", formatEscalation(cert.AlertAfter, cert.RealertEvery))
+		fmt.Fprintf(out, "Warning Threshold:        %d days\n", cert.WarningThreshold)
+		fmt.Fprintf(out, "Urgent Threshold:         %d days\n", cert.UrgentThreshold)
+		fmt.Fprintf(out, "Critical Threshold:       %d days\n", cert.CriticalThreshold)
+		fmt.Fprintf(out, "Days Until Expiration:    %d\n", cert.DaysUntilExpiration)
+		fmt.Fprintf(out, "Certificate Expires At:   %s\n", output.FormatTime(cert.CertificateExpiresAt))
+		fmt.Fprintf(out, "Certificate Issuer:       %s\n", cert.CertificateIssuer)
+		fmt.Fprintf(out, "Certificate Subject:      %s\n", cert.CertificateSubject)
+		if len(cert.CertificateSANs) > 0 {
+			fmt.Fprintf(out, "SANs:                     %s\n", strings.Join(cert.CertificateSANs, ", "))
+		}
+		if cert.KeyType != "" {
+			fmt.Fprintf(out, "Key:                      %s\n", formatCertKey(cert.KeyType, cert.KeySize))
+		}
+		if len(cert.Protocols) > 0 {
+			fmt.Fprintf(out, "Protocols:                %s\n", strings.Join(cert.Protocols, ", "))
+		}
+		if cert.Grade != "" {
+			fmt.Fprintf(out, "Grade:                    %s\n", formatCertGrade(cert.Grade))
+		}
+		fmt.Fprintf(out, "Last Check At:            %s\n", output.FormatTime(cert.LastCheckAt))
+		fmt.Fprintf(out, "Last Successful Check:    %s\n", output.FormatTime(cert.LastSuccessfulCheckAt))
+		fmt.Fprintf(out, "Consecutive Failures:     %d\n", cert.ConsecutiveFailures)
+		fmt.Fprintf(out, "Tags:                     %s\n", formatTags(cert.Tags))
+		fmt.Fprintf(out, "Notifications:            %s\n", formatChannels(cert.ChannelIDs))
+		fmt.Fprintf(out, "Created At:               %s\n", output.FormatTime(cert.CreatedAt))
+		fmt.Fprintf(out, "Updated At:               %s\n", output.FormatTime(cert.UpdatedAt))
+
+		printCertChain(out, cert.CertificateChain)
 		return nil
 	},
 }
 
+// certsCreateWizard prompts for the certs create settings
+var certsCreateWizard = []wizardField{
+	{flag: "domain", prompt: "Domain to monitor", required: true, validate: validateDomainName},
+	{flag: "name", prompt: "Monitor name", required: true, def: defaultToFlag("domain")},
+	{flag: "port", prompt: "Port"},
+	{flag: "interval", prompt: "Check interval"},
+	tagsWizardField,
+}
+
 // certs create
 var certsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new SSL certificate monitor",
-	Long:  "Create a new SSL certificate monitor",
+	Long: `Create a new SSL certificate monitor.
+
+For internal hosts, or to check a server before DNS points at it, --resolve
+connects to an explicit IP address instead of resolving the domain, like
+curl's --resolve: host and port must match --domain and --port. --sni sends
+a different server name in the TLS handshake than the domain.
+
+Examples:
+  groovekit certs create --name "example.com SSL" --domain example.com
+  groovekit certs create --name "New LB" --domain example.com --resolve example.com:443:203.0.113.10
+  groovekit certs create --name "Intranet" --domain 10.0.0.5 --sni intranet.corp.example`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
+		if err := runCreateWizard(cmd, certsCreateWizard); err != nil {
+			return err
+		}
+
 		// Get flag values
 		name, _ := cmd.Flags().GetString("name")
 		domain, _ := cmd.Flags().GetString("domain")
 		port, _ := cmd.Flags().GetInt("port")
-		interval, _ := cmd.Flags().GetInt("interval")
+		interval := getDurationFlag(cmd, "interval")
 
 		if name == "" {
-			return fmt.Errorf("--name is required")
+			return usageErrorf("--name is required")
 		}
 		if domain == "" {
-			return fmt.Errorf("--domain is required")
+			return usageErrorf("--domain is required")
 		}
 
 		req := &api.CreateSslMonitorRequest{
@@ -197,7 +296,43 @@ var certsCreateCmd = &cobra.Command{
 			Interval: interval,
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		if resolve, _ := cmd.Flags().GetString("resolve"); resolve != "" {
+			if req.ResolveIP, err = parseResolve(resolve, domain, port); err != nil {
+				return err
+			}
+		}
+		if req.SNI, _ = cmd.Flags().GetString("sni"); req.SNI != "" {
+			if err := validateSNI(req.SNI); err != nil {
+				return err
+			}
+		}
+
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil {
+			req.AlertAfter = *alertAfter
+		}
+		if realertEvery != nil {
+			req.RealertEvery = *realertEvery
+		}
+
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
+		}
+		req.Tags = tags
+
+		if req.ProjectID, err = getProject(cmd, client); err != nil {
+			return err
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
 		s.Start()
 		cert, err := client.CreateCert(req)
 		s.Stop()
@@ -206,31 +341,126 @@ var certsCreateCmd = &cobra.Command{
 			return fmt.Errorf("failed to create SSL monitor: %w", err)
 		}
 
-		output.SuccessMessage("SSL certificate monitor created successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(cert.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(cert.Name))
-		fmt.Printf("Domain:   %s\n", cert.Domain)
-		fmt.Printf("Port:     %d\n", cert.Port)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(cert.Interval))
+		output.SuccessMessage(out, "SSL certificate monitor created successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(cert.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(cert.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", cert.Domain)
+		fmt.Fprintf(out, "Port:     %d\n", cert.Port)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(cert.Interval))
 
 		return nil
 	},
 }
 
+// certs clone <id>
+var certsCloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of an SSL certificate monitor",
+	Long: `Create a new SSL certificate monitor with the same interval, grace period,
+and expiry thresholds as an existing one, optionally for another domain or
+port.` + cloneLongHelp + `
+
+Examples:
+  groovekit certs clone abc12345 --domain staging.example.com
+  groovekit certs clone abc12345 --name "API cert" --port 8443`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveCertID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		cert, err := client.GetCert(fullID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get cert: %w", err)
+		}
+
+		tags, err := cloneTags(cmd, cert.Tags)
+		if err != nil {
+			return err
+		}
+
+		req := &api.CreateSslMonitorRequest{
+			Name:              cloneName(cmd, cert.Name),
+			Domain:            cert.Domain,
+			Port:              cert.Port,
+			ResolveIP:         cert.ResolveIP,
+			SNI:               cert.SNI,
+			Interval:          cert.Interval,
+			GracePeriod:       cert.GracePeriod,
+			AlertAfter:        cert.AlertAfter,
+			RealertEvery:      cert.RealertEvery,
+			WarningThreshold:  cert.WarningThreshold,
+			UrgentThreshold:   cert.UrgentThreshold,
+			CriticalThreshold: cert.CriticalThreshold,
+			Status:            cert.Status,
+			Tags:              tags,
+			ProjectID:         cert.ProjectID,
+		}
+		// Address and server name overrides are specific to the domain and
+		// port, so a clone for another one doesn't keep them
+		if domain, _ := cmd.Flags().GetString("domain"); domain != "" {
+			req.Domain = domain
+			req.ResolveIP, req.SNI = "", ""
+		}
+		if cmd.Flags().Changed("port") {
+			req.Port, _ = cmd.Flags().GetInt("port")
+			req.ResolveIP = ""
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s = newSpinner(cmd)
+		s.Start()
+		clone, err := client.CreateCert(req)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to create SSL monitor: %w", err)
+		}
+
+		return finishClone(cmd, client, certsNotifyTarget, cert.Name, clone.Name, clone.ID, cert.ChannelIDs)
+	},
+}
+
 // certs update <id>
 var certsUpdateCmd = &cobra.Command{
 	Use:   "update <id>",
 	Short: "Update an SSL certificate monitor",
-	Long:  "Update an existing SSL certificate monitor",
-	Args:  cobra.ExactArgs(1),
+	Long: `Update an existing SSL certificate monitor.
+
+--resolve host:port:address connects to an explicit IP address instead of
+resolving the domain, and --sni overrides the server name sent in the TLS
+handshake (see 'groovekit certs create --help'). Pass an empty value to
+either to clear it.
+
+Examples:
+  groovekit certs update abc12345 --warning-threshold 45
+  groovekit certs update abc12345 --resolve example.com:443:203.0.113.10
+  groovekit certs update abc12345 --resolve "" --sni ""`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
+		fullID, err := cmdutil.ResolveCertID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -257,14 +487,41 @@ var certsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		if cmd.Flags().Changed("resolve") {
+			resolve, _ := cmd.Flags().GetString("resolve")
+			ip := ""
+			if resolve != "" {
+				domain, port, err := certTarget(cmd, client, fullID)
+				if err != nil {
+					return err
+				}
+				if ip, err = parseResolve(resolve, domain, port); err != nil {
+					return err
+				}
+			}
+			req.ResolveIP = &ip
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("sni") {
+			sni, _ := cmd.Flags().GetString("sni")
+			if sni != "" {
+				if err := validateSNI(sni); err != nil {
+					return err
+				}
+			}
+			req.SNI = &sni
+			hasUpdates = true
+		}
+
 		if cmd.Flags().Changed("interval") {
-			interval, _ := cmd.Flags().GetInt("interval")
+			interval := getDurationFlag(cmd, "interval")
 			req.Interval = &interval
 			hasUpdates = true
 		}
 
 		if cmd.Flags().Changed("grace-period") {
-			gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+			gracePeriod := getDurationFlag(cmd, "grace-period")
 			req.GracePeriod = &gracePeriod
 			hasUpdates = true
 		}
@@ -293,11 +550,29 @@ var certsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil || realertEvery != nil {
+			req.AlertAfter, req.RealertEvery = alertAfter, realertEvery
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("tag") {
+			tags, err := getTags(cmd)
+			if err != nil {
+				return err
+			}
+			req.Tags = &tags
+			hasUpdates = true
+		}
+
 		if !hasUpdates {
-			return fmt.Errorf("no fields to update. Use --name, --domain, --port, --interval, --grace-period, --warning-threshold, --urgent-threshold, --critical-threshold, or --status")
+			return fmt.Errorf("no fields to update. Use --name, --domain, --port, --resolve, --sni, --interval, --grace-period, --warning-threshold, --urgent-threshold, --critical-threshold, --status, --alert-after, --realert-every, or --tag")
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
 		cert, err := client.UpdateCert(fullID, req)
 		s.Stop()
@@ -306,87 +581,78 @@ var certsUpdateCmd = &cobra.Command{
 			return fmt.Errorf("failed to update SSL monitor: %w", err)
 		}
 
-		output.SuccessMessage("SSL certificate monitor updated successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(cert.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(cert.Name))
-		fmt.Printf("Domain:   %s\n", cert.Domain)
-		fmt.Printf("Port:     %d\n", cert.Port)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(cert.Interval))
-		fmt.Printf("Status:   %s\n", cert.Status)
+		output.SuccessMessage(out, "SSL certificate monitor updated successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(cert.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(cert.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", cert.Domain)
+		fmt.Fprintf(out, "Port:     %d\n", cert.Port)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(cert.Interval))
+		fmt.Fprintf(out, "Status:   %s\n", cert.Status)
 
 		return nil
 	},
 }
 
-// certs pause <id>
+// certs pause [id...]
 var certsPauseCmd = &cobra.Command{
-	Use:   "pause <id>",
-	Short: "Pause a cert",
-	Long:  "Pause an API endpoint cert (sets status to paused)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to paused
-		status := "paused"
-		req := &api.UpdateSslMonitorRequest{Status: &status}
-
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateCert(fullID, req)
-		s.Stop()
-
-		if err != nil {
-			return fmt.Errorf("failed to pause cert: %w", err)
-		}
-
-		output.SuccessMessage(fmt.Sprintf("Cert %s paused successfully", args[0]))
-		return nil
+	Use:   "pause [id...]",
+	Short: "Pause one or more certs",
+	Long: `Pause one or more SSL certificate monitors (sets status to paused).` + bulkLongHelp + `
+
+Examples:
+  groovekit certs pause abc12345 def67890
+  groovekit certs pause --tag env=staging --dry-run
+  groovekit certs pause --match 'name~staging'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, certsBulkTarget, statusAction(certsBulkTarget, "paused"))
 	},
 }
 
-// certs resume <id>
+// certs resume [id...]
 var certsResumeCmd = &cobra.Command{
-	Use:   "resume <id>",
-	Short: "Resume a cert",
-	Long:  "Resume a paused API endpoint cert (sets status to active)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to active
-		status := "active"
-		req := &api.UpdateSslMonitorRequest{Status: &status}
+	Use:   "resume [id...]",
+	Short: "Resume one or more certs",
+	Long: `Resume one or more paused SSL certificate monitors (sets status to active).` + bulkLongHelp + `
+
+Examples:
+  groovekit certs resume abc12345 def67890
+  groovekit certs resume --tag env=staging
+  groovekit certs resume --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, certsBulkTarget, statusAction(certsBulkTarget, "active"))
+	},
+}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateCert(fullID, req)
-		s.Stop()
+// certs notify <id>
+var certsNotifyCmd = &cobra.Command{
+	Use:   "notify <id>",
+	Short: "Manage where cert alerts go",
+	Long: `Add or remove the notification channels that a cert's alerts are
+routed to.` + notifyLongHelp + `
+
+Examples:
+  groovekit certs notify abc12345
+  groovekit certs notify abc12345 --add-channel ch_oncall --remove-channel ch_email`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runNotify(cmd, args[0], certsNotifyTarget)
+	},
+}
 
+// certsNotifyTarget reads and updates cert notification channels
+var certsNotifyTarget = notifyTarget{
+	noun:    "cert",
+	resolve: cmdutil.ResolveCertID,
+	channels: func(client api.APIClient, id string) ([]string, error) {
+		cert, err := client.GetCert(id)
 		if err != nil {
-			return fmt.Errorf("failed to resume cert: %w", err)
+			return nil, err
 		}
-
-		output.SuccessMessage(fmt.Sprintf("Cert %s resumed successfully", args[0]))
-		return nil
+		return cert.ChannelIDs, nil
+	},
+	update: func(client api.APIClient, id string, channels []string) error {
+		_, err := client.UpdateCert(id, &api.UpdateSslMonitorRequest{ChannelIDs: &channels})
+		return err
 	},
 }
 
@@ -397,13 +663,15 @@ var certsIncidentsCmd = &cobra.Command{
 	Long:  "Display incident history (downtime periods) for a cert",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
+		fullID, err := cmdutil.ResolveCertID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -413,7 +681,7 @@ var certsIncidentsCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -428,16 +696,19 @@ var certsIncidentsCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
-			return outputJSON(incidents)
+			return cmdutil.OutputJSON(out, incidents)
 		}
 
 		if len(incidents) == 0 {
-			output.InfoMessage("No incidents found - this cert has been running smoothly!")
+			output.InfoMessage(out, "No incidents found - this cert has been running smoothly!")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"STARTED", "ENDED", "DURATION", "STATUS", "ERROR"})
+		table, err := newListTable(cmd, []string{"ID", "STARTED", "ENDED", "DURATION", "STATUS", "ERROR", "ACK", "NOTE"})
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
@@ -447,149 +718,529 @@ var certsIncidentsCmd = &cobra.Command{
 
 			if incident.EndedAt != nil {
 				status = output.Green("Recovered")
-				ended = *incident.EndedAt
+				ended = output.FormatTime(*incident.EndedAt)
 			}
 
 			// Format duration
-			duration := formatIncidentDuration(incident.Duration)
+			duration := cmdutil.FormatIncidentDuration(incident.Duration)
 
-			// Truncate error message
 			errorMsg := "-"
 			if incident.ErrorMessage != nil {
-				errorMsg = truncate(*incident.ErrorMessage, 40)
+				errorMsg = *incident.ErrorMessage
 			}
 
 			table.Append([]string{
-				incident.StartedAt,
+				output.Cyan(cmdutil.ShortID(incident.ID)),
+				output.FormatTime(incident.StartedAt),
 				ended,
 				duration,
 				status,
 				errorMsg,
+				incidentAck(incident),
+				incidentNote(incident),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d incident(s)", len(incidents))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d incident(s)", len(incidents)))
 		return nil
 	},
 }
 
-// certs delete <id>
-var certsDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete a cert",
-	Long:  "Delete an API endpoint cert",
+// certs check <id>
+var certsCheckCmd = &cobra.Command{
+	Use:   "check <id>",
+	Short: "Check cert health",
+	Long:  "Query the current health of an SSL certificate monitor for use in scripts and CI gates.\nA certificate is down when checks are failing or it is inside its critical threshold." + checkLongHelp,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
-			return err
+			return checkFailed(cmd, err)
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveCertID(client, args[0])
+		fullID, err := cmdutil.ResolveCertID(client, args[0])
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		cert, err := client.GetCert(fullID)
+		if err != nil {
+			return checkFailed(cmd, fmt.Errorf("failed to get cert: %w", err))
+		}
+
+		if state, ok := statusHealth(cert.Status); ok {
+			return reportHealth(cmd, "cert", cert.Name, state, cert.Status)
+		}
+		if cert.ConsecutiveFailures > 0 {
+			return reportHealth(cmd, "cert", cert.Name, healthDown, fmt.Sprintf("%d consecutive failure(s)", cert.ConsecutiveFailures))
+		}
+		if cert.LastCheckAt == "" {
+			return reportHealth(cmd, "cert", cert.Name, healthUnknown, "not checked yet")
+		}
+		if cert.DaysUntilExpiration <= cert.CriticalThreshold {
+			return reportHealth(cmd, "cert", cert.Name, healthDown, fmt.Sprintf("expires in %d days", cert.DaysUntilExpiration))
+		}
+		return reportHealth(cmd, "cert", cert.Name, healthUp, "")
+	},
+}
+
+// certs inspect <domain>
+var certsInspectCmd = &cobra.Command{
+	Use:   "inspect <domain>",
+	Short: "Inspect a certificate over TLS",
+	Long: `Connect to a domain over TLS from this machine and print the certificate
+chain it presents: subject, issuer, SANs, validity, days left, signature
+algorithm, and key. The chain is shown even when it does not verify.
+
+With --create, offers to create an SSL monitor for the domain afterwards.
+Exits with status 6 when the chain does not verify.
+
+Examples:
+  groovekit certs inspect example.com
+  groovekit certs inspect mail.example.com --port 993
+  groovekit certs inspect example.com --create --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		port, _ := cmd.Flags().GetInt("port")
+		host, port, err := parseTLSTarget(args[0], port, cmd.Flags().Changed("port"))
 		if err != nil {
 			return err
 		}
+		serverName, _ := cmd.Flags().GetString("server-name")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		create, _ := cmd.Flags().GetBool("create")
 
-		// Confirm deletion
-		confirm, _ := cmd.Flags().GetBool("force")
-		if !confirm {
-			fmt.Printf("Are you sure you want to delete cert %s? (y/N): ", args[0])
-			var response string
-			_, _ = fmt.Scanln(&response)
-			if response != "y" && response != "Y" {
-				fmt.Println("Cancelled")
-				return nil
-			}
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		err = client.DeleteCert(fullID)
-		s.Stop()
+		result, err := probe.InspectTLS(probe.TLSOptions{Host: host, Port: port, ServerName: serverName})
+
+		if s != nil {
+			s.Stop()
+		}
 
 		if err != nil {
-			return fmt.Errorf("failed to delete cert: %w", err)
+			return err
+		}
+
+		if jsonOutput {
+			if err := cmdutil.OutputJSON(out, result); err != nil {
+				return err
+			}
+		} else {
+			printTLSResult(out, result)
 		}
 
-		output.SuccessMessage(fmt.Sprintf("Cert %s deleted successfully", args[0]))
+		if create {
+			if err := offerCertMonitor(cmd, host, port); err != nil {
+				return err
+			}
+		}
+
+		if !result.Verified && failOn(cmd, failLevelDown) {
+			return resourceDown(cmd)
+		}
 		return nil
 	},
 }
 
-// Helper function to resolve a short cert ID to a full ID
-func resolveCertID(client *api.Client, shortID string) (string, error) {
-	// If it looks like a full UUID, use it as-is
-	if len(shortID) >= 32 {
-		return shortID, nil
+// parseTLSTarget accepts a host, host:port, or URL. An explicit --port wins
+// over a port in the target.
+func parseTLSTarget(target string, port int, portSet bool) (string, int, error) {
+	if u, err := url.Parse(target); err == nil && u.Host != "" {
+		target = u.Host
+	}
+	host := target
+	if h, p, err := net.SplitHostPort(target); err == nil {
+		host = h
+		if !portSet {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid port in %q", target)
+			}
+			port = n
+		}
+	}
+	if host == "" {
+		return "", 0, fmt.Errorf("invalid domain %q", target)
+	}
+	return host, port, nil
+}
+
+// printTLSResult prints the connection details and each certificate in the chain
+func printTLSResult(w io.Writer, result *probe.TLSResult) {
+	fmt.Fprintf(w, "%s  %s, %s\n", output.Bold(net.JoinHostPort(result.Host, strconv.Itoa(result.Port))), result.TLSVersion, result.CipherSuite)
+	if result.Verified {
+		fmt.Fprintf(w, "Verified: %s\n", output.Green("✓ trusted chain"))
+	} else {
+		fmt.Fprintf(w, "Verified: %s\n", output.Red("✗ "+result.VerifyError))
+	}
+
+	for i, cert := range result.Chain {
+		role := "intermediate"
+		switch {
+		case i == 0:
+			role = "leaf"
+		case cert.IsCA && cert.Subject == cert.Issuer:
+			role = "root"
+		}
+
+		daysLeft := fmt.Sprintf("%d", cert.DaysLeft)
+		switch {
+		case cert.DaysLeft <= 7:
+			daysLeft = output.Red(daysLeft)
+		case cert.DaysLeft <= 30:
+			daysLeft = output.Yellow(daysLeft)
+		default:
+			daysLeft = output.Green(daysLeft)
+		}
+
+		fmt.Fprintf(w, "\n%s\n", output.Bold(fmt.Sprintf("Certificate %d (%s)", i+1, role)))
+		fmt.Fprintf(w, "  Subject:     %s\n", cert.Subject)
+		fmt.Fprintf(w, "  Issuer:      %s\n", cert.Issuer)
+		if len(cert.SANs) > 0 {
+			fmt.Fprintf(w, "  SANs:        %s\n", strings.Join(cert.SANs, ", "))
+		}
+		fmt.Fprintf(w, "  Valid From:  %s\n", cert.NotBefore.Format(time.RFC3339))
+		fmt.Fprintf(w, "  Expires:     %s\n", cert.NotAfter.Format(time.RFC3339))
+		fmt.Fprintf(w, "  Days Left:   %s\n", daysLeft)
+		fmt.Fprintf(w, "  Signature:   %s\n", cert.SignatureAlgorithm)
+		fmt.Fprintf(w, "  Public Key:  %s\n", cert.PublicKey)
+		fmt.Fprintf(w, "  Serial:      %s\n", cert.SerialNumber)
+	}
+}
+
+// parseResolve parses a curl-style --resolve host:port:address for a
+// monitor of domain on port, returning the address. The host may be * to
+// match any domain.
+func parseResolve(value, domain string, port int) (string, error) {
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 {
+		return "", usageErrorf("invalid --resolve %q: must be host:port:address, e.g. example.com:443:203.0.113.10", value)
+	}
+	host, portText, address := parts[0], parts[1], strings.Trim(parts[2], "[]")
+
+	if host != "*" && !strings.EqualFold(host, domain) {
+		return "", usageErrorf("invalid --resolve %q: host %q doesn't match the domain %q", value, host, domain)
 	}
+	if n, err := strconv.Atoi(portText); err != nil || n != port {
+		return "", usageErrorf("invalid --resolve %q: port %s doesn't match the port %d", value, portText, port)
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", usageErrorf("invalid --resolve %q: %q is not an IP address", value, address)
+	}
+	return ip.String(), nil
+}
 
-	// Otherwise, fetch all certs and match by prefix
-	result, err := client.ListCerts()
+// validateSNI checks that --sni is a bare hostname
+func validateSNI(sni string) error {
+	if strings.ContainsAny(sni, "/: ") || net.ParseIP(sni) != nil {
+		return usageErrorf("invalid --sni %q: must be a hostname, e.g. example.com", sni)
+	}
+	return nil
+}
+
+// certTarget returns the domain and port a monitor will check after an
+// update, fetching the monitor unless both are being changed
+func certTarget(cmd *cobra.Command, client api.APIClient, id string) (string, int, error) {
+	domain, _ := cmd.Flags().GetString("domain")
+	port, _ := cmd.Flags().GetInt("port")
+	if cmd.Flags().Changed("domain") && cmd.Flags().Changed("port") {
+		return domain, port, nil
+	}
+
+	cert, err := client.GetCert(id)
 	if err != nil {
-		return "", fmt.Errorf("failed to list SSL monitors: %w", err)
+		return "", 0, fmt.Errorf("failed to get cert: %w", err)
+	}
+	if !cmd.Flags().Changed("domain") {
+		domain = cert.Domain
+	}
+	if !cmd.Flags().Changed("port") {
+		port = cert.Port
 	}
+	return domain, port, nil
+}
+
+// formatResolve renders a monitor's address override the way it was given,
+// e.g. example.com:443:203.0.113.10
+func formatResolve(domain string, port int, ip string) string {
+	if strings.Contains(ip, ":") {
+		ip = "[" + ip + "]"
+	}
+	return fmt.Sprintf("%s:%d:%s", domain, port, ip)
+}
 
-	var matches []string
-	for _, cert := range result.SslMonitors {
-		if len(cert.ID) >= len(shortID) && cert.ID[:len(shortID)] == shortID {
-			matches = append(matches, cert.ID)
+// printCertChain lists each certificate in a monitored chain, leaf first
+func printCertChain(w io.Writer, chain []api.ChainCertificate) {
+	if len(chain) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", output.Bold("Certificate Chain"))
+	for i, cert := range chain {
+		role := "intermediate"
+		switch {
+		case i == 0:
+			role = "leaf"
+		case cert.Subject == cert.Issuer:
+			role = "root"
+		}
+		fmt.Fprintf(w, "  %d. %s (%s)\n", i+1, cert.Subject, role)
+		fmt.Fprintf(w, "     Issuer:     %s\n", cert.Issuer)
+		fmt.Fprintf(w, "     Valid:      %s to %s\n", cert.NotBefore, cert.NotAfter)
+		if cert.KeyType != "" {
+			fmt.Fprintf(w, "     Key:        %s\n", formatCertKey(cert.KeyType, cert.KeySize))
+		}
+		if cert.SignatureAlgorithm != "" {
+			fmt.Fprintf(w, "     Signature:  %s\n", cert.SignatureAlgorithm)
 		}
 	}
+}
 
-	if len(matches) == 0 {
-		return "", fmt.Errorf("no cert found with ID prefix '%s'", shortID)
+// printCertChainPEM prints a monitored certificate chain as PEM, leaf first
+func printCertChainPEM(w io.Writer, cert *api.SslMonitor) error {
+	var pems []string
+	for _, c := range cert.CertificateChain {
+		if c.PEM != "" {
+			pems = append(pems, strings.TrimSpace(c.PEM))
+		}
 	}
+	if len(pems) == 0 {
+		return fmt.Errorf("the API didn't return the certificate chain for %s; try 'groovekit certs inspect %s'", cert.Name, cert.Domain)
+	}
+	for _, pem := range pems {
+		fmt.Fprintln(w, pem)
+	}
+	return nil
+}
+
+// formatThresholds lists the warning, urgent, and critical expiry
+// thresholds, e.g. "30/14/7 days"
+func formatThresholds(warning, urgent, critical int) string {
+	return fmt.Sprintf("%d/%d/%d days", warning, urgent, critical)
+}
 
-	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID prefix '%s' matches multiple certs", shortID)
+// formatCertKey describes a public key, e.g. "RSA 2048-bit"
+func formatCertKey(keyType string, size int) string {
+	if size <= 0 {
+		return keyType
 	}
+	return fmt.Sprintf("%s %d-bit", keyType, size)
+}
 
-	return matches[0], nil
+// formatCertGrade colors an SSL Labs-style grade: green for A and better,
+// yellow for B, and red below that
+func formatCertGrade(grade string) string {
+	switch {
+	case strings.HasPrefix(grade, "A"):
+		return output.Green(grade)
+	case strings.HasPrefix(grade, "B"):
+		return output.Yellow(grade)
+	}
+	return output.Red(grade)
+}
+
+// offerCertMonitor asks whether to create an SSL monitor for an inspected
+// domain, and creates it
+func offerCertMonitor(cmd *cobra.Command, host string, port int) error {
+	out := cmd.OutOrStdout()
+
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		name = host + " SSL"
+	}
+
+	if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+		fmt.Fprintf(out, "\nCreate SSL monitor %q for %s:%d? (y/N): ", name, host, port)
+		var response string
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+		if response != "y" && response != "Y" {
+			fmt.Fprintln(out, "Cancelled")
+			return nil
+		}
+	}
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+		return err
+	}
+
+	s := newSpinner(cmd)
+	s.Start()
+	cert, err := client.CreateCert(&api.CreateSslMonitorRequest{Name: name, Domain: host, Port: port})
+	s.Stop()
+
+	if err != nil {
+		return fmt.Errorf("failed to create SSL monitor: %w", err)
+	}
+
+	output.SuccessMessage(out, fmt.Sprintf("SSL certificate monitor %s created (%s)", output.Bold(cert.Name), output.Cyan(cmdutil.ShortID(cert.ID))))
+	return nil
+}
+
+// certs move [id...]
+var certsMoveCmd = &cobra.Command{
+	Use:   "move [id...]",
+	Short: "Move one or more certs to a project",
+	Long: `Move one or more SSL certificate monitors to a project. Their check history,
+incidents, and settings are kept.` + bulkLongHelp + `
+
+Examples:
+  groovekit certs move abc12345 --project def67890
+  groovekit certs move --match 'name~checkout-*' --project def67890 --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMove(cmd, args, certsBulkTarget)
+	},
+}
+
+// certs delete [id...]
+var certsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more certs",
+	Long: `Delete one or more SSL certificate monitors. Everything that will be deleted is listed
+for confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit certs delete abc12345 def67890
+  groovekit certs delete --match 'name~tmp-*' --dry-run
+  groovekit certs delete --tag env=preview --force`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, certsBulkTarget, deleteAction(certsBulkTarget))
+	},
+}
+
+// certsBulkTarget lists certs for bulk actions and ID resolution
+var certsBulkTarget = bulkTarget{
+	noun:    "cert",
+	plural:  "certs",
+	command: "certs",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		result, err := client.ListAllCerts(nil)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(result.SslMonitors))
+		for _, cert := range result.SslMonitors {
+			items = append(items, bulkItem{id: cert.ID, name: cert.Name, status: cert.Status, tags: cert.Tags, fields: map[string]string{"domain": cert.Domain, "project": cert.ProjectID}})
+		}
+		return items, nil
+	},
+	setStatus: func(client api.APIClient, id, status string) error {
+		_, err := client.UpdateCert(id, &api.UpdateSslMonitorRequest{Status: &status})
+		return err
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteCert(id)
+	},
+	move: func(client api.APIClient, id, projectID string) error {
+		return client.MoveCert(id, projectID)
+	},
+	snooze: func(client api.APIClient, id string, until time.Time) error {
+		return client.SnoozeCert(id, until)
+	},
 }
 
 func init() {
 	// Add flags to list command
 	certsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(certsListCmd)
+	addListFilterFlags(certsListCmd)
+	addPageFlags(certsListCmd)
 
 	// Add flags to show command
 	certsShowCmd.Flags().Bool("json", false, "Output as JSON")
+	certsShowCmd.Flags().Bool("chain", false, "Print the certificate chain as PEM")
+	certsShowCmd.MarkFlagsMutuallyExclusive("json", "chain")
 
 	// Add flags to create command
 	certsCreateCmd.Flags().String("name", "", "SSL monitor name (required)")
 	certsCreateCmd.Flags().String("domain", "", "Domain to monitor (required)")
 	certsCreateCmd.Flags().Int("port", 443, "Port number")
-	certsCreateCmd.Flags().Int("interval", 1440, "Check interval in minutes (default: daily)")
-	_ = certsCreateCmd.MarkFlagRequired("name")
-	_ = certsCreateCmd.MarkFlagRequired("domain")
+	certsCreateCmd.Flags().String("resolve", "", "Connect to this address instead of resolving the domain, as host:port:address")
+	certsCreateCmd.Flags().String("sni", "", "Server name to send in the TLS handshake (default: the domain)")
+	addDurationFlag(certsCreateCmd, "interval", 1440, time.Minute, "Check interval")
+	addEscalationFlags(certsCreateCmd)
+	addTagFlag(certsCreateCmd)
+	addProjectFlag(certsCreateCmd)
+	addInteractiveFlag(certsCreateCmd)
+
+	// Add flags to clone command
+	addCloneFlags(certsCloneCmd)
+	certsCloneCmd.Flags().String("domain", "", "Domain for the copy to monitor (default the original's)")
+	certsCloneCmd.Flags().Int("port", 0, "Port for the copy to check (default the original's)")
 
 	// Add flags to update command
 	certsUpdateCmd.Flags().String("name", "", "SSL monitor name")
 	certsUpdateCmd.Flags().String("domain", "", "Domain to monitor")
 	certsUpdateCmd.Flags().Int("port", 0, "Port number")
-	certsUpdateCmd.Flags().Int("interval", 0, "Check interval in minutes")
-	certsUpdateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	certsUpdateCmd.Flags().String("resolve", "", "Connect to this address instead of resolving the domain, as host:port:address (empty to clear)")
+	certsUpdateCmd.Flags().String("sni", "", "Server name to send in the TLS handshake (empty to clear)")
+	addDurationFlag(certsUpdateCmd, "interval", 0, time.Minute, "Check interval")
+	addDurationFlag(certsUpdateCmd, "grace-period", 0, time.Minute, "Grace period")
 	certsUpdateCmd.Flags().Int("warning-threshold", 0, "Warning threshold in days")
 	certsUpdateCmd.Flags().Int("urgent-threshold", 0, "Urgent threshold in days")
 	certsUpdateCmd.Flags().Int("critical-threshold", 0, "Critical threshold in days")
 	certsUpdateCmd.Flags().String("status", "", "Monitor status (active, inactive, paused)")
+	registerFlagCompletion(certsUpdateCmd, "status", completeValues(monitorStatuses...))
+	addEscalationFlags(certsUpdateCmd)
+	addTagFlag(certsUpdateCmd)
+
+	// Add flags to notify command
+	addNotifyFlags(certsNotifyCmd)
+
+	// Add flags to pause and resume commands
+	addBulkFlags(certsPauseCmd)
+	addBulkFlags(certsResumeCmd)
 
 	// Add flags to incidents command
 	certsIncidentsCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(certsIncidentsCmd)
+
+	// Add flags to check command
+	certsCheckCmd.Flags().BoolP("verbose", "v", false, "Print the health result")
+	addFormatFlag(certsCheckCmd)
 
 	// Add flags to delete command
 	certsDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(certsDeleteCmd)
+	addProjectFlag(certsMoveCmd)
+	addBulkFlags(certsMoveCmd)
+
+	// Add flags to inspect command
+	certsInspectCmd.Flags().Int("port", 443, "Port number")
+	certsInspectCmd.Flags().String("server-name", "", "Server name to send for SNI and verify against (default: the domain)")
+	certsInspectCmd.Flags().Bool("json", false, "Output as JSON")
+	certsInspectCmd.Flags().Bool("create", false, "Offer to create an SSL monitor for the domain")
+	certsInspectCmd.Flags().String("name", "", "Name for the monitor created with --create (default: \"<domain> SSL\")")
+	certsInspectCmd.Flags().BoolP("yes", "y", false, "Create the monitor without asking (with --create)")
 
 	// Add subcommands
 	certsCmd.AddCommand(certsListCmd)
 	certsCmd.AddCommand(certsShowCmd)
 	certsCmd.AddCommand(certsCreateCmd)
+	certsCmd.AddCommand(certsCloneCmd)
 	certsCmd.AddCommand(certsUpdateCmd)
 	certsCmd.AddCommand(certsPauseCmd)
 	certsCmd.AddCommand(certsResumeCmd)
 	certsCmd.AddCommand(certsIncidentsCmd)
+	certsCmd.AddCommand(certsNotifyCmd)
+	certsCmd.AddCommand(certsCheckCmd)
+	certsCmd.AddCommand(certsInspectCmd)
 	certsCmd.AddCommand(certsDeleteCmd)
+	certsCmd.AddCommand(certsMoveCmd)
 
 	// Add certs command to root
 	rootCmd.AddCommand(certsCmd)
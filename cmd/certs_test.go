@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,107 +18,81 @@ func TestCertsCommand(t *testing.T) {
 	assert.NotEmpty(t, certsCmd.Long)
 }
 
-// TestCertsListCommand tests the certs list command
+// seedCerts adds an SSL monitor to the fake API, returning its ID
+func seedCerts(srv *apitest.Server) string {
+	id := srv.Fake.NewID()
+	srv.Fake.Certs = []api.SslMonitor{
+		{ID: id, Name: "Shop", Domain: "shop.example.com", Port: 443, Status: "active", Interval: 1440, DaysUntilExpiration: 40, WarningThreshold: 30, CriticalThreshold: 7, CertificateIssuer: "Let's Encrypt"},
+	}
+	return id
+}
+
+// TestCertsListCommand tests listing SSL monitors as a table and as JSON
 func TestCertsListCommand(t *testing.T) {
-	assert.Equal(t, "list", certsListCmd.Use)
-	assert.Equal(t, "List all certs", certsListCmd.Short)
-	assert.NotEmpty(t, certsListCmd.Long)
-	require.NotNil(t, certsListCmd.RunE, "certs list command should have a RunE function")
+	srv := startAPI(t)
+	seedCerts(srv)
 
-	// Verify --json flag exists
-	jsonFlag := certsListCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "certs list command should have --json flag")
-	assert.Equal(t, "bool", jsonFlag.Value.Type())
+	out := mustRun(t, "certs", "list")
+	assert.Contains(t, out, "shop.example.com")
+	assert.Contains(t, out, "40")
+	assert.Contains(t, out, "Total: 1 SSL certificate monitor(s)")
+
+	var result api.SslMonitorsResponse
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "certs", "list", "--json")), &result))
+	assert.Len(t, result.SslMonitors, 1)
 }
 
-// TestCertsShowCommand tests the certs show command
+// TestCertsShowCommand tests showing an SSL monitor by short ID
 func TestCertsShowCommand(t *testing.T) {
-	assert.Equal(t, "show <id>", certsShowCmd.Use)
-	assert.Equal(t, "Show cert details", certsShowCmd.Short)
-	assert.NotEmpty(t, certsShowCmd.Long)
-	require.NotNil(t, certsShowCmd.RunE, "certs show command should have a RunE function")
-
-	// Verify --json flag exists
-	jsonFlag := certsShowCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "certs show command should have --json flag")
+	srv := startAPI(t)
+	id := seedCerts(srv)
+
+	out := mustRun(t, "certs", "show", id[:8])
+	assert.Contains(t, out, "ID:                       "+id)
+	assert.Contains(t, out, "Domain:                   shop.example.com")
+	assert.Contains(t, out, "Days Until Expiration:    40")
+	assert.Contains(t, out, "Certificate Issuer:       Let's Encrypt")
 }
 
-// TestCertsCreateCommand tests the certs create command
+// TestCertsCreateCommand tests creating an SSL monitor and the request it
+// sends
 func TestCertsCreateCommand(t *testing.T) {
-	assert.Equal(t, "create", certsCreateCmd.Use)
-	assert.Equal(t, "Create a new SSL certificate monitor", certsCreateCmd.Short)
-	assert.NotEmpty(t, certsCreateCmd.Long)
-	require.NotNil(t, certsCreateCmd.RunE, "certs create command should have a RunE function")
-
-	// Verify required flags
-	nameFlag := certsCreateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "certs create command should have --name flag")
-	assert.Equal(t, "string", nameFlag.Value.Type())
+	srv := startAPI(t)
 
-	domainFlag := certsCreateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "certs create command should have --domain flag")
-	assert.Equal(t, "string", domainFlag.Value.Type())
+	out := mustRun(t, "certs", "create", "--name", "API", "--domain", "api.example.com", "--port", "8443")
+	assert.Contains(t, out, "SSL certificate monitor created successfully")
+	assert.Contains(t, out, "Port:     8443")
 
-	// Verify optional flags
-	portFlag := certsCreateCmd.Flags().Lookup("port")
-	require.NotNil(t, portFlag, "certs create command should have --port flag")
-	assert.Equal(t, "int", portFlag.Value.Type())
+	require.Len(t, srv.Fake.Certs, 1)
+	assert.Equal(t, "api.example.com", srv.Fake.Certs[0].Domain)
+	assert.Equal(t, 8443, srv.Fake.Certs[0].Port)
 
-	intervalFlag := certsCreateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "certs create command should have --interval flag")
-	assert.Equal(t, "int", intervalFlag.Value.Type())
+	_, _, err := runCommand(t, "certs", "create", "--domain", "api.example.com")
+	assert.ErrorContains(t, err, "--name is required")
 }
 
-// TestCertsUpdateCommand tests the certs update command
+// TestCertsUpdateCommand tests updating only the fields given
 func TestCertsUpdateCommand(t *testing.T) {
-	assert.Equal(t, "update <id>", certsUpdateCmd.Use)
-	assert.Equal(t, "Update an SSL certificate monitor", certsUpdateCmd.Short)
-	assert.NotEmpty(t, certsUpdateCmd.Long)
-	require.NotNil(t, certsUpdateCmd.RunE, "certs update command should have a RunE function")
-
-	// Verify flags exist
-	nameFlag := certsUpdateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "certs update command should have --name flag")
-
-	domainFlag := certsUpdateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "certs update command should have --domain flag")
+	srv := startAPI(t)
+	id := seedCerts(srv)
 
-	portFlag := certsUpdateCmd.Flags().Lookup("port")
-	require.NotNil(t, portFlag, "certs update command should have --port flag")
-
-	intervalFlag := certsUpdateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "certs update command should have --interval flag")
-
-	gracePeriodFlag := certsUpdateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "certs update command should have --grace-period flag")
-
-	warningThresholdFlag := certsUpdateCmd.Flags().Lookup("warning-threshold")
-	require.NotNil(t, warningThresholdFlag, "certs update command should have --warning-threshold flag")
-
-	urgentThresholdFlag := certsUpdateCmd.Flags().Lookup("urgent-threshold")
-	require.NotNil(t, urgentThresholdFlag, "certs update command should have --urgent-threshold flag")
-
-	criticalThresholdFlag := certsUpdateCmd.Flags().Lookup("critical-threshold")
-	require.NotNil(t, criticalThresholdFlag, "certs update command should have --critical-threshold flag")
-
-	statusFlag := certsUpdateCmd.Flags().Lookup("status")
-	require.NotNil(t, statusFlag, "certs update command should have --status flag")
+	out := mustRun(t, "certs", "update", id[:8], "--warning-threshold", "20")
+	assert.Contains(t, out, "SSL certificate monitor updated successfully")
+	assert.Equal(t, 20, srv.Fake.Certs[0].WarningThreshold)
+	assert.Equal(t, 7, srv.Fake.Certs[0].CriticalThreshold)
 }
 
-// TestCertsPauseCommand tests the certs pause command
+// TestCertsPauseCommand tests pausing and resuming an SSL monitor
 func TestCertsPauseCommand(t *testing.T) {
-	assert.Equal(t, "pause <id>", certsPauseCmd.Use)
-	assert.Equal(t, "Pause a cert", certsPauseCmd.Short)
-	assert.NotEmpty(t, certsPauseCmd.Long)
-	require.NotNil(t, certsPauseCmd.RunE, "certs pause command should have a RunE function")
-}
+	srv := startAPI(t)
+	id := seedCerts(srv)
 
-// TestCertsResumeCommand tests the certs resume command
-func TestCertsResumeCommand(t *testing.T) {
-	assert.Equal(t, "resume <id>", certsResumeCmd.Use)
-	assert.Equal(t, "Resume a cert", certsResumeCmd.Short)
-	assert.NotEmpty(t, certsResumeCmd.Long)
-	require.NotNil(t, certsResumeCmd.RunE, "certs resume command should have a RunE function")
+	out := mustRun(t, "certs", "pause", id[:8])
+	assert.Contains(t, out, "Cert Shop ("+id[:8]+") paused successfully")
+	assert.Equal(t, "paused", srv.Fake.Certs[0].Status)
+
+	mustRun(t, "certs", "resume", id[:8])
+	assert.Equal(t, "active", srv.Fake.Certs[0].Status)
 }
 
 // TestCertsIncidentsCommand tests the certs incidents command
@@ -129,17 +107,13 @@ func TestCertsIncidentsCommand(t *testing.T) {
 	require.NotNil(t, jsonFlag, "certs incidents command should have --json flag")
 }
 
-// TestCertsDeleteCommand tests the certs delete command
+// TestCertsDeleteCommand tests deleting an SSL monitor without a prompt
 func TestCertsDeleteCommand(t *testing.T) {
-	assert.Equal(t, "delete <id>", certsDeleteCmd.Use)
-	assert.Equal(t, "Delete a cert", certsDeleteCmd.Short)
-	assert.NotEmpty(t, certsDeleteCmd.Long)
-	require.NotNil(t, certsDeleteCmd.RunE, "certs delete command should have a RunE function")
+	srv := startAPI(t)
+	id := seedCerts(srv)
 
-	// Verify --force flag exists
-	forceFlag := certsDeleteCmd.Flags().Lookup("force")
-	require.NotNil(t, forceFlag, "certs delete command should have --force flag")
-	assert.Equal(t, "bool", forceFlag.Value.Type())
+	mustRun(t, "certs", "delete", id[:8], "--force")
+	assert.Empty(t, srv.Fake.Certs)
 }
 
 // TestCertsCommandHasSubcommands verifies all subcommands are registered
@@ -147,7 +121,7 @@ func TestCertsCommandHasSubcommands(t *testing.T) {
 	commands := certsCmd.Commands()
 
 	// Should have 8 subcommands
-	expectedSubcommands := []string{"list", "show", "create", "update", "pause", "resume", "incidents", "delete"}
+	expectedSubcommands := []string{"list", "show", "create", "clone", "update", "pause", "resume", "incidents", "notify", "inspect", "delete", "move"}
 	assert.GreaterOrEqual(t, len(commands), len(expectedSubcommands))
 
 	// Verify all expected subcommands exist
@@ -175,42 +149,127 @@ func TestResolveCertID(t *testing.T) {
 	// In a real scenario, you'd mock the API client
 	// For now, we just verify the function exists by checking if it's referenced
 	// A full integration test would require a mock API server
-	assert.NotNil(t, certsShowCmd.RunE, "resolveCertID is used by show command")
+	assert.NotNil(t, certsShowCmd.RunE, "cmdutil.ResolveCertID is used by show command")
 }
 
-// TestFormatIncidentDuration tests the formatIncidentDuration helper function
-func TestFormatIncidentDuration(t *testing.T) {
+// TestCertsInspectCommand tests the certs inspect command
+func TestCertsInspectCommand(t *testing.T) {
+	assert.Equal(t, "inspect <domain>", certsInspectCmd.Use)
+	assert.NotEmpty(t, certsInspectCmd.Long)
+	require.NotNil(t, certsInspectCmd.RunE, "certs inspect command should have a RunE function")
+
+	for _, name := range []string{"port", "server-name", "json", "create", "name", "yes"} {
+		assert.NotNil(t, certsInspectCmd.Flags().Lookup(name), "certs inspect command should have --%s flag", name)
+	}
+}
+
+// TestParseTLSTarget tests parsing hosts, host:port pairs, and URLs
+func TestParseTLSTarget(t *testing.T) {
 	tests := []struct {
-		name     string
-		seconds  float64
-		expected string
+		target  string
+		port    int
+		portSet bool
+		host    string
+		want    int
 	}{
-		{
-			name:     "seconds",
-			seconds:  30.0,
-			expected: "30s",
-		},
-		{
-			name:     "minutes",
-			seconds:  120.0,
-			expected: "2m",
-		},
-		{
-			name:     "hours",
-			seconds:  7200.0,
-			expected: "2.0h",
-		},
-		{
-			name:     "days",
-			seconds:  172800.0,
-			expected: "2.0d",
-		},
+		{"example.com", 443, false, "example.com", 443},
+		{"example.com:8443", 443, false, "example.com", 8443},
+		{"example.com:8443", 993, true, "example.com", 993},
+		{"https://example.com/health", 443, false, "example.com", 443},
+		{"https://example.com:9443/", 443, false, "example.com", 9443},
+		{"[::1]:8443", 443, false, "::1", 8443},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := formatIncidentDuration(tt.seconds)
-			assert.Equal(t, tt.expected, result)
+		t.Run(tt.target, func(t *testing.T) {
+			host, port, err := parseTLSTarget(tt.target, tt.port, tt.portSet)
+			require.NoError(t, err)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.want, port)
 		})
 	}
 }
+
+// TestPrintCertChain tests listing a monitored certificate chain
+func TestPrintCertChain(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	var buf bytes.Buffer
+	printCertChain(&buf, []api.ChainCertificate{
+		{Subject: "CN=example.com", Issuer: "CN=R11", NotBefore: "2026-01-01", NotAfter: "2026-04-01", KeyType: "ECDSA", KeySize: 256},
+		{Subject: "CN=R11", Issuer: "CN=ISRG Root X1", SignatureAlgorithm: "SHA256-RSA"},
+		{Subject: "CN=ISRG Root X1", Issuer: "CN=ISRG Root X1"},
+	})
+
+	got := buf.String()
+	assert.Contains(t, got, "1. CN=example.com (leaf)")
+	assert.Contains(t, got, "Key:        ECDSA 256-bit")
+	assert.Contains(t, got, "2. CN=R11 (intermediate)")
+	assert.Contains(t, got, "Signature:  SHA256-RSA")
+	assert.Contains(t, got, "3. CN=ISRG Root X1 (root)")
+
+	buf.Reset()
+	printCertChain(&buf, nil)
+	assert.Empty(t, buf.String())
+}
+
+// TestPrintCertChainPEM tests printing the chain as PEM, and failing when
+// the API didn't return it
+func TestPrintCertChainPEM(t *testing.T) {
+	leaf := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	issuer := "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----"
+
+	var buf bytes.Buffer
+	cert := &api.SslMonitor{CertificateChain: []api.ChainCertificate{{PEM: leaf}, {PEM: issuer}}}
+	require.NoError(t, printCertChainPEM(&buf, cert))
+	assert.Equal(t, leaf+issuer+"\n", buf.String())
+
+	err := printCertChainPEM(&buf, &api.SslMonitor{Name: "Example", Domain: "example.com"})
+	assert.ErrorContains(t, err, "groovekit certs inspect example.com")
+}
+
+// TestFormatCertKeyAndGrade tests key and grade formatting
+func TestFormatCertKeyAndGrade(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	assert.Equal(t, "RSA 2048-bit", formatCertKey("RSA", 2048))
+	assert.Equal(t, "Ed25519", formatCertKey("Ed25519", 0))
+	assert.Equal(t, "A+", formatCertGrade("A+"))
+	assert.Equal(t, "F", formatCertGrade("F"))
+}
+
+// TestParseResolve tests curl-style --resolve values
+func TestParseResolve(t *testing.T) {
+	ip, err := parseResolve("example.com:443:203.0.113.10", "example.com", 443)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.10", ip)
+
+	ip, err = parseResolve("*:8443:[2001:db8::1]", "example.com", 8443)
+	require.NoError(t, err)
+	assert.Equal(t, "2001:db8::1", ip)
+
+	ip, err = parseResolve("Example.COM:443:10.0.0.5", "example.com", 443)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.5", ip)
+
+	for _, value := range []string{
+		"example.com:443",
+		"other.com:443:203.0.113.10",
+		"example.com:8443:203.0.113.10",
+		"example.com:443:lb.internal",
+	} {
+		_, err := parseResolve(value, "example.com", 443)
+		assert.Error(t, err, value)
+	}
+}
+
+// TestValidateSNI tests that --sni must be a hostname
+func TestValidateSNI(t *testing.T) {
+	assert.NoError(t, validateSNI("intranet.corp.example"))
+	assert.Error(t, validateSNI("10.0.0.5"))
+	assert.Error(t, validateSNI("https://example.com"))
+}
+
+// TestFormatResolve tests rendering an address override
+func TestFormatResolve(t *testing.T) {
+	assert.Equal(t, "example.com:443:203.0.113.10", formatResolve("example.com", 443, "203.0.113.10"))
+	assert.Equal(t, "example.com:443:[2001:db8::1]", formatResolve("example.com", 443, "2001:db8::1"))
+}
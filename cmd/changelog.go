@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Changelog holds the release notes bundled into the binary at build time
+var Changelog = ""
+
+// releaseHeading matches "## [1.4.0] - 2026-03-02" style headings
+var releaseHeading = regexp.MustCompile(`^## \[([^\]]+)\](?:\s+-\s+(\S+))?`)
+
+// release is a single version section of the changelog
+type release struct {
+	Version string
+	Date    string
+	Notes   string
+}
+
+var changelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Show release notes",
+	Long: `Show release notes bundled with this version of the CLI.
+
+By default the notes for the installed version are printed. Use --since to
+see everything that changed after a given version, or --all for the full history.`,
+	Example: `  groovekit changelog
+  groovekit changelog --since v1.2.0
+  groovekit changelog --all`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		since, _ := cmd.Flags().GetString("since")
+		all, _ := cmd.Flags().GetBool("all")
+
+		releases := parseChangelog(Changelog)
+		if len(releases) == 0 {
+			return fmt.Errorf("no release notes bundled with this build")
+		}
+
+		var selected []release
+		switch {
+		case all:
+			selected = releases
+		case since != "":
+			for _, r := range releases {
+				if r.Version != "Unreleased" && compareVersions(r.Version, since) > 0 {
+					selected = append(selected, r)
+				}
+			}
+			if len(selected) == 0 {
+				output.InfoMessage(out, fmt.Sprintf("No releases newer than %s", since))
+				return nil
+			}
+		default:
+			selected = []release{currentRelease(releases, Version)}
+		}
+
+		for i, r := range selected {
+			if i > 0 {
+				fmt.Fprintln(out)
+			}
+			heading := r.Version
+			if r.Date != "" {
+				heading = fmt.Sprintf("%s (%s)", r.Version, r.Date)
+			}
+			fmt.Fprintln(out, output.Bold(heading))
+			fmt.Fprintln(out, r.Notes)
+		}
+		return nil
+	},
+}
+
+// parseChangelog splits a Keep a Changelog document into releases, newest first
+func parseChangelog(text string) []release {
+	var releases []release
+	var current *release
+	var notes []string
+
+	flush := func() {
+		if current != nil {
+			current.Notes = strings.TrimSpace(strings.Join(notes, "\n"))
+			releases = append(releases, *current)
+		}
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		if m := releaseHeading.FindStringSubmatch(line); m != nil {
+			flush()
+			current = &release{Version: m[1], Date: m[2]}
+			notes = nil
+			continue
+		}
+		if current != nil {
+			notes = append(notes, line)
+		}
+	}
+	flush()
+
+	// Drop an empty Unreleased section so it never shadows a real release
+	if len(releases) > 0 && releases[0].Version == "Unreleased" && releases[0].Notes == "" {
+		releases = releases[1:]
+	}
+	return releases
+}
+
+// currentRelease returns the notes for version, or the newest release when
+// version isn't found (e.g. dev builds)
+func currentRelease(releases []release, version string) release {
+	for _, r := range releases {
+		if compareVersions(r.Version, version) == 0 {
+			return r
+		}
+	}
+	for _, r := range releases {
+		if r.Version != "Unreleased" {
+			return r
+		}
+	}
+	return releases[0]
+}
+
+// compareVersions compares two semantic versions, ignoring a leading "v".
+// Returns -1, 0, or 1. Non-numeric parts compare as zero.
+func compareVersions(a, b string) int {
+	pa := versionParts(a)
+	pb := versionParts(b)
+	for i := 0; i < 3; i++ {
+		if pa[i] < pb[i] {
+			return -1
+		}
+		if pa[i] > pb[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionParts(v string) [3]int {
+	var parts [3]int
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	// Strip pre-release and build metadata (1.2.0-rc1, 1.2.0+abc)
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	for i, p := range strings.SplitN(v, ".", 3) {
+		n, _ := strconv.Atoi(p)
+		parts[i] = n
+	}
+	return parts
+}
+
+func init() {
+	changelogCmd.Flags().String("since", "", "Show releases newer than this version (e.g. v1.2.0)")
+	changelogCmd.Flags().Bool("all", false, "Show the full release history")
+
+	rootCmd.AddCommand(changelogCmd)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChangelog = `# Changelog
+
+## [Unreleased]
+
+## [1.4.0] - 2026-03-02
+
+### Added
+
+- apis command
+
+## [1.3.0] - 2026-02-18
+
+- dns monitors
+
+## [1.2.0] - 2026-01-10
+
+- domains
+`
+
+// TestChangelogCommand tests the basic structure of the changelog command
+func TestChangelogCommand(t *testing.T) {
+	assert.Equal(t, "changelog", changelogCmd.Use)
+	assert.Equal(t, "Show release notes", changelogCmd.Short)
+	require.NotNil(t, changelogCmd.RunE)
+
+	require.NotNil(t, changelogCmd.Flags().Lookup("since"), "changelog command should have --since flag")
+	require.NotNil(t, changelogCmd.Flags().Lookup("all"), "changelog command should have --all flag")
+}
+
+// TestParseChangelog tests splitting the changelog into releases
+func TestParseChangelog(t *testing.T) {
+	releases := parseChangelog(testChangelog)
+
+	require.Len(t, releases, 3, "empty Unreleased section should be dropped")
+	assert.Equal(t, "1.4.0", releases[0].Version)
+	assert.Equal(t, "2026-03-02", releases[0].Date)
+	assert.Equal(t, "### Added\n\n- apis command", releases[0].Notes)
+	assert.Equal(t, "1.2.0", releases[2].Version)
+}
+
+// TestCurrentRelease tests picking the notes for the running version
+func TestCurrentRelease(t *testing.T) {
+	releases := parseChangelog(testChangelog)
+
+	assert.Equal(t, "1.3.0", currentRelease(releases, "1.3.0").Version)
+	assert.Equal(t, "1.4.0", currentRelease(releases, "dev").Version)
+}
+
+// TestCompareVersions tests semantic version comparison
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.4.0", "v1.2.0", 1},
+		{"v1.2.0", "1.2.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"1.3.0-rc1", "1.3.0", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareVersions(tt.a, tt.b))
+		})
+	}
+}
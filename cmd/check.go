@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// healthState is the current health of a single resource, as reported by
+// the check subcommands
+type healthState int
+
+const (
+	// healthUp means the resource is active and passing
+	healthUp healthState = iota
+	// healthDown means the resource is failing
+	healthDown
+	// healthUnknown means the resource is paused or hasn't been checked
+	healthUnknown
+)
+
+// healthSeverity maps non-up health states to --fail-level severities
+var healthSeverity = map[healthState]string{
+	healthDown:    failLevelDown,
+	healthUnknown: failLevelWarning,
+}
+
+// checkLongHelp is appended to the Long description of every check subcommand
+const checkLongHelp = `
+
+Prints nothing unless --verbose is given. With --format github, the result
+is printed as a GitHub Actions workflow annotation: an error when the check
+fails, a warning for a condition below --fail-level, and a notice when up.
+
+Exit codes:
+  0  up (or a condition below --fail-level)
+  1  API error, health could not be determined
+  3  authentication error
+  4  no resource with that ID
+  6  down, or paused/not yet checked with --fail-level warning`
+
+// reportHealth prints the health line when verbose, or an annotation with
+// --format github, and converts the state into the command's exit status
+func reportHealth(cmd *cobra.Command, kind, name string, state healthState, detail string) error {
+	github, err := githubFormat(cmd)
+	if err != nil {
+		return checkFailed(cmd, err)
+	}
+	failing := state != healthUp && failOn(cmd, healthSeverity[state])
+
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	out := cmd.OutOrStdout()
+	switch {
+	case github:
+		annotateHealth(out, kind, name, state, detail, failing)
+	case verbose:
+		switch state {
+		case healthUp:
+			fmt.Fprintf(out, "%s %s %s is up\n", output.Green("✓"), kind, output.Bold(name))
+		case healthDown:
+			fmt.Fprintf(out, "%s %s %s is down: %s\n", output.Red("✗"), kind, output.Bold(name), detail)
+		default:
+			fmt.Fprintf(out, "%s %s %s is %s\n", output.Yellow("?"), kind, output.Bold(name), detail)
+		}
+	}
+
+	if !failing {
+		return nil
+	}
+	return resourceDown(cmd)
+}
+
+// annotateHealth reports a check result as a GitHub Actions annotation
+func annotateHealth(out io.Writer, kind, name string, state healthState, detail string, failing bool) {
+	level := annotationWarning
+	if failing {
+		level = annotationError
+	}
+	title := fmt.Sprintf("GrooveKit %s check", kind)
+	switch state {
+	case healthUp:
+		githubAnnotation(out, annotationNotice, title, fmt.Sprintf("%s %s is up", kind, name))
+	case healthDown:
+		githubAnnotation(out, level, title, fmt.Sprintf("%s %s is down: %s", kind, name, detail))
+	default:
+		githubAnnotation(out, level, title, fmt.Sprintf("%s %s is %s", kind, name, detail))
+	}
+}
+
+// checkFailed reports that health could not be determined, exiting with the
+// code for the underlying error
+func checkFailed(cmd *cobra.Command, err error) error {
+	if github, _ := githubFormat(cmd); github {
+		githubAnnotation(cmd.OutOrStdout(), annotationError, "GrooveKit check failed", err.Error())
+	}
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	return &exitCodeError{code: exitCode(err), err: err}
+}
+
+// statusHealth maps a monitor's configured status onto a health state for
+// anything that isn't actively checked
+func statusHealth(status string) (healthState, bool) {
+	if status != "" && status != "active" {
+		return healthUnknown, true
+	}
+	return healthUp, false
+}
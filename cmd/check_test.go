@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckCommands verifies every resource type has a check subcommand
+func TestCheckCommands(t *testing.T) {
+	for _, c := range []*cobra.Command{jobsCheckCmd, apisCheckCmd, certsCheckCmd, domainsCheckCmd, dnsCheckCmd} {
+		assert.Equal(t, "check <id>", c.Use)
+		assert.Contains(t, c.Long, "Exit codes")
+		require.NotNil(t, c.RunE, "%s should have a RunE function", c.CommandPath())
+
+		verboseFlag := c.Flags().Lookup("verbose")
+		require.NotNil(t, verboseFlag, "%s should have --verbose flag", c.CommandPath())
+		assert.Equal(t, "bool", verboseFlag.Value.Type())
+	}
+}
+
+// TestStatusHealth tests mapping configured status onto health
+func TestStatusHealth(t *testing.T) {
+	_, decided := statusHealth("active")
+	assert.False(t, decided)
+
+	state, decided := statusHealth("paused")
+	assert.True(t, decided)
+	assert.Equal(t, healthUnknown, state)
+}
+
+// TestReportHealthExitCodes tests the exit code for each health state and --fail-level
+func TestReportHealthExitCodes(t *testing.T) {
+	tests := []struct {
+		state     healthState
+		failLevel string
+		expected  int
+	}{
+		{healthUp, failLevelWarning, exitOK},
+		{healthDown, failLevelDown, exitResourceDown},
+		{healthDown, failLevelWarning, exitResourceDown},
+		{healthDown, failLevelNone, exitOK},
+		{healthUnknown, failLevelDown, exitOK},
+		{healthUnknown, failLevelWarning, exitResourceDown},
+	}
+
+	for _, tt := range tests {
+		c := &cobra.Command{}
+		c.Flags().Bool("verbose", false, "")
+		c.Flags().String("fail-level", tt.failLevel, "")
+
+		err := reportHealth(c, "job", "backup", tt.state, "")
+		assert.Equal(t, tt.expected, exitCode(err), "state %d with --fail-level %s", tt.state, tt.failLevel)
+	}
+}
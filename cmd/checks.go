@@ -1,13 +1,23 @@
 package cmd
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/chart"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/diff"
 	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
 	"github.com/spf13/cobra"
 )
 
@@ -19,18 +29,13 @@ var checksCmd = &cobra.Command{
 
 // checks list
 var checksListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List recent checks",
-	Long:  "List recent health checks for a monitor or pings for a job",
+	Use:        "list",
+	Short:      "List recent checks",
+	Long:       "List recent health checks for a monitor or pings for a job",
+	Deprecated: "use 'groovekit apis checks <id>' or 'groovekit jobs pings <id>' instead",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
 		monitorID, _ := cmd.Flags().GetString("monitor")
 		jobID, _ := cmd.Flags().GetString("job")
-		jsonOutput, _ := cmd.Flags().GetBool("json")
 
 		// Must specify either --monitor or --job
 		if monitorID == "" && jobID == "" {
@@ -42,146 +47,765 @@ var checksListCmd = &cobra.Command{
 		}
 
 		if monitorID != "" {
-			return listMonitorChecks(client, monitorID, jsonOutput)
+			return listMonitorChecks(cmd, monitorID)
 		}
 
-		return listJobPings(client, jobID, jsonOutput)
+		return listJobPings(cmd, jobID)
 	},
 }
 
-func listMonitorChecks(client *api.Client, monitorID string, jsonOutput bool) error {
-	// Resolve short ID to full ID
-	fullID, err := resolveMonitorID(client, monitorID)
-	if err != nil {
-		return err
-	}
+// checks diff <check-id-a> <check-id-b>
+var checksDiffCmd = &cobra.Command{
+	Use:   "diff <check-id-a> <check-id-b>",
+	Short: "Compare two checks",
+	Long: `Compare two checks for the same API monitor field by field, including their
+stored response bodies, headers, and validation results. JSON response
+bodies are compared key by key.
+
+Check IDs must be given in full unless --monitor is set, in which case short
+IDs are matched against that monitor's recent checks.
+
+Examples:
+  groovekit checks diff --monitor abc12345 c1aaaaaa c2bbbbbb
+  groovekit checks diff <check-id> <check-id> --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
 
-	var s *spinner.Spinner
-	if !jsonOutput {
-		s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-	}
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		monitorID, _ := cmd.Flags().GetString("monitor")
 
-	checks, err := client.ListApiChecks(fullID)
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
 
-	if s != nil {
-		s.Stop()
-	}
+		checks, err := fetchChecksToDiff(cmd.Context(), client, monitorID, args[0], args[1])
 
-	if err != nil {
-		return fmt.Errorf("failed to list checks: %w", err)
-	}
+		if s != nil {
+			s.Stop()
+		}
 
-	if jsonOutput {
-		return outputJSON(checks)
-	}
+		if err != nil {
+			return err
+		}
+
+		a, b := checks[0], checks[1]
+		if a.APIMonitorID != b.APIMonitorID {
+			return fmt.Errorf("checks %s and %s belong to different monitors", cmdutil.ShortID(a.ID), cmdutil.ShortID(b.ID))
+		}
+
+		changes := diff.Compare(checkDocument(a), checkDocument(b))
+
+		if jsonOutput {
+			if changes == nil {
+				changes = []diff.Change{}
+			}
+			return cmdutil.OutputJSON(out, map[string]interface{}{
+				"a":       a.ID,
+				"b":       b.ID,
+				"changes": changes,
+			})
+		}
+
+		fmt.Fprintf(out, "%s %s (%s) -> %s (%s)\n\n", output.Bold("Comparing"),
+			output.Cyan(cmdutil.ShortID(a.ID)), output.FormatTime(a.CreatedAt), output.Cyan(cmdutil.ShortID(b.ID)), output.FormatTime(b.CreatedAt))
 
-	if len(checks) == 0 {
-		output.InfoMessage("No checks found")
+		if len(changes) == 0 {
+			output.InfoMessage(out, "No differences")
+			return nil
+		}
+		printChanges(out, changes)
+		fmt.Fprintf(out, "\n%s %d\n", output.Bold("Changes:"), len(changes))
 		return nil
-	}
+	},
+}
 
-	// Create table
-	table := output.NewTable([]string{"TIME", "STATUS", "RESPONSE", "SUCCESS"})
-	table.Render()
+// checks graph --monitor <id>
+var checksGraphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Chart response times",
+	Long: `Draw a chart of an API monitor's response times over time from its recent
+checks, with failed checks marked under the time axis.
+
+The chart uses braille characters; pass --ascii for terminals or fonts
+without braille support.
+
+Examples:
+  groovekit checks graph --monitor abc12345
+  groovekit checks graph --monitor abc12345 --last 7d --width 100
+  groovekit checks graph --monitor abc12345 --ascii`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
 
-	// Add rows
-	for _, check := range checks {
-		statusCode := fmt.Sprintf("%d", check.StatusCode)
-		responseTime := fmt.Sprintf("%.2fms", check.ResponseTime)
+		monitorID, _ := cmd.Flags().GetString("monitor")
+		if monitorID == "" {
+			return fmt.Errorf("must specify --monitor")
+		}
 
-		success := output.Green("✓")
-		if !check.Success {
-			success = output.Red("✗")
+		last, _ := cmd.Flags().GetString("last")
+		now := time.Now()
+		since, err := parseSince(last, now)
+		if err != nil {
+			return usageErrorf("invalid --last %q: use a duration like 24h or 7d", last)
 		}
 
-		table.Append([]string{
-			check.CreatedAt,
-			statusCode,
-			responseTime,
-			success,
-		})
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, monitorID)
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		checks, err := client.ListAllApiChecks(fullID, &api.ListOptions{Since: since, Until: now}, 0)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to list checks: %w", err)
+		}
+
+		var points []chart.Point
+		var entries []historyEntry
+		for _, check := range checks {
+			created, err := time.Parse(time.RFC3339, check.CreatedAt)
+			if err != nil || created.Before(since) || created.After(now) {
+				continue
+			}
+			points = append(points, chart.Point{Time: output.InTimezone(created), Value: check.ResponseTime, Failed: !check.Success})
+			entries = append(entries, monitorCheckView.entry(check))
+		}
+
+		if len(points) == 0 {
+			output.InfoMessage(out, fmt.Sprintf("No checks in the last %s", last))
+			return nil
+		}
+
+		width, _ := cmd.Flags().GetInt("width")
+		height, _ := cmd.Flags().GetInt("height")
+		ascii, _ := cmd.Flags().GetBool("ascii")
+
+		fmt.Fprintf(out, "%s %s, last %s\n\n", output.Bold("Response time for"), output.Cyan(cmdutil.ShortID(fullID)), last)
+		fmt.Fprint(out, chart.Line(points, chart.Options{
+			Width:  width,
+			Height: height,
+			ASCII:  ascii,
+			From:   output.InTimezone(since),
+			To:     output.InTimezone(now),
+			Unit:   "ms",
+			Mark:   func(s string) string { return output.Red(s) },
+		}))
+
+		stats := computeHistoryStats(entries)
+		fmt.Fprintf(out, "\n%s, %d failed", countNoun(stats.total, "check", "checks"), stats.failed)
+		if stats.avgMs != nil {
+			fmt.Fprintf(out, " · avg %.0fms, p95 %.0fms, max %.0fms", *stats.avgMs, *stats.p95Ms, *stats.maxMs)
+		}
+		fmt.Fprintln(out)
+		return nil
+	},
+}
+
+// fetchChecksToDiff resolves and fetches both checks concurrently
+func fetchChecksToDiff(ctx context.Context, client api.APIClient, monitorID, idA, idB string) ([2]*api.Check, error) {
+	var checks [2]*api.Check
+
+	ids := [2]string{idA, idB}
+	if monitorID != "" {
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, monitorID)
+		if err != nil {
+			return checks, err
+		}
+		recent, err := client.ListApiChecks(fullID, nil)
+		if err != nil {
+			return checks, fmt.Errorf("failed to list checks: %w", err)
+		}
+		for i, id := range ids {
+			if ids[i], err = resolveCheckID(recent.APIChecks, id); err != nil {
+				return checks, err
+			}
+		}
 	}
 
-	table.Flush()
-	fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d check(s)", len(checks))))
-	return nil
+	errs := api.Each(ctx, len(ids), len(ids), func(_ context.Context, i int) (err error) {
+		checks[i], err = client.GetApiCheck(ids[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			return checks, fmt.Errorf("failed to get check %s: %w", cmdutil.ShortID(ids[i]), err)
+		}
+	}
+	return checks, nil
 }
 
-func listJobPings(client *api.Client, jobID string, jsonOutput bool) error {
-	// Resolve short ID to full ID
-	fullID, err := resolveJobID(client, jobID)
+// resolveCheckID matches a full or short check ID against a list of checks
+func resolveCheckID(checks []api.Check, id string) (string, error) {
+	candidates := make([]bulkItem, 0, len(checks))
+	for _, check := range checks {
+		candidates = append(candidates, bulkItem{id: check.ID, name: check.CreatedAt})
+	}
+	match, err := resolve.Match(candidates, id, "recent check", "checks")
 	if err != nil {
-		return err
+		return "", err
 	}
+	return match.id, nil
+}
 
-	var s *spinner.Spinner
-	if !jsonOutput {
-		s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
+// checkDocument turns a check into a JSON document for diffing, dropping
+// identity fields and parsing the response body when it is JSON
+func checkDocument(check *api.Check) map[string]interface{} {
+	encoded, _ := json.Marshal(check)
+	var doc map[string]interface{}
+	_ = json.Unmarshal(encoded, &doc)
+
+	delete(doc, "id")
+	delete(doc, "api_monitor_id")
+	delete(doc, "created_at")
+
+	if check.ResponseBody != nil {
+		var body interface{}
+		if err := json.Unmarshal([]byte(*check.ResponseBody), &body); err == nil {
+			doc["response_body"] = body
+		}
 	}
+	return doc
+}
 
-	pings, err := client.ListJobPings(fullID)
+// printChanges prints one colored line per change
+func printChanges(w io.Writer, changes []diff.Change) {
+	for _, change := range changes {
+		line := change.String()
+		switch change.Op {
+		case diff.Added:
+			line = output.Green(line)
+		case diff.Removed:
+			line = output.Red(line)
+		default:
+			line = output.Yellow(line)
+		}
+		fmt.Fprintln(w, line)
+	}
+}
 
-	if s != nil {
+// checks export --monitor <id>
+var checksExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export check history to a file",
+	Long: `Export an API monitor's full check history over a period to a CSV or
+newline-delimited JSON file for analysis in a spreadsheet, notebook, or
+data warehouse.
+
+Checks are fetched page by page and written as they arrive, so months of
+history export without being held in memory. Rows are newest first.
+
+The format defaults to ndjson when --output-file ends in .ndjson or .jsonl
+and csv otherwise. The export is written to checks-<id>.<format> unless
+--output-file is given; pass -o - to print it instead.
+
+Examples:
+  groovekit checks export --monitor abc12345
+  groovekit checks export --monitor abc12345 --since 90d -o checks.csv
+  groovekit checks export --monitor abc12345 --format ndjson -o - | jq .response_time`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		monitorID, _ := cmd.Flags().GetString("monitor")
+		if monitorID == "" {
+			return usageErrorf("must specify --monitor")
+		}
+
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		since, err := parseSince(sinceFlag, time.Now())
+		if err != nil {
+			return err
+		}
+
+		path, _ := cmd.Flags().GetString("output-file")
+		format, _ := cmd.Flags().GetString("format")
+		if format == "" {
+			format = exportFormatFor(path)
+		}
+		if format != exportCSV && format != exportNDJSON {
+			return usageErrorf("invalid --format %q: must be csv or ndjson", format)
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveAPIMonitorID(client, monitorID)
+		if err != nil {
+			return err
+		}
+
+		if path == "" {
+			path = fmt.Sprintf("checks-%s.%s", cmdutil.ShortID(fullID), format)
+		}
+
+		var count int
+		export := func() error {
+			count, err = exportChecks(cmd.OutOrStdout(), format, since, func(fn func([]api.Check) error) error {
+				return client.EachApiCheckPage(fullID, &api.ListOptions{Since: since}, fn)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export checks: %w", err)
+			}
+			return nil
+		}
+		if path == "-" {
+			return export()
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		err = runToOutputFile(cmd, path, export)
 		s.Stop()
-	}
 
-	if err != nil {
-		return fmt.Errorf("failed to list pings: %w", err)
+		if err != nil {
+			return err
+		}
+		output.InfoMessage(cmd.ErrOrStderr(), fmt.Sprintf("Exported %s since %s", countNoun(count, "check", "checks"), output.InTimezone(since).Format("2006-01-02 15:04")))
+		return nil
+	},
+}
+
+// checks prune --monitor <id> --before <age>
+var checksPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete old check history",
+	Long: `Delete an API monitor's checks from before a point in time, to keep the
+amount of stored history down without waiting for the account's retention
+period (see 'groovekit account show').
+
+--before takes an age like 90d or 720h, or a date like 2026-01-02. The
+deletion is confirmed first; --force skips the prompt. Deleted checks can't
+be recovered, so export them first if you need them.
+
+Examples:
+  groovekit checks prune --monitor abc12345 --before 90d
+  groovekit checks prune --monitor abc12345 --before 2026-01-01 --force`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		monitorID, _ := cmd.Flags().GetString("monitor")
+		beforeFlag, _ := cmd.Flags().GetString("before")
+		if monitorID == "" || beforeFlag == "" {
+			return usageErrorf("must specify --monitor and --before")
+		}
+
+		before, err := parseTimeFlag("before", beforeFlag, time.Now())
+		if err != nil {
+			return err
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		monitor, err := resolverFor(client, apisBulkTarget).Resource(monitorID)
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		force, _ := cmd.Flags().GetBool("force")
+		cutoff := output.InTimezone(before).Format("2006-01-02 15:04")
+
+		if !force {
+			fmt.Fprintf(out, "Delete checks for API monitor %s from before %s? (y/N): ", describeBulkItem(monitor), cutoff)
+			var response string
+			_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+			if response != "y" && response != "Y" {
+				fmt.Fprintln(out, "Cancelled")
+				return nil
+			}
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		deleted, err := client.PruneApiChecks(monitor.id, before)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to prune checks: %w", err)
+		}
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, map[string]interface{}{
+				"monitor_id": monitor.id,
+				"before":     before.UTC().Format(time.RFC3339),
+				"deleted":    deleted,
+			})
+		}
+
+		output.SuccessMessage(out, fmt.Sprintf("Deleted %s from before %s", countNoun(deleted, "check", "checks"), cutoff))
+		return nil
+	},
+}
+
+const (
+	exportCSV    = "csv"
+	exportNDJSON = "ndjson"
+)
+
+// checkExportColumns are the CSV header for checks export
+var checkExportColumns = []string{"id", "created_at", "status_code", "response_time_ms", "success", "slow", "error_message", "validation_error"}
+
+// exportFormatFor infers the export format from an output file name
+func exportFormatFor(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return exportNDJSON
 	}
+	return exportCSV
+}
 
-	if jsonOutput {
-		return outputJSON(pings)
+// exportChecks writes the checks from each page pages yields to w as csv or
+// ndjson, skipping any older than since, and returns how many it wrote.
+// Each page is flushed before the next is fetched.
+func exportChecks(w io.Writer, format string, since time.Time, pages func(func([]api.Check) error) error) (int, error) {
+	var cw *csv.Writer
+	var enc *json.Encoder
+	if format == exportCSV {
+		cw = csv.NewWriter(w)
+		if err := cw.Write(checkExportColumns); err != nil {
+			return 0, err
+		}
+	} else {
+		enc = json.NewEncoder(w)
 	}
 
-	if len(pings) == 0 {
-		output.InfoMessage("No pings found")
+	count := 0
+	err := pages(func(checks []api.Check) error {
+		for _, check := range checks {
+			if !notBefore(check.CreatedAt, since) {
+				continue
+			}
+			count++
+			if enc != nil {
+				if err := enc.Encode(check); err != nil {
+					return err
+				}
+				continue
+			}
+			_ = cw.Write([]string{
+				check.ID,
+				check.CreatedAt,
+				strconv.Itoa(check.StatusCode),
+				strconv.FormatFloat(check.ResponseTime, 'f', -1, 64),
+				strconv.FormatBool(check.Success),
+				strconv.FormatBool(check.Slow),
+				stringValue(check.ErrorMessage),
+				stringValue(check.ValidationError),
+			})
+		}
+		if cw != nil {
+			cw.Flush()
+			return cw.Error()
+		}
 		return nil
+	})
+	return count, err
+}
+
+// stringValue returns the string s points to, or "" when it is nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
 	}
+	return *s
+}
+
+// monitorCheckView renders API monitor checks
+var monitorCheckView = historyView[api.Check]{
+	noun:          "check",
+	headers:       []string{"ID", "TIME", "STATUS", "RESPONSE", "RESULT", "ERROR"},
+	hidden:        []string{"id"},
+	durationLabel: "Response time",
+	groupLabel:    "Status codes",
+	entry: func(check api.Check) historyEntry {
+		outcome := historyOK
+		result := output.Green("✓")
+		if !check.Success {
+			outcome = historyFailed
+			result = output.Red("✗")
+		}
+		// Flag slow responses apart from errors and bad status codes
+		if check.Slow && check.Success {
+			result = output.Yellow("✓ slow")
+		} else if check.Slow {
+			result = output.Yellow("✗ slow")
+		}
+
+		errorMsg := "-"
+		if check.ErrorMessage != nil && *check.ErrorMessage != "" {
+			errorMsg = *check.ErrorMessage
+		} else if check.ValidationError != nil && *check.ValidationError != "" {
+			errorMsg = *check.ValidationError
+		} else if check.Slow {
+			errorMsg = "slower than max response time"
+		}
+
+		// Checks that got no response have no status code
+		group := "no response"
+		if check.StatusCode != 0 {
+			group = strconv.Itoa(check.StatusCode)
+		}
 
-	// Create table
-	table := output.NewTable([]string{"TIME", "TYPE", "DURATION"})
-	table.Render()
+		return historyEntry{
+			createdAt: check.CreatedAt,
+			outcome:   outcome,
+			duration:  check.ResponseTime,
+			group:     group,
+			cells: []string{
+				output.Cyan(cmdutil.ShortID(check.ID)),
+				output.FormatTime(check.CreatedAt),
+				fmt.Sprintf("%d", check.StatusCode),
+				fmt.Sprintf("%.2fms", check.ResponseTime),
+				result,
+				errorMsg,
+			},
+			values: []interface{}{nil, nil, nil, check.ResponseTime},
+		}
+	},
+}
 
-	// Add rows
-	for _, ping := range pings {
+// jobPingView renders job heartbeat pings
+var jobPingView = historyView[api.Ping]{
+	noun:          "ping",
+	headers:       []string{"ID", "TIME", "TYPE", "DURATION"},
+	hidden:        []string{"id"},
+	durationLabel: "Duration",
+	entry: func(ping api.Ping) historyEntry {
 		pingType := ping.PingType
 		if pingType == "" {
 			pingType = "heartbeat"
 		}
 
+		outcome := historyOK
+		switch pingType {
+		case api.PingStart:
+			outcome = historyNeutral
+			pingType = output.Cyan(pingType)
+		case api.PingFail:
+			outcome = historyFailed
+			pingType = output.Red(pingType)
+		}
+
 		duration := "-"
+		durationMs := -1.0
 		if ping.Duration != nil && *ping.Duration != "" {
 			// Parse duration string (in seconds) and convert to milliseconds
 			if durationFloat, err := strconv.ParseFloat(*ping.Duration, 64); err == nil {
-				durationMs := durationFloat * 1000
+				durationMs = durationFloat * 1000
 				duration = fmt.Sprintf("%.0fms", durationMs)
 			} else {
 				duration = *ping.Duration
 			}
 		}
 
-		table.Append([]string{
-			ping.CreatedAt,
-			pingType,
-			duration,
+		var durationValue interface{}
+		if durationMs >= 0 {
+			durationValue = durationMs
+		}
+
+		return historyEntry{
+			createdAt: ping.CreatedAt,
+			outcome:   outcome,
+			duration:  durationMs,
+			cells: []string{
+				output.Cyan(cmdutil.ShortID(ping.ID)),
+				output.FormatTime(ping.CreatedAt),
+				pingType,
+				duration,
+			},
+			values: []interface{}{nil, nil, nil, durationValue},
+		}
+	},
+}
+
+// listMonitorChecks prints check history for an API monitor
+func listMonitorChecks(cmd *cobra.Command, monitorID string) error {
+	filter, err := parseHistoryFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	var statusCodes []int
+	if cmd.Flags().Lookup("status-code") != nil {
+		statusCodes, _ = cmd.Flags().GetIntSlice("status-code")
+	}
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	// Resolve short ID to full ID
+	fullID, err := cmdutil.ResolveAPIMonitorID(client, monitorID)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var s *spinner.Spinner
+	if !jsonOutput {
+		s = newSpinner(cmd)
+		s.Start()
+	}
+
+	checks, err := client.ListAllApiChecks(fullID, filter.listOptions(), filter.fetchLimit(len(statusCodes) > 0))
+
+	if s != nil {
+		s.Stop()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to list checks: %w", err)
+	}
+
+	if len(statusCodes) > 0 {
+		checks = filterItems(checks, func(check api.Check) bool {
+			for _, code := range statusCodes {
+				if check.StatusCode == code {
+					return true
+				}
+			}
+			return false
 		})
 	}
 
-	table.Flush()
-	fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d ping(s)", len(pings))))
-	return nil
+	return renderHistory(cmd, filter, checks, monitorCheckView)
+}
+
+// listJobPings prints ping history for a job
+func listJobPings(cmd *cobra.Command, jobID string) error {
+	filter, err := parseHistoryFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	var pingType string
+	if cmd.Flags().Lookup("type") != nil {
+		pingType, _ = cmd.Flags().GetString("type")
+		pingType = strings.ToLower(pingType)
+		switch pingType {
+		case "", api.PingStart, api.PingSuccess, api.PingFail, "heartbeat":
+		default:
+			return usageErrorf("invalid --type %q: must be start, success, fail, or heartbeat", pingType)
+		}
+	}
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	// Resolve short ID to full ID
+	fullID, err := cmdutil.ResolveJobID(client, jobID)
+	if err != nil {
+		return err
+	}
+
+	if follow, _ := cmd.Flags().GetBool("follow"); follow {
+		return followJobPings(cmd, client, fullID, filter, pingType)
+	}
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var s *spinner.Spinner
+	if !jsonOutput {
+		s = newSpinner(cmd)
+		s.Start()
+	}
+
+	pings, err := client.ListAllJobPings(fullID, filter.listOptions(), filter.fetchLimit(pingType != ""))
+
+	if s != nil {
+		s.Stop()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to list pings: %w", err)
+	}
+
+	if pingType != "" {
+		pings = filterItems(pings, func(ping api.Ping) bool {
+			return pingTypeMatches(ping, pingType)
+		})
+	}
+
+	return renderHistory(cmd, filter, pings, jobPingView)
+}
+
+// pingTypeMatches reports whether a ping is of the --type given, where
+// heartbeat means a ping without a type
+func pingTypeMatches(ping api.Ping, pingType string) bool {
+	if ping.PingType == "" {
+		return pingType == "heartbeat"
+	}
+	return ping.PingType == pingType
 }
 
 func init() {
 	// Add flags to list command
 	checksListCmd.Flags().StringP("monitor", "m", "", "Monitor ID to view checks for")
 	checksListCmd.Flags().StringP("job", "j", "", "Job ID to view pings for")
-	checksListCmd.Flags().Bool("json", false, "Output as JSON")
+	addHistoryFlags(checksListCmd)
+
+	// Add flags to diff command
+	checksDiffCmd.Flags().StringP("monitor", "m", "", "Monitor the checks belong to, to allow short check IDs")
+	checksDiffCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to graph command
+	checksGraphCmd.Flags().StringP("monitor", "m", "", "Monitor ID to chart checks for")
+	checksGraphCmd.Flags().String("last", "24h", "How far back to chart (e.g. 6h, 24h, 7d)")
+	checksGraphCmd.Flags().Int("width", 60, "Chart width in characters")
+	checksGraphCmd.Flags().Int("height", 10, "Chart height in lines")
+	checksGraphCmd.Flags().Bool("ascii", false, "Draw with plain ASCII instead of braille characters")
+
+	// Add flags to export command
+	checksExportCmd.Flags().StringP("monitor", "m", "", "Monitor ID to export checks for")
+	checksExportCmd.Flags().String("since", "30d", "How far back to export (e.g. 24h, 30d, or a date like 2026-01-02)")
+	checksExportCmd.Flags().String("format", "", "Export format: csv or ndjson (default from the file extension, else csv)")
+	checksExportCmd.Flags().StringP("output-file", "o", "", "File to write the export to, or - for stdout (default checks-<id>.<format>)")
+
+	// Add flags to prune command
+	checksPruneCmd.Flags().StringP("monitor", "m", "", "Monitor ID to delete checks for")
+	checksPruneCmd.Flags().String("before", "", "Delete checks older than this (e.g. 90d, or a date like 2026-01-02)")
+	checksPruneCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	checksPruneCmd.Flags().Bool("json", false, "Output as JSON")
 
 	// Add subcommands
 	checksCmd.AddCommand(checksListCmd)
+	checksCmd.AddCommand(checksDiffCmd)
+	checksCmd.AddCommand(checksGraphCmd)
+	checksCmd.AddCommand(checksExportCmd)
+	checksCmd.AddCommand(checksPruneCmd)
 
 	// Add checks command to root
 	rootCmd.AddCommand(checksCmd)
@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestAuthCommand tests the basic structure of the auth command
@@ -12,3 +19,242 @@ func TestChecksCommand(t *testing.T) {
 	assert.Equal(t, "Manage authentication", authCmd.Short)
 	assert.NotEmpty(t, authCmd.Long)
 }
+
+// TestChecksListDeprecated tests that checks list still works but points at the new commands
+func TestChecksListDeprecated(t *testing.T) {
+	assert.Contains(t, checksListCmd.Deprecated, "apis checks")
+	assert.Contains(t, checksListCmd.Deprecated, "jobs pings")
+	assert.NotNil(t, checksListCmd.Flags().Lookup("monitor"))
+	assert.NotNil(t, checksListCmd.Flags().Lookup("job"))
+	assert.NotNil(t, checksListCmd.Flags().Lookup("failed"))
+}
+
+// TestHistoryCommands tests the per-resource history subcommands
+func TestHistoryCommands(t *testing.T) {
+	assert.Equal(t, "checks <id>", apisChecksCmd.Use)
+	assert.NotNil(t, apisChecksCmd.Flags().Lookup("status-code"))
+	assert.Equal(t, "pings <id>", jobsPingsCmd.Use)
+	assert.NotNil(t, jobsPingsCmd.Flags().Lookup("type"))
+
+	for _, c := range []*cobra.Command{apisChecksCmd, jobsPingsCmd} {
+		for _, name := range []string{"json", "failed", "failed-only", "since", "until", "limit", "sort", "columns", "output-file"} {
+			assert.NotNil(t, c.Flags().Lookup(name), "%s should have --%s", c.Name(), name)
+		}
+	}
+}
+
+// TestChecksGraphFlags tests the graph command's flags and defaults
+func TestChecksGraphFlags(t *testing.T) {
+	for _, name := range []string{"monitor", "last", "width", "height", "ascii"} {
+		assert.NotNil(t, checksGraphCmd.Flags().Lookup(name), "graph should have --%s", name)
+	}
+	assert.Equal(t, "24h", checksGraphCmd.Flags().Lookup("last").DefValue)
+}
+
+// TestJobPingViewOutcome tests how ping types map to history outcomes
+func TestJobPingViewOutcome(t *testing.T) {
+	duration := "1.5"
+	assert.Equal(t, historyNeutral, jobPingView.entry(api.Ping{PingType: api.PingStart}).outcome)
+	assert.Equal(t, historyFailed, jobPingView.entry(api.Ping{PingType: api.PingFail}).outcome)
+	assert.Equal(t, historyOK, jobPingView.entry(api.Ping{}).outcome)
+
+	entry := jobPingView.entry(api.Ping{PingType: api.PingSuccess, Duration: &duration})
+	assert.Equal(t, historyOK, entry.outcome)
+	assert.Equal(t, 1500.0, entry.duration)
+	assert.Equal(t, -1.0, jobPingView.entry(api.Ping{}).duration)
+}
+
+// TestComputeHistoryStats tests success rate and duration percentiles
+func TestComputeHistoryStats(t *testing.T) {
+	var entries []historyEntry
+	for i := 1; i <= 20; i++ {
+		entries = append(entries, historyEntry{outcome: historyOK, duration: float64(i * 10)})
+	}
+	entries[0].outcome = historyFailed
+	entries = append(entries, historyEntry{outcome: historyNeutral, duration: -1})
+
+	stats := computeHistoryStats(entries)
+	assert.Equal(t, 21, stats.total)
+	assert.Equal(t, 19, stats.ok)
+	assert.Equal(t, 1, stats.failed)
+	assert.InDelta(t, 95.0, *stats.successRate, 0.001)
+	assert.InDelta(t, 105.0, *stats.avgMs, 0.001)
+	assert.Equal(t, 190.0, *stats.p95Ms)
+	assert.Equal(t, 200.0, *stats.maxMs)
+
+	empty := computeHistoryStats([]historyEntry{{outcome: historyNeutral, duration: -1}})
+	assert.Nil(t, empty.successRate)
+	assert.Nil(t, empty.avgMs)
+}
+
+// TestComputeHistoryStats_Groups tests the status code breakdown
+func TestComputeHistoryStats_Groups(t *testing.T) {
+	entries := []historyEntry{
+		monitorCheckView.entry(api.Check{StatusCode: 503}),
+		monitorCheckView.entry(api.Check{StatusCode: 200, Success: true}),
+		monitorCheckView.entry(api.Check{StatusCode: 200, Success: true}),
+		monitorCheckView.entry(api.Check{}),
+	}
+
+	stats := computeHistoryStats(entries)
+	assert.Equal(t, []historyGroup{{"200", 2}, {"503", 1}, {"no response", 1}}, stats.groups)
+	assert.Equal(t, "200 ×2 (50.0%), 503 ×1 (25.0%), no response ×1 (25.0%)", formatHistoryGroups(stats.groups, stats.total))
+}
+
+// TestMonitorCheckView_Slow tests flagging checks over the max response time
+func TestMonitorCheckView_Slow(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	entry := monitorCheckView.entry(api.Check{StatusCode: 200, ResponseTime: 1450, Slow: true})
+	assert.Equal(t, historyFailed, entry.outcome)
+	assert.Equal(t, "✗ slow", entry.cells[4])
+	assert.Equal(t, "slower than max response time", entry.cells[5])
+
+	entry = monitorCheckView.entry(api.Check{StatusCode: 200, Success: true, Slow: true})
+	assert.Equal(t, historyOK, entry.outcome)
+	assert.Equal(t, "✓ slow", entry.cells[4])
+}
+
+// TestParseHistoryFlags tests reading and validating the history filters
+func TestParseHistoryFlags(t *testing.T) {
+	newCmd := func(args ...string) *cobra.Command {
+		c := &cobra.Command{Use: "test"}
+		addHistoryFlags(c)
+		require.NoError(t, c.ParseFlags(args))
+		return c
+	}
+
+	filter, err := parseHistoryFlags(newCmd("--failed-only", "--since", "2026-03-01T00:00:00Z", "--until", "2026-03-02T00:00:00Z", "--limit", "5"))
+	require.NoError(t, err)
+	assert.True(t, filter.failedOnly)
+	assert.Equal(t, 5, filter.limit)
+	assert.Equal(t, 0, filter.fetchLimit(false))
+
+	assert.True(t, filter.keep(historyEntry{outcome: historyFailed, createdAt: "2026-03-01T12:00:00Z"}))
+	assert.False(t, filter.keep(historyEntry{outcome: historyOK, createdAt: "2026-03-01T12:00:00Z"}))
+	assert.False(t, filter.keep(historyEntry{outcome: historyFailed, createdAt: "2026-03-02T12:00:00Z"}))
+	assert.False(t, filter.keep(historyEntry{outcome: historyFailed, createdAt: "2026-02-28T12:00:00Z"}))
+
+	filter, err = parseHistoryFlags(newCmd("--limit", "5"))
+	require.NoError(t, err)
+	assert.Equal(t, 5, filter.fetchLimit(false))
+	assert.Equal(t, 0, filter.fetchLimit(true))
+
+	_, err = parseHistoryFlags(newCmd("--since", "2026-03-02", "--until", "2026-03-01"))
+	assert.EqualError(t, err, "--until must be after --since")
+
+	_, err = parseHistoryFlags(newCmd("--until", "yesterday"))
+	assert.ErrorContains(t, err, `invalid --until "yesterday"`)
+}
+
+// TestResolveCheckID tests matching short check IDs against recent checks
+func TestResolveCheckID(t *testing.T) {
+	checks := []api.Check{{ID: "abc111"}, {ID: "abc222"}, {ID: "def333"}}
+
+	id, err := resolveCheckID(checks, "def")
+	assert.NoError(t, err)
+	assert.Equal(t, "def333", id)
+
+	id, err = resolveCheckID(checks, "abc222")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc222", id)
+
+	_, err = resolveCheckID(checks, "abc")
+	assert.ErrorContains(t, err, "ambiguous")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, err = resolveCheckID(checks, "zzz")
+	assert.ErrorContains(t, err, "no recent check")
+	assert.Equal(t, exitNotFound, exitCode(err))
+}
+
+// TestCheckDocument tests that identity fields are dropped and JSON bodies parsed
+func TestCheckDocument(t *testing.T) {
+	body := `{"status":"ok"}`
+	doc := checkDocument(&api.Check{ID: "c1", APIMonitorID: "m1", StatusCode: 200, ResponseBody: &body, CreatedAt: "now"})
+
+	assert.NotContains(t, doc, "id")
+	assert.NotContains(t, doc, "api_monitor_id")
+	assert.NotContains(t, doc, "created_at")
+	assert.Equal(t, 200.0, doc["status_code"])
+	assert.Equal(t, map[string]interface{}{"status": "ok"}, doc["response_body"])
+
+	text := "plain text"
+	doc = checkDocument(&api.Check{ResponseBody: &text})
+	assert.Equal(t, "plain text", doc["response_body"])
+}
+
+// TestExportFormatFor tests inferring the export format from a file name
+func TestExportFormatFor(t *testing.T) {
+	assert.Equal(t, exportNDJSON, exportFormatFor("checks.ndjson"))
+	assert.Equal(t, exportNDJSON, exportFormatFor("checks.JSONL"))
+	assert.Equal(t, exportCSV, exportFormatFor("checks.csv"))
+	assert.Equal(t, exportCSV, exportFormatFor(""))
+}
+
+// TestExportChecks tests writing each page as CSV or NDJSON and skipping
+// checks older than since
+func TestExportChecks(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	failure := "timeout"
+	pages := func(fn func([]api.Check) error) error {
+		if err := fn([]api.Check{{ID: "a", CreatedAt: "2026-03-02T00:00:00Z", StatusCode: 200, ResponseTime: 120.5, Success: true}}); err != nil {
+			return err
+		}
+		return fn([]api.Check{
+			{ID: "b", CreatedAt: "2026-03-01T12:00:00Z", ResponseTime: 5000, ErrorMessage: &failure},
+			{ID: "c", CreatedAt: "2026-02-28T00:00:00Z"},
+		})
+	}
+
+	var buf bytes.Buffer
+	count, err := exportChecks(&buf, exportCSV, since, pages)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "id,created_at,status_code,response_time_ms,success,slow,error_message,validation_error\n"+
+		"a,2026-03-02T00:00:00Z,200,120.5,true,false,,\n"+
+		"b,2026-03-01T12:00:00Z,0,5000,false,false,timeout,\n", buf.String())
+
+	buf.Reset()
+	count, err = exportChecks(&buf, exportNDJSON, since, pages)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var check api.Check
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &check))
+	assert.Equal(t, "b", check.ID)
+	assert.Equal(t, "timeout", *check.ErrorMessage)
+}
+
+// TestChecksPruneCommand tests deleting a monitor's old checks after
+// confirming
+func TestChecksPruneCommand(t *testing.T) {
+	srv := startAPI(t)
+	srv.Fake.Apis = []api.ApiMonitor{{ID: "abc12345-0000-4000-8000-000000000001", Name: "Checkout API"}}
+	now := time.Now().UTC()
+	srv.Fake.Checks["abc12345-0000-4000-8000-000000000001"] = []api.Check{
+		{ID: "c3", CreatedAt: now.Add(-time.Hour).Format(time.RFC3339)},
+		{ID: "c2", CreatedAt: now.AddDate(0, 0, -100).Format(time.RFC3339)},
+		{ID: "c1", CreatedAt: now.AddDate(0, 0, -200).Format(time.RFC3339)},
+	}
+
+	_, _, err := runCommand(t, "checks", "prune", "--monitor", "abc12345")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	out, _, err := runCommandWithInput(t, "n\n", "checks", "prune", "--monitor", "abc12345", "--before", "90d")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Delete checks for API monitor Checkout API (abc12345) from before")
+	assert.Contains(t, out, "Cancelled")
+	assert.Len(t, srv.Fake.Checks["abc12345-0000-4000-8000-000000000001"], 3)
+
+	out, _, err = runCommandWithInput(t, "y\n", "checks", "prune", "--monitor", "abc12345", "--before", "90d")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Deleted 2 checks from before")
+	assert.Len(t, srv.Fake.Checks["abc12345-0000-4000-8000-000000000001"], 1)
+
+	out = mustRun(t, "checks", "prune", "--monitor", "abc12345", "--before", "1d", "--force", "--json")
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.Equal(t, 0.0, result["deleted"])
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// cloneLongHelp is shared by every clone subcommand
+const cloneLongHelp = `
+
+The copy keeps the original's settings, status, tags, and notification
+channels; flags override individual fields. Without --name it is called
+"<original name> (copy)".`
+
+// addCloneFlags registers the flags shared by clone subcommands
+func addCloneFlags(c *cobra.Command) {
+	c.Flags().String("name", "", `Name for the copy (default "<original name> (copy)")`)
+	addTagFlag(c)
+}
+
+// cloneName returns --name, or the original's name marked as a copy
+func cloneName(cmd *cobra.Command, original string) string {
+	if name, _ := cmd.Flags().GetString("name"); name != "" {
+		return name
+	}
+	return original + " (copy)"
+}
+
+// cloneTags returns the tags given with --tag, or the original's tags
+func cloneTags(cmd *cobra.Command, original []string) ([]string, error) {
+	if cmd.Flags().Changed("tag") {
+		return getTags(cmd)
+	}
+	return original, nil
+}
+
+// finishClone routes the copy's alerts to the original's notification
+// channels, which can't be set on create, and reports the new resource
+func finishClone(cmd *cobra.Command, client api.APIClient, target notifyTarget, original, name, id string, channels []string) error {
+	if len(channels) > 0 {
+		if err := target.update(client, id, channels); err != nil {
+			return fmt.Errorf("created %s %s (%s), but failed to copy its notification channels: %w", target.noun, name, cmdutil.ShortID(id), err)
+		}
+	}
+	output.SuccessMessage(cmd.OutOrStdout(), fmt.Sprintf("Cloned %s as %s (%s)", original, output.Bold(name), output.Cyan(cmdutil.ShortID(id))))
+	return nil
+}
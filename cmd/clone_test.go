@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCloneCommands tests that every resource has a clone command with the shared flags
+func TestCloneCommands(t *testing.T) {
+	for _, c := range []*cobra.Command{apisCloneCmd, jobsCloneCmd, certsCloneCmd, domainsCloneCmd, dnsCloneCmd} {
+		assert.Equal(t, "clone <id>", c.Use)
+		assert.NotEmpty(t, c.Long)
+		require.NotNil(t, c.RunE)
+		assert.NotNil(t, c.Flags().Lookup("name"), "%s should have --name flag", c.CommandPath())
+		assert.NotNil(t, c.Flags().Lookup("tag"), "%s should have --tag flag", c.CommandPath())
+	}
+
+	assert.NotNil(t, apisCloneCmd.Flags().Lookup("url"))
+	assert.NotNil(t, certsCloneCmd.Flags().Lookup("port"))
+	assert.NotNil(t, dnsCloneCmd.Flags().Lookup("expected"))
+}
+
+// TestCloneName tests naming a copy
+func TestCloneName(t *testing.T) {
+	c := &cobra.Command{}
+	addCloneFlags(c)
+	assert.Equal(t, "Health check (copy)", cloneName(c, "Health check"))
+
+	require.NoError(t, c.Flags().Set("name", "Staging copy"))
+	assert.Equal(t, "Staging copy", cloneName(c, "Health check"))
+}
+
+// TestCloneTags tests that --tag replaces the original's tags
+func TestCloneTags(t *testing.T) {
+	c := &cobra.Command{}
+	addCloneFlags(c)
+	tags, err := cloneTags(c, []string{"env=prod"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"env=prod"}, tags)
+
+	require.NoError(t, c.Flags().Set("tag", "env=staging"))
+	tags, err = cloneTags(c, []string{"env=prod"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"env=staging"}, tags)
+}
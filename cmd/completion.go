@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// monitorStatuses are the statuses the update commands can set
+var monitorStatuses = []string{"active", "inactive", "paused"}
+
+// filterStatuses are the statuses --status on list commands filters by
+var filterStatuses = []string{"active", "paused"}
+
+// pingTypes are the ping types jobs pings can show
+var pingTypes = []string{api.PingStart, api.PingSuccess, api.PingFail, "heartbeat"}
+
+// completeValues completes a flag to one of values. Values may carry a
+// description after a tab, which shells that support it show alongside.
+func completeValues(values ...string) cobra.CompletionFunc {
+	return cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp)
+}
+
+// completeList completes the last item of a comma-separated flag to one of
+// values that isn't already listed
+func completeList(values ...string) cobra.CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return listCompletions(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// listCompletions returns toComplete's finished items followed by each of
+// values not among them, so the shell completes just the item being typed
+func listCompletions(values []string, toComplete string) []string {
+	prefix := toComplete[:strings.LastIndex(toComplete, ",")+1]
+	chosen := strings.Split(prefix, ",")
+
+	completions := []string{}
+	for _, value := range values {
+		name, _, _ := strings.Cut(value, "\t")
+		if !slices.Contains(chosen, name) {
+			completions = append(completions, prefix+value)
+		}
+	}
+	return completions
+}
+
+// registerFlagCompletion sets the completion function for c's flag, which
+// must already be defined
+func registerFlagCompletion(c *cobra.Command, flag string, fn cobra.CompletionFunc) {
+	if err := c.RegisterFlagCompletionFunc(flag, fn); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// complete runs the completion function registered for c's flag
+func complete(t *testing.T, c *cobra.Command, flag, toComplete string) []string {
+	t.Helper()
+	fn, ok := c.GetFlagCompletionFunc(flag)
+	require.True(t, ok, "%s --%s should have a completion function", c.CommandPath(), flag)
+	values, directive := fn(c, nil, toComplete)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	return values
+}
+
+// TestFlagCompletions tests that flags with a fixed set of values complete
+// to them
+func TestFlagCompletions(t *testing.T) {
+	tests := []struct {
+		cmd      *cobra.Command
+		flag     string
+		expected []string
+	}{
+		{dnsCreateCmd, "type", dnsRecordTypes},
+		{dnsUpdateCmd, "type", dnsRecordTypes},
+		{apisCreateCmd, "method", httpMethods},
+		{apisUpdateCmd, "http-method", httpMethods},
+		{jobsUpdateCmd, "status", monitorStatuses},
+		{apisUpdateCmd, "status", monitorStatuses},
+		{certsUpdateCmd, "status", monitorStatuses},
+		{domainsUpdateCmd, "status", monitorStatuses},
+		{dnsUpdateCmd, "status", monitorStatuses},
+		{jobsListCmd, "status", filterStatuses},
+		{dnsListCmd, "status", filterStatuses},
+		{jobsPingsCmd, "type", pingTypes},
+		{alertsListCmd, "type", alertTypes},
+		{incidentsListCmd, "type", statusKinds},
+		{reportSLACmd, "type", statusKinds},
+		{searchCmd, "type", statusKinds},
+		{watchCmd, "type", statusKinds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cmd.CommandPath()+" --"+tt.flag, func(t *testing.T) {
+			assert.Equal(t, tt.expected, complete(t, tt.cmd, tt.flag, ""))
+		})
+	}
+}
+
+// TestListCompletions tests completing the last item of a comma-separated
+// flag
+func TestListCompletions(t *testing.T) {
+	values := []string{"jobs", "apis", "certs\tSSL certificates"}
+	assert.Equal(t, []string{"jobs", "apis", "certs\tSSL certificates"}, listCompletions(values, ""))
+	assert.Equal(t, []string{"jobs,apis", "jobs,certs\tSSL certificates"}, listCompletions(values, "jobs,a"))
+	assert.Equal(t, []string{"jobs,certs,apis"}, listCompletions(values, "jobs,certs,"))
+}
+
+// TestCompleteRegions tests completing --regions from the API
+func TestCompleteRegions(t *testing.T) {
+	srv := startAPI(t)
+	srv.Fake.Regions = []api.Region{
+		{ID: "us-east", Name: "US East", Default: true},
+		{ID: "eu-west", Name: "EU West"},
+	}
+
+	assert.Equal(t, []string{"us-east\tUS East", "eu-west\tEU West"}, complete(t, apisCreateCmd, "regions", ""))
+	assert.Equal(t, []string{"eu-west,us-east\tUS East"}, complete(t, apisUpdateCmd, "regions", "eu-west,"))
+
+	t.Setenv("GROOVEKIT_TOKEN", "")
+	assert.Empty(t, complete(t, apisCreateCmd, "regions", ""))
+}
+
+// TestCompletionCommand tests asking the hidden completion command for a
+// flag's values, the way shells do
+func TestCompletionCommand(t *testing.T) {
+	startAPI(t)
+
+	stdout := mustRun(t, cobra.ShellCompRequestCmd, "dns", "create", "--type", "")
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	assert.Equal(t, dnsRecordTypes, lines[:len(lines)-1])
+	assert.Equal(t, ":4", lines[len(lines)-1])
+}
@@ -0,0 +1,366 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Values of the output setting
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// configSetting is a setting managed with the config command
+type configSetting struct {
+	key         string
+	description string
+	// def is the value in effect when the setting isn't set
+	def string
+	// values are the accepted values; nil accepts any that check allows
+	values []string
+	check  func(root *config.Config, value string) error
+	// get and set read and change the setting in the whole config file; set
+	// is given "" to unset it
+	get func(root *config.Config) string
+	set func(root *config.Config, value string)
+}
+
+// configSettings are the settings the config command manages
+var configSettings = []configSetting{
+	{
+		key:         "api-base-url",
+		description: "API URL of the current profile, for self-hosted deployments",
+		def:         config.DefaultAPIBaseURL,
+		check: func(_ *config.Config, value string) error {
+			if err := validateAPIURL(value); err != nil {
+				return usageErrorf("invalid value %q for api-base-url: %v", value, err)
+			}
+			return nil
+		},
+		get: func(root *config.Config) string {
+			return root.ProfileSettings(config.ProfileName()).APIBaseURL
+		},
+		set: func(root *config.Config, value string) {
+			root.ProfileSettings(config.ProfileName()).APIBaseURL = strings.TrimSuffix(value, "/")
+		},
+	},
+	{
+		key:         "output",
+		description: "Default output format of commands with --json: table or json",
+		def:         formatTable,
+		values:      []string{formatTable, formatJSON},
+		get:         func(root *config.Config) string { return root.Output },
+		set:         func(root *config.Config, value string) { root.Output = value },
+	},
+	{
+		key:         "color",
+		description: "When to color output: auto (when writing to a terminal), always, or never",
+		def:         output.ColorAuto,
+		values:      []string{output.ColorAuto, output.ColorAlways, output.ColorNever},
+		get:         func(root *config.Config) string { return root.Color },
+		set:         func(root *config.Config, value string) { root.Color = value },
+	},
+	{
+		key:         "timezone",
+		description: "Time zone times are shown in: local, UTC, or a name such as Europe/London",
+		def:         output.TimezoneLocal,
+		check: func(_ *config.Config, value string) error {
+			if _, err := output.LoadTimezone(value); err != nil {
+				return usageErrorf("invalid value %q for timezone: %v", value, err)
+			}
+			return nil
+		},
+		get: func(root *config.Config) string { return root.Timezone },
+		set: func(root *config.Config, value string) { root.Timezone = value },
+	},
+	{
+		key:         "profile",
+		description: "Profile used when neither --profile nor GROOVEKIT_PROFILE is given",
+		def:         config.DefaultProfile,
+		check: func(root *config.Config, value string) error {
+			if _, ok := root.Profiles[value]; !ok && value != config.DefaultProfile {
+				return usageErrorf("no profile named %q. Log in to create it with 'groovekit auth login --profile %s'", value, value)
+			}
+			return nil
+		},
+		get: func(root *config.Config) string { return root.CurrentProfile },
+		set: func(root *config.Config, value string) { root.CurrentProfile = value },
+	},
+	{
+		key:         "update-channel",
+		description: "Release channel checked once a day for new versions: stable, or none",
+		def:         config.UpdateChannelNone,
+		values:      []string{config.UpdateChannelStable, config.UpdateChannelNone},
+		get:         func(root *config.Config) string { return root.UpdateChannel },
+		set:         func(root *config.Config, value string) { root.SetUpdateChannel(value) },
+	},
+}
+
+// validateAPIURL checks value is an absolute http:// or https:// URL
+func validateAPIURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("must be an http:// or https:// URL")
+	}
+	return nil
+}
+
+// findConfigSetting returns the setting for key, accepting underscores for
+// hyphens so keys can be given as they appear in the config file
+func findConfigSetting(key string) (*configSetting, error) {
+	normalized := strings.ReplaceAll(strings.ToLower(key), "_", "-")
+	keys := make([]string, 0, len(configSettings))
+	for i := range configSettings {
+		if configSettings[i].key == normalized {
+			return &configSettings[i], nil
+		}
+		keys = append(keys, configSettings[i].key)
+	}
+	return nil, usageErrorf("unknown config key %q: must be one of %s", key, strings.Join(keys, ", "))
+}
+
+// validate checks value is accepted for the setting
+func (s *configSetting) validate(root *config.Config, value string) error {
+	if s.values != nil && !slices.Contains(s.values, value) {
+		return usageErrorf("invalid value %q for %s: must be %s", value, s.key, strings.Join(s.values, ", "))
+	}
+	if s.check != nil {
+		return s.check(root, value)
+	}
+	return nil
+}
+
+// value returns the setting's value in effect and whether it was set
+func (s *configSetting) value(root *config.Config) (string, bool) {
+	if v := s.get(root); v != "" {
+		return v, true
+	}
+	return s.def, false
+}
+
+// applySettings applies the color, output, and timezone settings to the
+// command about to run. --no-color, --json, --timezone, and environment
+// variables win over the settings.
+func applySettings(cmd *cobra.Command) error {
+	root, err := config.LoadSettings()
+	if err != nil {
+		root = &config.Config{}
+	}
+	noColor, _ := cmd.Flags().GetBool("no-color")
+	output.SetColor(output.ResolveColor(noColor, root.Color, os.Getenv))
+	if root.Output == formatJSON {
+		if flag := cmd.Flags().Lookup("json"); flag != nil && !flag.Changed {
+			_ = flag.Value.Set("true")
+		}
+	}
+
+	// A hand-edited setting that isn't a zone falls back to local, as an
+	// unknown color does, so config commands can still fix it
+	loc, err := output.LoadTimezone(root.Timezone)
+	if err != nil {
+		loc = time.Local
+	}
+	timezone, source := os.Getenv("GROOVEKIT_TIMEZONE"), "GROOVEKIT_TIMEZONE"
+	if flag := cmd.Flags().Lookup("timezone"); flag != nil && flag.Changed {
+		timezone, source = flag.Value.String(), "--timezone"
+	}
+	if timezone != "" {
+		if loc, err = output.LoadTimezone(timezone); err != nil {
+			return usageErrorf("invalid %s: %v", source, err)
+		}
+	}
+	output.SetTimezone(loc)
+	return nil
+}
+
+// loadSettings reads the config file for the config command
+func loadSettings() (*config.Config, error) {
+	root, err := config.LoadSettings()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return root, nil
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage CLI settings",
+	Long: `View and change CLI settings without editing the config file by hand.
+
+Settings:
+  api-base-url    API URL of the current profile, for self-hosted deployments
+  output          Default output format of commands with --json: table or json
+  color           When to color output: auto, always, or never
+  timezone        Time zone times are shown in: local (the default), UTC,
+                  or a name such as Europe/London
+  profile         Profile used when neither --profile nor GROOVEKIT_PROFILE
+                  is given
+  update-channel  Release channel checked once a day for new versions:
+                  stable, or none (the default) to turn the check off
+
+api-base-url belongs to the current profile; the others are shared by all
+profiles. Environment variables such as GROOVEKIT_API_URL still take
+precedence over the config file.`,
+}
+
+// config get <key>
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print a setting",
+	Long: `Print the value of a setting, or its default when it isn't set.
+
+Examples:
+  groovekit config get output
+  groovekit config get api-base-url`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		setting, err := findConfigSetting(args[0])
+		if err != nil {
+			return err
+		}
+		root, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		value, _ := setting.value(root)
+		fmt.Fprintln(cmd.OutOrStdout(), value)
+		return nil
+	},
+}
+
+// config set <key> <value>
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Change a setting",
+	Long: `Change a setting in the config file.
+
+With update-channel set to stable, the CLI looks up the latest release at
+most once a day, in the background, and prints a one-line hint after
+commands when a newer version is available. The hint is never shown in CI,
+when stderr isn't a terminal, or when GROOVEKIT_NO_UPDATE_CHECK is set.
+
+With output set to json, commands that have --json output JSON unless
+--json=false is given.
+
+Examples:
+  groovekit config set output json
+  groovekit config set color never
+  groovekit config set api-base-url https://groovekit.internal.example.com
+  groovekit config set profile staging
+  groovekit config set update-channel stable`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		setting, err := findConfigSetting(args[0])
+		if err != nil {
+			return err
+		}
+		root, err := loadSettings()
+		if err != nil {
+			return err
+		}
+		if err := setting.validate(root, args[1]); err != nil {
+			return err
+		}
+
+		if err := config.UpdateSettings(func(root *config.Config) { setting.set(root, args[1]) }); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		output.SuccessMessage(out, fmt.Sprintf("Set %s to %s", setting.key, args[1]))
+		return nil
+	},
+}
+
+// config unset <key>
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Reset a setting to its default",
+	Long: `Remove a setting from the config file so its default applies again.
+
+Examples:
+  groovekit config unset output`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		setting, err := findConfigSetting(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := config.UpdateSettings(func(root *config.Config) { setting.set(root, "") }); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		output.SuccessMessage(cmd.OutOrStdout(), fmt.Sprintf("Reset %s to %s", setting.key, setting.def))
+		return nil
+	},
+}
+
+// config list
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all settings",
+	Long: `List every setting with its value, marking those left at their default.
+
+Examples:
+  groovekit config list
+  groovekit config list --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		root, err := loadSettings()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if jsonOutput {
+			values := make(map[string]string, len(configSettings))
+			for i := range configSettings {
+				values[configSettings[i].key], _ = configSettings[i].value(root)
+			}
+			return cmdutil.OutputJSON(cmd.OutOrStdout(), values)
+		}
+
+		table, err := newListTable(cmd, []string{"KEY", "VALUE", "DESCRIPTION"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for i := range configSettings {
+			setting := &configSettings[i]
+			value, set := setting.value(root)
+			if !set {
+				value += " (default)"
+			}
+			table.Append([]string{output.Cyan(setting.key), value, setting.description})
+		}
+		table.Flush()
+		return nil
+	},
+}
+
+func init() {
+	// Add flags to config subcommands
+	configListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(configListCmd)
+
+	// Add subcommands
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configListCmd)
+
+	// Add config command to root
+	rootCmd.AddCommand(configCmd)
+}
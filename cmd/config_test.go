@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFindConfigSetting tests looking up settings by key
+func TestFindConfigSetting(t *testing.T) {
+	setting, err := findConfigSetting("update-channel")
+	require.NoError(t, err)
+	assert.Equal(t, "update-channel", setting.key)
+
+	// Keys as written in the config file
+	setting, err = findConfigSetting("api_base_url")
+	require.NoError(t, err)
+	assert.Equal(t, "api-base-url", setting.key)
+
+	_, err = findConfigSetting("update_chanel")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "update-channel")
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestConfigSettingValidate tests validating values of known settings
+func TestConfigSettingValidate(t *testing.T) {
+	root := &config.Config{Profiles: map[string]*config.Config{"staging": {}}}
+	tests := []struct {
+		key, value string
+		valid      bool
+	}{
+		{"update-channel", "stable", true},
+		{"update-channel", "nightly", false},
+		{"output", "json", true},
+		{"output", "yaml", false},
+		{"color", "never", true},
+		{"color", "sometimes", false},
+		{"timezone", "local", true},
+		{"timezone", "UTC", true},
+		{"timezone", "America/New_York", true},
+		{"timezone", "EST5EDT8", false},
+		{"api-base-url", "https://groovekit.internal", true},
+		{"api-base-url", "groovekit.internal", false},
+		{"api-base-url", "ftp://groovekit.internal", false},
+		{"profile", "staging", true},
+		{"profile", "default", true},
+		{"profile", "prod", false},
+	}
+	for _, tt := range tests {
+		setting, err := findConfigSetting(tt.key)
+		require.NoError(t, err)
+		err = setting.validate(root, tt.value)
+		if tt.valid {
+			assert.NoError(t, err, "%s=%s", tt.key, tt.value)
+		} else {
+			assert.Error(t, err, "%s=%s", tt.key, tt.value)
+			assert.Equal(t, exitUsage, exitCode(err))
+		}
+	}
+}
+
+// TestConfigSettingValue tests falling back to the default when unset
+func TestConfigSettingValue(t *testing.T) {
+	setting, err := findConfigSetting("color")
+	require.NoError(t, err)
+
+	value, set := setting.value(&config.Config{})
+	assert.Equal(t, "auto", value)
+	assert.False(t, set)
+
+	root := &config.Config{}
+	setting.set(root, "never")
+	value, set = setting.value(root)
+	assert.Equal(t, "never", value)
+	assert.True(t, set)
+}
+
+// TestConfigCommand tests that the config command has its subcommands
+func TestConfigCommand(t *testing.T) {
+	names := map[string]bool{}
+	for _, c := range configCmd.Commands() {
+		names[c.Name()] = true
+	}
+	for _, name := range []string{"get", "set", "unset", "list"} {
+		assert.True(t, names[name], "config should have %s", name)
+	}
+}
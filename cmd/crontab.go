@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/cron"
+)
+
+// crontabEntry is a scheduled command read from a crontab
+type crontabEntry struct {
+	line     int
+	schedule *cron.Schedule
+	// user is set for system crontabs (/etc/crontab, /etc/cron.d), which
+	// name the user to run as before the command
+	user    string
+	command string
+	name    string
+	// interval and gracePeriod are in minutes, like the job fields
+	interval    int
+	gracePeriod int
+}
+
+// isSystemCrontab reports whether path is in the system crontab format,
+// with a user field
+func isSystemCrontab(path string) bool {
+	return path == "/etc/crontab" || filepath.Dir(path) == "/etc/cron.d"
+}
+
+// parseCrontab reads the scheduled entries from a crontab. Blank lines,
+// comments, and environment settings are skipped; a comment directly above
+// an entry names its job. Entries that can't be monitored, such as @reboot,
+// are returned as warnings.
+func parseCrontab(data string, system bool, now time.Time) (entries []crontabEntry, warnings []string) {
+	comment := ""
+	for i, raw := range strings.Split(data, "\n") {
+		line := strings.TrimSpace(raw)
+		lineNo := i + 1
+
+		switch {
+		case line == "":
+			comment = ""
+			continue
+		case strings.HasPrefix(line, "#"):
+			comment = strings.TrimSpace(strings.TrimLeft(line, "#"))
+			continue
+		case isCrontabEnv(line):
+			comment = ""
+			continue
+		}
+
+		entry, err := parseCrontabLine(line, system, now)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("line %d: %v", lineNo, err))
+			comment = ""
+			continue
+		}
+		entry.line = lineNo
+		entry.name = comment
+		if entry.name == "" {
+			entry.name = commandName(entry.command)
+		}
+		entries = append(entries, entry)
+		comment = ""
+	}
+	return entries, warnings
+}
+
+// isCrontabEnv reports whether a line sets an environment variable, e.g.
+// MAILTO=ops@example.com or PATH = /usr/bin
+func isCrontabEnv(line string) bool {
+	name, _, ok := strings.Cut(line, "=")
+	name = strings.TrimSpace(name)
+	return ok && name != "" && !strings.ContainsAny(name, " \t*@")
+}
+
+// parseCrontabLine splits one crontab entry into its schedule, user, and
+// command, and works out the job's interval and grace period
+func parseCrontabLine(line string, system bool, now time.Time) (crontabEntry, error) {
+	fields := strings.Fields(line)
+	scheduleFields := 5
+	if strings.HasPrefix(fields[0], "@") {
+		if strings.EqualFold(fields[0], "@reboot") {
+			return crontabEntry{}, fmt.Errorf("skipping @reboot entry, it has no schedule to monitor")
+		}
+		scheduleFields = 1
+	}
+	want := scheduleFields + 1
+	if system {
+		want++
+	}
+	if len(fields) < want {
+		return crontabEntry{}, fmt.Errorf("skipping incomplete entry %q", line)
+	}
+
+	schedule, err := cron.Parse(strings.Join(fields[:scheduleFields], " "))
+	if err != nil {
+		return crontabEntry{}, fmt.Errorf("skipping entry: %w", err)
+	}
+	interval := scheduleInterval(schedule, now)
+	if interval == 0 {
+		return crontabEntry{}, fmt.Errorf("skipping entry %q, it never runs", schedule)
+	}
+
+	entry := crontabEntry{schedule: schedule}
+	if system {
+		entry.user = fields[scheduleFields]
+	}
+	// Keep the command's own spacing from the original line
+	entry.command = skipFields(line, want-1)
+	entry.interval = interval
+	entry.gracePeriod = inferGracePeriod(entry.interval)
+	return entry, nil
+}
+
+// skipFields returns line after its first n whitespace-separated fields
+func skipFields(line string, n int) string {
+	for i := 0; i < n; i++ {
+		line = strings.TrimLeft(line, " \t")
+		line = line[strings.IndexAny(line+" ", " \t"):]
+	}
+	return strings.TrimSpace(line)
+}
+
+// scheduleInterval returns the longest gap between a schedule's runs in
+// whole minutes, or 0 if it never runs
+func scheduleInterval(schedule *cron.Schedule, now time.Time) int {
+	return int(math.Ceil(schedule.Interval(now).Minutes()))
+}
+
+// inferGracePeriod allows a tenth of the interval for a run to finish and
+// ping, at least 5 minutes and at most an hour
+func inferGracePeriod(interval int) int {
+	return min(max(interval/10, 5), 60)
+}
+
+// commandName names a job after the program a crontab entry runs, e.g.
+// "backup.sh" for "/usr/local/bin/backup.sh --full > /dev/null"
+func commandName(command string) string {
+	fields := strings.Fields(command)
+	for _, field := range fields {
+		// Skip leading environment assignments such as TZ=UTC
+		if !strings.Contains(field, "=") {
+			return filepath.Base(field)
+		}
+	}
+	return fields[0]
+}
+
+// wrapperLine rewrites a crontab entry to run its command through
+// `groovekit jobs run`. Commands that rely on the shell (pipes, redirects,
+// variables) are passed to sh -c, since jobs run executes its arguments
+// directly.
+func wrapperLine(entry crontabEntry, token string) string {
+	command := entry.command
+	first := strings.Fields(command)[0]
+	if strings.ContainsAny(command, "|&;<>()$`\\\"'*?[]#~%{}") || strings.Contains(first, "=") {
+		command = "sh -c " + shellQuote(command)
+	}
+	parts := []string{entry.schedule.String()}
+	if entry.user != "" {
+		parts = append(parts, entry.user)
+	}
+	parts = append(parts, "groovekit jobs run", token, "--", command)
+	return strings.Join(parts, " ")
+}
+
+// shellQuote single-quotes s for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
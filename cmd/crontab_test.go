@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCrontab tests reading entries, names, and skipped lines from a user crontab
+func TestParseCrontab(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 30, 0, 0, time.UTC)
+	data := `MAILTO=ops@example.com
+SHELL = /bin/bash
+
+# Nightly database backup
+0 3 * * * /usr/local/bin/backup.sh --full > /var/log/backup.log 2>&1
+
+*/5 * * * *   php   /var/www/artisan schedule:run
+@reboot /usr/local/bin/start-agent
+0 9 * * mon-fri TZ=UTC /opt/report
+61 * * * * broken
+`
+
+	entries, warnings := parseCrontab(data, false, now)
+	require.Len(t, entries, 3)
+
+	assert.Equal(t, "Nightly database backup", entries[0].name)
+	assert.Equal(t, 5, entries[0].line)
+	assert.Equal(t, "0 3 * * *", entries[0].schedule.String())
+	assert.Equal(t, "/usr/local/bin/backup.sh --full > /var/log/backup.log 2>&1", entries[0].command)
+	assert.Equal(t, 1440, entries[0].interval)
+	assert.Equal(t, 60, entries[0].gracePeriod)
+
+	assert.Equal(t, "php", entries[1].name)
+	assert.Equal(t, "php   /var/www/artisan schedule:run", entries[1].command)
+	assert.Equal(t, 5, entries[1].interval)
+	assert.Equal(t, 5, entries[1].gracePeriod)
+
+	assert.Equal(t, "report", entries[2].name)
+	assert.Equal(t, 72*60, entries[2].interval)
+
+	require.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], "line 8: skipping @reboot entry")
+	assert.Contains(t, warnings[1], "line 10: skipping entry: invalid cron expression")
+}
+
+// TestParseCrontab_System tests the user field in system crontabs
+func TestParseCrontab_System(t *testing.T) {
+	entries, warnings := parseCrontab("17 * * * * root cd / && run-parts --report /etc/cron.hourly\n@daily root\n", true, time.Now())
+	require.Len(t, entries, 1)
+	assert.Equal(t, "root", entries[0].user)
+	assert.Equal(t, "cd / && run-parts --report /etc/cron.hourly", entries[0].command)
+	assert.Equal(t, 60, entries[0].interval)
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "incomplete entry")
+
+	assert.True(t, isSystemCrontab("/etc/crontab"))
+	assert.True(t, isSystemCrontab("/etc/cron.d/certbot"))
+	assert.False(t, isSystemCrontab("crontab.txt"))
+}
+
+// TestInferGracePeriod tests the grace period bounds
+func TestInferGracePeriod(t *testing.T) {
+	assert.Equal(t, 5, inferGracePeriod(1))
+	assert.Equal(t, 6, inferGracePeriod(60))
+	assert.Equal(t, 60, inferGracePeriod(10080))
+}
+
+// TestWrapperLine tests rewriting entries to run through jobs run
+func TestWrapperLine(t *testing.T) {
+	entries, _ := parseCrontab(`0 3 * * * /usr/local/bin/backup.sh --full
+@hourly echo "it's $(date)" >> /tmp/log
+`, false, time.Now())
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "0 3 * * * groovekit jobs run tok1 -- /usr/local/bin/backup.sh --full", wrapperLine(entries[0], "tok1"))
+	assert.Equal(t, `@hourly groovekit jobs run tok2 -- sh -c 'echo "it'\''s $(date)" >> /tmp/log'`, wrapperLine(entries[1], "tok2"))
+
+	entries[0].user = "root"
+	assert.Equal(t, "0 3 * * * root groovekit jobs run tok1 -- /usr/local/bin/backup.sh --full", wrapperLine(entries[0], "tok1"))
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/diff"
+	"gopkg.in/yaml.v3"
+)
+
+// monitorDefinitionFields are the API monitor fields a local definition can
+// set, using the API's field names
+var monitorDefinitionFields = []string{
+	"name", "url", "http_method", "headers", "request_body", "expected_status_codes",
+	"timeout", "interval", "grace_period", "alert_after", "realert_every", "status",
+	"validate_response_paths", "json_schema", "assert_contains", "assert_regex",
+	"assert_jsonpath", "regions", "max_response_time", "tags", "notification_channel_ids",
+}
+
+// monitorReadOnlyFields are reported by the API but can't be set, so a
+// definition exported with `apis show --json` may contain them. They are
+// ignored rather than rejected.
+var monitorReadOnlyFields = []string{
+	"id", "api_check_token", "has_auth_headers", "last_check_at", "consecutive_failures",
+	"down", "uptime_percentage", "average_response_time", "created_at", "updated_at",
+}
+
+// loadMonitorDefinition reads an API monitor definition from a YAML or JSON
+// file, keeping only the fields it sets
+func loadMonitorDefinition(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definition: %w", err)
+	}
+
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse definition %s: %w", path, err)
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("definition %s is empty", path)
+	}
+
+	// Round-trip through JSON so values compare like API responses, e.g.
+	// YAML integers become float64
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse definition %s: %w", path, err)
+	}
+	var def map[string]interface{}
+	if err := json.Unmarshal(encoded, &def); err != nil {
+		return nil, fmt.Errorf("failed to parse definition %s: %w", path, err)
+	}
+
+	var unknown []string
+	for key := range def {
+		switch {
+		case slices.Contains(monitorDefinitionFields, key):
+		case slices.Contains(monitorReadOnlyFields, key):
+			delete(def, key)
+		default:
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown field(s) in %s: %s (valid fields: %s)", path,
+			strings.Join(unknown, ", "), strings.Join(monitorDefinitionFields, ", "))
+	}
+	return def, nil
+}
+
+// diffMonitorDefinition compares the fields a definition sets against the
+// remote monitor. Changes go from the remote value to the local one, so
+// they read as what applying the definition would do. Empty lists and
+// missing values are treated alike.
+func diffMonitorDefinition(monitor *api.ApiMonitor, def map[string]interface{}) []diff.Change {
+	encoded, _ := json.Marshal(monitor)
+	var remote map[string]interface{}
+	_ = json.Unmarshal(encoded, &remote)
+
+	current := map[string]interface{}{}
+	desired := map[string]interface{}{}
+	for key, value := range def {
+		current[key] = emptyToNil(remote[key])
+		desired[key] = emptyToNil(value)
+	}
+	return diff.Compare(current, desired)
+}
+
+// emptyToNil maps empty lists and objects to nil
+func emptyToNil(v interface{}) interface{} {
+	switch value := v.(type) {
+	case []interface{}:
+		if len(value) == 0 {
+			return nil
+		}
+	case map[string]interface{}:
+		if len(value) == 0 {
+			return nil
+		}
+	}
+	return v
+}
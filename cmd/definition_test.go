@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/diff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeDefinition writes a definition file to a temp dir and returns its path
+func writeDefinition(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+// TestLoadMonitorDefinition tests reading YAML and JSON definitions
+func TestLoadMonitorDefinition(t *testing.T) {
+	def, err := loadMonitorDefinition(writeDefinition(t, "monitor.yaml", `
+name: Prod API
+interval: 5
+expected_status_codes: [200, 204]
+id: ignored-read-only
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":                  "Prod API",
+		"interval":              5.0,
+		"expected_status_codes": []interface{}{200.0, 204.0},
+	}, def)
+
+	def, err = loadMonitorDefinition(writeDefinition(t, "monitor.json", `{"url": "https://x.io", "tags": ["env=prod"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://x.io", def["url"])
+}
+
+// TestLoadMonitorDefinition_Errors tests rejecting bad definitions
+func TestLoadMonitorDefinition_Errors(t *testing.T) {
+	_, err := loadMonitorDefinition(writeDefinition(t, "typo.yaml", "name: x\nintervall: 5\n"))
+	assert.ErrorContains(t, err, "unknown field(s) in")
+	assert.ErrorContains(t, err, ": intervall (valid fields: name, url,")
+
+	_, err = loadMonitorDefinition(writeDefinition(t, "empty.yaml", ""))
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = loadMonitorDefinition(writeDefinition(t, "bad.yaml", "name: [unclosed\n"))
+	assert.ErrorContains(t, err, "failed to parse definition")
+
+	_, err = loadMonitorDefinition(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read definition")
+}
+
+// TestDiffMonitorDefinition tests comparing only the fields a definition sets
+func TestDiffMonitorDefinition(t *testing.T) {
+	monitor := &api.ApiMonitor{
+		Name:                "Prod API",
+		URL:                 "https://x.io/health",
+		Interval:            5,
+		ExpectedStatusCodes: []int{200},
+		Timeout:             30,
+	}
+
+	changes := diffMonitorDefinition(monitor, map[string]interface{}{
+		"name":                  "Prod API",
+		"interval":              10.0,
+		"expected_status_codes": []interface{}{200.0, 204.0},
+		"tags":                  []interface{}{},
+		"http_method":           "HEAD",
+	})
+
+	assert.Equal(t, []diff.Change{
+		{Path: "expected_status_codes[1]", Op: diff.Added, New: 204.0},
+		{Path: "http_method", Op: diff.Changed, Old: "", New: "HEAD"},
+		{Path: "interval", Op: diff.Changed, Old: 5.0, New: 10.0},
+	}, changes)
+
+	assert.Empty(t, diffMonitorDefinition(monitor, map[string]interface{}{"timeout": 30.0}))
+}
@@ -2,13 +2,17 @@ package cmd
 
 import (
 	"fmt"
+	"net"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
 	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/probe"
 	"github.com/spf13/cobra"
 )
 
@@ -24,21 +28,31 @@ var dnsListCmd = &cobra.Command{
 	Short: "List all DNS monitors",
 	Long:  "List all DNS record monitors for your account",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		filter := getListFilter(cmd)
+		if err := filter.resolveProject(client); err != nil {
+			return err
+		}
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
-		result, err := client.ListDnsMonitors()
+		list := client.ListDnsMonitors
+		if filter.all {
+			list = client.ListAllDnsMonitors
+		}
+		result, err := list(filter.options())
 
 		if s != nil {
 			s.Stop()
@@ -47,33 +61,44 @@ var dnsListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to list DNS monitors: %w", err)
 		}
+
+		// Filter client-side too, in case the API ignored any of the options
+		if filter.active() {
+			result.DnsMonitors = filterItems(result.DnsMonitors, func(dns api.DnsMonitor) bool {
+				return filter.match(dns.Status, dns.Name, dns.HasMismatch, dns.Tags, dns.ProjectID)
+			})
+			result.TotalCount = len(result.DnsMonitors)
+		}
+
+		result.DnsMonitors = limitItems(result.DnsMonitors, filter.limit)
+
 		if jsonOutput {
-			return outputJSON(result)
+			return cmdutil.OutputJSON(out, result)
 		}
 
 		if len(result.DnsMonitors) == 0 {
-			output.InfoMessage("No DNS monitors found")
-			fmt.Println("\nCreate your first DNS monitor:")
-			fmt.Println("  groovekit dns create --name 'Example MX' --domain example.com --type MX --expected mail.example.com")
+			if filter.active() {
+				output.InfoMessage(out, filter.emptyMessage("DNS monitors"))
+				return nil
+			}
+			output.InfoMessage(out, "No DNS monitors found")
+			fmt.Fprintln(out, "\nCreate your first DNS monitor:")
+			fmt.Fprintln(out, "  groovekit dns create --name 'Example MX' --domain example.com --type MX --expected mail.example.com")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"ID", "NAME", "DOMAIN", "TYPE", "MISMATCH", "STATUS"})
+		table, err := newListTable(cmd, []string{"ID", "NAME", "DOMAIN", "TYPE", "MISMATCH", "STATUS", "EXPECTED", "CURRENT", "NAMESERVER"}, "expected", "current", "nameserver")
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
 		for _, dns := range result.DnsMonitors {
-			status := dns.Status
-			if dns.Status == "active" {
-				status = output.Green(status)
-			}
+			status := formatListStatus(dns.Status, dns.SnoozedUntil, time.Now())
 
-			// Truncate ID to first 8 chars (like Docker)
-			shortID := dns.ID
-			if len(shortID) > 8 {
-				shortID = shortID[:8]
-			}
+			shortID := cmdutil.ShortID(dns.ID)
 
 			// Color-code mismatch
 			var mismatch string
@@ -90,11 +115,17 @@ var dnsListCmd = &cobra.Command{
 				dns.RecordType,
 				mismatch,
 				status,
+				valueOrDash(strings.Join(dns.ExpectedValues, ", ")),
+				valueOrDash(strings.Join(dns.CurrentValues, ", ")),
+				valueOrDash(dns.Nameserver),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d DNS monitor(s)", len(result.DnsMonitors))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d DNS monitor(s)", len(result.DnsMonitors)))
+		if result.HasMore && !filter.all {
+			output.InfoMessage(out, "More results are available, use --all to fetch every page")
+		}
 		return nil
 	},
 }
@@ -106,13 +137,15 @@ var dnsShowCmd = &cobra.Command{
 	Long:  "Display detailed information about a specific DNS monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -122,7 +155,7 @@ var dnsShowCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -136,100 +169,218 @@ var dnsShowCmd = &cobra.Command{
 			return fmt.Errorf("failed to get DNS monitor: %w", err)
 		}
 		if jsonOutput {
-			return outputJSON(dns)
+			return cmdutil.OutputJSON(out, dns)
 		}
 
 		// Print DNS monitor details
-		fmt.Printf("ID:                       %s\n", output.Cyan(dns.ID))
-		fmt.Printf("Name:                     %s\n", output.Bold(dns.Name))
-		fmt.Printf("Domain:                   %s\n", dns.Domain)
-		fmt.Printf("Record Type:              %s\n", dns.RecordType)
-		fmt.Printf("Status:                   %s\n", dns.Status)
-		fmt.Printf("Check Interval:           %s\n", output.FormatDuration(dns.Interval))
-		fmt.Printf("Grace Period:             %s\n", output.FormatDuration(dns.GracePeriod))
+		fmt.Fprintf(out, "ID:                       %s\n", output.Cyan(dns.ID))
+		fmt.Fprintf(out, "Name:                     %s\n", output.Bold(dns.Name))
+		fmt.Fprintf(out, "Domain:                   %s\n", dns.Domain)
+		fmt.Fprintf(out, "Record Type:              %s\n", dns.RecordType)
+		fmt.Fprintf(out, "Match Mode:               %s\n", dnsMatchMode(dns.MatchMode))
+		if dns.Nameserver != "" {
+			fmt.Fprintf(out, "Nameserver:               %s\n", dns.Nameserver)
+		}
+		fmt.Fprintf(out, "Status:                   %s\n", dns.Status)
+		if snoozed, ok := formatSnoozedUntil(dns.SnoozedUntil, time.Now()); ok {
+			fmt.Fprintf(out, "Snoozed Until:            %s\n", output.Yellow(snoozed))
+		}
+		fmt.Fprintf(out, "Check Interval:           %s\n", output.FormatDuration(dns.Interval))
+		fmt.Fprintf(out, "Grace Period:             %s\n", output.FormatDuration(dns.GracePeriod))
+		fmt.Fprintf(out, "Alerts:                   %s\n", formatEscalation(dns.AlertAfter, dns.RealertEvery))
 
 		// Show expected values
-		fmt.Printf("\nExpected Values:\n")
+		fmt.Fprintf(out, "\nExpected Values:\n")
 		if len(dns.ExpectedValues) == 0 {
-			fmt.Printf("  (none)\n")
+			fmt.Fprintf(out, "  (none)\n")
 		} else {
 			for _, val := range dns.ExpectedValues {
-				fmt.Printf("  - %s\n", val)
+				fmt.Fprintf(out, "  - %s\n", val)
 			}
 		}
 
 		// Show current values
-		fmt.Printf("\nCurrent Values:\n")
+		fmt.Fprintf(out, "\nCurrent Values:\n")
 		if len(dns.CurrentValues) == 0 {
-			fmt.Printf("  (none)\n")
+			fmt.Fprintf(out, "  (none)\n")
 		} else {
+			unexpected := map[string]bool{}
+			matches, _ := probe.CompareRecordsMode(dns.ExpectedValues, dns.CurrentValues, dnsMatchMode(dns.MatchMode))
+			for _, m := range matches {
+				if m.Status == probe.RecordUnexpected {
+					unexpected[m.Value] = true
+				}
+			}
 			for _, val := range dns.CurrentValues {
 				// Highlight if this value is not in expected values
-				if !slices.Contains(dns.ExpectedValues, val) {
-					fmt.Printf("  - %s (unexpected)\n", output.Red(val))
+				if unexpected[val] {
+					fmt.Fprintf(out, "  - %s (unexpected)\n", output.Red(val))
 				} else {
-					fmt.Printf("  - %s\n", output.Green(val))
+					fmt.Fprintf(out, "  - %s\n", output.Green(val))
 				}
 			}
 		}
 
 		// Show mismatch status
 		if dns.HasMismatch {
-			fmt.Printf("\nStatus:                   %s\n", output.Red("✗ Mismatch - values don't match!"))
+			fmt.Fprintf(out, "\nStatus:                   %s\n", output.Red("✗ Mismatch - values don't match!"))
 		} else {
-			fmt.Printf("\nStatus:                   %s\n", output.Green("✓ Values match"))
+			fmt.Fprintf(out, "\nStatus:                   %s\n", output.Green("✓ Values match"))
 		}
 
 		if dns.LastChanged != nil {
-			fmt.Printf("Last Changed:             %s\n", *dns.LastChanged)
+			fmt.Fprintf(out, "Last Changed:             %s\n", output.FormatTime(*dns.LastChanged))
 		}
-		fmt.Printf("Last Check At:            %s\n", dns.LastCheckAt)
-		fmt.Printf("Last Successful Check:    %s\n", dns.LastSuccessfulCheckAt)
-		fmt.Printf("Consecutive Failures:     %d\n", dns.ConsecutiveFailures)
-		fmt.Printf("Created At:               %s\n", dns.CreatedAt)
-		fmt.Printf("Updated At:               %s\n", dns.UpdatedAt)
+		fmt.Fprintf(out, "Last Check At:            %s\n", output.FormatTime(dns.LastCheckAt))
+		fmt.Fprintf(out, "Last Successful Check:    %s\n", output.FormatTime(dns.LastSuccessfulCheckAt))
+		fmt.Fprintf(out, "Consecutive Failures:     %d\n", dns.ConsecutiveFailures)
+		fmt.Fprintf(out, "Tags:                     %s\n", formatTags(dns.Tags))
+		fmt.Fprintf(out, "Notifications:            %s\n", formatChannels(dns.ChannelIDs))
+		fmt.Fprintf(out, "Created At:               %s\n", output.FormatTime(dns.CreatedAt))
+		fmt.Fprintf(out, "Updated At:               %s\n", output.FormatTime(dns.UpdatedAt))
 
 		return nil
 	},
 }
 
+// dnsRecordTypes are the record types a DNS monitor can watch
+var dnsRecordTypes = []string{"A", "AAAA", "MX", "CNAME", "TXT", "NS", "SOA", "CAA", "SRV", "PTR"}
+
+// dnsMatchMode returns a monitor's match mode, which defaults to exact
+func dnsMatchMode(mode string) string {
+	if mode == "" {
+		return probe.MatchExact
+	}
+	return mode
+}
+
+// validateMatchMode checks a --match-mode value and, in regex mode, that
+// every expected value compiles
+func validateMatchMode(mode string, expected []string) error {
+	if !slices.Contains(probe.MatchModes, mode) {
+		return usageErrorf("invalid --match-mode %q. Must be one of: %s", mode, strings.Join(probe.MatchModes, ", "))
+	}
+	if mode == probe.MatchRegex {
+		if _, err := probe.CompileRecordPatterns(expected); err != nil {
+			return usageErrorf("--expected: %v", err)
+		}
+	}
+	return nil
+}
+
+// validateNameserver checks a --nameserver value is a host or IP address,
+// optionally with a port
+func validateNameserver(nameserver string) error {
+	host := nameserver
+	if h, port, err := net.SplitHostPort(nameserver); err == nil {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return usageErrorf("invalid --nameserver %q: port must be between 1 and 65535", nameserver)
+		}
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if host == "" || strings.ContainsAny(host, "/ ") || (strings.Contains(host, ":") && net.ParseIP(host) == nil) {
+		return usageErrorf("invalid --nameserver %q: must be a host or IP address, e.g. 1.1.1.1 or ns1.example.com:53", nameserver)
+	}
+	return nil
+}
+
+// dnsMatchSettings returns the match mode and expected values a monitor
+// will have after an update, fetching the monitor unless both are being
+// changed
+func dnsMatchSettings(cmd *cobra.Command, client api.APIClient, id string) (string, []string, error) {
+	mode, _ := cmd.Flags().GetString("match-mode")
+	expected, _ := cmd.Flags().GetStringSlice("expected")
+	if cmd.Flags().Changed("match-mode") && cmd.Flags().Changed("expected") {
+		return mode, expected, nil
+	}
+
+	dns, err := client.GetDnsMonitor(id)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get DNS monitor: %w", err)
+	}
+	if !cmd.Flags().Changed("match-mode") {
+		mode = dnsMatchMode(dns.MatchMode)
+	}
+	if !cmd.Flags().Changed("expected") {
+		expected = dns.ExpectedValues
+	}
+	return mode, expected, nil
+}
+
+// dnsCreateWizard prompts for the dns create settings, offering the
+// record's current values as the expected ones
+var dnsCreateWizard = []wizardField{
+	{flag: "domain", prompt: "Domain to monitor", required: true, validate: validateDomainName},
+	{flag: "name", prompt: "Monitor name", required: true, def: defaultToFlag("domain")},
+	{flag: "type", prompt: "Record type", required: true, options: dnsRecordTypes},
+	{flag: "expected", prompt: "Expected values, comma-separated", required: true, def: func(cmd *cobra.Command) string {
+		domain, _ := cmd.Flags().GetString("domain")
+		recordType, _ := cmd.Flags().GetString("type")
+		nameserver, _ := cmd.Flags().GetString("nameserver")
+		values, err := probe.LookupDNS(probe.DNSOptions{Domain: domain, RecordType: recordType, Nameserver: nameserver})
+		if err != nil {
+			return ""
+		}
+		return strings.Join(values, ",")
+	}},
+	{flag: "interval", prompt: "Check interval"},
+	{flag: "grace-period", prompt: "Grace period"},
+	tagsWizardField,
+}
+
 // dns create
 var dnsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new DNS monitor",
 	Long:  "Create a new DNS record monitor",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
+		if err := runCreateWizard(cmd, dnsCreateWizard); err != nil {
+			return err
+		}
+
 		// Get flag values
 		name, _ := cmd.Flags().GetString("name")
 		domain, _ := cmd.Flags().GetString("domain")
 		recordType, _ := cmd.Flags().GetString("type")
 		expectedValues, _ := cmd.Flags().GetStringSlice("expected")
-		interval, _ := cmd.Flags().GetInt("interval")
-		gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+		matchMode, _ := cmd.Flags().GetString("match-mode")
+		nameserver, _ := cmd.Flags().GetString("nameserver")
+		interval := getDurationFlag(cmd, "interval")
+		gracePeriod := getDurationFlag(cmd, "grace-period")
 
 		if name == "" {
-			return fmt.Errorf("--name is required")
+			return usageErrorf("--name is required")
 		}
 		if domain == "" {
-			return fmt.Errorf("--domain is required")
+			return usageErrorf("--domain is required")
 		}
 		if recordType == "" {
-			return fmt.Errorf("--type is required")
+			return usageErrorf("--type is required")
 		}
 		if len(expectedValues) == 0 {
-			return fmt.Errorf("--expected is required (at least one value)")
+			return usageErrorf("--expected is required (at least one value)")
 		}
 
 		// Validate record type
-		validTypes := []string{"A", "AAAA", "MX", "CNAME", "TXT", "NS"}
 		recordType = strings.ToUpper(recordType)
-		if !slices.Contains(validTypes, recordType) {
-			return fmt.Errorf("invalid record type '%s'. Must be one of: %s", recordType, strings.Join(validTypes, ", "))
+		if !slices.Contains(dnsRecordTypes, recordType) {
+			return fmt.Errorf("invalid record type '%s'. Must be one of: %s", recordType, strings.Join(dnsRecordTypes, ", "))
+		}
+		if err := validateMatchMode(matchMode, expectedValues); err != nil {
+			return err
+		}
+		if nameserver != "" {
+			if err := validateNameserver(nameserver); err != nil {
+				return err
+			}
 		}
 
 		req := &api.CreateDnsMonitorRequest{
@@ -237,11 +388,38 @@ var dnsCreateCmd = &cobra.Command{
 			Domain:         domain,
 			RecordType:     recordType,
 			ExpectedValues: expectedValues,
+			MatchMode:      matchMode,
+			Nameserver:     nameserver,
 			Interval:       interval,
 			GracePeriod:    gracePeriod,
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil {
+			req.AlertAfter = *alertAfter
+		}
+		if realertEvery != nil {
+			req.RealertEvery = *realertEvery
+		}
+
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
+		}
+		req.Tags = tags
+
+		if req.ProjectID, err = getProject(cmd, client); err != nil {
+			return err
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
 		s.Start()
 		dnsMonitor, err := client.CreateDnsMonitor(req)
 		s.Stop()
@@ -250,18 +428,98 @@ var dnsCreateCmd = &cobra.Command{
 			return fmt.Errorf("failed to create DNS monitor: %w", err)
 		}
 
-		output.SuccessMessage("DNS monitor created successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(dnsMonitor.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(dnsMonitor.Name))
-		fmt.Printf("Domain:   %s\n", dnsMonitor.Domain)
-		fmt.Printf("Type:     %s\n", dnsMonitor.RecordType)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(dnsMonitor.Interval))
-		fmt.Printf("Expected: %s\n", strings.Join(dnsMonitor.ExpectedValues, ", "))
+		output.SuccessMessage(out, "DNS monitor created successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(dnsMonitor.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(dnsMonitor.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", dnsMonitor.Domain)
+		fmt.Fprintf(out, "Type:     %s\n", dnsMonitor.RecordType)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(dnsMonitor.Interval))
+		fmt.Fprintf(out, "Expected: %s (%s)\n", strings.Join(dnsMonitor.ExpectedValues, ", "), dnsMatchMode(dnsMonitor.MatchMode))
 
 		return nil
 	},
 }
 
+// dns clone <id>
+var dnsCloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of a DNS monitor",
+	Long: `Create a new DNS record monitor with the same record type, expected values,
+interval, and grace period as an existing one, optionally for another
+domain.` + cloneLongHelp + `
+
+Examples:
+  groovekit dns clone abc12345 --domain www.example.org
+  groovekit dns clone abc12345 --domain staging.example.com --expected 203.0.113.20`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		dns, err := client.GetDnsMonitor(fullID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get DNS monitor: %w", err)
+		}
+
+		tags, err := cloneTags(cmd, dns.Tags)
+		if err != nil {
+			return err
+		}
+
+		req := &api.CreateDnsMonitorRequest{
+			Name:           cloneName(cmd, dns.Name),
+			Domain:         dns.Domain,
+			RecordType:     dns.RecordType,
+			ExpectedValues: dns.ExpectedValues,
+			MatchMode:      dns.MatchMode,
+			Nameserver:     dns.Nameserver,
+			Interval:       dns.Interval,
+			GracePeriod:    dns.GracePeriod,
+			AlertAfter:     dns.AlertAfter,
+			RealertEvery:   dns.RealertEvery,
+			Status:         dns.Status,
+			Tags:           tags,
+			ProjectID:      dns.ProjectID,
+		}
+		if domain, _ := cmd.Flags().GetString("domain"); domain != "" {
+			req.Domain = domain
+		}
+		if cmd.Flags().Changed("expected") {
+			req.ExpectedValues, _ = cmd.Flags().GetStringSlice("expected")
+			if err := validateMatchMode(dnsMatchMode(req.MatchMode), req.ExpectedValues); err != nil {
+				return err
+			}
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s = newSpinner(cmd)
+		s.Start()
+		clone, err := client.CreateDnsMonitor(req)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to create DNS monitor: %w", err)
+		}
+
+		return finishClone(cmd, client, dnsNotifyTarget, dns.Name, clone.Name, clone.ID, dns.ChannelIDs)
+	},
+}
+
 // dns update <id>
 var dnsUpdateCmd = &cobra.Command{
 	Use:   "update <id>",
@@ -269,13 +527,15 @@ var dnsUpdateCmd = &cobra.Command{
 	Long:  "Update an existing DNS record monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -300,9 +560,8 @@ var dnsUpdateCmd = &cobra.Command{
 			recordType, _ := cmd.Flags().GetString("type")
 			recordType = strings.ToUpper(recordType)
 			// Validate record type
-			validTypes := []string{"A", "AAAA", "MX", "CNAME", "TXT", "NS"}
-			if !slices.Contains(validTypes, recordType) {
-				return fmt.Errorf("invalid record type '%s'. Must be one of: %s", recordType, strings.Join(validTypes, ", "))
+			if !slices.Contains(dnsRecordTypes, recordType) {
+				return fmt.Errorf("invalid record type '%s'. Must be one of: %s", recordType, strings.Join(dnsRecordTypes, ", "))
 			}
 			req.RecordType = &recordType
 			hasUpdates = true
@@ -314,14 +573,40 @@ var dnsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		if cmd.Flags().Changed("match-mode") || cmd.Flags().Changed("expected") {
+			matchMode, expectedValues, err := dnsMatchSettings(cmd, client, fullID)
+			if err != nil {
+				return err
+			}
+			if err := validateMatchMode(matchMode, expectedValues); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("match-mode") {
+				req.MatchMode = &matchMode
+				hasUpdates = true
+			}
+		}
+
+		if cmd.Flags().Changed("nameserver") {
+			nameserver, _ := cmd.Flags().GetString("nameserver")
+			// An empty value goes back to the hosted check's default resolver
+			if nameserver != "" {
+				if err := validateNameserver(nameserver); err != nil {
+					return err
+				}
+			}
+			req.Nameserver = &nameserver
+			hasUpdates = true
+		}
+
 		if cmd.Flags().Changed("interval") {
-			interval, _ := cmd.Flags().GetInt("interval")
+			interval := getDurationFlag(cmd, "interval")
 			req.Interval = &interval
 			hasUpdates = true
 		}
 
 		if cmd.Flags().Changed("grace-period") {
-			gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+			gracePeriod := getDurationFlag(cmd, "grace-period")
 			req.GracePeriod = &gracePeriod
 			hasUpdates = true
 		}
@@ -332,11 +617,29 @@ var dnsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil || realertEvery != nil {
+			req.AlertAfter, req.RealertEvery = alertAfter, realertEvery
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("tag") {
+			tags, err := getTags(cmd)
+			if err != nil {
+				return err
+			}
+			req.Tags = &tags
+			hasUpdates = true
+		}
+
 		if !hasUpdates {
-			return fmt.Errorf("no fields to update. Use --name, --domain, --type, --expected, --interval, --grace-period, or --status")
+			return fmt.Errorf("no fields to update. Use --name, --domain, --type, --expected, --match-mode, --nameserver, --interval, --grace-period, --status, --alert-after, --realert-every, or --tag")
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
 		dnsMonitor, err := client.UpdateDnsMonitor(fullID, req)
 		s.Stop()
@@ -345,87 +648,78 @@ var dnsUpdateCmd = &cobra.Command{
 			return fmt.Errorf("failed to update DNS monitor: %w", err)
 		}
 
-		output.SuccessMessage("DNS monitor updated successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(dnsMonitor.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(dnsMonitor.Name))
-		fmt.Printf("Domain:   %s\n", dnsMonitor.Domain)
-		fmt.Printf("Type:     %s\n", dnsMonitor.RecordType)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(dnsMonitor.Interval))
-		fmt.Printf("Status:   %s\n", dnsMonitor.Status)
+		output.SuccessMessage(out, "DNS monitor updated successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(dnsMonitor.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(dnsMonitor.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", dnsMonitor.Domain)
+		fmt.Fprintf(out, "Type:     %s\n", dnsMonitor.RecordType)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(dnsMonitor.Interval))
+		fmt.Fprintf(out, "Status:   %s\n", dnsMonitor.Status)
 
 		return nil
 	},
 }
 
-// dns pause <id>
+// dns pause [id...]
 var dnsPauseCmd = &cobra.Command{
-	Use:   "pause <id>",
-	Short: "Pause a DNS monitor",
-	Long:  "Pause a DNS record monitor (sets status to paused)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to paused
-		status := "paused"
-		req := &api.UpdateDnsMonitorRequest{Status: &status}
-
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateDnsMonitor(fullID, req)
-		s.Stop()
-
-		if err != nil {
-			return fmt.Errorf("failed to pause DNS monitor: %w", err)
-		}
-
-		output.SuccessMessage(fmt.Sprintf("DNS monitor %s paused successfully", args[0]))
-		return nil
+	Use:   "pause [id...]",
+	Short: "Pause one or more DNS monitors",
+	Long: `Pause one or more DNS record monitors (sets status to paused).` + bulkLongHelp + `
+
+Examples:
+  groovekit dns pause abc12345 def67890
+  groovekit dns pause --tag env=staging --dry-run
+  groovekit dns pause --match 'name~staging'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, dnsBulkTarget, statusAction(dnsBulkTarget, "paused"))
 	},
 }
 
-// dns resume <id>
+// dns resume [id...]
 var dnsResumeCmd = &cobra.Command{
-	Use:   "resume <id>",
-	Short: "Resume a DNS monitor",
-	Long:  "Resume a paused DNS record monitor (sets status to active)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to active
-		status := "active"
-		req := &api.UpdateDnsMonitorRequest{Status: &status}
+	Use:   "resume [id...]",
+	Short: "Resume one or more DNS monitors",
+	Long: `Resume one or more paused DNS record monitors (sets status to active).` + bulkLongHelp + `
+
+Examples:
+  groovekit dns resume abc12345 def67890
+  groovekit dns resume --tag env=staging
+  groovekit dns resume --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, dnsBulkTarget, statusAction(dnsBulkTarget, "active"))
+	},
+}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateDnsMonitor(fullID, req)
-		s.Stop()
+// dns notify <id>
+var dnsNotifyCmd = &cobra.Command{
+	Use:   "notify <id>",
+	Short: "Manage where DNS monitor alerts go",
+	Long: `Add or remove the notification channels that a DNS monitor's alerts are
+routed to.` + notifyLongHelp + `
+
+Examples:
+  groovekit dns notify abc12345
+  groovekit dns notify abc12345 --add-channel ch_oncall --remove-channel ch_email`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runNotify(cmd, args[0], dnsNotifyTarget)
+	},
+}
 
+// dnsNotifyTarget reads and updates DNS monitor notification channels
+var dnsNotifyTarget = notifyTarget{
+	noun:    "DNS monitor",
+	resolve: cmdutil.ResolveDNSMonitorID,
+	channels: func(client api.APIClient, id string) ([]string, error) {
+		monitor, err := client.GetDnsMonitor(id)
 		if err != nil {
-			return fmt.Errorf("failed to resume DNS monitor: %w", err)
+			return nil, err
 		}
-
-		output.SuccessMessage(fmt.Sprintf("DNS monitor %s resumed successfully", args[0]))
-		return nil
+		return monitor.ChannelIDs, nil
+	},
+	update: func(client api.APIClient, id string, channels []string) error {
+		_, err := client.UpdateDnsMonitor(id, &api.UpdateDnsMonitorRequest{ChannelIDs: &channels})
+		return err
 	},
 }
 
@@ -436,13 +730,15 @@ var dnsIncidentsCmd = &cobra.Command{
 	Long:  "Display incident history (downtime periods) for a DNS monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -452,7 +748,7 @@ var dnsIncidentsCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -467,16 +763,19 @@ var dnsIncidentsCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
-			return outputJSON(incidents)
+			return cmdutil.OutputJSON(out, incidents)
 		}
 
 		if len(incidents) == 0 {
-			output.InfoMessage("No incidents found - this DNS monitor has been running smoothly!")
+			output.InfoMessage(out, "No incidents found - this DNS monitor has been running smoothly!")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"STARTED", "ENDED", "DURATION", "STATUS", "ERROR"})
+		table, err := newListTable(cmd, []string{"ID", "STARTED", "ENDED", "DURATION", "STATUS", "ERROR", "ACK", "NOTE"})
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
@@ -486,111 +785,379 @@ var dnsIncidentsCmd = &cobra.Command{
 
 			if incident.EndedAt != nil {
 				status = output.Green("Recovered")
-				ended = *incident.EndedAt
+				ended = output.FormatTime(*incident.EndedAt)
 			}
 
 			// Format duration
-			duration := formatIncidentDuration(incident.Duration)
+			duration := cmdutil.FormatIncidentDuration(incident.Duration)
 
-			// Truncate error message
 			errorMsg := "-"
 			if incident.ErrorMessage != nil {
-				errorMsg = truncate(*incident.ErrorMessage, 40)
+				errorMsg = *incident.ErrorMessage
 			}
 
 			table.Append([]string{
-				incident.StartedAt,
+				output.Cyan(cmdutil.ShortID(incident.ID)),
+				output.FormatTime(incident.StartedAt),
 				ended,
 				duration,
 				status,
 				errorMsg,
+				incidentAck(incident),
+				incidentNote(incident),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d incident(s)", len(incidents))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d incident(s)", len(incidents)))
 		return nil
 	},
 }
 
-// dns delete <id>
-var dnsDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete a DNS monitor",
-	Long:  "Delete a DNS record monitor",
+// dns check <id>
+var dnsCheckCmd = &cobra.Command{
+	Use:   "check <id>",
+	Short: "Check DNS monitor health",
+	Long:  "Query the current health of a DNS record monitor for use in scripts and CI gates.\nA DNS monitor is down when current values don't match the expected values." + checkLongHelp,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		dns, err := client.GetDnsMonitor(fullID)
+		if err != nil {
+			return checkFailed(cmd, fmt.Errorf("failed to get DNS monitor: %w", err))
+		}
+
+		if state, ok := statusHealth(dns.Status); ok {
+			return reportHealth(cmd, "DNS monitor", dns.Name, state, dns.Status)
+		}
+		if dns.HasMismatch {
+			return reportHealth(cmd, "DNS monitor", dns.Name, healthDown, "values don't match")
+		}
+		if dns.ConsecutiveFailures > 0 {
+			return reportHealth(cmd, "DNS monitor", dns.Name, healthDown, fmt.Sprintf("%d consecutive failure(s)", dns.ConsecutiveFailures))
+		}
+		if dns.LastCheckAt == "" {
+			return reportHealth(cmd, "DNS monitor", dns.Name, healthUnknown, "not checked yet")
+		}
+		return reportHealth(cmd, "DNS monitor", dns.Name, healthUp, "")
+	},
+}
+
+// dns history <id>
+var dnsHistoryCmd = &cobra.Command{
+	Use:   "history <id>",
+	Short: "Show changes to a DNS monitor's record",
+	Long: `List every change to a DNS monitor's record detected by its checks, newest
+first, with the values before and after, to answer questions like "when did
+the MX record change?".
+
+Examples:
+  groovekit dns history abc12345
+  groovekit dns history abc12345 --since 30d
+  groovekit dns history abc12345 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		var since time.Time
+		if sinceFlag != "" {
+			var err error
+			if since, err = parseSince(sinceFlag, time.Now()); err != nil {
+				return err
+			}
+		}
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDnsMonitorID(client, args[0])
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
 		if err != nil {
 			return err
 		}
 
-		// Confirm deletion
-		confirm, _ := cmd.Flags().GetBool("force")
-		if !confirm {
-			fmt.Printf("Are you sure you want to delete DNS monitor %s? (y/N): ", args[0])
-			var response string
-			_, _ = fmt.Scanln(&response)
-			if response != "y" && response != "Y" {
-				fmt.Println("Cancelled")
-				return nil
-			}
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		err = client.DeleteDnsMonitor(fullID)
-		s.Stop()
+		changes, err := client.ListDnsMonitorChanges(fullID)
+
+		if s != nil {
+			s.Stop()
+		}
 
 		if err != nil {
-			return fmt.Errorf("failed to delete DNS monitor: %w", err)
+			return fmt.Errorf("failed to get DNS changes: %w", err)
+		}
+
+		changes = limitItems(filterItems(changes, func(change api.DnsChange) bool {
+			return notBefore(change.DetectedAt, since)
+		}), limit)
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, changes)
 		}
 
-		output.SuccessMessage(fmt.Sprintf("DNS monitor %s deleted successfully", args[0]))
+		if len(changes) == 0 {
+			output.InfoMessage(out, "No record changes found")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{"DETECTED", "OLD VALUES", "NEW VALUES"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for _, change := range changes {
+			old, changed := formatDnsChange(change)
+			table.Append([]string{output.FormatTime(change.DetectedAt), old, changed})
+		}
+		table.Flush()
+
+		output.TotalMessage(out, fmt.Sprintf("Total: %s", countNoun(len(changes), "change", "changes")))
 		return nil
 	},
 }
 
-// Helper function to resolve a short DNS monitor ID to a full ID
-func resolveDnsMonitorID(client *api.Client, shortID string) (string, error) {
-	// If it looks like a full UUID, use it as-is
-	if len(shortID) >= 32 {
-		return shortID, nil
+// formatDnsChange renders a change's old and new values, highlighting
+// removed values in red and added ones in green
+func formatDnsChange(change api.DnsChange) (string, string) {
+	format := func(values, other []string, color func(a ...any) string) string {
+		if len(values) == 0 {
+			return "(none)"
+		}
+		formatted := make([]string, len(values))
+		for i, value := range values {
+			formatted[i] = value
+			if !slices.Contains(other, value) {
+				formatted[i] = color(value)
+			}
+		}
+		return strings.Join(formatted, ", ")
 	}
+	return format(change.OldValues, change.NewValues, output.Red), format(change.NewValues, change.OldValues, output.Green)
+}
 
-	// Otherwise, fetch all DNS monitors and match by prefix
-	result, err := client.ListDnsMonitors()
-	if err != nil {
-		return "", fmt.Errorf("failed to list DNS monitors: %w", err)
-	}
+// dns lookup <id>
+var dnsLookupCmd = &cobra.Command{
+	Use:   "lookup <id>",
+	Short: "Resolve a DNS monitor's record locally",
+	Long: `Resolve a DNS monitor's record from this machine and compare the live
+answers with its expected values, without waiting for the hosted check.
+Use --nameserver to query a specific server, e.g. an authoritative one to
+check a change before it propagates; it defaults to the monitor's own
+nameserver, if it has one. Answers are compared using the monitor's match
+mode. SOA and CAA records can't be resolved locally.
+
+Exits with status 6 when the live answers don't match.
+
+Examples:
+  groovekit dns lookup abc12345
+  groovekit dns lookup abc12345 --nameserver 1.1.1.1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
 
-	var matches []string
-	for _, dns := range result.DnsMonitors {
-		if len(dns.ID) >= len(shortID) && dns.ID[:len(shortID)] == shortID {
-			matches = append(matches, dns.ID)
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
 		}
-	}
 
-	if len(matches) == 0 {
-		return "", fmt.Errorf("no DNS monitor found with ID prefix '%s'", shortID)
-	}
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveDNSMonitorID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		nameserver, _ := cmd.Flags().GetString("nameserver")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		dns, err := client.GetDnsMonitor(fullID)
+		var values []string
+		if err == nil {
+			if nameserver == "" {
+				nameserver = dns.Nameserver
+			}
+			values, err = probe.LookupDNS(probe.DNSOptions{Domain: dns.Domain, RecordType: dns.RecordType, Nameserver: nameserver})
+			if err != nil {
+				err = fmt.Errorf("failed to resolve %s %s: %w", dns.RecordType, dns.Domain, err)
+			}
+		} else {
+			err = fmt.Errorf("failed to get DNS monitor: %w", err)
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		matchMode := dnsMatchMode(dns.MatchMode)
+		matches, err := probe.CompareRecordsMode(dns.ExpectedValues, values, matchMode)
+		if err != nil {
+			return fmt.Errorf("failed to compare records: %w", err)
+		}
+		matched := probe.RecordsMatch(matches)
 
-	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID prefix '%s' matches multiple DNS monitors", shortID)
+		if jsonOutput {
+			if err := cmdutil.OutputJSON(out, map[string]interface{}{
+				"domain":          dns.Domain,
+				"record_type":     dns.RecordType,
+				"nameserver":      nameserver,
+				"values":          values,
+				"expected_values": dns.ExpectedValues,
+				"match_mode":      matchMode,
+				"records":         matches,
+				"matched":         matched,
+			}); err != nil {
+				return err
+			}
+		} else {
+			via := "system resolver"
+			if nameserver != "" {
+				via = nameserver
+			}
+			fmt.Fprintf(out, "%s %s via %s (%s match)\n\n", output.Bold(dns.Domain), dns.RecordType, via, matchMode)
+
+			for _, m := range matches {
+				switch m.Status {
+				case probe.RecordMatched:
+					fmt.Fprintf(out, "  %s %s\n", output.Green("✓"), m.Value)
+				case probe.RecordMissing:
+					fmt.Fprintf(out, "  %s %s %s\n", output.Red("✗"), output.Red(m.Value), "(expected, not found)")
+				case probe.RecordExtra:
+					fmt.Fprintf(out, "  %s %s %s\n", output.Green("+"), m.Value, "(found, allowed by subset match)")
+				default:
+					fmt.Fprintf(out, "  %s %s %s\n", output.Yellow("+"), output.Yellow(m.Value), "(found, not expected)")
+				}
+			}
+			if len(matches) == 0 {
+				fmt.Fprintln(out, "  (no records)")
+			}
+
+			if !sameValues(values, dns.CurrentValues) {
+				fmt.Fprintf(out, "\nThe hosted check last saw: %s\n", strings.Join(dns.CurrentValues, ", "))
+			}
+
+			verdict := output.Green("MATCH")
+			if !matched {
+				verdict = output.Red("MISMATCH")
+			}
+			fmt.Fprintf(out, "\n%s %s\n", output.Bold("Result:"), verdict)
+		}
+
+		if !matched && failOn(cmd, failLevelDown) {
+			return resourceDown(cmd)
+		}
+		return nil
+	},
+}
+
+// sameValues reports whether two record sets match, ignoring order and case
+func sameValues(a, b []string) bool {
+	for _, m := range probe.CompareRecords(a, b) {
+		if m.Status != probe.RecordMatched {
+			return false
+		}
 	}
+	return true
+}
 
-	return matches[0], nil
+// dns move [id...]
+var dnsMoveCmd = &cobra.Command{
+	Use:   "move [id...]",
+	Short: "Move one or more DNS monitors to a project",
+	Long: `Move one or more DNS record monitors to a project. Their check history,
+incidents, and settings are kept.` + bulkLongHelp + `
+
+Examples:
+  groovekit dns move abc12345 --project def67890
+  groovekit dns move --match 'name~checkout-*' --project def67890 --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMove(cmd, args, dnsBulkTarget)
+	},
+}
+
+// dns delete [id...]
+var dnsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more DNS monitors",
+	Long: `Delete one or more DNS record monitors. Everything that will be deleted is listed
+for confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit dns delete abc12345 def67890
+  groovekit dns delete --match 'name~tmp-*' --dry-run
+  groovekit dns delete --tag env=preview --force`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, dnsBulkTarget, deleteAction(dnsBulkTarget))
+	},
+}
+
+// dnsBulkTarget lists DNS monitors for bulk actions and ID resolution
+var dnsBulkTarget = bulkTarget{
+	noun:    "DNS monitor",
+	plural:  "DNS monitors",
+	command: "dns",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		result, err := client.ListAllDnsMonitors(nil)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(result.DnsMonitors))
+		for _, dns := range result.DnsMonitors {
+			items = append(items, bulkItem{id: dns.ID, name: dns.Name, status: dns.Status, tags: dns.Tags, fields: map[string]string{"domain": dns.Domain, "type": dns.RecordType, "project": dns.ProjectID}})
+		}
+		return items, nil
+	},
+	setStatus: func(client api.APIClient, id, status string) error {
+		_, err := client.UpdateDnsMonitor(id, &api.UpdateDnsMonitorRequest{Status: &status})
+		return err
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteDnsMonitor(id)
+	},
+	move: func(client api.APIClient, id, projectID string) error {
+		return client.MoveDnsMonitor(id, projectID)
+	},
+	snooze: func(client api.APIClient, id string, until time.Time) error {
+		return client.SnoozeDnsMonitor(id, until)
+	},
 }
 
 func init() {
 	// Add flags to list command
 	dnsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(dnsListCmd)
+	addListFilterFlags(dnsListCmd)
+	addPageFlags(dnsListCmd)
 
 	// Add flags to show command
 	dnsShowCmd.Flags().Bool("json", false, "Output as JSON")
@@ -598,39 +1165,84 @@ func init() {
 	// Add flags to create command
 	dnsCreateCmd.Flags().String("name", "", "DNS monitor name (required)")
 	dnsCreateCmd.Flags().String("domain", "", "Domain to monitor (required)")
-	dnsCreateCmd.Flags().String("type", "", "DNS record type: A, AAAA, MX, CNAME, TXT, NS (required)")
+	dnsCreateCmd.Flags().String("type", "", "DNS record type: A, AAAA, MX, CNAME, TXT, NS, SOA, CAA, SRV, PTR (required)")
+	registerFlagCompletion(dnsCreateCmd, "type", completeValues(dnsRecordTypes...))
 	dnsCreateCmd.Flags().StringSlice("expected", []string{}, "Expected value(s) - can be specified multiple times or comma-separated (required)")
-	dnsCreateCmd.Flags().Int("interval", 1440, "Check interval in minutes (default: daily)")
-	dnsCreateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
-	_ = dnsCreateCmd.MarkFlagRequired("name")
-	_ = dnsCreateCmd.MarkFlagRequired("domain")
-	_ = dnsCreateCmd.MarkFlagRequired("type")
-	_ = dnsCreateCmd.MarkFlagRequired("expected")
+	dnsCreateCmd.Flags().String("match-mode", probe.MatchExact, "How expected values are compared: exact, subset (extra answers allowed), or regex")
+	dnsCreateCmd.Flags().String("nameserver", "", "Nameserver checks query, e.g. 1.1.1.1 or ns1.example.com:53 (default: the hosted check's resolver)")
+	addDurationFlag(dnsCreateCmd, "interval", 1440, time.Minute, "Check interval")
+	addDurationFlag(dnsCreateCmd, "grace-period", 0, time.Minute, "Grace period")
+	addEscalationFlags(dnsCreateCmd)
+	addTagFlag(dnsCreateCmd)
+	addProjectFlag(dnsCreateCmd)
+	addInteractiveFlag(dnsCreateCmd)
+
+	// Add flags to clone command
+	addCloneFlags(dnsCloneCmd)
+	dnsCloneCmd.Flags().String("domain", "", "Domain for the copy to monitor (default the original's)")
+	dnsCloneCmd.Flags().StringSlice("expected", []string{}, "Expected value(s) for the copy (default the original's)")
 
 	// Add flags to update command
 	dnsUpdateCmd.Flags().String("name", "", "DNS monitor name")
 	dnsUpdateCmd.Flags().String("domain", "", "Domain to monitor")
-	dnsUpdateCmd.Flags().String("type", "", "DNS record type: A, AAAA, MX, CNAME, TXT, NS")
+	dnsUpdateCmd.Flags().String("type", "", "DNS record type: A, AAAA, MX, CNAME, TXT, NS, SOA, CAA, SRV, PTR")
+	registerFlagCompletion(dnsUpdateCmd, "type", completeValues(dnsRecordTypes...))
 	dnsUpdateCmd.Flags().StringSlice("expected", []string{}, "Expected value(s) - can be specified multiple times or comma-separated")
-	dnsUpdateCmd.Flags().Int("interval", 0, "Check interval in minutes")
-	dnsUpdateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	dnsUpdateCmd.Flags().String("match-mode", "", "How expected values are compared: exact, subset (extra answers allowed), or regex")
+	dnsUpdateCmd.Flags().String("nameserver", "", "Nameserver checks query (empty to use the hosted check's resolver)")
+	addDurationFlag(dnsUpdateCmd, "interval", 0, time.Minute, "Check interval")
+	addDurationFlag(dnsUpdateCmd, "grace-period", 0, time.Minute, "Grace period")
 	dnsUpdateCmd.Flags().String("status", "", "Monitor status (active, inactive, paused)")
+	registerFlagCompletion(dnsUpdateCmd, "status", completeValues(monitorStatuses...))
+	addEscalationFlags(dnsUpdateCmd)
+	addTagFlag(dnsUpdateCmd)
+
+	// Add flags to notify command
+	addNotifyFlags(dnsNotifyCmd)
+
+	// Add flags to pause and resume commands
+	addBulkFlags(dnsPauseCmd)
+	addBulkFlags(dnsResumeCmd)
 
 	// Add flags to incidents command
 	dnsIncidentsCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(dnsIncidentsCmd)
+
+	// Add flags to check command
+	dnsCheckCmd.Flags().BoolP("verbose", "v", false, "Print the health result")
+	addFormatFlag(dnsCheckCmd)
 
 	// Add flags to delete command
 	dnsDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(dnsDeleteCmd)
+	addProjectFlag(dnsMoveCmd)
+	addBulkFlags(dnsMoveCmd)
+
+	// Add flags to history command
+	dnsHistoryCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(dnsHistoryCmd)
+	dnsHistoryCmd.Flags().String("since", "", "Only show changes detected after this time (e.g. 24h, 30d, 2026-01-02)")
+	dnsHistoryCmd.Flags().Int("limit", 0, "Maximum number of changes to show")
+
+	// Add flags to lookup command
+	dnsLookupCmd.Flags().String("nameserver", "", "Nameserver to query, e.g. 1.1.1.1 or ns1.example.com:53 (default: the monitor's, or the system resolver)")
+	dnsLookupCmd.Flags().Bool("json", false, "Output as JSON")
 
 	// Add subcommands
 	dnsCmd.AddCommand(dnsListCmd)
 	dnsCmd.AddCommand(dnsShowCmd)
 	dnsCmd.AddCommand(dnsCreateCmd)
+	dnsCmd.AddCommand(dnsCloneCmd)
 	dnsCmd.AddCommand(dnsUpdateCmd)
 	dnsCmd.AddCommand(dnsPauseCmd)
 	dnsCmd.AddCommand(dnsResumeCmd)
 	dnsCmd.AddCommand(dnsIncidentsCmd)
+	dnsCmd.AddCommand(dnsNotifyCmd)
+	dnsCmd.AddCommand(dnsCheckCmd)
+	dnsCmd.AddCommand(dnsLookupCmd)
+	dnsCmd.AddCommand(dnsHistoryCmd)
 	dnsCmd.AddCommand(dnsDeleteCmd)
+	dnsCmd.AddCommand(dnsMoveCmd)
 
 	// Add dns command to root
 	rootCmd.AddCommand(dnsCmd)
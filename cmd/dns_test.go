@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"encoding/json"
 	"slices"
 	"testing"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,109 +19,78 @@ func TestDnsCommand(t *testing.T) {
 	assert.NotEmpty(t, dnsCmd.Long)
 }
 
-// TestDnsListCommand tests the dns list command
+// seedDnsMonitors adds a DNS monitor whose record has drifted to the fake
+// API, returning its ID
+func seedDnsMonitors(srv *apitest.Server) string {
+	id := srv.Fake.NewID()
+	srv.Fake.DnsMonitors = []api.DnsMonitor{
+		{ID: id, Name: "WWW", Domain: "www.example.com", RecordType: "A", Status: "active", Interval: 60, ExpectedValues: []string{"192.0.2.1"}, CurrentValues: []string{"192.0.2.9"}, HasMismatch: true},
+	}
+	return id
+}
+
+// TestDnsListCommand tests listing DNS monitors as a table and as JSON
 func TestDnsListCommand(t *testing.T) {
-	assert.Equal(t, "list", dnsListCmd.Use)
-	assert.Equal(t, "List all DNS monitors", dnsListCmd.Short)
-	assert.NotEmpty(t, dnsListCmd.Long)
-	require.NotNil(t, dnsListCmd.RunE, "dns list command should have a RunE function")
+	srv := startAPI(t)
+	seedDnsMonitors(srv)
 
-	// Verify --json flag exists
-	jsonFlag := dnsListCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "dns list command should have --json flag")
-	assert.Equal(t, "bool", jsonFlag.Value.Type())
+	out := mustRun(t, "dns", "list")
+	assert.Contains(t, out, "www.example.com")
+	assert.Contains(t, out, "Yes")
+	assert.Contains(t, out, "Total: 1 DNS monitor(s)")
+
+	var result api.DnsMonitorsResponse
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "dns", "list", "--json")), &result))
+	assert.Len(t, result.DnsMonitors, 1)
 }
 
-// TestDnsShowCommand tests the dns show command
+// TestDnsShowCommand tests showing a DNS monitor's expected and current
+// values
 func TestDnsShowCommand(t *testing.T) {
-	assert.Equal(t, "show <id>", dnsShowCmd.Use)
-	assert.Equal(t, "Show DNS monitor details", dnsShowCmd.Short)
-	assert.NotEmpty(t, dnsShowCmd.Long)
-	require.NotNil(t, dnsShowCmd.RunE, "dns show command should have a RunE function")
-
-	// Verify --json flag exists
-	jsonFlag := dnsShowCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "dns show command should have --json flag")
+	srv := startAPI(t)
+	id := seedDnsMonitors(srv)
+
+	out := mustRun(t, "dns", "show", id[:8])
+	assert.Contains(t, out, "ID:                       "+id)
+	assert.Contains(t, out, "Record Type:              A")
+	assert.Contains(t, out, "192.0.2.1")
+	assert.Contains(t, out, "192.0.2.9")
 }
 
-// TestDnsCreateCommand tests the dns create command
+// TestDnsCreateCommand tests creating a DNS monitor with comma-separated
+// expected values
 func TestDnsCreateCommand(t *testing.T) {
-	assert.Equal(t, "create", dnsCreateCmd.Use)
-	assert.Equal(t, "Create a new DNS monitor", dnsCreateCmd.Short)
-	assert.NotEmpty(t, dnsCreateCmd.Long)
-	require.NotNil(t, dnsCreateCmd.RunE, "dns create command should have a RunE function")
-
-	// Verify required flags
-	nameFlag := dnsCreateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "dns create command should have --name flag")
-	assert.Equal(t, "string", nameFlag.Value.Type())
-
-	domainFlag := dnsCreateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "dns create command should have --domain flag")
-	assert.Equal(t, "string", domainFlag.Value.Type())
-
-	typeFlag := dnsCreateCmd.Flags().Lookup("type")
-	require.NotNil(t, typeFlag, "dns create command should have --type flag")
-	assert.Equal(t, "string", typeFlag.Value.Type())
+	srv := startAPI(t)
 
-	expectedFlag := dnsCreateCmd.Flags().Lookup("expected")
-	require.NotNil(t, expectedFlag, "dns create command should have --expected flag")
-	assert.Equal(t, "stringSlice", expectedFlag.Value.Type())
+	out := mustRun(t, "dns", "create", "--name", "API", "--domain", "api.example.com", "--type", "A", "--expected", "192.0.2.1,192.0.2.2")
+	assert.Contains(t, out, "DNS monitor created successfully")
+	assert.Contains(t, out, "Expected: 192.0.2.1, 192.0.2.2 (exact)")
 
-	// Verify optional flags
-	intervalFlag := dnsCreateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "dns create command should have --interval flag")
-	assert.Equal(t, "int", intervalFlag.Value.Type())
-
-	gracePeriodFlag := dnsCreateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "dns create command should have --grace-period flag")
-	assert.Equal(t, "int", gracePeriodFlag.Value.Type())
+	require.Len(t, srv.Fake.DnsMonitors, 1)
+	assert.Equal(t, []string{"192.0.2.1", "192.0.2.2"}, srv.Fake.DnsMonitors[0].ExpectedValues)
 }
 
-// TestDnsUpdateCommand tests the dns update command
+// TestDnsUpdateCommand tests replacing a DNS monitor's expected values
 func TestDnsUpdateCommand(t *testing.T) {
-	assert.Equal(t, "update <id>", dnsUpdateCmd.Use)
-	assert.Equal(t, "Update a DNS monitor", dnsUpdateCmd.Short)
-	assert.NotEmpty(t, dnsUpdateCmd.Long)
-	require.NotNil(t, dnsUpdateCmd.RunE, "dns update command should have a RunE function")
-
-	// Verify flags exist
-	nameFlag := dnsUpdateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "dns update command should have --name flag")
-
-	domainFlag := dnsUpdateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "dns update command should have --domain flag")
-
-	typeFlag := dnsUpdateCmd.Flags().Lookup("type")
-	require.NotNil(t, typeFlag, "dns update command should have --type flag")
-
-	expectedFlag := dnsUpdateCmd.Flags().Lookup("expected")
-	require.NotNil(t, expectedFlag, "dns update command should have --expected flag")
+	srv := startAPI(t)
+	id := seedDnsMonitors(srv)
 
-	intervalFlag := dnsUpdateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "dns update command should have --interval flag")
-
-	gracePeriodFlag := dnsUpdateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "dns update command should have --grace-period flag")
-
-	statusFlag := dnsUpdateCmd.Flags().Lookup("status")
-	require.NotNil(t, statusFlag, "dns update command should have --status flag")
+	out := mustRun(t, "dns", "update", id[:8], "--expected", "192.0.2.9")
+	assert.Contains(t, out, "DNS monitor updated successfully")
+	assert.Equal(t, []string{"192.0.2.9"}, srv.Fake.DnsMonitors[0].ExpectedValues)
+	assert.Equal(t, "A", srv.Fake.DnsMonitors[0].RecordType)
 }
 
-// TestDnsPauseCommand tests the dns pause command
+// TestDnsPauseCommand tests pausing and resuming a DNS monitor
 func TestDnsPauseCommand(t *testing.T) {
-	assert.Equal(t, "pause <id>", dnsPauseCmd.Use)
-	assert.Equal(t, "Pause a DNS monitor", dnsPauseCmd.Short)
-	assert.NotEmpty(t, dnsPauseCmd.Long)
-	require.NotNil(t, dnsPauseCmd.RunE, "dns pause command should have a RunE function")
-}
+	srv := startAPI(t)
+	id := seedDnsMonitors(srv)
+
+	mustRun(t, "dns", "pause", id[:8])
+	assert.Equal(t, "paused", srv.Fake.DnsMonitors[0].Status)
 
-// TestDnsResumeCommand tests the dns resume command
-func TestDnsResumeCommand(t *testing.T) {
-	assert.Equal(t, "resume <id>", dnsResumeCmd.Use)
-	assert.Equal(t, "Resume a DNS monitor", dnsResumeCmd.Short)
-	assert.NotEmpty(t, dnsResumeCmd.Long)
-	require.NotNil(t, dnsResumeCmd.RunE, "dns resume command should have a RunE function")
+	mustRun(t, "dns", "resume", id[:8])
+	assert.Equal(t, "active", srv.Fake.DnsMonitors[0].Status)
 }
 
 // TestDnsIncidentsCommand tests the dns incidents command
@@ -132,17 +105,13 @@ func TestDnsIncidentsCommand(t *testing.T) {
 	require.NotNil(t, jsonFlag, "dns incidents command should have --json flag")
 }
 
-// TestDnsDeleteCommand tests the dns delete command
+// TestDnsDeleteCommand tests deleting a DNS monitor without a prompt
 func TestDnsDeleteCommand(t *testing.T) {
-	assert.Equal(t, "delete <id>", dnsDeleteCmd.Use)
-	assert.Equal(t, "Delete a DNS monitor", dnsDeleteCmd.Short)
-	assert.NotEmpty(t, dnsDeleteCmd.Long)
-	require.NotNil(t, dnsDeleteCmd.RunE, "dns delete command should have a RunE function")
+	srv := startAPI(t)
+	id := seedDnsMonitors(srv)
 
-	// Verify --force flag exists
-	forceFlag := dnsDeleteCmd.Flags().Lookup("force")
-	require.NotNil(t, forceFlag, "dns delete command should have --force flag")
-	assert.Equal(t, "bool", forceFlag.Value.Type())
+	mustRun(t, "dns", "delete", id[:8], "--force")
+	assert.Empty(t, srv.Fake.DnsMonitors)
 }
 
 // TestDnsCommandHasSubcommands verifies all subcommands are registered
@@ -150,7 +119,7 @@ func TestDnsCommandHasSubcommands(t *testing.T) {
 	commands := dnsCmd.Commands()
 
 	// Should have 8 subcommands
-	expectedSubcommands := []string{"list", "show", "create", "update", "pause", "resume", "incidents", "delete"}
+	expectedSubcommands := []string{"list", "show", "create", "clone", "update", "pause", "resume", "incidents", "notify", "lookup", "history", "delete", "move"}
 	assert.GreaterOrEqual(t, len(commands), len(expectedSubcommands))
 
 	// Verify all expected subcommands exist
@@ -178,7 +147,7 @@ func TestResolveDnsMonitorID(t *testing.T) {
 	// In a real scenario, you'd mock the API client
 	// For now, we just verify the function exists by checking if it's referenced
 	// A full integration test would require a mock API server
-	assert.NotNil(t, dnsShowCmd.RunE, "resolveDnsMonitorID is used by show command")
+	assert.NotNil(t, dnsShowCmd.RunE, "cmdutil.ResolveDNSMonitorID is used by show command")
 }
 
 // TestContainsHelper tests the contains helper function
@@ -216,3 +185,65 @@ func TestContainsHelper(t *testing.T) {
 		})
 	}
 }
+
+// TestDnsLookupCommand tests the dns lookup command
+func TestDnsLookupCommand(t *testing.T) {
+	assert.Equal(t, "lookup <id>", dnsLookupCmd.Use)
+	assert.NotEmpty(t, dnsLookupCmd.Long)
+	require.NotNil(t, dnsLookupCmd.RunE, "dns lookup command should have a RunE function")
+	assert.NotNil(t, dnsLookupCmd.Flags().Lookup("nameserver"))
+	assert.NotNil(t, dnsLookupCmd.Flags().Lookup("json"))
+}
+
+// TestSameValues tests comparing record sets regardless of order and case
+func TestSameValues(t *testing.T) {
+	assert.True(t, sameValues([]string{"a.example.com", "b.example.com"}, []string{"B.example.com.", "a.example.com"}))
+	assert.False(t, sameValues([]string{"a.example.com"}, []string{"a.example.com", "b.example.com"}))
+	assert.True(t, sameValues(nil, nil))
+}
+
+// TestDnsMatchFlags tests that create and update accept --match-mode and
+// --nameserver
+func TestDnsMatchFlags(t *testing.T) {
+	for _, c := range []*cobra.Command{dnsCreateCmd, dnsUpdateCmd} {
+		for _, name := range []string{"match-mode", "nameserver"} {
+			assert.NotNil(t, c.Flags().Lookup(name), "%s should have --%s", c.CommandPath(), name)
+		}
+	}
+	assert.Contains(t, dnsRecordTypes, "SRV")
+	assert.Contains(t, dnsRecordTypes, "CAA")
+}
+
+// TestValidateMatchMode tests checking --match-mode and regex expected values
+func TestValidateMatchMode(t *testing.T) {
+	assert.NoError(t, validateMatchMode("exact", []string{"("}))
+	assert.NoError(t, validateMatchMode("subset", nil))
+	assert.NoError(t, validateMatchMode("regex", []string{`v=spf1 .*`}))
+	assert.ErrorContains(t, validateMatchMode("regex", []string{"("}), `invalid pattern "("`)
+	assert.ErrorContains(t, validateMatchMode("fuzzy", nil), "Must be one of: exact, subset, regex")
+}
+
+// TestValidateNameserver tests checking --nameserver values
+func TestValidateNameserver(t *testing.T) {
+	for _, ns := range []string{"1.1.1.1", "1.1.1.1:5353", "ns1.example.com", "2606:4700::1111", "[2606:4700::1111]:53"} {
+		assert.NoError(t, validateNameserver(ns), ns)
+	}
+	for _, ns := range []string{"1.1.1.1:0", "ns1.example.com:dns", "https://1.1.1.1", "a:b:c"} {
+		assert.Error(t, validateNameserver(ns), ns)
+	}
+}
+
+// TestFormatDnsChange tests rendering a change's old and new values
+func TestFormatDnsChange(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	old, changed := formatDnsChange(api.DnsChange{
+		OldValues: []string{"mail.example.com", "mail2.example.com"},
+		NewValues: []string{"mail.example.com", "mx.example.net"},
+	})
+	assert.Equal(t, "mail.example.com, mail2.example.com", old)
+	assert.Equal(t, "mail.example.com, mx.example.net", changed)
+
+	old, _ = formatDnsChange(api.DnsChange{NewValues: []string{"192.0.2.10"}})
+	assert.Equal(t, "(none)", old)
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// dockerEvents are the container events that can change its health; others,
+// such as the exec events every health check causes, are left out
+var dockerEvents = []string{"health_status", "start", "restart", "die", "pause", "unpause"}
+
+// dockerPing returns the ping a container state reports: success for a
+// healthy container, or a running one without a health check, and fail for
+// an unhealthy or stopped one. Containers that are starting, restarting, or
+// paused report nothing until they settle.
+func dockerPing(state string) string {
+	switch state {
+	case "healthy", "running":
+		return api.PingSuccess
+	case "unhealthy", "exited", "dead":
+		return api.PingFail
+	}
+	return ""
+}
+
+// formatDockerState colors a container state by the ping it reports
+func formatDockerState(state string) string {
+	switch dockerPing(state) {
+	case api.PingSuccess:
+		return output.Green(state)
+	case api.PingFail:
+		return output.Red(state)
+	}
+	return output.Yellow(state)
+}
+
+// dockerEventState returns the state a container event reports, or "" when
+// the container has to be inspected to find out
+func dockerEventState(action string) string {
+	if status, ok := strings.CutPrefix(action, "health_status: "); ok {
+		return status
+	}
+	if action == "die" {
+		return "exited"
+	}
+	return ""
+}
+
+// dockerError prefers what docker printed on stderr to its exit status
+func dockerError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
+
+// dockerState reads a container's health status, or its run state when it
+// has no health check
+func dockerState(ctx context.Context, container string) (string, error) {
+	data, err := exec.CommandContext(ctx, "docker", "inspect", "--type", "container", "--format",
+		"{{if .State.Health}}{{.State.Health.Status}}{{else}}{{.State.Status}}{{end}}", container).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect container %s: %w", container, dockerError(err))
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// watchDockerEvents streams the container's events from docker events,
+// sending each event's action until the stream ends. The returned function
+// waits for docker to exit.
+func watchDockerEvents(ctx context.Context, container string) (<-chan string, func() error, error) {
+	args := []string{"events", "--format", "{{json .}}", "--filter", "type=container", "--filter", "container=" + container}
+	for _, event := range dockerEvents {
+		args = append(args, "--filter", "event="+event)
+	}
+	child := exec.CommandContext(ctx, "docker", args...)
+	var stderr strings.Builder
+	child.Stderr = &stderr
+	stdout, err := child.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := child.Start(); err != nil {
+		return nil, nil, fmt.Errorf("failed to run docker events: %w", err)
+	}
+
+	actions := make(chan string)
+	go func() {
+		defer close(actions)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			var event struct {
+				Action string `json:"Action"`
+				Status string `json:"status"`
+			}
+			if json.Unmarshal(scanner.Bytes(), &event) != nil {
+				continue
+			}
+			if event.Action == "" {
+				event.Action = event.Status
+			}
+			actions <- event.Action
+		}
+	}()
+
+	wait := func() error {
+		err := child.Wait()
+		if msg := strings.TrimSpace(stderr.String()); err != nil && msg != "" {
+			err = errors.New(msg)
+		}
+		return err
+	}
+	return actions, wait, nil
+}
+
+// jobs docker-watch
+var jobsDockerWatchCmd = &cobra.Command{
+	Use:   "docker-watch",
+	Short: "Report a Docker container's health as job pings",
+	Long: `Watch a local Docker container and send pings for a job as its health
+changes, so a container's health check becomes a GrooveKit heartbeat. A
+healthy container sends success pings, and an unhealthy or stopped one sends
+fail pings. Containers without a health check count as healthy while they're
+running. Nothing is sent while a container is starting or paused.
+
+A ping is sent as soon as the state changes, and again every --interval, so
+set --interval shorter than the job's interval. The job can be given by ID
+or ping token, as with jobs ping. Runs until interrupted, or until docker
+events stops, e.g. when the Docker daemon restarts.
+
+Examples:
+  groovekit jobs docker-watch --container web --job abc12345
+  groovekit jobs docker-watch --container db --job <ping-token> --interval 30s`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+		errOut := cmd.ErrOrStderr()
+
+		container, _ := cmd.Flags().GetString("container")
+		job, _ := cmd.Flags().GetString("job")
+		interval := time.Duration(getDurationFlag(cmd, "interval")) * time.Second
+
+		if container == "" {
+			return usageErrorf("--container is required")
+		}
+		if job == "" {
+			return usageErrorf("--job is required")
+		}
+		if interval < minWatchInterval {
+			return usageErrorf("--interval must be at least %s", minWatchInterval)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		client := api.NewClient(cfg)
+		token, name := pingTarget(client, cfg, job)
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		state, err := dockerState(ctx, container)
+		if err != nil {
+			return err
+		}
+		actions, wait, err := watchDockerEvents(ctx, container)
+		if err != nil {
+			return err
+		}
+
+		report := func() {
+			pingType := dockerPing(state)
+			if pingType == "" {
+				return
+			}
+			if err := client.SendPing(token, pingType, 0); err != nil {
+				fmt.Fprintf(errOut, "%s %s\n", watchTimestamp(), output.Yellow(fmt.Sprintf("failed to send %s ping: %v", pingType, err)))
+			}
+		}
+
+		fmt.Fprintf(out, "%s Container %s is %s. Sending pings for %s every %s. Press Ctrl+C to stop.\n", watchTimestamp(), output.Bold(container), formatDockerState(state), name, interval)
+		report()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				report()
+			case action, ok := <-actions:
+				if !ok {
+					err := wait()
+					if ctx.Err() != nil || err == nil {
+						return nil
+					}
+					return fmt.Errorf("docker events stopped: %w", err)
+				}
+				next := dockerEventState(action)
+				if next == "" {
+					if next, err = dockerState(ctx, container); err != nil {
+						fmt.Fprintf(errOut, "%s %s\n", watchTimestamp(), output.Yellow(err.Error()))
+						continue
+					}
+				}
+				if next == state {
+					continue
+				}
+				state = next
+				fmt.Fprintf(out, "%s Container %s is %s\n", watchTimestamp(), output.Bold(container), formatDockerState(state))
+				report()
+				ticker.Reset(interval)
+			}
+		}
+	},
+}
+
+func init() {
+	// Add flags to docker-watch command
+	jobsDockerWatchCmd.Flags().String("container", "", "Name or ID of the container to watch")
+	jobsDockerWatchCmd.Flags().String("job", "", "ID or ping token of the job to ping")
+	addDurationFlag(jobsDockerWatchCmd, "interval", 60, time.Second, "How often to repeat the current state's ping")
+
+	// Add subcommands
+	jobsCmd.AddCommand(jobsDockerWatchCmd)
+}
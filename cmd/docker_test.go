@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDocker puts a docker script on PATH that reports the web container
+// as healthy, then prints a few events and exits
+func fakeDocker(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as docker")
+	}
+	dir := t.TempDir()
+	script := `#!/bin/sh
+case "$1" in
+inspect)
+	eval "container=\${$#}"
+	if [ "$container" != web ]; then echo "Error: No such container: $container" >&2; exit 1; fi
+	echo starting ;;
+events)
+	echo '{"status":"health_status: healthy","Action":"health_status: healthy"}'
+	echo 'not json'
+	echo '{"Action":"health_status: healthy"}'
+	echo '{"Action":"health_status: unhealthy"}'
+	echo '{"Action":"die"}'
+	echo '{"Action":"start"}' ;;
+esac
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "docker"), []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// TestJobsDockerWatchCommand tests sending pings as a container's health
+// changes
+func TestJobsDockerWatchCommand(t *testing.T) {
+	srv := startAPI(t)
+	_, sync := seedJobs(srv)
+	fakeDocker(t)
+
+	out := mustRun(t, "jobs", "docker-watch", "--container", "web", "--job", sync[:8])
+	assert.Contains(t, out, "Container web is starting. Sending pings for job Sync every 1m0s")
+	assert.Contains(t, out, "Container web is healthy")
+	assert.Contains(t, out, "Container web is unhealthy")
+	assert.Contains(t, out, "Container web is exited")
+
+	// Nothing is sent while starting, and repeated states send nothing new;
+	// the start event's inspection reports starting again. Success pings go
+	// to the plain ping URL, so they arrive without a type.
+	var pings []string
+	for _, ping := range srv.Fake.SentPings {
+		pings = append(pings, ping.PingType)
+	}
+	assert.Equal(t, []string{"", api.PingFail, api.PingFail}, pings)
+
+	_, _, err := runCommand(t, "jobs", "docker-watch", "--container", "db", "--job", sync[:8])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to inspect container db: Error: No such container: db")
+
+	_, _, err = runCommand(t, "jobs", "docker-watch", "--container", "web")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, _, err = runCommand(t, "jobs", "docker-watch", "--container", "web", "--job", sync[:8], "--interval", "soon")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid duration "soon"`)
+
+	_, _, err = runCommand(t, "jobs", "docker-watch", "--container", "web", "--job", sync[:8], "--interval", "5")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--interval must be at least 10s")
+}
+
+// TestDockerPing tests which container states send which pings
+func TestDockerPing(t *testing.T) {
+	assert.Equal(t, api.PingSuccess, dockerPing("healthy"))
+	assert.Equal(t, api.PingSuccess, dockerPing("running"))
+	assert.Equal(t, api.PingFail, dockerPing("unhealthy"))
+	assert.Equal(t, api.PingFail, dockerPing("exited"))
+	assert.Empty(t, dockerPing("starting"))
+	assert.Empty(t, dockerPing("paused"))
+
+	assert.Equal(t, "unhealthy", dockerEventState("health_status: unhealthy"))
+	assert.Equal(t, "exited", dockerEventState("die"))
+	assert.Empty(t, dockerEventState("start"))
+}
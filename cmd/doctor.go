@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/update"
+	"github.com/spf13/cobra"
+)
+
+// Outcomes of a doctor check
+const (
+	doctorPass = "pass"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// Doctor thresholds
+const (
+	doctorTimeout     = 10 * time.Second
+	slowLatency       = time.Second
+	clockSkewWarning  = 30 * time.Second
+	clockSkewCritical = 5 * time.Minute
+)
+
+// doctorCheck is the outcome of one diagnostic
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose problems with the CLI's setup",
+	Long: `Check the CLI's setup and print a pass, warn, or fail line for each part:
+config file permissions, whether the access token is accepted, API
+reachability and latency, clock skew against the API, proxy settings, and
+whether a newer version is available.
+
+Include the output when asking for support. Exits with status 1 when any
+check fails.
+
+Examples:
+  groovekit doctor
+  groovekit doctor --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		client := api.NewClient(cfg)
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		ctx, cancel := context.WithTimeout(cmd.Context(), doctorTimeout)
+		defer cancel()
+		conn, connErr := client.CheckConnection(ctx)
+		latest := func(ctx context.Context) (string, error) {
+			return update.Latest(ctx, http.DefaultClient, update.LatestReleaseURL)
+		}
+
+		checks := []doctorCheck{
+			checkConfigFile(config.Path()),
+			checkToken(cfg, conn),
+			checkReachability(cfg.APIBaseURL, conn, connErr),
+			checkClockSkew(time.Now(), conn),
+			checkProxy(cfg.APIBaseURL, os.Getenv),
+			checkVersion(ctx, Version, latest),
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		failed := false
+		for _, check := range checks {
+			failed = failed || check.Status == doctorFail
+		}
+
+		if jsonOutput {
+			err = cmdutil.OutputJSON(out, checks)
+		} else {
+			printDoctorChecks(out, checks)
+		}
+		if err != nil || !failed {
+			return err
+		}
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	},
+}
+
+// checkConfigFile checks that the config file, which can hold the access
+// token, is only readable by its owner
+func checkConfigFile(path string) doctorCheck {
+	check := doctorCheck{Name: "Config file"}
+	info, err := os.Stat(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("%s doesn't exist; run 'groovekit auth login' to create it", path)
+	case err != nil:
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("can't read %s: %v", path, err)
+	case runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0:
+		check.Status = doctorFail
+		check.Detail = fmt.Sprintf("%s is readable by other users (mode %04o); run 'chmod 600 %s'", path, info.Mode().Perm(), path)
+	default:
+		check.Status, check.Detail = doctorPass, fmt.Sprintf("%s (mode %04o)", path, info.Mode().Perm())
+	}
+	return check
+}
+
+// checkToken checks that there is an access token and the API accepts it
+func checkToken(cfg *config.Config, conn *api.Connection) doctorCheck {
+	check := doctorCheck{Name: "Access token"}
+	stored := "in the config file"
+	if cfg.TokenInKeyring() {
+		stored = "in the OS keyring"
+	}
+	switch {
+	case !cfg.IsAuthenticated():
+		check.Status, check.Detail = doctorFail, "not logged in; run 'groovekit auth login'"
+	case conn == nil:
+		check.Status, check.Detail = doctorWarn, "can't be verified while the API is unreachable"
+	case conn.StatusCode == http.StatusUnauthorized || conn.StatusCode == http.StatusForbidden:
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("rejected by the API (%d); run 'groovekit auth login'", conn.StatusCode)
+	case conn.StatusCode >= 200 && conn.StatusCode < 300:
+		check.Status, check.Detail = doctorPass, "valid, stored "+stored
+	default:
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("can't be verified: the API responded %d %s", conn.StatusCode, http.StatusText(conn.StatusCode))
+	}
+	return check
+}
+
+// checkReachability reports whether the API answered and how quickly
+func checkReachability(baseURL string, conn *api.Connection, err error) doctorCheck {
+	check := doctorCheck{Name: "API"}
+	switch {
+	case err != nil:
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("%s is unreachable: %v", baseURL, err)
+	case conn.StatusCode >= 500:
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("%s responded %d %s", baseURL, conn.StatusCode, http.StatusText(conn.StatusCode))
+	case conn.Latency >= slowLatency:
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("%s is slow to respond (%dms)", baseURL, conn.Latency.Milliseconds())
+	default:
+		check.Status, check.Detail = doctorPass, fmt.Sprintf("%s responded in %dms", baseURL, conn.Latency.Milliseconds())
+	}
+	return check
+}
+
+// checkClockSkew compares the local clock with the API's, since a skewed
+// clock breaks --since filters and signed webhook verification
+func checkClockSkew(now time.Time, conn *api.Connection) doctorCheck {
+	check := doctorCheck{Name: "Clock"}
+	if conn == nil || conn.ServerTime.IsZero() {
+		check.Status, check.Detail = doctorWarn, "can't compare with the API's clock"
+		return check
+	}
+	skew := now.Sub(conn.ServerTime).Round(time.Second)
+	direction := "ahead of"
+	if skew < 0 {
+		skew, direction = -skew, "behind"
+	}
+	switch {
+	case skew >= clockSkewCritical:
+		check.Status = doctorFail
+	case skew >= clockSkewWarning:
+		check.Status = doctorWarn
+	default:
+		check.Status, check.Detail = doctorPass, "in sync with the API"
+		return check
+	}
+	check.Detail = fmt.Sprintf("%s %s the API's; sync it with NTP", skew, direction)
+	return check
+}
+
+// checkProxy reports which proxy, if any, requests to the API go through,
+// following the standard HTTP(S)_PROXY and NO_PROXY variables
+func checkProxy(baseURL string, getenv func(string) string) doctorCheck {
+	check := doctorCheck{Name: "Proxy", Status: doctorPass}
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("API URL %q is invalid: %v", baseURL, err)
+		return check
+	}
+
+	names := []string{"HTTPS_PROXY", "https_proxy"}
+	if target.Scheme == "http" {
+		names = []string{"HTTP_PROXY", "http_proxy"}
+	}
+	var name, value string
+	for _, n := range names {
+		if v := getenv(n); v != "" {
+			name, value = n, v
+			break
+		}
+	}
+	if value == "" {
+		check.Detail = "none; connecting directly"
+		return check
+	}
+
+	noProxy := getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = getenv("no_proxy")
+	}
+	if bypassesProxy(target.Hostname(), noProxy) {
+		check.Detail = fmt.Sprintf("%s is set, but NO_PROXY excludes %s; connecting directly", name, target.Hostname())
+		return check
+	}
+
+	proxy, err := url.Parse(value)
+	if err != nil || proxy.Host == "" {
+		// Values without a scheme are treated as http:// proxies
+		proxy, err = url.Parse("http://" + value)
+	}
+	if err != nil || proxy.Host == "" {
+		check.Status, check.Detail = doctorFail, fmt.Sprintf("%s is not a valid proxy URL", name)
+		return check
+	}
+	// Show only the host, never credentials embedded in the URL
+	check.Detail = fmt.Sprintf("via %s (from %s)", proxy.Host, name)
+	return check
+}
+
+// bypassesProxy reports whether a NO_PROXY list covers host
+func bypassesProxy(host, noProxy string) bool {
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		entry = strings.TrimPrefix(entry, "*")
+		host = strings.ToLower(host)
+		if host == strings.TrimPrefix(entry, ".") || strings.HasSuffix(host, "."+strings.TrimPrefix(entry, ".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkVersion compares the installed version with the latest release
+func checkVersion(ctx context.Context, current string, latest func(context.Context) (string, error)) doctorCheck {
+	check := doctorCheck{Name: "Version"}
+	if current == "dev" {
+		check.Status, check.Detail = doctorWarn, "development build; can't compare with releases"
+		return check
+	}
+	newest, err := latest(ctx)
+	switch {
+	case err != nil:
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("%s installed; couldn't look up the latest release: %v", current, err)
+	case compareVersions(newest, current) > 0:
+		check.Status, check.Detail = doctorWarn, fmt.Sprintf("%s installed, %s is available", current, newest)
+	default:
+		check.Status, check.Detail = doctorPass, fmt.Sprintf("%s is the latest release", current)
+	}
+	return check
+}
+
+// printDoctorChecks prints a line per check and a summary
+func printDoctorChecks(out io.Writer, checks []doctorCheck) {
+	warnings, failures := 0, 0
+	for _, check := range checks {
+		symbol := output.Green("✓")
+		switch check.Status {
+		case doctorWarn:
+			symbol = output.Yellow("!")
+			warnings++
+		case doctorFail:
+			symbol = output.Red("✗")
+			failures++
+		}
+		fmt.Fprintf(out, "%s %-14s %s\n", symbol, check.Name, check.Detail)
+	}
+
+	fmt.Fprintln(out)
+	switch {
+	case failures > 0:
+		output.ErrorMessage(out, fmt.Sprintf("%s, %s", countNoun(failures, "failure", "failures"), countNoun(warnings, "warning", "warnings")))
+	case warnings > 0:
+		fmt.Fprintln(out, output.Yellow(fmt.Sprintf("No failures, %s", countNoun(warnings, "warning", "warnings"))))
+	default:
+		output.SuccessMessage(out, "All checks passed")
+	}
+}
+
+func init() {
+	doctorCmd.Flags().Bool("json", false, "Output as JSON")
+
+	rootCmd.AddCommand(doctorCmd)
+}
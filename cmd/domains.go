@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/diff"
 	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/probe"
 	"github.com/spf13/cobra"
 )
 
@@ -22,21 +26,31 @@ var domainsListCmd = &cobra.Command{
 	Short: "List all domains",
 	Long:  "List all domain expiration monitors for your account",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		filter := getListFilter(cmd)
+		if err := filter.resolveProject(client); err != nil {
+			return err
+		}
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
-		result, err := client.ListDomains()
+		list := client.ListDomains
+		if filter.all {
+			list = client.ListAllDomains
+		}
+		result, err := list(filter.options())
 
 		if s != nil {
 			s.Stop()
@@ -45,33 +59,44 @@ var domainsListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to list domains: %w", err)
 		}
+
+		// Filter client-side too, in case the API ignored any of the options
+		if filter.active() {
+			result.DomainMonitors = filterItems(result.DomainMonitors, func(domain api.DomainMonitor) bool {
+				return filter.match(domain.Status, domain.Name, domainExpiry(domain).down(), domain.Tags, domain.ProjectID)
+			})
+			result.TotalCount = len(result.DomainMonitors)
+		}
+
+		result.DomainMonitors = limitItems(result.DomainMonitors, filter.limit)
+
 		if jsonOutput {
-			return outputJSON(result)
+			return cmdutil.OutputJSON(out, result)
 		}
 
 		if len(result.DomainMonitors) == 0 {
-			output.InfoMessage("No domain monitors found")
-			fmt.Println("\nCreate your first domain monitor:")
-			fmt.Println("  groovekit domains create --name 'example.com' --domain example.com")
+			if filter.active() {
+				output.InfoMessage(out, filter.emptyMessage("domain monitors"))
+				return nil
+			}
+			output.InfoMessage(out, "No domain monitors found")
+			fmt.Fprintln(out, "\nCreate your first domain monitor:")
+			fmt.Fprintln(out, "  groovekit domains create --name 'example.com' --domain example.com")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"ID", "NAME", "DOMAIN", "DAYS LEFT", "REGISTRAR", "STATUS"})
+		table, err := newListTable(cmd, []string{"ID", "NAME", "DOMAIN", "DAYS LEFT", "REGISTRAR", "STATUS", "EXPIRES", "THRESHOLDS"}, "expires", "thresholds")
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
 		for _, domain := range result.DomainMonitors {
-			status := domain.Status
-			if domain.Status == "active" {
-				status = output.Green(status)
-			}
+			status := formatListStatus(domain.Status, domain.SnoozedUntil, time.Now())
 
-			// Truncate ID to first 8 chars (like Docker)
-			shortID := domain.ID
-			if len(shortID) > 8 {
-				shortID = shortID[:8]
-			}
+			shortID := cmdutil.ShortID(domain.ID)
 
 			// Format days until expiration with color coding
 			daysLeft := fmt.Sprintf("%d", domain.DaysUntilExpiration)
@@ -90,13 +115,18 @@ var domainsListCmd = &cobra.Command{
 				domain.Name,
 				domain.Domain,
 				daysLeft,
-				truncate(domain.Registrar, 20),
+				domain.Registrar,
 				status,
+				valueOrDash(output.FormatTime(domain.ExpiresAt)),
+				formatThresholds(domain.WarningThreshold, domain.UrgentThreshold, domain.CriticalThreshold),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d domain monitor(s)", len(result.DomainMonitors))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d domain monitor(s)", len(result.DomainMonitors)))
+		if result.HasMore && !filter.all {
+			output.InfoMessage(out, "More results are available, use --all to fetch every page")
+		}
 		return nil
 	},
 }
@@ -108,13 +138,15 @@ var domainsShowCmd = &cobra.Command{
 	Long:  "Display detailed information about a specific domain monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -124,7 +156,7 @@ var domainsShowCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -138,60 +170,113 @@ var domainsShowCmd = &cobra.Command{
 			return fmt.Errorf("failed to get domain: %w", err)
 		}
 		if jsonOutput {
-			return outputJSON(domain)
+			return cmdutil.OutputJSON(out, domain)
 		}
 
 		// Print domain details
-		fmt.Printf("ID:                       %s\n", output.Cyan(domain.ID))
-		fmt.Printf("Name:                     %s\n", output.Bold(domain.Name))
-		fmt.Printf("Domain:                   %s\n", domain.Domain)
-		fmt.Printf("Status:                   %s\n", domain.Status)
-		fmt.Printf("Check Interval:           %s\n", output.FormatDuration(domain.Interval))
-		fmt.Printf("Grace Period:             %s\n", output.FormatDuration(domain.GracePeriod))
-		fmt.Printf("Warning Threshold:        %d days\n", domain.WarningThreshold)
-		fmt.Printf("Urgent Threshold:         %d days\n", domain.UrgentThreshold)
-		fmt.Printf("Critical Threshold:       %d days\n", domain.CriticalThreshold)
-		fmt.Printf("Days Until Expiration:    %d\n", domain.DaysUntilExpiration)
-		fmt.Printf("Expires At:               %s\n", domain.ExpiresAt)
-		fmt.Printf("Registrar:                %s\n", domain.Registrar)
+		fmt.Fprintf(out, "ID:                       %s\n", output.Cyan(domain.ID))
+		fmt.Fprintf(out, "Name:                     %s\n", output.Bold(domain.Name))
+		fmt.Fprintf(out, "Domain:                   %s\n", domain.Domain)
+		fmt.Fprintf(out, "Status:                   %s\n", domain.Status)
+		if snoozed, ok := formatSnoozedUntil(domain.SnoozedUntil, time.Now()); ok {
+			fmt.Fprintf(out, "Snoozed Until:            %s\n", output.Yellow(snoozed))
+		}
+		fmt.Fprintf(out, "Check Interval:           %s\n", output.FormatDuration(domain.Interval))
+		fmt.Fprintf(out, "Grace Period:             %s\n", output.FormatDuration(domain.GracePeriod))
+		fmt.Fprintf(out, "Alerts:                   %s\n", formatEscalation(domain.AlertAfter, domain.RealertEvery))
+		fmt.Fprintf(out, "Warning Threshold:        %d days\n", domain.WarningThreshold)
+		fmt.Fprintf(out, "Urgent Threshold:         %d days\n", domain.UrgentThreshold)
+		fmt.Fprintf(out, "Critical Threshold:       %d days\n", domain.CriticalThreshold)
+		fmt.Fprintf(out, "Days Until Expiration:    %d\n", domain.DaysUntilExpiration)
+		fmt.Fprintf(out, "Expires At:               %s\n", output.FormatTime(domain.ExpiresAt))
+		fmt.Fprintf(out, "Registrar:                %s\n", domain.Registrar)
 		if domain.RegistrarURL != nil {
-			fmt.Printf("Registrar URL:            %s\n", *domain.RegistrarURL)
+			fmt.Fprintf(out, "Registrar URL:            %s\n", *domain.RegistrarURL)
+		}
+		fmt.Fprintf(out, "Transfer Lock:            %s\n", formatTransferLock(domain.TransferLocked))
+		if len(domain.DomainStatuses) > 0 {
+			fmt.Fprintf(out, "Domain Status:            %s\n", strings.Join(domain.DomainStatuses, ", "))
 		}
-		fmt.Printf("Last Check At:            %s\n", domain.LastCheckAt)
-		fmt.Printf("Last Successful Check:    %s\n", domain.LastSuccessfulCheckAt)
-		fmt.Printf("Consecutive Failures:     %d\n", domain.ConsecutiveFailures)
-		fmt.Printf("Created At:               %s\n", domain.CreatedAt)
-		fmt.Printf("Updated At:               %s\n", domain.UpdatedAt)
+		fmt.Fprintf(out, "DNSSEC:                   %s\n", formatDNSSEC(domain.DNSSEC))
+		fmt.Fprintf(out, "Nameservers:              %s\n", valueOrDash(strings.Join(domain.Nameservers, ", ")))
+		fmt.Fprintf(out, "Last Check At:            %s\n", output.FormatTime(domain.LastCheckAt))
+		fmt.Fprintf(out, "Last Successful Check:    %s\n", output.FormatTime(domain.LastSuccessfulCheckAt))
+		fmt.Fprintf(out, "Consecutive Failures:     %d\n", domain.ConsecutiveFailures)
+		fmt.Fprintf(out, "Tags:                     %s\n", formatTags(domain.Tags))
+		fmt.Fprintf(out, "Notifications:            %s\n", formatChannels(domain.ChannelIDs))
+		fmt.Fprintf(out, "Created At:               %s\n", output.FormatTime(domain.CreatedAt))
+		fmt.Fprintf(out, "Updated At:               %s\n", output.FormatTime(domain.UpdatedAt))
 
 		return nil
 	},
 }
 
+// formatTransferLock describes a domain's registrar transfer lock, warning
+// when it's unlocked since that allows the domain to be transferred away
+func formatTransferLock(locked *bool) string {
+	switch {
+	case locked == nil:
+		return "unknown"
+	case *locked:
+		return output.Green("locked")
+	}
+	return output.Yellow("unlocked")
+}
+
+// formatDNSSEC describes whether a domain's zone is signed
+func formatDNSSEC(state string) string {
+	switch state {
+	case "":
+		return "unknown"
+	case "signed":
+		return output.Green(state)
+	}
+	return state
+}
+
+// domainsCreateWizard prompts for the domains create settings
+var domainsCreateWizard = []wizardField{
+	{flag: "domain", prompt: "Domain to monitor", required: true, validate: validateDomainName},
+	{flag: "name", prompt: "Monitor name", required: true, def: defaultToFlag("domain")},
+	{flag: "interval", prompt: "Check interval"},
+	{flag: "grace-period", prompt: "Grace period"},
+	{flag: "warning-threshold", prompt: "Warn when this many days from expiry"},
+	{flag: "urgent-threshold", prompt: "Urgent when this many days from expiry"},
+	{flag: "critical-threshold", prompt: "Critical when this many days from expiry"},
+	tagsWizardField,
+}
+
 // domains create
 var domainsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new domain monitor",
 	Long:  "Create a new domain expiration monitor",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
+		if err := runCreateWizard(cmd, domainsCreateWizard); err != nil {
+			return err
+		}
+
 		// Get flag values
 		name, _ := cmd.Flags().GetString("name")
 		domain, _ := cmd.Flags().GetString("domain")
-		interval, _ := cmd.Flags().GetInt("interval")
-		gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+		interval := getDurationFlag(cmd, "interval")
+		gracePeriod := getDurationFlag(cmd, "grace-period")
 		warningThreshold, _ := cmd.Flags().GetInt("warning-threshold")
 		urgentThreshold, _ := cmd.Flags().GetInt("urgent-threshold")
 		criticalThreshold, _ := cmd.Flags().GetInt("critical-threshold")
 
 		if name == "" {
-			return fmt.Errorf("--name is required")
+			return usageErrorf("--name is required")
 		}
 		if domain == "" {
-			return fmt.Errorf("--domain is required")
+			return usageErrorf("--domain is required")
 		}
 
 		req := &api.CreateDomainMonitorRequest{
@@ -204,7 +289,32 @@ var domainsCreateCmd = &cobra.Command{
 			CriticalThreshold: criticalThreshold,
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil {
+			req.AlertAfter = *alertAfter
+		}
+		if realertEvery != nil {
+			req.RealertEvery = *realertEvery
+		}
+
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
+		}
+		req.Tags = tags
+
+		if req.ProjectID, err = getProject(cmd, client); err != nil {
+			return err
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
 		s.Start()
 		domainMonitor, err := client.CreateDomain(req)
 		s.Stop()
@@ -213,16 +323,88 @@ var domainsCreateCmd = &cobra.Command{
 			return fmt.Errorf("failed to create domain monitor: %w", err)
 		}
 
-		output.SuccessMessage("Domain monitor created successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(domainMonitor.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(domainMonitor.Name))
-		fmt.Printf("Domain:   %s\n", domainMonitor.Domain)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(domainMonitor.Interval))
+		output.SuccessMessage(out, "Domain monitor created successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(domainMonitor.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(domainMonitor.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", domainMonitor.Domain)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(domainMonitor.Interval))
 
 		return nil
 	},
 }
 
+// domains clone <id>
+var domainsCloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of a domain monitor",
+	Long: `Create a new domain expiration monitor with the same interval, grace period,
+and expiry thresholds as an existing one, optionally for another domain.` + cloneLongHelp + `
+
+Examples:
+  groovekit domains clone abc12345 --domain example.org
+  groovekit domains clone abc12345 --domain example.net --tag brand`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		domain, err := client.GetDomain(fullID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get domain: %w", err)
+		}
+
+		tags, err := cloneTags(cmd, domain.Tags)
+		if err != nil {
+			return err
+		}
+
+		req := &api.CreateDomainMonitorRequest{
+			Name:              cloneName(cmd, domain.Name),
+			Domain:            domain.Domain,
+			Interval:          domain.Interval,
+			GracePeriod:       domain.GracePeriod,
+			AlertAfter:        domain.AlertAfter,
+			RealertEvery:      domain.RealertEvery,
+			WarningThreshold:  domain.WarningThreshold,
+			UrgentThreshold:   domain.UrgentThreshold,
+			CriticalThreshold: domain.CriticalThreshold,
+			Status:            domain.Status,
+			Tags:              tags,
+			ProjectID:         domain.ProjectID,
+		}
+		if name, _ := cmd.Flags().GetString("domain"); name != "" {
+			req.Domain = name
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, 1); err != nil {
+			return err
+		}
+
+		s = newSpinner(cmd)
+		s.Start()
+		clone, err := client.CreateDomain(req)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to create domain monitor: %w", err)
+		}
+
+		return finishClone(cmd, client, domainsNotifyTarget, domain.Name, clone.Name, clone.ID, domain.ChannelIDs)
+	},
+}
+
 // domains update <id>
 var domainsUpdateCmd = &cobra.Command{
 	Use:   "update <id>",
@@ -230,13 +412,15 @@ var domainsUpdateCmd = &cobra.Command{
 	Long:  "Update an existing domain expiration monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -258,13 +442,13 @@ var domainsUpdateCmd = &cobra.Command{
 		}
 
 		if cmd.Flags().Changed("interval") {
-			interval, _ := cmd.Flags().GetInt("interval")
+			interval := getDurationFlag(cmd, "interval")
 			req.Interval = &interval
 			hasUpdates = true
 		}
 
 		if cmd.Flags().Changed("grace-period") {
-			gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+			gracePeriod := getDurationFlag(cmd, "grace-period")
 			req.GracePeriod = &gracePeriod
 			hasUpdates = true
 		}
@@ -293,11 +477,29 @@ var domainsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil || realertEvery != nil {
+			req.AlertAfter, req.RealertEvery = alertAfter, realertEvery
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("tag") {
+			tags, err := getTags(cmd)
+			if err != nil {
+				return err
+			}
+			req.Tags = &tags
+			hasUpdates = true
+		}
+
 		if !hasUpdates {
-			return fmt.Errorf("no fields to update. Use --name, --domain, --interval, --grace-period, --warning-threshold, --urgent-threshold, --critical-threshold, or --status")
+			return fmt.Errorf("no fields to update. Use --name, --domain, --interval, --grace-period, --warning-threshold, --urgent-threshold, --critical-threshold, --status, --alert-after, --realert-every, or --tag")
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
 		domainMonitor, err := client.UpdateDomain(fullID, req)
 		s.Stop()
@@ -306,86 +508,77 @@ var domainsUpdateCmd = &cobra.Command{
 			return fmt.Errorf("failed to update domain monitor: %w", err)
 		}
 
-		output.SuccessMessage("Domain monitor updated successfully\n")
-		fmt.Printf("ID:       %s\n", output.Cyan(domainMonitor.ID))
-		fmt.Printf("Name:     %s\n", output.Bold(domainMonitor.Name))
-		fmt.Printf("Domain:   %s\n", domainMonitor.Domain)
-		fmt.Printf("Interval: %s\n", output.FormatDuration(domainMonitor.Interval))
-		fmt.Printf("Status:   %s\n", domainMonitor.Status)
+		output.SuccessMessage(out, "Domain monitor updated successfully\n")
+		fmt.Fprintf(out, "ID:       %s\n", output.Cyan(domainMonitor.ID))
+		fmt.Fprintf(out, "Name:     %s\n", output.Bold(domainMonitor.Name))
+		fmt.Fprintf(out, "Domain:   %s\n", domainMonitor.Domain)
+		fmt.Fprintf(out, "Interval: %s\n", output.FormatDuration(domainMonitor.Interval))
+		fmt.Fprintf(out, "Status:   %s\n", domainMonitor.Status)
 
 		return nil
 	},
 }
 
-// domains pause <id>
+// domains pause [id...]
 var domainsPauseCmd = &cobra.Command{
-	Use:   "pause <id>",
-	Short: "Pause a domain monitor",
-	Long:  "Pause a domain expiration monitor (sets status to paused)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to paused
-		status := "paused"
-		req := &api.UpdateDomainMonitorRequest{Status: &status}
-
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateDomain(fullID, req)
-		s.Stop()
-
-		if err != nil {
-			return fmt.Errorf("failed to pause domain monitor: %w", err)
-		}
-
-		output.SuccessMessage(fmt.Sprintf("Domain monitor %s paused successfully", args[0]))
-		return nil
+	Use:   "pause [id...]",
+	Short: "Pause one or more domain monitors",
+	Long: `Pause one or more domain expiration monitors (sets status to paused).` + bulkLongHelp + `
+
+Examples:
+  groovekit domains pause abc12345 def67890
+  groovekit domains pause --tag env=staging --dry-run
+  groovekit domains pause --match 'name~staging'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, domainsBulkTarget, statusAction(domainsBulkTarget, "paused"))
 	},
 }
 
-// domains resume <id>
+// domains resume [id...]
 var domainsResumeCmd = &cobra.Command{
-	Use:   "resume <id>",
-	Short: "Resume a domain monitor",
-	Long:  "Resume a paused domain expiration monitor (sets status to active)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to active
-		status := "active"
-		req := &api.UpdateDomainMonitorRequest{Status: &status}
+	Use:   "resume [id...]",
+	Short: "Resume one or more domain monitors",
+	Long: `Resume one or more paused domain expiration monitors (sets status to active).` + bulkLongHelp + `
+
+Examples:
+  groovekit domains resume abc12345 def67890
+  groovekit domains resume --tag env=staging
+  groovekit domains resume --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, domainsBulkTarget, statusAction(domainsBulkTarget, "active"))
+	},
+}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateDomain(fullID, req)
-		s.Stop()
+// domains notify <id>
+var domainsNotifyCmd = &cobra.Command{
+	Use:   "notify <id>",
+	Short: "Manage where domain monitor alerts go",
+	Long: `Add or remove the notification channels that a domain monitor's alerts are
+routed to.` + notifyLongHelp + `
+
+Examples:
+  groovekit domains notify abc12345
+  groovekit domains notify abc12345 --add-channel ch_oncall --remove-channel ch_email`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runNotify(cmd, args[0], domainsNotifyTarget)
+	},
+}
 
+// domainsNotifyTarget reads and updates domain monitor notification channels
+var domainsNotifyTarget = notifyTarget{
+	noun:    "domain monitor",
+	resolve: cmdutil.ResolveDomainID,
+	channels: func(client api.APIClient, id string) ([]string, error) {
+		domain, err := client.GetDomain(id)
 		if err != nil {
-			return fmt.Errorf("failed to resume domain monitor: %w", err)
+			return nil, err
 		}
-
-		output.SuccessMessage(fmt.Sprintf("Domain monitor %s resumed successfully", args[0]))
-		return nil
+		return domain.ChannelIDs, nil
+	},
+	update: func(client api.APIClient, id string, channels []string) error {
+		_, err := client.UpdateDomain(id, &api.UpdateDomainMonitorRequest{ChannelIDs: &channels})
+		return err
 	},
 }
 
@@ -396,13 +589,15 @@ var domainsIncidentsCmd = &cobra.Command{
 	Long:  "Display incident history (downtime periods) for a domain monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -412,7 +607,7 @@ var domainsIncidentsCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -427,16 +622,19 @@ var domainsIncidentsCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
-			return outputJSON(incidents)
+			return cmdutil.OutputJSON(out, incidents)
 		}
 
 		if len(incidents) == 0 {
-			output.InfoMessage("No incidents found - this domain monitor has been running smoothly!")
+			output.InfoMessage(out, "No incidents found - this domain monitor has been running smoothly!")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"STARTED", "ENDED", "DURATION", "STATUS", "ERROR"})
+		table, err := newListTable(cmd, []string{"ID", "STARTED", "ENDED", "DURATION", "STATUS", "ERROR", "ACK", "NOTE"})
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
@@ -446,111 +644,398 @@ var domainsIncidentsCmd = &cobra.Command{
 
 			if incident.EndedAt != nil {
 				status = output.Green("Recovered")
-				ended = *incident.EndedAt
+				ended = output.FormatTime(*incident.EndedAt)
 			}
 
 			// Format duration
-			duration := formatIncidentDuration(incident.Duration)
+			duration := cmdutil.FormatIncidentDuration(incident.Duration)
 
-			// Truncate error message
 			errorMsg := "-"
 			if incident.ErrorMessage != nil {
-				errorMsg = truncate(*incident.ErrorMessage, 40)
+				errorMsg = *incident.ErrorMessage
 			}
 
 			table.Append([]string{
-				incident.StartedAt,
+				output.Cyan(cmdutil.ShortID(incident.ID)),
+				output.FormatTime(incident.StartedAt),
 				ended,
 				duration,
 				status,
 				errorMsg,
+				incidentAck(incident),
+				incidentNote(incident),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d incident(s)", len(incidents))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d incident(s)", len(incidents)))
 		return nil
 	},
 }
 
-// domains delete <id>
-var domainsDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete a domain monitor",
-	Long:  "Delete a domain expiration monitor",
+// domains check <id>
+var domainsCheckCmd = &cobra.Command{
+	Use:   "check <id>",
+	Short: "Check domain health",
+	Long:  "Query the current health of a domain expiration monitor for use in scripts and CI gates.\nA domain is down when checks are failing or it is inside its critical threshold." + checkLongHelp,
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
-			return err
+			return checkFailed(cmd, err)
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveDomainID(client, args[0])
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		domain, err := client.GetDomain(fullID)
+		if err != nil {
+			return checkFailed(cmd, fmt.Errorf("failed to get domain: %w", err))
+		}
+
+		if state, ok := statusHealth(domain.Status); ok {
+			return reportHealth(cmd, "domain", domain.Name, state, domain.Status)
+		}
+		if domain.ConsecutiveFailures > 0 {
+			return reportHealth(cmd, "domain", domain.Name, healthDown, fmt.Sprintf("%d consecutive failure(s)", domain.ConsecutiveFailures))
+		}
+		if domain.LastCheckAt == "" {
+			return reportHealth(cmd, "domain", domain.Name, healthUnknown, "not checked yet")
+		}
+		if domain.DaysUntilExpiration <= domain.CriticalThreshold {
+			return reportHealth(cmd, "domain", domain.Name, healthDown, fmt.Sprintf("expires in %d days", domain.DaysUntilExpiration))
+		}
+		return reportHealth(cmd, "domain", domain.Name, healthUp, "")
+	},
+}
+
+// domains whois <id|domain>
+var domainsWhoisCmd = &cobra.Command{
+	Use:   "whois <id|domain>",
+	Short: "Run a live WHOIS query for a domain",
+	Long: `Query WHOIS from this machine and show the domain's registrar, expiration,
+nameservers, and lock status. When the domain has a monitor, the live
+answer is compared with what the hosted check last recorded.
+
+The argument can be a monitor ID (or prefix), a monitored domain, or any
+other domain.
+
+Examples:
+  groovekit domains whois abc12345
+  groovekit domains whois example.com
+  groovekit domains whois example.com --server whois.verisign-grs.com --raw`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
-		// Confirm deletion
-		confirm, _ := cmd.Flags().GetBool("force")
-		if !confirm {
-			fmt.Printf("Are you sure you want to delete domain monitor %s? (y/N): ", args[0])
-			var response string
-			_, _ = fmt.Scanln(&response)
-			if response != "y" && response != "Y" {
-				fmt.Println("Cancelled")
-				return nil
+		server, _ := cmd.Flags().GetString("server")
+		raw, _ := cmd.Flags().GetBool("raw")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput && !raw {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		monitor, err := findDomainMonitor(client, args[0])
+		var result *probe.WhoisResult
+		if err == nil {
+			domain := args[0]
+			if monitor != nil {
+				domain = monitor.Domain
 			}
+			result, err = probe.LookupWhois(probe.WhoisOptions{Domain: domain, Server: server})
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		err = client.DeleteDomain(fullID)
-		s.Stop()
+		if s != nil {
+			s.Stop()
+		}
 
 		if err != nil {
-			return fmt.Errorf("failed to delete domain monitor: %w", err)
+			return err
 		}
 
-		output.SuccessMessage(fmt.Sprintf("Domain monitor %s deleted successfully", args[0]))
+		if raw {
+			fmt.Fprint(out, result.Raw)
+			return nil
+		}
+
+		var changes []diff.Change
+		if monitor != nil {
+			changes = diff.Compare(recordedWhois(monitor), liveWhois(result))
+		}
+
+		if jsonOutput {
+			report := map[string]interface{}{"whois": result}
+			if monitor != nil {
+				report["monitor_id"] = monitor.ID
+				report["monitor"] = recordedWhois(monitor)
+				report["changes"] = changes
+			}
+			return cmdutil.OutputJSON(out, report)
+		}
+
+		fmt.Fprintf(out, "%s via %s\n\n", output.Bold(result.Domain), result.Server)
+		fmt.Fprintf(out, "Registrar:       %s\n", valueOrDash(result.Registrar))
+		if result.ExpiresAt != nil {
+			days := int(time.Until(*result.ExpiresAt).Hours() / 24)
+			fmt.Fprintf(out, "Expires:         %s (%d days)\n", result.ExpiresAt.Format("2006-01-02"), days)
+		} else {
+			fmt.Fprintln(out, "Expires:         -")
+		}
+		fmt.Fprintf(out, "Nameservers:     %s\n", valueOrDash(strings.Join(result.Nameservers, ", ")))
+		fmt.Fprintf(out, "Status:          %s\n", valueOrDash(strings.Join(result.Statuses, ", ")))
+		if result.Locked {
+			fmt.Fprintf(out, "Transfer Lock:   %s\n", output.Green("locked"))
+		} else {
+			fmt.Fprintf(out, "Transfer Lock:   %s\n", output.Yellow("unlocked"))
+		}
+
+		if monitor == nil {
+			return nil
+		}
+		fmt.Fprintf(out, "\n%s (%s, last checked %s)\n", output.Bold("Compared with the monitor"), cmdutil.ShortID(monitor.ID), valueOrDash(output.FormatTime(monitor.LastCheckAt)))
+		if len(changes) == 0 {
+			fmt.Fprintln(out, output.Green("No differences"))
+			return nil
+		}
+		printChanges(out, changes)
 		return nil
 	},
 }
 
-// Helper function to resolve a short domain ID to a full ID
-func resolveDomainID(client *api.Client, shortID string) (string, error) {
-	// If it looks like a full UUID, use it as-is
-	if len(shortID) >= 32 {
-		return shortID, nil
-	}
+// domains changes <id>
+var domainsChangesCmd = &cobra.Command{
+	Use:   "changes <id>",
+	Short: "Show changes to a domain's WHOIS record",
+	Long: `List every change to a domain's WHOIS record detected by its checks, newest
+first: registrar, expiration, nameservers, status codes, transfer lock, and
+DNSSEC. Unexpected nameserver or lock changes can be a sign of a hijacked
+domain.
+
+Examples:
+  groovekit domains changes abc12345
+  groovekit domains changes abc12345 --field nameservers --since 90d
+  groovekit domains changes abc12345 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		var since time.Time
+		if sinceFlag != "" {
+			var err error
+			if since, err = parseSince(sinceFlag, time.Now()); err != nil {
+				return err
+			}
+		}
+		field, _ := cmd.Flags().GetString("field")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveDomainID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		changes, err := client.ListDomainChanges(fullID)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get domain changes: %w", err)
+		}
+
+		changes = limitItems(filterItems(changes, func(change api.DomainChange) bool {
+			return (field == "" || strings.EqualFold(change.Field, field)) && notBefore(change.DetectedAt, since)
+		}), limit)
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, changes)
+		}
 
-	// Otherwise, fetch all domains and match by prefix
-	result, err := client.ListDomains()
+		if len(changes) == 0 {
+			output.InfoMessage(out, "No WHOIS changes found")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{"DETECTED", "FIELD", "OLD VALUE", "NEW VALUE"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for _, change := range changes {
+			table.Append([]string{
+				output.FormatTime(change.DetectedAt),
+				change.Field,
+				output.Red(valueOrDash(change.OldValue)),
+				output.Green(valueOrDash(change.NewValue)),
+			})
+		}
+		table.Flush()
+
+		output.TotalMessage(out, fmt.Sprintf("Total: %s", countNoun(len(changes), "change", "changes")))
+		return nil
+	},
+}
+
+// findDomainMonitor finds the domain monitor for an ID prefix or domain.
+// An unmonitored domain returns no monitor and no error.
+func findDomainMonitor(client api.APIClient, arg string) (*api.DomainMonitor, error) {
+	result, err := client.ListAllDomains(nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to list domain monitors: %w", err)
+		return nil, fmt.Errorf("failed to list domain monitors: %w", err)
 	}
 
-	var matches []string
+	var byID []api.DomainMonitor
 	for _, domain := range result.DomainMonitors {
-		if len(domain.ID) >= len(shortID) && domain.ID[:len(shortID)] == shortID {
-			matches = append(matches, domain.ID)
+		if strings.HasPrefix(domain.ID, arg) {
+			byID = append(byID, domain)
 		}
 	}
+	if len(byID) > 1 {
+		return nil, fmt.Errorf("ambiguous ID prefix '%s' matches multiple domain monitors", arg)
+	}
+	if len(byID) == 1 {
+		return &byID[0], nil
+	}
 
-	if len(matches) == 0 {
-		return "", fmt.Errorf("no domain monitor found with ID prefix '%s'", shortID)
+	for i, domain := range result.DomainMonitors {
+		if normalizeDomain(domain.Domain) == normalizeDomain(arg) {
+			return &result.DomainMonitors[i], nil
+		}
 	}
+	if !strings.Contains(arg, ".") {
+		return nil, fmt.Errorf("no domain monitor found with ID prefix '%s'", arg)
+	}
+	return nil, nil
+}
 
-	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID prefix '%s' matches multiple domain monitors", shortID)
+// recordedWhois is the WHOIS data a domain monitor last recorded
+func recordedWhois(monitor *api.DomainMonitor) map[string]interface{} {
+	expires := monitor.ExpiresAt
+	if len(expires) > 10 {
+		expires = expires[:10]
 	}
+	return map[string]interface{}{
+		"registrar":  monitor.Registrar,
+		"expires_at": expires,
+	}
+}
+
+// liveWhois is the part of a WHOIS answer that domain monitors record
+func liveWhois(result *probe.WhoisResult) map[string]interface{} {
+	expires := ""
+	if result.ExpiresAt != nil {
+		expires = result.ExpiresAt.Format("2006-01-02")
+	}
+	return map[string]interface{}{
+		"registrar":  result.Registrar,
+		"expires_at": expires,
+	}
+}
+
+// valueOrDash shows "-" for empty values
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// domains move [id...]
+var domainsMoveCmd = &cobra.Command{
+	Use:   "move [id...]",
+	Short: "Move one or more domain monitors to a project",
+	Long: `Move one or more domain expiration monitors to a project. Their check history,
+incidents, and settings are kept.` + bulkLongHelp + `
+
+Examples:
+  groovekit domains move abc12345 --project def67890
+  groovekit domains move --match 'name~checkout-*' --project def67890 --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMove(cmd, args, domainsBulkTarget)
+	},
+}
+
+// domains delete [id...]
+var domainsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more domain monitors",
+	Long: `Delete one or more domain expiration monitors. Everything that will be deleted is listed
+for confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit domains delete abc12345 def67890
+  groovekit domains delete --match 'name~tmp-*' --dry-run
+  groovekit domains delete --tag env=preview --force`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, domainsBulkTarget, deleteAction(domainsBulkTarget))
+	},
+}
 
-	return matches[0], nil
+// domainsBulkTarget lists domain monitors for bulk actions and ID resolution
+var domainsBulkTarget = bulkTarget{
+	noun:    "domain monitor",
+	plural:  "domain monitors",
+	command: "domains",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		result, err := client.ListAllDomains(nil)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(result.DomainMonitors))
+		for _, domain := range result.DomainMonitors {
+			items = append(items, bulkItem{id: domain.ID, name: domain.Name, status: domain.Status, tags: domain.Tags, fields: map[string]string{"domain": domain.Domain, "project": domain.ProjectID}})
+		}
+		return items, nil
+	},
+	setStatus: func(client api.APIClient, id, status string) error {
+		_, err := client.UpdateDomain(id, &api.UpdateDomainMonitorRequest{Status: &status})
+		return err
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteDomain(id)
+	},
+	move: func(client api.APIClient, id, projectID string) error {
+		return client.MoveDomain(id, projectID)
+	},
+	snooze: func(client api.APIClient, id string, until time.Time) error {
+		return client.SnoozeDomain(id, until)
+	},
 }
 
 func init() {
 	// Add flags to list command
 	domainsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(domainsListCmd)
+	addListFilterFlags(domainsListCmd)
+	addPageFlags(domainsListCmd)
 
 	// Add flags to show command
 	domainsShowCmd.Flags().Bool("json", false, "Output as JSON")
@@ -558,39 +1043,81 @@ func init() {
 	// Add flags to create command
 	domainsCreateCmd.Flags().String("name", "", "Domain monitor name (required)")
 	domainsCreateCmd.Flags().String("domain", "", "Domain to monitor (required)")
-	domainsCreateCmd.Flags().Int("interval", 1440, "Check interval in minutes (default: daily)")
-	domainsCreateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	addDurationFlag(domainsCreateCmd, "interval", 1440, time.Minute, "Check interval")
+	addDurationFlag(domainsCreateCmd, "grace-period", 0, time.Minute, "Grace period")
 	domainsCreateCmd.Flags().Int("warning-threshold", 30, "Warning threshold in days")
 	domainsCreateCmd.Flags().Int("urgent-threshold", 14, "Urgent threshold in days")
 	domainsCreateCmd.Flags().Int("critical-threshold", 7, "Critical threshold in days")
-	_ = domainsCreateCmd.MarkFlagRequired("name")
-	_ = domainsCreateCmd.MarkFlagRequired("domain")
+	addEscalationFlags(domainsCreateCmd)
+	addTagFlag(domainsCreateCmd)
+	addProjectFlag(domainsCreateCmd)
+	addInteractiveFlag(domainsCreateCmd)
+
+	// Add flags to clone command
+	addCloneFlags(domainsCloneCmd)
+	domainsCloneCmd.Flags().String("domain", "", "Domain for the copy to monitor (default the original's)")
 
 	// Add flags to update command
 	domainsUpdateCmd.Flags().String("name", "", "Domain monitor name")
 	domainsUpdateCmd.Flags().String("domain", "", "Domain to monitor")
-	domainsUpdateCmd.Flags().Int("interval", 0, "Check interval in minutes")
-	domainsUpdateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	addDurationFlag(domainsUpdateCmd, "interval", 0, time.Minute, "Check interval")
+	addDurationFlag(domainsUpdateCmd, "grace-period", 0, time.Minute, "Grace period")
 	domainsUpdateCmd.Flags().Int("warning-threshold", 0, "Warning threshold in days")
 	domainsUpdateCmd.Flags().Int("urgent-threshold", 0, "Urgent threshold in days")
 	domainsUpdateCmd.Flags().Int("critical-threshold", 0, "Critical threshold in days")
 	domainsUpdateCmd.Flags().String("status", "", "Monitor status (active, inactive, paused)")
+	registerFlagCompletion(domainsUpdateCmd, "status", completeValues(monitorStatuses...))
+	addEscalationFlags(domainsUpdateCmd)
+	addTagFlag(domainsUpdateCmd)
+
+	// Add flags to notify command
+	addNotifyFlags(domainsNotifyCmd)
+
+	// Add flags to pause and resume commands
+	addBulkFlags(domainsPauseCmd)
+	addBulkFlags(domainsResumeCmd)
 
 	// Add flags to incidents command
 	domainsIncidentsCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(domainsIncidentsCmd)
+
+	// Add flags to check command
+	domainsCheckCmd.Flags().BoolP("verbose", "v", false, "Print the health result")
+	addFormatFlag(domainsCheckCmd)
+
+	// Add flags to whois command
+	domainsWhoisCmd.Flags().String("server", "", "Query this WHOIS server instead of the TLD's registry")
+	domainsWhoisCmd.Flags().Bool("raw", false, "Print the raw WHOIS answer")
+	domainsWhoisCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to changes command
+	domainsChangesCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(domainsChangesCmd)
+	domainsChangesCmd.Flags().String("field", "", "Only show changes to this field, e.g. nameservers or transfer_locked")
+	domainsChangesCmd.Flags().String("since", "", "Only show changes detected after this time (e.g. 24h, 30d, 2026-01-02)")
+	domainsChangesCmd.Flags().Int("limit", 0, "Maximum number of changes to show")
 
 	// Add flags to delete command
 	domainsDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(domainsDeleteCmd)
+	addProjectFlag(domainsMoveCmd)
+	addBulkFlags(domainsMoveCmd)
 
 	// Add subcommands
 	domainsCmd.AddCommand(domainsListCmd)
 	domainsCmd.AddCommand(domainsShowCmd)
 	domainsCmd.AddCommand(domainsCreateCmd)
+	domainsCmd.AddCommand(domainsCloneCmd)
 	domainsCmd.AddCommand(domainsUpdateCmd)
 	domainsCmd.AddCommand(domainsPauseCmd)
 	domainsCmd.AddCommand(domainsResumeCmd)
 	domainsCmd.AddCommand(domainsIncidentsCmd)
+	domainsCmd.AddCommand(domainsNotifyCmd)
+	domainsCmd.AddCommand(domainsCheckCmd)
+	domainsCmd.AddCommand(domainsWhoisCmd)
+	domainsCmd.AddCommand(domainsChangesCmd)
 	domainsCmd.AddCommand(domainsDeleteCmd)
+	domainsCmd.AddCommand(domainsMoveCmd)
 
 	// Add domains command to root
 	rootCmd.AddCommand(domainsCmd)
@@ -1,8 +1,14 @@
 package cmd
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/scookdev/groovekit-cli/internal/diff"
+	"github.com/scookdev/groovekit-cli/internal/probe"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,116 +20,79 @@ func TestDomainsCommand(t *testing.T) {
 	assert.NotEmpty(t, domainsCmd.Long)
 }
 
-// TestDomainsListCommand tests the domains list command
+// seedDomains adds a domain monitor to the fake API, returning its ID
+func seedDomains(srv *apitest.Server) string {
+	id := srv.Fake.NewID()
+	srv.Fake.Domains = []api.DomainMonitor{
+		{ID: id, Name: "Shop", Domain: "example.com", Status: "active", Interval: 1440, Registrar: "Example Registrar", DaysUntilExpiration: 200, WarningThreshold: 30, UrgentThreshold: 14, CriticalThreshold: 7},
+	}
+	return id
+}
+
+// TestDomainsListCommand tests listing domain monitors as a table and as
+// JSON
 func TestDomainsListCommand(t *testing.T) {
-	assert.Equal(t, "list", domainsListCmd.Use)
-	assert.Equal(t, "List all domains", domainsListCmd.Short)
-	assert.NotEmpty(t, domainsListCmd.Long)
-	require.NotNil(t, domainsListCmd.RunE, "domains list command should have a RunE function")
+	srv := startAPI(t)
+	seedDomains(srv)
 
-	// Verify --json flag exists
-	jsonFlag := domainsListCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "domains list command should have --json flag")
-	assert.Equal(t, "bool", jsonFlag.Value.Type())
+	out := mustRun(t, "domains", "list")
+	assert.Contains(t, out, "example.com")
+	assert.Contains(t, out, "Example Registrar")
+	assert.Contains(t, out, "Total: 1 domain monitor(s)")
+
+	var result api.DomainMonitorsResponse
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "domains", "list", "--json")), &result))
+	assert.Len(t, result.DomainMonitors, 1)
 }
 
-// TestDomainsShowCommand tests the domains show command
+// TestDomainsShowCommand tests showing a domain monitor by short ID
 func TestDomainsShowCommand(t *testing.T) {
-	assert.Equal(t, "show <id>", domainsShowCmd.Use)
-	assert.Equal(t, "Show domain details", domainsShowCmd.Short)
-	assert.NotEmpty(t, domainsShowCmd.Long)
-	require.NotNil(t, domainsShowCmd.RunE, "domains show command should have a RunE function")
+	srv := startAPI(t)
+	id := seedDomains(srv)
 
-	// Verify --json flag exists
-	jsonFlag := domainsShowCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "domains show command should have --json flag")
+	out := mustRun(t, "domains", "show", id[:8])
+	assert.Contains(t, out, "ID:                       "+id)
+	assert.Contains(t, out, "Registrar:                Example Registrar")
+	assert.Contains(t, out, "Days Until Expiration:    200")
 }
 
-// TestDomainsCreateCommand tests the domains create command
+// TestDomainsCreateCommand tests creating a domain monitor with the
+// default thresholds
 func TestDomainsCreateCommand(t *testing.T) {
-	assert.Equal(t, "create", domainsCreateCmd.Use)
-	assert.Equal(t, "Create a new domain monitor", domainsCreateCmd.Short)
-	assert.NotEmpty(t, domainsCreateCmd.Long)
-	require.NotNil(t, domainsCreateCmd.RunE, "domains create command should have a RunE function")
-
-	// Verify required flags
-	nameFlag := domainsCreateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "domains create command should have --name flag")
-	assert.Equal(t, "string", nameFlag.Value.Type())
-
-	domainFlag := domainsCreateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "domains create command should have --domain flag")
-	assert.Equal(t, "string", domainFlag.Value.Type())
-
-	// Verify optional flags
-	intervalFlag := domainsCreateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "domains create command should have --interval flag")
-	assert.Equal(t, "int", intervalFlag.Value.Type())
-
-	gracePeriodFlag := domainsCreateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "domains create command should have --grace-period flag")
-	assert.Equal(t, "int", gracePeriodFlag.Value.Type())
-
-	warningThresholdFlag := domainsCreateCmd.Flags().Lookup("warning-threshold")
-	require.NotNil(t, warningThresholdFlag, "domains create command should have --warning-threshold flag")
-	assert.Equal(t, "int", warningThresholdFlag.Value.Type())
-
-	urgentThresholdFlag := domainsCreateCmd.Flags().Lookup("urgent-threshold")
-	require.NotNil(t, urgentThresholdFlag, "domains create command should have --urgent-threshold flag")
-	assert.Equal(t, "int", urgentThresholdFlag.Value.Type())
-
-	criticalThresholdFlag := domainsCreateCmd.Flags().Lookup("critical-threshold")
-	require.NotNil(t, criticalThresholdFlag, "domains create command should have --critical-threshold flag")
-	assert.Equal(t, "int", criticalThresholdFlag.Value.Type())
-}
+	srv := startAPI(t)
 
-// TestDomainsUpdateCommand tests the domains update command
-func TestDomainsUpdateCommand(t *testing.T) {
-	assert.Equal(t, "update <id>", domainsUpdateCmd.Use)
-	assert.Equal(t, "Update a domain monitor", domainsUpdateCmd.Short)
-	assert.NotEmpty(t, domainsUpdateCmd.Long)
-	require.NotNil(t, domainsUpdateCmd.RunE, "domains update command should have a RunE function")
-
-	// Verify flags exist
-	nameFlag := domainsUpdateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "domains update command should have --name flag")
-
-	domainFlag := domainsUpdateCmd.Flags().Lookup("domain")
-	require.NotNil(t, domainFlag, "domains update command should have --domain flag")
-
-	intervalFlag := domainsUpdateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "domains update command should have --interval flag")
+	out := mustRun(t, "domains", "create", "--name", "Docs", "--domain", "example.org")
+	assert.Contains(t, out, "Domain monitor created successfully")
+	assert.Contains(t, out, "Domain:   example.org")
 
-	gracePeriodFlag := domainsUpdateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "domains update command should have --grace-period flag")
-
-	warningThresholdFlag := domainsUpdateCmd.Flags().Lookup("warning-threshold")
-	require.NotNil(t, warningThresholdFlag, "domains update command should have --warning-threshold flag")
-
-	urgentThresholdFlag := domainsUpdateCmd.Flags().Lookup("urgent-threshold")
-	require.NotNil(t, urgentThresholdFlag, "domains update command should have --urgent-threshold flag")
+	require.Len(t, srv.Fake.Domains, 1)
+	domain := srv.Fake.Domains[0]
+	assert.Equal(t, "example.org", domain.Domain)
+	assert.Equal(t, 30, domain.WarningThreshold)
+	assert.Equal(t, 7, domain.CriticalThreshold)
+}
 
-	criticalThresholdFlag := domainsUpdateCmd.Flags().Lookup("critical-threshold")
-	require.NotNil(t, criticalThresholdFlag, "domains update command should have --critical-threshold flag")
+// TestDomainsUpdateCommand tests updating only the fields given
+func TestDomainsUpdateCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedDomains(srv)
 
-	statusFlag := domainsUpdateCmd.Flags().Lookup("status")
-	require.NotNil(t, statusFlag, "domains update command should have --status flag")
+	out := mustRun(t, "domains", "update", id[:8], "--critical-threshold", "3")
+	assert.Contains(t, out, "Domain monitor updated successfully")
+	assert.Equal(t, 3, srv.Fake.Domains[0].CriticalThreshold)
+	assert.Equal(t, 30, srv.Fake.Domains[0].WarningThreshold)
 }
 
-// TestDomainsPauseCommand tests the domains pause command
+// TestDomainsPauseCommand tests pausing and resuming a domain monitor
 func TestDomainsPauseCommand(t *testing.T) {
-	assert.Equal(t, "pause <id>", domainsPauseCmd.Use)
-	assert.Equal(t, "Pause a domain monitor", domainsPauseCmd.Short)
-	assert.NotEmpty(t, domainsPauseCmd.Long)
-	require.NotNil(t, domainsPauseCmd.RunE, "domains pause command should have a RunE function")
-}
+	srv := startAPI(t)
+	id := seedDomains(srv)
 
-// TestDomainsResumeCommand tests the domains resume command
-func TestDomainsResumeCommand(t *testing.T) {
-	assert.Equal(t, "resume <id>", domainsResumeCmd.Use)
-	assert.Equal(t, "Resume a domain monitor", domainsResumeCmd.Short)
-	assert.NotEmpty(t, domainsResumeCmd.Long)
-	require.NotNil(t, domainsResumeCmd.RunE, "domains resume command should have a RunE function")
+	mustRun(t, "domains", "pause", id[:8])
+	assert.Equal(t, "paused", srv.Fake.Domains[0].Status)
+
+	mustRun(t, "domains", "resume", id[:8])
+	assert.Equal(t, "active", srv.Fake.Domains[0].Status)
 }
 
 // TestDomainsIncidentsCommand tests the domains incidents command
@@ -138,17 +107,13 @@ func TestDomainsIncidentsCommand(t *testing.T) {
 	require.NotNil(t, jsonFlag, "domains incidents command should have --json flag")
 }
 
-// TestDomainsDeleteCommand tests the domains delete command
+// TestDomainsDeleteCommand tests deleting a domain monitor without a prompt
 func TestDomainsDeleteCommand(t *testing.T) {
-	assert.Equal(t, "delete <id>", domainsDeleteCmd.Use)
-	assert.Equal(t, "Delete a domain monitor", domainsDeleteCmd.Short)
-	assert.NotEmpty(t, domainsDeleteCmd.Long)
-	require.NotNil(t, domainsDeleteCmd.RunE, "domains delete command should have a RunE function")
-
-	// Verify --force flag exists
-	forceFlag := domainsDeleteCmd.Flags().Lookup("force")
-	require.NotNil(t, forceFlag, "domains delete command should have --force flag")
-	assert.Equal(t, "bool", forceFlag.Value.Type())
+	srv := startAPI(t)
+	id := seedDomains(srv)
+
+	mustRun(t, "domains", "delete", id[:8], "--force")
+	assert.Empty(t, srv.Fake.Domains)
 }
 
 // TestDomainsCommandHasSubcommands verifies all subcommands are registered
@@ -156,7 +121,7 @@ func TestDomainsCommandHasSubcommands(t *testing.T) {
 	commands := domainsCmd.Commands()
 
 	// Should have 8 subcommands
-	expectedSubcommands := []string{"list", "show", "create", "update", "pause", "resume", "incidents", "delete"}
+	expectedSubcommands := []string{"list", "show", "create", "clone", "update", "pause", "resume", "incidents", "notify", "whois", "changes", "delete", "move"}
 	assert.GreaterOrEqual(t, len(commands), len(expectedSubcommands))
 
 	// Verify all expected subcommands exist
@@ -184,5 +149,37 @@ func TestResolveDomainID(t *testing.T) {
 	// In a real scenario, you'd mock the API client
 	// For now, we just verify the function exists by checking if it's referenced
 	// A full integration test would require a mock API server
-	assert.NotNil(t, domainsShowCmd.RunE, "resolveDomainID is used by show command")
+	assert.NotNil(t, domainsShowCmd.RunE, "cmdutil.ResolveDomainID is used by show command")
+}
+
+// TestRecordedAndLiveWhois tests comparing a monitor's WHOIS data with a live answer
+func TestRecordedAndLiveWhois(t *testing.T) {
+	expires := time.Date(2027, 8, 13, 4, 0, 0, 0, time.UTC)
+	monitor := &api.DomainMonitor{Registrar: "Namecheap", ExpiresAt: "2027-08-13T04:00:00Z"}
+
+	same := &probe.WhoisResult{Registrar: "Namecheap", ExpiresAt: &expires}
+	assert.Empty(t, diff.Compare(recordedWhois(monitor), liveWhois(same)))
+
+	renewed := expires.AddDate(1, 0, 0)
+	changes := diff.Compare(recordedWhois(monitor), liveWhois(&probe.WhoisResult{Registrar: "Namecheap", ExpiresAt: &renewed}))
+	assert.Equal(t, []diff.Change{{Path: "expires_at", Op: diff.Changed, Old: "2027-08-13", New: "2028-08-13"}}, changes)
+}
+
+// TestFormatTransferLock tests describing a domain's transfer lock
+func TestFormatTransferLock(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	locked, unlocked := true, false
+	assert.Equal(t, "unknown", formatTransferLock(nil))
+	assert.Equal(t, "locked", formatTransferLock(&locked))
+	assert.Equal(t, "unlocked", formatTransferLock(&unlocked))
+}
+
+// TestFormatDNSSEC tests describing a domain's DNSSEC state
+func TestFormatDNSSEC(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	assert.Equal(t, "unknown", formatDNSSEC(""))
+	assert.Equal(t, "signed", formatDNSSEC("signed"))
+	assert.Equal(t, "unsigned", formatDNSSEC("unsigned"))
 }
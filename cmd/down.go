@@ -0,0 +1,517 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// downItem is a resource that is currently down, with its ongoing incident
+type downItem struct {
+	Type         string  `json:"type"`
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Problem      string  `json:"problem"`
+	SnoozedUntil *string `json:"snoozed_until,omitempty"`
+	// Incident is the latest ongoing incident, or nil when there is none or
+	// it couldn't be fetched
+	Incident *api.Incident `json:"incident,omitempty"`
+
+	target bulkTarget
+	fetch  func(string) ([]api.Incident, error)
+}
+
+// describe names the resource with its type, e.g. "job Backup (1a2b3c4d)"
+func (item downItem) describe() string {
+	return item.target.noun + " " + describeBulkItem(bulkItem{id: item.ID, name: item.Name})
+}
+
+var downCmd = &cobra.Command{
+	Use:   "down",
+	Short: "List everything that is down and acknowledge it",
+	Long: `List every job, API monitor, SSL certificate, domain, and DNS monitor that
+is currently down, with when its incident started, who acknowledged it, and
+whether its alerts are snoozed.
+
+With --interactive, step through them in the terminal instead: move with the
+arrow keys (or j and k), press a to acknowledge the incident, s to snooze
+alerts for --snooze-for, and q to quit.
+
+Examples:
+  groovekit down
+  groovekit down --interactive
+  groovekit down ack abc12345 --note "rolling back"`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		interactive, _ := cmd.Flags().GetBool("interactive")
+		if jsonOutput && interactive {
+			return usageErrorf("--interactive and --json can't be used together")
+		}
+		snoozeFor := getDurationFlag(cmd, "snooze-for")
+		if snoozeFor <= 0 {
+			return usageErrorf("--snooze-for must be longer than 0")
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		items, errs := collectDown(cmd.Context(), client)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		// Nothing could be listed at all
+		if len(errs) == len(statusKinds) {
+			return errs[0]
+		}
+		for _, err := range errs {
+			output.ErrorMessage(cmd.ErrOrStderr(), err.Error())
+		}
+
+		switch {
+		case jsonOutput:
+			err = cmdutil.OutputJSON(out, items)
+		case len(items) == 0:
+			output.SuccessMessage(out, "Nothing is down")
+		case interactive:
+			err = runDownPicker(cmd, client, items, time.Duration(snoozeFor)*time.Minute)
+		default:
+			err = printDownItems(cmd, items)
+		}
+		if err != nil || len(errs) == 0 {
+			return err
+		}
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	},
+}
+
+// down ack <id>
+var downAckCmd = &cobra.Command{
+	Use:   "ack <id>",
+	Short: "Acknowledge a down monitor's incident",
+	Long: `Acknowledge the ongoing incident of a monitor listed by 'groovekit down',
+optionally leaving a note, so the rest of the team knows someone is on it.
+The ID is the monitor's, as shown in the ID column.
+
+Examples:
+  groovekit down ack abc12345
+  groovekit down ack abc12345 --note "rolling back"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		note, _ := cmd.Flags().GetString("note")
+
+		s := newSpinner(cmd)
+		s.Start()
+		items, errs := collectDown(cmd.Context(), client)
+		s.Stop()
+
+		// The monitor may be of a type that couldn't be listed
+		for _, err := range errs {
+			output.ErrorMessage(cmd.ErrOrStderr(), err.Error())
+		}
+		item, err := resolveDownItem(items, args[0])
+		if err != nil {
+			return err
+		}
+
+		s = newSpinner(cmd)
+		s.Start()
+		err = ackDownItem(client, item, note)
+		s.Stop()
+
+		if err != nil {
+			return err
+		}
+		output.SuccessMessage(out, fmt.Sprintf("Incident %s for %s acknowledged", cmdutil.ShortID(item.Incident.ID), item.describe()))
+		if note != "" {
+			fmt.Fprintf(out, "Note: %s\n", note)
+		}
+		return nil
+	},
+}
+
+// collectDown lists every resource type concurrently and fetches the
+// ongoing incident of each one that is down. It returns what it could
+// find, with an error for each type that couldn't be listed.
+func collectDown(ctx context.Context, client api.APIClient) ([]*downItem, []error) {
+	res, listErrs := fetchAccountResources(ctx, client, nil)
+	var errs []error
+	for _, kind := range statusKinds {
+		if err, ok := listErrs[kind]; ok {
+			errs = append(errs, err)
+		}
+	}
+
+	items := []*downItem{}
+	if res.jobs != nil {
+		for _, job := range res.jobs.Jobs {
+			if job.Down {
+				items = append(items, &downItem{Type: "job", ID: job.ID, Name: job.Name, Problem: "missed heartbeat", SnoozedUntil: job.SnoozedUntil,
+					target: jobsBulkTarget, fetch: client.ListJobIncidents})
+			}
+		}
+	}
+	if res.apis != nil {
+		for _, monitor := range res.apis.APIMonitors {
+			if monitor.Down {
+				items = append(items, &downItem{Type: "api", ID: monitor.ID, Name: monitor.Name, Problem: monitor.URL, SnoozedUntil: monitor.SnoozedUntil,
+					target: apisBulkTarget, fetch: client.ListApiIncidents})
+			}
+		}
+	}
+	if res.certs != nil {
+		for _, cert := range res.certs.SslMonitors {
+			if expiry := certExpiry(cert); expiry.down() {
+				items = append(items, &downItem{Type: "cert", ID: cert.ID, Name: cert.Name, Problem: expiry.problem(), SnoozedUntil: cert.SnoozedUntil,
+					target: certsBulkTarget, fetch: client.ListCertIncidents})
+			}
+		}
+	}
+	if res.domains != nil {
+		for _, domain := range res.domains.DomainMonitors {
+			if expiry := domainExpiry(domain); expiry.down() {
+				items = append(items, &downItem{Type: "domain", ID: domain.ID, Name: domain.Name, Problem: expiry.problem(), SnoozedUntil: domain.SnoozedUntil,
+					target: domainsBulkTarget, fetch: client.ListDomainIncidents})
+			}
+		}
+	}
+	if res.dns != nil {
+		for _, dns := range res.dns.DnsMonitors {
+			if dns.HasMismatch {
+				items = append(items, &downItem{Type: "dns", ID: dns.ID, Name: dns.Name, Problem: dns.RecordType + " mismatch", SnoozedUntil: dns.SnoozedUntil,
+					target: dnsBulkTarget, fetch: client.ListDnsMonitorIncidents})
+			}
+		}
+	}
+
+	// A resource whose incidents can't be fetched is still listed as down
+	api.Each(ctx, len(items), api.DefaultConcurrency, func(_ context.Context, i int) error {
+		incidents, err := items[i].fetch(items[i].ID)
+		if err != nil {
+			return err
+		}
+		for _, incident := range incidents {
+			if incident.EndedAt == nil {
+				items[i].Incident = &incident
+				break
+			}
+		}
+		return nil
+	})
+	return items, errs
+}
+
+// resolveDownItem finds a down resource by full ID or unique ID prefix
+func resolveDownItem(items []*downItem, query string) (*downItem, error) {
+	candidates := make([]bulkItem, 0, len(items))
+	for _, item := range items {
+		candidates = append(candidates, bulkItem{id: item.ID, name: item.Name})
+	}
+	match, err := resolve.Match(candidates, query, "down monitor", "down monitors")
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.ID == match.id {
+			return item, nil
+		}
+	}
+	return nil, &resolve.NotFoundError{Noun: "down monitor", Query: query}
+}
+
+// ackDownItem acknowledges a down resource's ongoing incident
+func ackDownItem(client api.APIClient, item *downItem, note string) error {
+	if item.Incident == nil || item.Incident.ID == "" {
+		return fmt.Errorf("%s has no ongoing incident to acknowledge", item.describe())
+	}
+	acknowledged := true
+	incident, err := client.UpdateIncident(item.Incident.ID, &api.UpdateIncidentRequest{Acknowledged: &acknowledged, Note: note})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge incident: %w", err)
+	}
+	item.Incident = incident
+	return nil
+}
+
+// snoozeDownItem holds back a down resource's alerts for a while
+func snoozeDownItem(client api.APIClient, item *downItem, snoozeFor time.Duration) error {
+	until := time.Now().Add(snoozeFor).Truncate(time.Second)
+	if err := item.target.snooze(client, item.ID, until); err != nil {
+		return fmt.Errorf("failed to snooze %s: %w", item.describe(), err)
+	}
+	snoozedUntil := until.UTC().Format(time.RFC3339)
+	item.SnoozedUntil = &snoozedUntil
+	return nil
+}
+
+// downSince shows when a down resource's incident started, or "-"
+func downSince(item *downItem) string {
+	if item.Incident == nil {
+		return "-"
+	}
+	return output.FormatTime(item.Incident.StartedAt)
+}
+
+// downAck describes whether a down resource's incident is acknowledged
+func downAck(item *downItem) string {
+	if item.Incident == nil {
+		return "-"
+	}
+	return incidentAck(*item.Incident)
+}
+
+// downSnoozed shows how long a down resource's alerts stay snoozed, or "-"
+func downSnoozed(item *downItem, now time.Time) string {
+	left, ok := snoozeLeft(item.SnoozedUntil, now)
+	if !ok {
+		return "-"
+	}
+	return output.Yellow(cmdutil.FormatIncidentDuration(left.Seconds()))
+}
+
+// printDownItems renders down resources as a table
+func printDownItems(cmd *cobra.Command, items []*downItem) error {
+	out := cmd.OutOrStdout()
+
+	table, err := newListTable(cmd, []string{"ID", "TYPE", "NAME", "PROBLEM", "DOWN SINCE", "ACK", "SNOOZED"})
+	if err != nil {
+		return err
+	}
+	table.Render()
+	now := time.Now()
+	for _, item := range items {
+		table.Append([]string{
+			output.Cyan(cmdutil.ShortID(item.ID)),
+			item.Type,
+			item.Name,
+			output.Red(item.Problem),
+			downSince(item),
+			downAck(item),
+			downSnoozed(item, now),
+		})
+	}
+	table.Flush()
+
+	output.TotalMessage(out, fmt.Sprintf("Total: %d down", len(items)))
+	output.InfoMessage(out, "Acknowledge with 'groovekit down ack <id>', or step through with 'groovekit down --interactive'")
+	return nil
+}
+
+// downKey is a key press in the interactive picker
+type downKey int
+
+const (
+	downKeyNone downKey = iota
+	downKeyUp
+	downKeyDown
+	downKeyAck
+	downKeySnooze
+	downKeyQuit
+)
+
+// readDownKey reads a key press: the arrow keys or j and k to move, a to
+// acknowledge, s to snooze, and q, Esc, Ctrl-C, or Ctrl-D to quit
+func readDownKey(in *bufio.Reader) (downKey, error) {
+	b, err := in.ReadByte()
+	if err != nil {
+		return downKeyNone, err
+	}
+	switch b {
+	case 0x1b:
+		// Arrow keys arrive as Esc [ A or Esc [ B in a single read
+		if in.Buffered() < 2 {
+			return downKeyQuit, nil
+		}
+		seq, _ := in.Peek(2)
+		if seq[0] != '[' {
+			return downKeyQuit, nil
+		}
+		_, _ = in.Discard(2)
+		switch seq[1] {
+		case 'A':
+			return downKeyUp, nil
+		case 'B':
+			return downKeyDown, nil
+		}
+	case 'k':
+		return downKeyUp, nil
+	case 'j':
+		return downKeyDown, nil
+	case 'a':
+		return downKeyAck, nil
+	case 's':
+		return downKeySnooze, nil
+	case 'q', 0x03, 0x04:
+		return downKeyQuit, nil
+	}
+	return downKeyNone, nil
+}
+
+// downPicker steps through down resources, acknowledging or snoozing each
+type downPicker struct {
+	items     []*downItem
+	cursor    int
+	snoozeFor time.Duration
+	// message reports the outcome of the last action
+	message string
+}
+
+// runDownPicker runs the interactive picker until the user quits. In a
+// terminal keys are read as they are pressed and the list is redrawn in
+// place; otherwise keys are read from the input as is.
+func runDownPicker(cmd *cobra.Command, client api.APIClient, items []*downItem, snoozeFor time.Duration) error {
+	in, out := cmd.InOrStdin(), cmd.OutOrStdout()
+	redraw := false
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) && isTerminal(out) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed to read keys from the terminal: %w", err)
+		}
+		defer func() { _ = term.Restore(int(f.Fd()), state) }()
+		out = rawWriter{out}
+		redraw = true
+	}
+
+	p := &downPicker{items: items, snoozeFor: snoozeFor}
+	keys := bufio.NewReader(in)
+	lines := 0
+	for {
+		if redraw && lines > 0 {
+			// Move back to the top of the last drawing and clear it
+			fmt.Fprintf(out, "\x1b[%dA\x1b[J", lines)
+		}
+		lines = p.render(out)
+
+		key, err := readDownKey(keys)
+		if errors.Is(err, io.EOF) || key == downKeyQuit {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		p.press(client, key)
+	}
+}
+
+// press applies a key press to the picker
+func (p *downPicker) press(client api.APIClient, key downKey) {
+	item := p.items[p.cursor]
+	switch key {
+	case downKeyUp:
+		p.cursor = max(p.cursor-1, 0)
+		p.message = ""
+	case downKeyDown:
+		p.cursor = min(p.cursor+1, len(p.items)-1)
+		p.message = ""
+	case downKeyAck:
+		if err := ackDownItem(client, item, ""); err != nil {
+			p.message = output.Red("✗ " + err.Error())
+			return
+		}
+		p.message = output.Green(fmt.Sprintf("✓ Acknowledged the incident for %s", item.describe()))
+	case downKeySnooze:
+		if err := snoozeDownItem(client, item, p.snoozeFor); err != nil {
+			p.message = output.Red("✗ " + err.Error())
+			return
+		}
+		p.message = output.Green(fmt.Sprintf("✓ Snoozed alerts for %s until %s", item.describe(), output.FormatTime(*item.SnoozedUntil)))
+	}
+}
+
+// render draws the picker, returning how many lines it took
+func (p *downPicker) render(w io.Writer) int {
+	nameWidth, problemWidth := 0, 0
+	for _, item := range p.items {
+		nameWidth = max(nameWidth, len(cmdutil.Truncate(item.Name, 30)))
+		problemWidth = max(problemWidth, len(cmdutil.Truncate(item.Problem, 40)))
+	}
+
+	lines := []string{
+		output.Bold(fmt.Sprintf("%s down", countNoun(len(p.items), "monitor", "monitors"))) + "  ↑/↓ move · a ack · s snooze " + formatDurationUnits(int(p.snoozeFor/time.Minute), time.Minute) + " · q quit",
+		"",
+	}
+	now := time.Now()
+	for i, item := range p.items {
+		cursor := " "
+		if i == p.cursor {
+			cursor = output.Cyan("›")
+		}
+		state := []string{"since " + downSince(item), "ack " + downAck(item)}
+		if snoozed := downSnoozed(item, now); snoozed != "-" {
+			state = append(state, "snoozed "+snoozed)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %-6s %-*s  %-*s  %s", cursor, output.Cyan(cmdutil.ShortID(item.ID)), item.Type,
+			nameWidth, cmdutil.Truncate(item.Name, 30), problemWidth, cmdutil.Truncate(item.Problem, 40), strings.Join(state, " · ")))
+	}
+	if p.message != "" {
+		lines = append(lines, "", p.message)
+	}
+
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	return len(lines)
+}
+
+// rawWriter ends lines with \r\n for a terminal in raw mode, which no
+// longer moves to the start of the line on \n
+type rawWriter struct {
+	io.Writer
+}
+
+func (w rawWriter) Write(p []byte) (int, error) {
+	if _, err := w.Writer.Write([]byte(strings.ReplaceAll(string(p), "\n", "\r\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func init() {
+	// Add flags to down command
+	downCmd.Flags().Bool("json", false, "Output as JSON")
+	downCmd.Flags().BoolP("interactive", "i", false, "Step through down monitors to acknowledge or snooze each")
+	addDurationFlag(downCmd, "snooze-for", 60, time.Minute, "How long s snoozes alerts for in --interactive")
+	addTableFlags(downCmd)
+
+	// Add flags to ack command
+	downAckCmd.Flags().String("note", "", "Note to leave on the incident, e.g. what is being done about it")
+
+	// Add subcommands
+	downCmd.AddCommand(downAckCmd)
+
+	// Add down command to root
+	rootCmd.AddCommand(downCmd)
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedDown adds a down job with an ongoing incident, a down API monitor
+// without one, and an up job to the fake API, returning the down IDs
+func seedDown(srv *apitest.Server) (job, monitor string) {
+	_, job = seedJobs(srv)
+	srv.Fake.Incidents[job] = []api.Incident{{ID: "inc-1", StartedAt: "2026-10-15T08:00:00Z"}}
+	monitor = seedApis(srv)
+	srv.Fake.Apis[0].Down = true
+	return job, monitor
+}
+
+// TestDownCommand tests listing down resources across types
+func TestDownCommand(t *testing.T) {
+	srv := startAPI(t)
+	job, monitor := seedDown(srv)
+
+	out := mustRun(t, "down")
+	assert.Contains(t, out, job[:8])
+	assert.Contains(t, out, "missed heartbeat")
+	assert.Contains(t, out, "2026-10-15 08:00:00 UTC")
+	assert.Contains(t, out, monitor[:8])
+	assert.Contains(t, out, "https://shop.example.com/health")
+	assert.NotContains(t, out, "Backup", "up resources aren't listed")
+	assert.Contains(t, out, "Total: 2 down")
+
+	var items []downItem
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "down", "--json")), &items))
+	require.Len(t, items, 2)
+	assert.Equal(t, "job", items[0].Type)
+	require.NotNil(t, items[0].Incident)
+	assert.Equal(t, "inc-1", items[0].Incident.ID)
+	assert.Nil(t, items[1].Incident)
+
+	srv.Fake.Jobs, srv.Fake.Apis = nil, nil
+	assert.Contains(t, mustRun(t, "down"), "Nothing is down")
+}
+
+// TestDownAckCommand tests acknowledging a down resource's incident by the
+// resource's ID
+func TestDownAckCommand(t *testing.T) {
+	srv := startAPI(t)
+	job, monitor := seedDown(srv)
+
+	out := mustRun(t, "down", "ack", job[:8], "--note", "rolling back")
+	assert.Contains(t, out, "Incident inc-1 for job Sync ("+job[:8]+") acknowledged")
+	assert.Contains(t, out, "Note: rolling back")
+	assert.NotNil(t, srv.Fake.Incidents[job][0].AcknowledgedAt)
+
+	assert.Contains(t, mustRun(t, "down"), "test@example.com")
+
+	_, _, err := runCommand(t, "down", "ack", monitor[:8])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "API monitor Checkout ("+monitor[:8]+") has no ongoing incident to acknowledge")
+
+	backup := srv.Fake.Jobs[0].ID
+	_, _, err = runCommand(t, "down", "ack", backup[:8])
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no down monitor found")
+}
+
+// TestDownInteractive tests stepping through down resources with keys read
+// from input that isn't a terminal
+func TestDownInteractive(t *testing.T) {
+	srv := startAPI(t)
+	job, _ := seedDown(srv)
+
+	// Acknowledge the job, move down with the arrow key, snooze the API
+	// monitor, and quit
+	out, _, err := runCommandWithInput(t, "a\x1b[Bsq", "down", "--interactive", "--snooze-for", "30m")
+	require.NoError(t, err)
+	assert.Contains(t, out, "2 monitors down")
+	assert.Contains(t, out, "✓ Acknowledged the incident for job Sync ("+job[:8]+")")
+	assert.Contains(t, out, "✓ Snoozed alerts for API monitor Checkout")
+	assert.Contains(t, out, "snoozed 30m")
+
+	assert.NotNil(t, srv.Fake.Incidents[job][0].AcknowledgedAt)
+	assert.NotNil(t, srv.Fake.Apis[0].SnoozedUntil)
+
+	// Acknowledging the API monitor fails without ending the session
+	out, _, err = runCommandWithInput(t, "ja", "down", "-i")
+	require.NoError(t, err)
+	assert.Contains(t, out, "✗ API monitor Checkout")
+
+	_, _, err = runCommand(t, "down", "-i", "--json")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestReadDownKey tests reading picker keys, including arrow key escape
+// sequences
+func TestReadDownKey(t *testing.T) {
+	in := bufio.NewReader(strings.NewReader("\x1b[A\x1b[Bjkasqx\x03"))
+	var keys []downKey
+	for {
+		key, err := readDownKey(in)
+		if err != nil {
+			break
+		}
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []downKey{downKeyUp, downKeyDown, downKeyDown, downKeyUp, downKeyAck, downKeySnooze, downKeyQuit, downKeyNone, downKeyQuit}, keys)
+
+	// A lone Esc quits
+	key, err := readDownKey(bufio.NewReader(strings.NewReader("\x1b")))
+	require.NoError(t, err)
+	assert.Equal(t, downKeyQuit, key)
+}
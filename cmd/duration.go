@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// durationValue is a flag holding a whole number of minutes, seconds, or
+// milliseconds, matching the API's fields. It accepts durations like 90s, 15m, 6h, 1d, or
+// 2w, and plain numbers in the flag's unit so existing scripts keep working.
+type durationValue struct {
+	n    *int
+	unit time.Duration
+}
+
+// durationUnits are the suffixes time.ParseDuration doesn't know
+var durationUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// addDurationFlag registers an interval, grace period, or timeout flag
+// measured in unit (time.Millisecond, time.Second, time.Minute, or a day)
+func addDurationFlag(c *cobra.Command, name string, value int, unit time.Duration, usage string) {
+	n := value
+	examples := "15m, 6h, or 1d"
+	switch unit {
+	case time.Millisecond:
+		examples = "800ms or 2s"
+	case time.Second:
+		examples = "30s or 2m"
+	case durationUnits["d"]:
+		examples = "30d or 2w"
+	}
+	usage = fmt.Sprintf("%s, e.g. %s (plain numbers are %s)", usage, examples, unitName(unit))
+	c.Flags().Var(&durationValue{n: &n, unit: unit}, name, usage)
+}
+
+// getDurationFlag returns a duration flag's value in its unit
+func getDurationFlag(cmd *cobra.Command, name string) int {
+	return *cmd.Flags().Lookup(name).Value.(*durationValue).n
+}
+
+// Set parses a duration or a plain number of units
+func (d *durationValue) Set(s string) error {
+	n, err := parseDurationUnits(s, d.unit)
+	if err != nil {
+		return err
+	}
+	*d.n = n
+	return nil
+}
+
+// String formats the value in the largest unit that divides it evenly, so
+// defaults read as 1h or 1d rather than 60 or 1440
+func (d *durationValue) String() string {
+	if d.n == nil || *d.n == 0 {
+		return "0"
+	}
+	return formatDurationUnits(*d.n, d.unit)
+}
+
+// Type names the flag's value in help output
+func (d *durationValue) Type() string {
+	return "duration"
+}
+
+// parseDurationUnits converts s to a whole number of unit
+func parseDurationUnits(s string, unit time.Duration) (int, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.Atoi(s); err == nil {
+		if n < 0 {
+			return 0, fmt.Errorf("must not be negative")
+		}
+		return n, nil
+	}
+
+	var d time.Duration
+	suffix := s[max(len(s)-1, 0):]
+	if size, ok := durationUnits[suffix]; ok {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, suffix))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		d = time.Duration(n) * size
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, fmt.Errorf("invalid duration %q, use e.g. 30s, 15m, 6h, or 1d", s)
+		}
+	}
+
+	if d < 0 {
+		return 0, fmt.Errorf("must not be negative")
+	}
+	if d%unit != 0 {
+		return 0, fmt.Errorf("%s is not a whole number of %s", s, unitName(unit))
+	}
+	return int(d / unit), nil
+}
+
+// formatDurationUnits formats n units compactly, e.g. 90 minutes as 90m,
+// 1440 minutes as 1d, and 800 milliseconds as 800ms
+func formatDurationUnits(n int, unit time.Duration) string {
+	d := time.Duration(n) * unit
+	for _, u := range []struct {
+		suffix string
+		size   time.Duration
+	}{{"w", 7 * 24 * time.Hour}, {"d", 24 * time.Hour}, {"h", time.Hour}, {"m", time.Minute}} {
+		if d%u.size == 0 {
+			return fmt.Sprintf("%d%s", d/u.size, u.suffix)
+		}
+	}
+	if d%time.Second != 0 {
+		return fmt.Sprintf("%dms", d/time.Millisecond)
+	}
+	return fmt.Sprintf("%ds", d/time.Second)
+}
+
+// unitName names a flag unit for messages
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Millisecond:
+		return "milliseconds"
+	case time.Second:
+		return "seconds"
+	case durationUnits["d"]:
+		return "days"
+	}
+	return "minutes"
+}
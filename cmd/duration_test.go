@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseDurationUnits tests converting durations to whole minutes and seconds
+func TestParseDurationUnits(t *testing.T) {
+	tests := []struct {
+		input string
+		unit  time.Duration
+		want  int
+	}{
+		{"15", time.Minute, 15},
+		{"15m", time.Minute, 15},
+		{"6h", time.Minute, 360},
+		{"1h30m", time.Minute, 90},
+		{"1d", time.Minute, 1440},
+		{"2w", time.Minute, 20160},
+		{"120s", time.Minute, 2},
+		{"30", time.Second, 30},
+		{"30s", time.Second, 30},
+		{"2m", time.Second, 120},
+		{"30", 24 * time.Hour, 30},
+		{"2w", 24 * time.Hour, 14},
+		{"800", time.Millisecond, 800},
+		{"800ms", time.Millisecond, 800},
+		{"1.5s", time.Millisecond, 1500},
+	}
+	for _, tt := range tests {
+		got, err := parseDurationUnits(tt.input, tt.unit)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+
+	_, err := parseDurationUnits("90s", time.Minute)
+	assert.EqualError(t, err, "90s is not a whole number of minutes")
+	_, err = parseDurationUnits("soon", time.Minute)
+	assert.EqualError(t, err, `invalid duration "soon", use e.g. 30s, 15m, 6h, or 1d`)
+	_, err = parseDurationUnits("xd", time.Minute)
+	assert.EqualError(t, err, `invalid duration "xd"`)
+	_, err = parseDurationUnits("36h", 24*time.Hour)
+	assert.EqualError(t, err, "36h is not a whole number of days")
+	_, err = parseDurationUnits("-5m", time.Minute)
+	assert.EqualError(t, err, "must not be negative")
+}
+
+// TestFormatDurationUnits tests showing defaults in the largest even unit
+func TestFormatDurationUnits(t *testing.T) {
+	assert.Equal(t, "1d", formatDurationUnits(1440, time.Minute))
+	assert.Equal(t, "1h", formatDurationUnits(60, time.Minute))
+	assert.Equal(t, "90m", formatDurationUnits(90, time.Minute))
+	assert.Equal(t, "1w", formatDurationUnits(10080, time.Minute))
+	assert.Equal(t, "30s", formatDurationUnits(30, time.Second))
+	assert.Equal(t, "30d", formatDurationUnits(30, 24*time.Hour))
+	assert.Equal(t, "800ms", formatDurationUnits(800, time.Millisecond))
+	assert.Equal(t, "2s", formatDurationUnits(2000, time.Millisecond))
+}
+
+// TestDurationFlag tests registering and reading a duration flag
+func TestDurationFlag(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	addDurationFlag(c, "interval", 60, time.Minute, "Check interval")
+
+	flag := c.Flags().Lookup("interval")
+	assert.Equal(t, "1h", flag.DefValue)
+	assert.Contains(t, flag.Usage, "plain numbers are minutes")
+	assert.Equal(t, 60, getDurationFlag(c, "interval"))
+
+	require.NoError(t, c.ParseFlags([]string{"--interval", "6h"}))
+	assert.Equal(t, 360, getDurationFlag(c, "interval"))
+
+	err := c.ParseFlags([]string{"--interval", "45s"})
+	assert.ErrorContains(t, err, "45s is not a whole number of minutes")
+}
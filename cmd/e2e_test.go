@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startAPI starts a fake API server and points commands run by runCommand
+// at it as a logged-in user, with an empty config directory and no colors
+func startAPI(t *testing.T) *apitest.Server {
+	t.Helper()
+	srv := apitest.NewServer(t)
+	dir := config.Dir()
+	config.SetDir(t.TempDir())
+	t.Cleanup(func() { config.SetDir(dir) })
+	t.Setenv("GROOVEKIT_API_URL", srv.URL)
+	t.Setenv("GROOVEKIT_TOKEN", srv.Token)
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("GROOVEKIT_TIMEZONE", "UTC")
+	return srv
+}
+
+// useTimezone displays times in the named zone until the test ends, for
+// tests that render rows without running a command
+func useTimezone(t *testing.T, name string) {
+	t.Helper()
+	loc, err := output.LoadTimezone(name)
+	require.NoError(t, err)
+	output.SetTimezone(loc)
+	t.Cleanup(func() { output.SetTimezone(time.Local) })
+}
+
+// runCommand runs the groovekit command line args the way main does,
+// returning what it printed to stdout and stderr
+func runCommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	return runCommandWithInput(t, "", args...)
+}
+
+// runCommandWithInput runs a command with stdin, e.g. answers to prompts
+func runCommandWithInput(t *testing.T, stdin string, args ...string) (string, string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetIn(strings.NewReader(stdin))
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	rootCmd.SetArgs(args)
+	defer func() {
+		rootCmd.SetIn(nil)
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+		resetFlags(rootCmd)
+		// Undo what the root command's setup changes for the process
+		listCacheEnabled = false
+		commandStarted = false
+		config.SetAPIURL("")
+	}()
+
+	_, err := rootCmd.ExecuteC()
+	return stdout.String(), stderr.String(), err
+}
+
+// mustRun runs a command that should succeed and returns its stdout
+func mustRun(t *testing.T, args ...string) string {
+	t.Helper()
+	stdout, stderr, err := runCommand(t, args...)
+	require.NoError(t, err, "groovekit %s\nstderr: %s", strings.Join(args, " "), stderr)
+	return stdout
+}
+
+// resetFlags puts every flag of c and its subcommands back to its default,
+// since cobra keeps parsed values between runs of the same command tree
+func resetFlags(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if !f.Changed && f.Value.String() == f.DefValue {
+			return
+		}
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			var values []string
+			if def := strings.Trim(f.DefValue, "[]"); def != "" {
+				values = strings.Split(def, ",")
+			}
+			_ = slice.Replace(values)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	c.Flags().VisitAll(reset)
+	c.PersistentFlags().VisitAll(reset)
+	for _, sub := range c.Commands() {
+		resetFlags(sub)
+	}
+}
+
+// TestRunCommand_ResetsFlags tests that flags from one run don't leak into
+// the next
+func TestRunCommand_ResetsFlags(t *testing.T) {
+	startAPI(t)
+
+	mustRun(t, "jobs", "list", "--json")
+	flag := jobsListCmd.Flags().Lookup("json")
+	assert.False(t, flag.Changed)
+	assert.Equal(t, "false", flag.Value.String())
+}
+
+// TestRunCommand_NotLoggedIn tests that commands fail without a token
+func TestRunCommand_NotLoggedIn(t *testing.T) {
+	startAPI(t)
+	t.Setenv("GROOVEKIT_TOKEN", "")
+
+	_, _, err := runCommand(t, "jobs", "list")
+	assert.ErrorIs(t, err, cmdutil.ErrNotLoggedIn)
+	assert.Equal(t, exitAuthError, exitCode(err))
+}
+
+// TestRunCommand_InvalidToken tests that the API rejecting the token maps
+// to the auth exit code
+func TestRunCommand_InvalidToken(t *testing.T) {
+	startAPI(t)
+	t.Setenv("GROOVEKIT_TOKEN", "expired")
+
+	_, _, err := runCommand(t, "apis", "list")
+	require.Error(t, err)
+	assert.Equal(t, exitAuthError, exitCode(err))
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// addEscalationFlags registers the flags controlling when a resource alerts
+// on its create and update commands
+func addEscalationFlags(c *cobra.Command) {
+	c.Flags().Int("alert-after", 0, "Consecutive failures before alerting (default: alert on the first failure)")
+	addDurationFlag(c, "realert-every", 0, time.Minute, "Repeat alerts this often while still down (0 alerts once)")
+}
+
+// getEscalation returns the --alert-after and --realert-every values, or nil
+// for those that weren't given
+func getEscalation(cmd *cobra.Command) (alertAfter, realertEvery *int, err error) {
+	if cmd.Flags().Changed("alert-after") {
+		n, _ := cmd.Flags().GetInt("alert-after")
+		if n < 1 {
+			return nil, nil, usageErrorf("--alert-after must be at least 1")
+		}
+		alertAfter = &n
+	}
+	if cmd.Flags().Changed("realert-every") {
+		every := getDurationFlag(cmd, "realert-every")
+		realertEvery = &every
+	}
+	return alertAfter, realertEvery, nil
+}
+
+// formatEscalation describes when a resource alerts, e.g. "after 3
+// consecutive failures, repeated every 30 minutes while down"
+func formatEscalation(alertAfter, realertEvery int) string {
+	when := "on the first failure"
+	if alertAfter > 1 {
+		when = fmt.Sprintf("after %d consecutive failures", alertAfter)
+	}
+	if realertEvery > 0 {
+		return fmt.Sprintf("%s, repeated every %s while down", when, output.FormatDuration(realertEvery))
+	}
+	return when + ", once"
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetEscalation tests reading --alert-after and --realert-every
+func TestGetEscalation(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	addEscalationFlags(c)
+
+	alertAfter, realertEvery, err := getEscalation(c)
+	require.NoError(t, err)
+	assert.Nil(t, alertAfter)
+	assert.Nil(t, realertEvery)
+
+	require.NoError(t, c.ParseFlags([]string{"--alert-after", "3", "--realert-every", "2h"}))
+	alertAfter, realertEvery, err = getEscalation(c)
+	require.NoError(t, err)
+	require.NotNil(t, alertAfter)
+	require.NotNil(t, realertEvery)
+	assert.Equal(t, 3, *alertAfter)
+	assert.Equal(t, 120, *realertEvery)
+
+	require.NoError(t, c.ParseFlags([]string{"--alert-after", "0"}))
+	_, _, err = getEscalation(c)
+	assert.ErrorContains(t, err, "--alert-after must be at least 1")
+}
+
+// TestEscalationFlagsRegistered tests that every create and update command
+// accepts the escalation flags
+func TestEscalationFlagsRegistered(t *testing.T) {
+	for _, c := range []*cobra.Command{
+		jobsCreateCmd, jobsUpdateCmd, apisCreateCmd, apisUpdateCmd, certsCreateCmd,
+		certsUpdateCmd, domainsCreateCmd, domainsUpdateCmd, dnsCreateCmd, dnsUpdateCmd,
+	} {
+		for _, name := range []string{"alert-after", "realert-every"} {
+			assert.NotNil(t, c.Flags().Lookup(name), "%s should have --%s", c.CommandPath(), name)
+		}
+	}
+}
+
+// TestFormatEscalation tests describing when a resource alerts
+func TestFormatEscalation(t *testing.T) {
+	assert.Equal(t, "on the first failure, once", formatEscalation(0, 0))
+	assert.Equal(t, "on the first failure, once", formatEscalation(1, 0))
+	assert.Equal(t, "after 3 consecutive failures, once", formatEscalation(3, 0))
+	assert.Equal(t, "after 3 consecutive failures, repeated every 30 minutes while down", formatEscalation(3, 30))
+}
@@ -0,0 +1,425 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Expiry levels, from a monitor's thresholds
+const (
+	expiryCritical = "critical"
+	expiryUrgent   = "urgent"
+	expiryWarning  = "warning"
+	expiryOK       = "ok"
+	expiryUnknown  = "unknown"
+)
+
+// expiringResource is a cert or domain with its expiration
+type expiringResource struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Domain    string `json:"domain"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+	DaysLeft  int    `json:"days_left"`
+	Level     string `json:"level"`
+	// InWindow is set when the item expires within --within
+	InWindow bool `json:"in_window"`
+	warning  int
+	urgent   int
+}
+
+// formatICS is the --format for an iCalendar file of expirations
+const formatICS = "ics"
+
+var expiringCmd = &cobra.Command{
+	Use:   "expiring",
+	Short: "List SSL certificates and domains by expiration",
+	Long: `List every monitored SSL certificate and domain, soonest expiration first,
+colored by each monitor's warning, urgent, and critical thresholds.
+
+Exits with status 6 when anything expires within --within (30 days by
+default), so a weekly cron job can nag about upcoming renewals. Monitors
+that haven't been checked yet are listed last and never fail the command.
+
+--format ics writes an iCalendar file instead, with an all-day event on
+each expiration date and reminders at the monitor's warning and urgent
+thresholds, to import or subscribe to in a team calendar. It doesn't fail
+on --within. --format slack prints what expires within --within as Slack
+Block Kit JSON, or posts it to an incoming webhook with --post-to.
+
+Examples:
+  groovekit expiring
+  groovekit expiring --within 14d
+  groovekit expiring --json
+  groovekit expiring --format ics > renewals.ics
+  groovekit expiring --format slack --post-to "$SLACK_WEBHOOK_URL"`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		within := getDurationFlag(cmd, "within")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		format, err := getFormat(cmd, formatText, formatICS, formatSlack)
+		if err != nil {
+			return err
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput && format == formatText {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		items, errs := fetchExpiringResources(cmd.Context(), client, within)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		// Nothing could be listed at all
+		if len(errs) == 2 {
+			return errs[0]
+		}
+		for _, err := range errs {
+			output.ErrorMessage(cmd.ErrOrStderr(), err.Error())
+		}
+
+		inWindow := 0
+		for _, item := range items {
+			if item.InWindow {
+				inWindow++
+			}
+		}
+
+		if jsonOutput {
+			err = cmdutil.OutputJSON(out, items)
+		} else if format == formatICS {
+			err = writeExpiringICS(out, items, time.Now())
+		} else if format == formatSlack {
+			err = emitSlack(cmd, expiringSlackMessage(items, within))
+		} else if len(items) == 0 {
+			output.InfoMessage(out, "No SSL certificates or domains are monitored")
+		} else {
+			err = printExpiringResources(cmd, items, inWindow, within)
+		}
+		if err != nil {
+			return err
+		}
+		if len(errs) > 0 {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return &exitCodeError{code: exitGeneric}
+		}
+		if inWindow > 0 && format != formatICS && failOn(cmd, failLevelDown) {
+			return resourceDown(cmd)
+		}
+		return nil
+	},
+}
+
+// fetchExpiringResources lists certs and domains concurrently, soonest
+// expiration first, marking those expiring within the given number of days
+func fetchExpiringResources(ctx context.Context, client api.APIClient, within int) ([]expiringResource, []error) {
+	var certs *api.SslMonitorsResponse
+	var domains *api.DomainMonitorsResponse
+	fetches := []func() error{
+		func() (err error) { certs, err = client.ListAllCerts(nil); return err },
+		func() (err error) { domains, err = client.ListAllDomains(nil); return err },
+	}
+	fetchErrs := api.Each(ctx, len(fetches), api.DefaultConcurrency, func(_ context.Context, i int) error {
+		return fetches[i]()
+	})
+
+	var errs []error
+	if fetchErrs[0] != nil {
+		errs = append(errs, fmt.Errorf("failed to list certs: %w", fetchErrs[0]))
+	}
+	if fetchErrs[1] != nil {
+		errs = append(errs, fmt.Errorf("failed to list domains: %w", fetchErrs[1]))
+	}
+
+	items := []expiringResource{}
+	if certs != nil {
+		for _, cert := range certs.SslMonitors {
+			items = append(items, newExpiringResource("cert", cert.ID, cert.Name, cert.Domain, cert.CertificateExpiresAt, certExpiry(cert), within))
+		}
+	}
+	if domains != nil {
+		for _, domain := range domains.DomainMonitors {
+			items = append(items, newExpiringResource("domain", domain.ID, domain.Name, domain.Domain, domain.ExpiresAt, domainExpiry(domain), within))
+		}
+	}
+	sortExpiringResources(items)
+	return items, errs
+}
+
+// newExpiringResource builds the row for a cert or domain. One with no
+// expiration hasn't been checked yet, so its level is unknown.
+func newExpiringResource(kind, id, name, domain, expiresAt string, expiry monitorExpiry, within int) expiringResource {
+	item := expiringResource{Type: kind, ID: id, Name: name, Domain: domain, ExpiresAt: expiresAt, DaysLeft: expiry.daysLeft, Level: expiryUnknown, warning: expiry.warningDays(), urgent: expiry.urgent}
+	if expiresAt == "" {
+		return item
+	}
+	item.Level = expiry.level()
+	item.InWindow = expiry.daysLeft <= within
+	return item
+}
+
+// monitorExpiry is the expiry state of a cert or domain monitor. Every
+// command that judges expiry goes through it, so they agree on what counts
+// as expiring or down.
+type monitorExpiry struct {
+	status              string
+	lastCheckAt         string
+	consecutiveFailures int
+	daysLeft            int
+	warning             int
+	urgent              int
+	critical            int
+}
+
+// certExpiry returns a cert monitor's expiry state
+func certExpiry(cert api.SslMonitor) monitorExpiry {
+	return monitorExpiry{
+		status:              cert.Status,
+		lastCheckAt:         cert.LastCheckAt,
+		consecutiveFailures: cert.ConsecutiveFailures,
+		daysLeft:            cert.DaysUntilExpiration,
+		warning:             cert.WarningThreshold,
+		urgent:              cert.UrgentThreshold,
+		critical:            cert.CriticalThreshold,
+	}
+}
+
+// domainExpiry returns a domain monitor's expiry state
+func domainExpiry(domain api.DomainMonitor) monitorExpiry {
+	return monitorExpiry{
+		status:              domain.Status,
+		lastCheckAt:         domain.LastCheckAt,
+		consecutiveFailures: domain.ConsecutiveFailures,
+		daysLeft:            domain.DaysUntilExpiration,
+		warning:             domain.WarningThreshold,
+		urgent:              domain.UrgentThreshold,
+		critical:            domain.CriticalThreshold,
+	}
+}
+
+// checked reports whether the monitor has been checked; until then days
+// left is 0 and means nothing
+func (e monitorExpiry) checked() bool {
+	return e.lastCheckAt != ""
+}
+
+// warningDays is the warning threshold, or the default when none is set
+func (e monitorExpiry) warningDays() int {
+	if e.warning <= 0 {
+		return defaultExpiryWarningDays
+	}
+	return e.warning
+}
+
+// level places days left against the monitor's thresholds
+func (e monitorExpiry) level() string {
+	switch {
+	case e.daysLeft <= e.critical:
+		return expiryCritical
+	case e.daysLeft <= e.urgent:
+		return expiryUrgent
+	case e.daysLeft <= e.warningDays():
+		return expiryWarning
+	}
+	return expiryOK
+}
+
+// expiring reports whether a checked, active monitor is inside its warning
+// threshold
+func (e monitorExpiry) expiring() bool {
+	return e.checked() && e.status != "paused" && e.level() != expiryOK
+}
+
+// down reports whether the monitor counts as down: checks are failing or
+// it is inside its critical threshold
+func (e monitorExpiry) down() bool {
+	return e.consecutiveFailures > 0 || (e.checked() && e.daysLeft <= e.critical)
+}
+
+// problem describes why the monitor is down
+func (e monitorExpiry) problem() string {
+	if e.consecutiveFailures > 0 {
+		return fmt.Sprintf("check failing (%s)", countNoun(e.consecutiveFailures, "failure", "failures"))
+	}
+	return fmt.Sprintf("%d days left", e.daysLeft)
+}
+
+// sortExpiringResources orders items soonest expiration first, with
+// unchecked ones last
+func sortExpiringResources(items []expiringResource) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if (a.Level == expiryUnknown) != (b.Level == expiryUnknown) {
+			return b.Level == expiryUnknown
+		}
+		if a.DaysLeft != b.DaysLeft {
+			return a.DaysLeft < b.DaysLeft
+		}
+		return a.Name < b.Name
+	})
+}
+
+// formatExpiryDays colors days left by expiry level, as certs list and
+// domains list do
+func formatExpiryDays(item expiringResource) string {
+	if item.Level == expiryUnknown {
+		return "-"
+	}
+	days := fmt.Sprintf("%d", item.DaysLeft)
+	switch item.Level {
+	case expiryCritical:
+		return output.Red(days)
+	case expiryUrgent, expiryWarning:
+		return output.Yellow(days)
+	}
+	return output.Green(days)
+}
+
+// printExpiringResources renders the expiring table and a summary of what
+// falls inside the window
+func printExpiringResources(cmd *cobra.Command, items []expiringResource, inWindow, within int) error {
+	table, err := newListTable(cmd, []string{"TYPE", "ID", "NAME", "DOMAIN", "EXPIRES", "DAYS LEFT", "LEVEL"})
+	if err != nil {
+		return err
+	}
+	table.Render()
+	for _, item := range items {
+		expires := "-"
+		if len(item.ExpiresAt) >= 10 {
+			expires = item.ExpiresAt[:10]
+		}
+		table.Append([]string{item.Type, output.Cyan(cmdutil.ShortID(item.ID)), item.Name, item.Domain, expires, formatExpiryDays(item), item.Level})
+	}
+	table.Flush()
+
+	window := countNoun(within, "day", "days")
+	if inWindow > 0 {
+		output.TotalMessage(cmd.OutOrStdout(), output.Red(fmt.Sprintf("%s expiring within %s", countNoun(inWindow, "certificate or domain", "certificates and domains"), window)))
+	} else {
+		output.TotalMessage(cmd.OutOrStdout(), fmt.Sprintf("Nothing expires within %s", window))
+	}
+	return nil
+}
+
+// writeExpiringICS writes an iCalendar file with an all-day event on each
+// expiration date, reminding at the warning and urgent thresholds. Items
+// that haven't been checked yet are left out.
+func writeExpiringICS(w io.Writer, items []expiringResource, now time.Time) error {
+	var lines []string
+	add := func(format string, args ...any) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	add("BEGIN:VCALENDAR")
+	add("VERSION:2.0")
+	add("PRODID:-//GrooveKit//groovekit-cli//EN")
+	add("CALSCALE:GREGORIAN")
+	add("X-WR-CALNAME:GrooveKit renewals")
+	for _, item := range items {
+		if item.Level == expiryUnknown || len(item.ExpiresAt) < 10 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", item.ExpiresAt[:10])
+		if err != nil {
+			continue
+		}
+
+		noun, command := "SSL certificate", "certs"
+		if item.Type == "domain" {
+			noun, command = "domain", "domains"
+		}
+		subject := fmt.Sprintf("%s %s", noun, item.Domain)
+
+		add("BEGIN:VEVENT")
+		add("UID:%s-%s@groovekit", item.Type, item.ID)
+		add("DTSTAMP:%s", now.UTC().Format("20060102T150405Z"))
+		add("DTSTART;VALUE=DATE:%s", date.Format("20060102"))
+		add("DTEND;VALUE=DATE:%s", date.AddDate(0, 0, 1).Format("20060102"))
+		add("SUMMARY:%s", icsEscape("Renew "+subject))
+		add("DESCRIPTION:%s", icsEscape(fmt.Sprintf("The %s monitored by %s (%s) expires on %s.\nDetails: groovekit %s show %s",
+			subject, item.Name, cmdutil.ShortID(item.ID), date.Format("2006-01-02"), command, cmdutil.ShortID(item.ID))))
+		add("TRANSP:TRANSPARENT")
+		for _, days := range icsAlarmDays(item) {
+			add("BEGIN:VALARM")
+			add("ACTION:DISPLAY")
+			add("TRIGGER:-P%dD", days)
+			add("DESCRIPTION:%s", icsEscape(fmt.Sprintf("Renew %s: expires in %s", subject, countNoun(days, "day", "days"))))
+			add("END:VALARM")
+		}
+		add("END:VEVENT")
+	}
+	add("END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, icsFold(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// icsAlarmDays returns how many days before expiration to remind: at the
+// warning threshold and, when it's earlier, the urgent one
+func icsAlarmDays(item expiringResource) []int {
+	days := []int{item.warning}
+	if item.urgent > 0 && item.urgent < item.warning {
+		days = append(days, item.urgent)
+	}
+	return days
+}
+
+// icsEscape escapes an iCalendar text value
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// icsFold splits a content line into 75-octet lines, continuing each with
+// a leading space, without breaking UTF-8 sequences
+func icsFold(line string) string {
+	const limit = 75
+	var folded strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			folded.WriteString("\r\n ")
+			width = 1
+		}
+		folded.WriteRune(r)
+		width += size
+	}
+	return folded.String()
+}
+
+func init() {
+	addDurationFlag(expiringCmd, "within", 30, 24*time.Hour, "Fail when anything expires within this long")
+	expiringCmd.Flags().Bool("json", false, "Output as JSON")
+	expiringCmd.Flags().String("format", formatText, "Output format: text, ics for an iCalendar file of renewal dates, or slack for Slack Block Kit JSON")
+	addPostToFlag(expiringCmd)
+	addTableFlags(expiringCmd)
+
+	rootCmd.AddCommand(expiringCmd)
+}
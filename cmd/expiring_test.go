@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExpiringCommand tests the expiring command structure
+func TestExpiringCommand(t *testing.T) {
+	assert.Equal(t, "expiring", expiringCmd.Use)
+	for _, name := range []string{"within", "json", "format", "sort", "columns"} {
+		assert.NotNil(t, expiringCmd.Flags().Lookup(name), "should have --%s flag", name)
+	}
+	assert.Equal(t, "30d", expiringCmd.Flags().Lookup("within").DefValue)
+}
+
+// TestMonitorExpiryLevel tests placing days left against a monitor's
+// thresholds
+func TestMonitorExpiryLevel(t *testing.T) {
+	level := func(daysLeft, warning, urgent, critical int) string {
+		return monitorExpiry{daysLeft: daysLeft, warning: warning, urgent: urgent, critical: critical}.level()
+	}
+	assert.Equal(t, expiryCritical, level(5, 30, 14, 7))
+	assert.Equal(t, expiryCritical, level(-2, 30, 14, 7))
+	assert.Equal(t, expiryUrgent, level(10, 30, 14, 7))
+	assert.Equal(t, expiryWarning, level(30, 30, 14, 7))
+	assert.Equal(t, expiryOK, level(31, 30, 14, 7))
+
+	// No warning threshold falls back to the default
+	assert.Equal(t, expiryWarning, level(20, 0, 0, 0))
+}
+
+// TestMonitorExpiryDown tests when certs and domains count as down
+func TestMonitorExpiryDown(t *testing.T) {
+	checked := "2026-03-01T00:00:00Z"
+	assert.True(t, monitorExpiry{consecutiveFailures: 2, lastCheckAt: checked, daysLeft: 90, critical: 7}.down())
+	assert.True(t, monitorExpiry{lastCheckAt: checked, daysLeft: 5, critical: 7}.down())
+	assert.False(t, monitorExpiry{critical: 7}.down())
+	assert.False(t, monitorExpiry{lastCheckAt: checked, daysLeft: 90, critical: 7}.down())
+
+	assert.Equal(t, "check failing (2 failures)", monitorExpiry{consecutiveFailures: 2, daysLeft: 90}.problem())
+	assert.Equal(t, "5 days left", monitorExpiry{daysLeft: 5}.problem())
+}
+
+// TestMonitorExpiryExpiring tests that unchecked and paused monitors are
+// never expiring
+func TestMonitorExpiryExpiring(t *testing.T) {
+	checked := "2026-03-01T00:00:00Z"
+	assert.True(t, monitorExpiry{status: "active", lastCheckAt: checked, daysLeft: 20, warning: 30}.expiring())
+	assert.False(t, monitorExpiry{status: "active", lastCheckAt: checked, daysLeft: 60, warning: 30}.expiring())
+	assert.False(t, monitorExpiry{status: "active", daysLeft: 0, critical: 7}.expiring())
+	assert.False(t, monitorExpiry{status: "paused", lastCheckAt: checked, daysLeft: 3, critical: 7}.expiring())
+}
+
+// TestNewExpiringResource tests marking items inside the --within window
+func TestNewExpiringResource(t *testing.T) {
+	item := newExpiringResource("cert", "c1", "API", "api.example.com", "2026-10-20T00:00:00Z", monitorExpiry{daysLeft: 12, warning: 30, urgent: 14, critical: 7}, 14)
+	assert.Equal(t, expiryUrgent, item.Level)
+	assert.True(t, item.InWindow)
+
+	item = newExpiringResource("domain", "d1", "example.com", "example.com", "2027-01-01", monitorExpiry{daysLeft: 60, warning: 30, urgent: 14, critical: 7}, 14)
+	assert.Equal(t, expiryOK, item.Level)
+	assert.False(t, item.InWindow)
+
+	// Not checked yet
+	item = newExpiringResource("domain", "d2", "new.example", "new.example", "", monitorExpiry{daysLeft: 0, warning: 30, urgent: 14, critical: 7}, 14)
+	assert.Equal(t, expiryUnknown, item.Level)
+	assert.False(t, item.InWindow)
+}
+
+// TestSortExpiringResources tests ordering soonest first with unchecked last
+func TestSortExpiringResources(t *testing.T) {
+	items := []expiringResource{
+		{Name: "unchecked", Level: expiryUnknown},
+		{Name: "later", DaysLeft: 90, Level: expiryOK},
+		{Name: "b-soon", DaysLeft: 3, Level: expiryCritical},
+		{Name: "a-soon", DaysLeft: 3, Level: expiryCritical},
+	}
+	sortExpiringResources(items)
+
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"a-soon", "b-soon", "later", "unchecked"}, names)
+}
+
+// TestWriteExpiringICS tests the iCalendar export of expirations
+func TestWriteExpiringICS(t *testing.T) {
+	items := []expiringResource{
+		newExpiringResource("cert", "c1234567-aaaa", "Checkout", "api.example.com", "2026-10-20T12:00:00Z", monitorExpiry{daysLeft: 5, warning: 30, urgent: 14, critical: 7}, 30),
+		newExpiringResource("domain", "d1234567-bbbb", "Example", "example.com", "2027-01-01", monitorExpiry{daysLeft: 78, warning: 60, critical: 7}, 30),
+		newExpiringResource("domain", "d7654321-cccc", "New", "new.example", "", monitorExpiry{daysLeft: 0, warning: 30, urgent: 14, critical: 7}, 30),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeExpiringICS(&buf, items, time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)))
+	ics := buf.String()
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 2, strings.Count(ics, "BEGIN:VEVENT"), "unchecked items are left out")
+
+	assert.Contains(t, ics, "UID:cert-c1234567-aaaa@groovekit\r\n")
+	assert.Contains(t, ics, "DTSTAMP:20261015T090000Z\r\n")
+	assert.Contains(t, ics, "DTSTART;VALUE=DATE:20261020\r\nDTEND;VALUE=DATE:20261021\r\n")
+	assert.Contains(t, ics, "SUMMARY:Renew SSL certificate api.example.com\r\n")
+	assert.Contains(t, ics, "TRIGGER:-P30D\r\n")
+	assert.Contains(t, ics, "TRIGGER:-P14D\r\n")
+
+	assert.Contains(t, ics, "DTSTART;VALUE=DATE:20270101\r\n")
+	assert.Contains(t, ics, "TRIGGER:-P60D\r\n")
+	assert.Equal(t, 3, strings.Count(ics, "BEGIN:VALARM"), "the domain has no urgent threshold")
+
+	for _, line := range strings.Split(ics, "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+}
+
+// TestICSEscapeAndFold tests escaping text values and folding long lines
+func TestICSEscapeAndFold(t *testing.T) {
+	assert.Equal(t, `a\, b\; c\\d\nnext`, icsEscape("a, b; c\\d\nnext"))
+
+	assert.Equal(t, "short", icsFold("short"))
+	folded := icsFold(strings.Repeat("x", 80))
+	assert.Equal(t, strings.Repeat("x", 75)+"\r\n "+strings.Repeat("x", 5), folded)
+
+	// Multi-byte characters aren't split across lines
+	folded = icsFold(strings.Repeat("x", 74) + "é")
+	assert.Equal(t, strings.Repeat("x", 74)+"\r\n é", folded)
+}
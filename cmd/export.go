@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/hcl"
+	"github.com/spf13/cobra"
+)
+
+// formatTerraform is the --format value for Terraform configuration
+const formatTerraform = "terraform"
+
+// accountExport is every resource in the account
+type accountExport struct {
+	Jobs           []api.Job           `json:"jobs"`
+	APIMonitors    []api.ApiMonitor    `json:"api_monitors"`
+	SslMonitors    []api.SslMonitor    `json:"ssl_monitors"`
+	DomainMonitors []api.DomainMonitor `json:"domain_monitors"`
+	DnsMonitors    []api.DnsMonitor    `json:"dns_monitors"`
+}
+
+// terraformResource describes how a resource type is exported as Terraform
+// resources
+type terraformResource struct {
+	// key is the resource type's list in accountExport's JSON
+	key          string
+	resourceType string
+	target       bulkTarget
+	// fields are the settable fields written as attributes, using the
+	// API's field names
+	fields []string
+}
+
+// terraformCommonFields are settable on every resource type
+var terraformCommonFields = []string{"tags", "project_id", "notification_channel_ids"}
+
+// terraformResources are the resource types export writes, in order.
+// Webhook secrets are left out and API monitor headers are read from a
+// variable, so credentials don't end up in plain text.
+var terraformResources = []terraformResource{
+	{"jobs", "groovekit_job", jobsBulkTarget, slices.Concat([]string{
+		"name", "interval", "grace_period", "alert_after", "realert_every", "schedule", "status",
+		"webhook_url", "allowed_ips",
+	}, terraformCommonFields)},
+	{"api_monitors", "groovekit_api_monitor", apisBulkTarget, slices.Concat(
+		slices.DeleteFunc(slices.Clone(monitorDefinitionFields), func(field string) bool { return slices.Contains(terraformCommonFields, field) }),
+		terraformCommonFields)},
+	{"ssl_monitors", "groovekit_ssl_monitor", certsBulkTarget, slices.Concat([]string{
+		"name", "domain", "port", "resolve_ip", "sni", "check_interval", "grace_period", "alert_after", "realert_every",
+		"warning_threshold", "urgent_threshold", "critical_threshold", "status",
+	}, terraformCommonFields)},
+	{"domain_monitors", "groovekit_domain_monitor", domainsBulkTarget, slices.Concat([]string{
+		"name", "domain", "check_interval", "grace_period", "alert_after", "realert_every",
+		"warning_threshold", "urgent_threshold", "critical_threshold", "status",
+	}, terraformCommonFields)},
+	{"dns_monitors", "groovekit_dns_monitor", dnsBulkTarget, slices.Concat([]string{
+		"name", "domain", "record_type", "expected_values", "match_mode", "nameserver", "check_interval",
+		"grace_period", "alert_after", "realert_every", "status",
+	}, terraformCommonFields)},
+}
+
+// fetchAccountExport lists every resource in the account, failing if any
+// type can't be listed so the export is never silently incomplete
+func fetchAccountExport(ctx context.Context, client api.APIClient) (*accountExport, error) {
+	res, errs := fetchAccountResources(ctx, client, nil)
+	for _, kind := range statusKinds {
+		if err := errs[kind]; err != nil {
+			return nil, err
+		}
+	}
+	return &accountExport{
+		Jobs:           res.jobs.Jobs,
+		APIMonitors:    res.apis.APIMonitors,
+		SslMonitors:    res.certs.SslMonitors,
+		DomainMonitors: res.domains.DomainMonitors,
+		DnsMonitors:    res.dns.DnsMonitors,
+	}, nil
+}
+
+// terraformBlocks renders the resources as a resource block and an import
+// block each, after the provider requirement
+func terraformBlocks(export *accountExport) ([]hcl.Block, error) {
+	// Round-trip through JSON to read fields by their API names
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	var lists map[string][]map[string]any
+	if err := json.Unmarshal(data, &lists); err != nil {
+		return nil, err
+	}
+
+	blocks := []hcl.Block{{Type: "terraform", Blocks: []hcl.Block{{
+		Type:       "required_providers",
+		Attributes: []hcl.Attribute{{Name: "groovekit", Value: map[string]any{"source": "scookdev/groovekit"}}},
+	}}}}
+	for _, res := range terraformResources {
+		labels := map[string]bool{}
+		for _, item := range lists[res.key] {
+			id, _ := item["id"].(string)
+			name, _ := item["name"].(string)
+
+			base := hcl.Identifier(name, strings.TrimPrefix(res.resourceType, "groovekit_"))
+			label := base
+			for n := 2; labels[label]; n++ {
+				label = fmt.Sprintf("%s_%d", base, n)
+			}
+			labels[label] = true
+
+			comment := res.target.noun + " " + name + " (" + cmdutil.ShortID(id) + ")"
+			if secret, _ := item["webhook_secret"].(string); secret != "" {
+				comment += "\nwebhook_secret isn't exported; set it from a sensitive variable"
+			}
+			block := hcl.Block{Type: "resource", Labels: []string{res.resourceType, label}}
+			for _, field := range res.fields {
+				value, ok := terraformValue(item[field])
+				if !ok {
+					continue
+				}
+				if field == "headers" {
+					// Header values are often credentials
+					variable := label + "_headers"
+					comment += "\nheaders come from var." + variable + "; declare it as a sensitive variable"
+					value = hcl.Expr("var." + variable)
+				}
+				block.Attributes = append(block.Attributes, hcl.Attribute{Name: field, Value: value})
+			}
+			block.Comment = comment
+			blocks = append(blocks, block, hcl.Block{Type: "import", Attributes: []hcl.Attribute{
+				{Name: "to", Value: hcl.Expr(res.resourceType + "." + label)},
+				{Name: "id", Value: id},
+			}})
+		}
+	}
+	return blocks, nil
+}
+
+// terraformValue reports whether a field is worth writing: unset fields,
+// empty strings, and empty lists and maps are left to the provider's
+// defaults
+func terraformValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case []any:
+		return v, len(v) > 0
+	case map[string]any:
+		return v, len(v) > 0
+	}
+	return value, true
+}
+
+// writeTerraform writes the account as Terraform configuration
+func writeTerraform(w io.Writer, export *accountExport) error {
+	blocks, err := terraformBlocks(export)
+	if err != nil {
+		return err
+	}
+	return hcl.Write(w, blocks)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export every resource in the account",
+	Long: `Export every job, API monitor, SSL certificate, domain, and DNS monitor in
+the account, as JSON by default.
+
+With --format terraform, the resources are written as HCL for the GrooveKit
+Terraform provider, to move a fleet built by hand into infrastructure as
+code: a resource block for each resource, with its settable fields, and an
+import block with its ID, so terraform plan adopts the existing resources
+instead of creating new ones. Resource names come from the resources'
+names. API monitor headers are read from a variable per monitor, so their
+values stay out of the file.
+
+Job webhook secrets aren't exported in either format; in Terraform, the
+job's block says when one needs setting. In JSON, API monitor header values
+are [redacted], as by apis show.
+
+Examples:
+  groovekit export > groovekit.json
+  groovekit export --format terraform -o groovekit.tf`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		format, _ := cmd.Flags().GetString("format")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		switch {
+		case format != formatJSON && format != formatTerraform:
+			return usageErrorf("invalid --format %q: must be json or terraform", format)
+		case jsonOutput && format != formatJSON:
+			return usageErrorf("--format %s and --json can't be combined", format)
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		export, err := fetchAccountExport(cmd.Context(), client)
+		s.Stop()
+
+		if err != nil {
+			return err
+		}
+
+		if format == formatTerraform {
+			return writeTerraform(out, export)
+		}
+		for i := range export.Jobs {
+			export.Jobs[i].WebhookSecret = ""
+		}
+		for i, monitor := range export.APIMonitors {
+			if headers := monitorHeaders(monitor.Headers); headers != nil {
+				export.APIMonitors[i].Headers = redactHeaders(headers)
+			}
+		}
+		return cmdutil.OutputJSON(out, export)
+	},
+}
+
+func init() {
+	// Add flags to export command
+	exportCmd.Flags().String("format", formatJSON, "Output format: json, or terraform for Terraform configuration")
+	exportCmd.Flags().Bool("json", false, "Output as JSON, the default")
+	addOutputFileFlag(exportCmd)
+
+	// Add export command to root
+	rootCmd.AddCommand(exportCmd)
+}
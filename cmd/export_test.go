@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportCommand tests exporting every resource as JSON
+func TestExportCommand(t *testing.T) {
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+	checkout := seedApis(srv)
+	srv.Fake.Jobs[0].WebhookSecret = "s3cret"
+	srv.Fake.Apis[0].Headers = map[string]any{"Authorization": "Bearer tok"}
+
+	out := mustRun(t, "export")
+	assert.NotContains(t, out, "s3cret")
+	assert.NotContains(t, out, "Bearer tok")
+
+	var export accountExport
+	require.NoError(t, json.Unmarshal([]byte(out), &export))
+	require.Len(t, export.Jobs, 2)
+	assert.Equal(t, backup, export.Jobs[0].ID)
+	require.Len(t, export.APIMonitors, 1)
+	assert.Equal(t, checkout, export.APIMonitors[0].ID)
+	assert.Empty(t, export.SslMonitors)
+	assert.Empty(t, export.Jobs[0].WebhookSecret, "webhook secrets aren't exported")
+	assert.Equal(t, map[string]any{"Authorization": redactedValue}, export.APIMonitors[0].Headers)
+
+	path := filepath.Join(t.TempDir(), "groovekit.json")
+	mustRun(t, "export", "-o", path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"api_monitors"`)
+
+	_, _, err = runCommand(t, "export", "--format", "yaml")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestExportTerraform tests rendering resources as Terraform resource and
+// import blocks
+func TestExportTerraform(t *testing.T) {
+	srv := startAPI(t)
+	backup, sync := seedJobs(srv)
+	checkout := seedApis(srv)
+	srv.Fake.Jobs[1].Name = "Backup"
+	srv.Fake.Jobs[1].WebhookSecret = "s3cret"
+	srv.Fake.Jobs[0].Tags = []string{"team=ops"}
+	srv.Fake.Apis[0].Headers = map[string]any{"Authorization": "Bearer tok"}
+
+	out := mustRun(t, "export", "--format", "terraform")
+	assert.Contains(t, out, `source = "scookdev/groovekit"`)
+
+	assert.Contains(t, out, "# job Backup ("+backup[:8]+")\n"+`resource "groovekit_job" "backup" {`)
+	assert.Contains(t, out, `  tags          = ["team=ops"]`)
+	assert.Contains(t, out, "import {\n  to = groovekit_job.backup\n  id = \""+backup+"\"\n}")
+
+	// Repeated names get numbered labels, and secrets are only mentioned
+	assert.Contains(t, out, `resource "groovekit_job" "backup_2" {`)
+	assert.Contains(t, out, "  to = groovekit_job.backup_2\n  id = \""+sync+"\"")
+	assert.Contains(t, out, "# webhook_secret isn't exported")
+	assert.NotContains(t, out, "s3cret")
+
+	assert.Contains(t, out, `resource "groovekit_api_monitor" "checkout" {`)
+	assert.Contains(t, out, `url                   = "https://shop.example.com/health"`)
+	assert.Contains(t, out, "id = \""+checkout+"\"")
+	assert.NotContains(t, out, "last_check_at")
+	assert.NotContains(t, out, "ping_token")
+
+	// Header values come from a variable
+	assert.Contains(t, out, "# headers come from var.checkout_headers; declare it as a sensitive variable")
+	assert.Contains(t, out, "headers               = var.checkout_headers")
+	assert.NotContains(t, out, "Bearer tok")
+
+	_, _, err := runCommand(t, "export", "--format", "terraform", "--json")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useFakeAPI makes commands talk to an in-memory fake API as a logged-in
+// user for the rest of the test
+func useFakeAPI(t *testing.T) *testutil.FakeAPI {
+	t.Helper()
+	t.Setenv("GROOVEKIT_TOKEN", "test-token")
+
+	fake := testutil.NewFakeAPI()
+	previous := cmdutil.SetClientFactory(func(*config.Config) api.APIClient { return fake })
+	t.Cleanup(func() { cmdutil.SetClientFactory(previous) })
+	return fake
+}
+
+// TestAuthenticatedClient_Fake tests that commands get the client from
+// cmdutil's client factory
+func TestAuthenticatedClient_Fake(t *testing.T) {
+	fake := useFakeAPI(t)
+
+	client, err := cmdutil.AuthenticatedClient()
+	require.NoError(t, err)
+	assert.Same(t, fake, client)
+}
+
+// TestResolveJobID_Fake tests resolving short job IDs against the API's
+// listing
+func TestResolveJobID_Fake(t *testing.T) {
+	fake := useFakeAPI(t)
+	fake.Jobs = []api.Job{
+		{ID: "abc12345-0000-4000-8000-000000000001", Name: "Backup"},
+		{ID: "abc99999-0000-4000-8000-000000000002", Name: "Report"},
+	}
+
+	id, err := cmdutil.ResolveJobID(fake, "abc12")
+	require.NoError(t, err)
+	assert.Equal(t, "abc12345-0000-4000-8000-000000000001", id)
+
+	_, err = cmdutil.ResolveJobID(fake, "abc")
+	assert.Error(t, err)
+
+	// Resolvers are cached per client, so a failing listing needs a new one
+	failing := useFakeAPI(t)
+	failing.Errors["ListAllJobs"] = errors.New("boom")
+	_, err = cmdutil.ResolveJobID(failing, "abc12")
+	assert.ErrorContains(t, err, "boom")
+}
+
+// TestResolverKinds_Fake tests that every kind of resource cmdutil resolves
+// IDs of has a registered resolver
+func TestResolverKinds_Fake(t *testing.T) {
+	fake := useFakeAPI(t)
+	kinds := []string{
+		cmdutil.KindJobs, cmdutil.KindAPIMonitors, cmdutil.KindCerts, cmdutil.KindDomains,
+		cmdutil.KindDNSMonitors, cmdutil.KindProjects, cmdutil.KindIncidents,
+	}
+	for _, kind := range kinds {
+		assert.NotNil(t, cmdutil.ResolverFor(fake, kind), kind)
+	}
+}
+
+// TestBuildStatusSummary_Fake tests aggregating health across resource
+// types, including the ongoing incidents of down resources
+func TestBuildStatusSummary_Fake(t *testing.T) {
+	fake := useFakeAPI(t)
+	fake.Jobs = []api.Job{{ID: "job-1", Name: "Backup", Down: true}, {ID: "job-2", Name: "Sync"}}
+	fake.Apis = []api.ApiMonitor{{ID: "api-1", Name: "Checkout"}}
+	fake.Certs = []api.SslMonitor{
+		{ID: "cert-1", Name: "x.io", LastCheckAt: "2026-10-15T06:00:00Z", DaysUntilExpiration: 5, WarningThreshold: 30, CriticalThreshold: 7},
+		// Not checked yet, so 0 days left means nothing
+		{ID: "cert-2", Name: "new.io", CriticalThreshold: 7},
+	}
+	fake.Incidents["job-1"] = []api.Incident{{ID: "inc-1", StartedAt: "2026-10-15T08:00:00Z"}}
+
+	summary := buildStatusSummary(context.Background(), fake, nil)
+	assert.Equal(t, map[string]int{"jobs": 2, "apis": 1, "certs": 2, "domains": 0, "dns": 0}, summary.Counts)
+	require.Len(t, summary.Down, 1)
+	assert.Equal(t, "Backup", summary.Down[0].Name)
+	require.Len(t, summary.Expiring, 1)
+	assert.Equal(t, "x.io", summary.Expiring[0].Name)
+	assert.True(t, summary.Expiring[0].Critical)
+	require.Len(t, summary.OngoingIncidents, 1)
+	assert.Equal(t, "2026-10-15T08:00:00Z", summary.OngoingIncidents[0].StartedAt)
+	assert.False(t, summary.Healthy)
+	assert.Nil(t, summary.Errors)
+
+	fake.Errors["ListAllDomains"] = errors.New("boom")
+	summary = buildStatusSummary(context.Background(), fake, nil)
+	assert.Contains(t, summary.Errors["domains"], "boom")
+}
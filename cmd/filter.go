@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// listFilter holds the filter flags shared by every list command
+type listFilter struct {
+	status       string
+	nameContains string
+	tags         []string
+	down         bool
+	// project is a project ID, resolved to a full ID by resolveProject
+	project string
+	limit   int
+	all     bool
+}
+
+// addListFilterFlags registers --status, --name-contains, --down, --tag,
+// and --project
+func addListFilterFlags(c *cobra.Command) {
+	c.Flags().String("status", "", "Only show resources with this status (active, paused)")
+	registerFlagCompletion(c, "status", completeValues(filterStatuses...))
+	c.Flags().String("name-contains", "", "Only show resources whose name contains this text (case-insensitive)")
+	c.Flags().Bool("down", false, "Only show resources that are currently down")
+	c.Flags().StringArray("tag", nil, "Only show resources with this tag: key=value, or key for any value (repeatable; all must match)")
+	c.Flags().String("project", "", "Only show resources in this project (ID)")
+}
+
+// addPageFlags registers --limit and --all
+func addPageFlags(c *cobra.Command) {
+	c.Flags().Int("limit", 0, "Maximum number of results to show")
+	c.Flags().Bool("all", false, "Fetch every page of results")
+}
+
+// getListFilter reads the filter flags from a list command
+func getListFilter(cmd *cobra.Command) listFilter {
+	status, _ := cmd.Flags().GetString("status")
+	nameContains, _ := cmd.Flags().GetString("name-contains")
+	tags, _ := cmd.Flags().GetStringArray("tag")
+	down, _ := cmd.Flags().GetBool("down")
+	limit, _ := cmd.Flags().GetInt("limit")
+	all, _ := cmd.Flags().GetBool("all")
+	project, _ := cmd.Flags().GetString("project")
+	return listFilter{
+		status:       strings.ToLower(status),
+		nameContains: nameContains,
+		tags:         tags,
+		down:         down,
+		project:      project,
+		limit:        limit,
+		all:          all,
+	}
+}
+
+// active reports whether any filter was given
+func (f listFilter) active() bool {
+	return f.status != "" || f.nameContains != "" || len(f.tags) > 0 || f.down || f.project != ""
+}
+
+// resolveProject resolves a short --project ID to the full ID, so it can be
+// sent to the API and compared with resources' project IDs
+func (f *listFilter) resolveProject(client api.APIClient) error {
+	if f.project == "" {
+		return nil
+	}
+	id, err := cmdutil.ResolveProjectID(client, f.project)
+	if err != nil {
+		return err
+	}
+	f.project = id
+	return nil
+}
+
+// options converts the filter into API list options so the server can
+// narrow results when it supports it
+func (f listFilter) options() *api.ListOptions {
+	if !f.active() && f.limit <= 0 {
+		return nil
+	}
+	opts := &api.ListOptions{Status: f.status, Tags: f.tags, Name: f.nameContains, Project: f.project}
+	if f.limit > 0 && !f.all {
+		opts.PerPage = f.limit
+	}
+	return opts
+}
+
+// match applies the filter client-side to a single resource
+func (f listFilter) match(status, name string, down bool, tags []string, projectID string) bool {
+	if f.status != "" && !strings.EqualFold(status, f.status) {
+		return false
+	}
+	if f.nameContains != "" && !strings.Contains(strings.ToLower(name), strings.ToLower(f.nameContains)) {
+		return false
+	}
+	if f.down && !down {
+		return false
+	}
+	if !hasTags(tags, f.tags) {
+		return false
+	}
+	if f.project != "" && projectID != f.project {
+		return false
+	}
+	return true
+}
+
+// emptyMessage is shown when filtering leaves nothing to list
+func (f listFilter) emptyMessage(kind string) string {
+	return fmt.Sprintf("No %s match the given filters", kind)
+}
+
+// filterItems returns the items for which keep returns true
+func filterItems[T any](items []T, keep func(T) bool) []T {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if keep(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// notBefore reports whether an RFC 3339 timestamp is at or after since, treating a
+// zero since or an unparseable timestamp as a match
+func notBefore(timestamp string, since time.Time) bool {
+	if since.IsZero() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, timestamp)
+	return err != nil || !t.Before(since)
+}
+
+// limitItems returns at most n items; n <= 0 means no limit
+func limitItems[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListFilterFlags verifies every list command has the filter flags
+func TestListFilterFlags(t *testing.T) {
+	for _, c := range []*cobra.Command{jobsListCmd, apisListCmd, certsListCmd, domainsListCmd, dnsListCmd} {
+		for _, name := range []string{"status", "name-contains", "down", "tag", "project", "limit", "all"} {
+			require.NotNil(t, c.Flags().Lookup(name), "%s should have --%s flag", c.CommandPath(), name)
+		}
+	}
+}
+
+// TestListFilterMatch tests client-side filtering
+func TestListFilterMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter listFilter
+		want   bool
+	}{
+		{"no filter", listFilter{}, true},
+		{"status matches", listFilter{status: "paused"}, true},
+		{"status differs", listFilter{status: "active"}, false},
+		{"name contains", listFilter{nameContains: "BACK"}, true},
+		{"name missing", listFilter{nameContains: "sync"}, false},
+		{"down", listFilter{down: true}, false},
+		{"tag matches", listFilter{tags: []string{"Prod"}}, true},
+		{"tag missing", listFilter{tags: []string{"staging"}}, false},
+		{"tag key matches any value", listFilter{tags: []string{"env"}}, true},
+		{"tag key=value matches", listFilter{tags: []string{"env=production"}}, true},
+		{"tag value differs", listFilter{tags: []string{"env=staging"}}, false},
+		{"all tags must match", listFilter{tags: []string{"prod", "team=payments"}}, false},
+		{"project matches", listFilter{project: "proj-1"}, true},
+		{"project differs", listFilter{project: "proj-2"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.match("Paused", "Nightly Backup", false, []string{"prod", "db", "env=production"}, "proj-1")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestListFilterOptions tests conversion to API list options
+func TestListFilterOptions(t *testing.T) {
+	assert.Nil(t, listFilter{}.options())
+
+	opts := listFilter{status: "paused", tags: []string{"env=prod"}, down: true}.options()
+	require.NotNil(t, opts)
+	assert.Equal(t, "paused", opts.Status)
+	assert.Equal(t, []string{"env=prod"}, opts.Tags)
+
+	assert.Equal(t, "proj-1", listFilter{project: "proj-1"}.options().Project)
+
+	// --limit sets the page size unless every page is being fetched
+	assert.Equal(t, 25, listFilter{limit: 25}.options().PerPage)
+	assert.Zero(t, listFilter{limit: 25, all: true}.options().PerPage)
+}
+
+// TestLimitItems tests truncating results to --limit
+func TestLimitItems(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, limitItems([]int{1, 2, 3}, 2))
+	assert.Equal(t, []int{1, 2, 3}, limitItems([]int{1, 2, 3}, 0))
+	assert.Equal(t, []int{1}, limitItems([]int{1}, 5))
+}
+
+// TestFilterItems tests the generic slice filter
+func TestFilterItems(t *testing.T) {
+	got := filterItems([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 })
+	assert.Equal(t, []int{2, 4}, got)
+}
+
+// TestNotBefore tests filtering timestamps by --since
+func TestNotBefore(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.True(t, notBefore("2026-03-10T00:00:00Z", since))
+	assert.True(t, notBefore("2026-03-01T00:00:00Z", since))
+	assert.False(t, notBefore("2026-02-01T00:00:00Z", since))
+	assert.True(t, notBefore("2026-02-01T00:00:00Z", time.Time{}))
+	assert.True(t, notBefore("", since), "unparseable timestamps are kept")
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// minFollowInterval keeps --follow from hammering the API
+const minFollowInterval = 2 * time.Second
+
+// followBacklog is how many recent pings --follow prints before waiting for
+// new ones, unless --limit or --since says otherwise
+const followBacklog = 10
+
+// followJobPings prints a job's recent pings, then polls for new ones and
+// prints each as it arrives until interrupted
+func followJobPings(cmd *cobra.Command, client api.APIClient, jobID string, filter historyFilter, pingType string) error {
+	out := cmd.OutOrStdout()
+
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval < minFollowInterval {
+		return usageErrorf("--interval must be at least %s", minFollowInterval)
+	}
+	if !filter.until.IsZero() {
+		return usageErrorf("--until can't be used with --follow")
+	}
+	if cmd.Flags().Changed("output-file") {
+		return usageErrorf("--output-file can't be used with --follow")
+	}
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	backlog := filter.limit
+	if backlog == 0 && filter.since.IsZero() {
+		backlog = followBacklog
+	}
+	recent, err := client.ListAllJobPings(jobID, filter.listOptions(), backlog)
+	if err != nil {
+		return fmt.Errorf("failed to list pings: %w", err)
+	}
+
+	emit := func(pings []api.Ping) error {
+		for _, ping := range pings {
+			if pingType != "" && !pingTypeMatches(ping, pingType) {
+				continue
+			}
+			if !filter.keep(jobPingView.entry(ping)) {
+				continue
+			}
+			if jsonOutput {
+				if err := json.NewEncoder(out).Encode(ping); err != nil {
+					return err
+				}
+				continue
+			}
+			fmt.Fprintln(out, formatPingLine(ping))
+		}
+		return nil
+	}
+
+	follower := &pingFollower{}
+	if err := emit(follower.add(limitItems(recent, backlog))); err != nil {
+		return err
+	}
+	// With no pings yet, only ask for ones sent from now on
+	if follower.since.IsZero() {
+		follower.since = time.Now()
+	}
+	if !jsonOutput {
+		output.InfoMessage(cmd.ErrOrStderr(), fmt.Sprintf("Following pings for %s every %s. Press Ctrl+C to stop.", cmdutil.ShortID(jobID), interval))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		pings, err := client.ListAllJobPings(jobID, &api.ListOptions{Since: follower.since}, 0)
+		if err != nil {
+			fmt.Fprintf(cmd.ErrOrStderr(), "%s %s\n", watchTimestamp(), output.Yellow(fmt.Sprintf("poll failed: %v", err)))
+			continue
+		}
+		if err := emit(follower.add(pings)); err != nil {
+			return err
+		}
+	}
+}
+
+// pingFollower remembers the newest pings seen between polls, so pings at
+// the boundary of one poll's --since aren't printed twice
+type pingFollower struct {
+	since time.Time
+	seen  map[string]bool
+}
+
+// add takes a newest-first page of pings and returns those not seen before,
+// oldest first
+func (f *pingFollower) add(pings []api.Ping) []api.Ping {
+	var fresh []api.Ping
+	for _, ping := range slices.Backward(pings) {
+		created, err := time.Parse(time.RFC3339, ping.CreatedAt)
+		if err != nil || created.Before(f.since) || f.seen[ping.ID] {
+			continue
+		}
+		if created.After(f.since) {
+			f.since = created
+			f.seen = map[string]bool{}
+		}
+		f.seen[ping.ID] = true
+		fresh = append(fresh, ping)
+	}
+	return fresh
+}
+
+// formatPingLine renders a followed ping as one log line: time, type,
+// duration, and where it came from
+func formatPingLine(ping api.Ping) string {
+	pingType := ping.PingType
+	if pingType == "" {
+		pingType = "heartbeat"
+	}
+	typeCell := fmt.Sprintf("%-9s", pingType)
+	switch pingType {
+	case api.PingStart:
+		typeCell = output.Cyan(typeCell)
+	case api.PingFail:
+		typeCell = output.Red(typeCell)
+	}
+
+	// The history view already turns the duration into milliseconds
+	duration := jobPingView.entry(ping).cells[3]
+
+	created := ping.CreatedAt
+	if t, err := time.Parse(time.RFC3339, ping.CreatedAt); err == nil {
+		created = output.InTimezone(t).Format("2006-01-02 15:04:05")
+	}
+
+	return fmt.Sprintf("%s  %s  %-8s  %-15s  %s", created, typeCell, duration, valueOrDash(ping.SourceIP), output.Cyan(cmdutil.ShortID(ping.ID)))
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// pingIDs returns the IDs of pings, in order
+func pingIDs(pings []api.Ping) []string {
+	var ids []string
+	for _, ping := range pings {
+		ids = append(ids, ping.ID)
+	}
+	return ids
+}
+
+// TestPingFollower tests returning only unseen pings, oldest first, across
+// polls that overlap at the --since boundary
+func TestPingFollower(t *testing.T) {
+	f := &pingFollower{}
+
+	fresh := f.add([]api.Ping{
+		{ID: "b", CreatedAt: "2026-10-15T10:01:00Z"},
+		{ID: "a", CreatedAt: "2026-10-15T10:00:00Z"},
+	})
+	assert.Equal(t, []string{"a", "b"}, pingIDs(fresh))
+
+	// The next poll asks for pings since b, so b comes back
+	fresh = f.add([]api.Ping{
+		{ID: "d", CreatedAt: "2026-10-15T10:02:00Z"},
+		{ID: "c", CreatedAt: "2026-10-15T10:01:00Z"},
+		{ID: "b", CreatedAt: "2026-10-15T10:01:00Z"},
+	})
+	assert.Equal(t, []string{"c", "d"}, pingIDs(fresh))
+
+	assert.Empty(t, f.add([]api.Ping{{ID: "d", CreatedAt: "2026-10-15T10:02:00Z"}}))
+}
+
+// TestFormatPingLine tests a followed ping's log line
+func TestFormatPingLine(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	duration := "1.5"
+	line := formatPingLine(api.Ping{ID: "p1234567-aaaa", PingType: api.PingSuccess, Duration: &duration, SourceIP: "203.0.113.5", CreatedAt: "2026-10-15T10:00:00Z"})
+	assert.Contains(t, line, "success    1500ms    203.0.113.5      p1234567")
+
+	line = formatPingLine(api.Ping{ID: "p7654321-bbbb", CreatedAt: "2026-10-15T10:00:00Z"})
+	assert.Contains(t, line, "heartbeat  -         -                p7654321")
+}
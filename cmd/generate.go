@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// ghaWorkflowHeader explains how to set up and call the generated workflow
+const ghaWorkflowHeader = `# GrooveKit deployment gate, generated by groovekit generate gha.
+#
+# Setup: create an API key in the GrooveKit dashboard (Settings → API Keys)
+# and add it to this repository as a secret named GROOVEKIT_TOKEN (Settings →
+# Secrets and variables → Actions → New repository secret).
+#
+# Run the gate before deploying by calling this workflow from your deploy
+# workflow, e.g. when this file is .github/workflows/groovekit-gate.yml:
+#
+#   jobs:
+#     gate:
+#       uses: ./.github/workflows/groovekit-gate.yml
+#       secrets: inherit
+#     deploy:
+#       needs: gate
+#       ...
+name: GrooveKit health gate
+
+on:
+  workflow_call:
+    secrets:
+      GROOVEKIT_TOKEN:
+        required: true
+  workflow_dispatch:
+
+permissions:
+  contents: read
+
+jobs:
+  health:
+    name: Check monitor health
+    runs-on: ubuntu-latest
+    env:
+      GROOVEKIT_TOKEN: ${{ secrets.GROOVEKIT_TOKEN }}
+    steps:
+      - name: Install GrooveKit CLI
+        env:
+          GH_TOKEN: ${{ github.token }}
+        run: |
+          gh release download %s--repo scookdev/groovekit-cli --pattern 'groovekit_*_Linux_x86_64.tar.gz' --output - | tar -xz -C "$RUNNER_TEMP" groovekit
+          echo "$RUNNER_TEMP" >> "$GITHUB_PATH"
+`
+
+// ghaStep is a workflow step that runs a groovekit command
+type ghaStep struct {
+	name string
+	args []string
+}
+
+// ghaWorkflow renders the gate workflow, installing the given CLI release,
+// or the latest one for a development build, and running each step
+func ghaWorkflow(version string, steps []ghaStep) string {
+	release := ""
+	if version != "" && version != "dev" {
+		release = "v" + strings.TrimPrefix(version, "v") + " "
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, ghaWorkflowHeader, release)
+	for _, step := range steps {
+		fmt.Fprintf(&b, "      - name: %q\n", step.name)
+		fmt.Fprintf(&b, "        run: groovekit %s\n", strings.Join(step.args, " "))
+	}
+	return b.String()
+}
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate configuration for other tools",
+	Long:  "Generate ready-to-commit configuration that runs GrooveKit from other tools, such as CI workflows.",
+}
+
+// generate gha
+var generateGHACmd = &cobra.Command{
+	Use:   "gha",
+	Short: "Generate a GitHub Actions workflow that gates deploys on monitor health",
+	Long: `Print a GitHub Actions workflow that installs the CLI and fails when
+monitors are unhealthy, to run as a gate before deploying. With --monitor,
+it runs the check command for each given monitor, which can be of any type;
+without it, it runs status to check the whole account. Results show up as
+workflow annotations on the run.
+
+The workflow reads an API key from the GROOVEKIT_TOKEN repository secret;
+setup steps are printed to stderr and kept as comments in the workflow.
+--fail-level is passed on to the commands in the workflow.
+
+Examples:
+  groovekit generate gha --monitor abc12345 -o .github/workflows/groovekit-gate.yml
+  groovekit generate gha --monitor abc12345 --monitor def67890 --fail-level warning
+  groovekit generate gha > .github/workflows/groovekit-gate.yml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		ids, _ := cmd.Flags().GetStringArray("monitor")
+		var passOn []string
+		if cmd.Flags().Changed("fail-level") {
+			level, _ := cmd.Flags().GetString("fail-level")
+			passOn = append(passOn, "--fail-level", level)
+		}
+
+		var steps []ghaStep
+		if len(ids) == 0 {
+			steps = append(steps, ghaStep{name: "Check account health", args: append([]string{"status", "--format", "github"}, passOn...)})
+		} else {
+			client, err := cmdutil.AuthenticatedClient()
+			if err != nil {
+				return err
+			}
+
+			s := newSpinner(cmd)
+			s.Start()
+			for _, id := range ids {
+				var m monitorMatch
+				if m, err = resolveMonitor(client, id); err != nil {
+					break
+				}
+				steps = append(steps, ghaStep{
+					name: "Check " + m.target.noun + " " + m.item.name,
+					args: append([]string{m.target.command, "check", m.item.id, "--format", "github"}, passOn...),
+				})
+			}
+			s.Stop()
+
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprint(out, ghaWorkflow(Version, steps))
+
+		errOut := cmd.ErrOrStderr()
+		output.InfoMessage(errOut, "Add a GrooveKit API key as a repository secret named GROOVEKIT_TOKEN:")
+		fmt.Fprintln(errOut, "  Settings → Secrets and variables → Actions → New repository secret")
+		fmt.Fprintln(errOut, "  or: gh secret set GROOVEKIT_TOKEN")
+		return nil
+	},
+}
+
+func init() {
+	// Add flags to gha command
+	generateGHACmd.Flags().StringArray("monitor", nil, "ID of a monitor of any type to check (repeatable; default: check the whole account with status)")
+	addOutputFileFlag(generateGHACmd)
+
+	// Add subcommands
+	generateCmd.AddCommand(generateGHACmd)
+
+	// Add generate command to root
+	rootCmd.AddCommand(generateCmd)
+}
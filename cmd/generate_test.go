@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// ghaSteps parses a generated workflow and returns its gate job's steps
+func ghaSteps(t *testing.T, workflow string) []map[string]any {
+	t.Helper()
+	var doc struct {
+		Jobs map[string]struct {
+			Env   map[string]string `yaml:"env"`
+			Steps []map[string]any  `yaml:"steps"`
+		} `yaml:"jobs"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(workflow), &doc))
+	require.Contains(t, doc.Jobs, "health")
+	assert.Equal(t, "${{ secrets.GROOVEKIT_TOKEN }}", doc.Jobs["health"].Env["GROOVEKIT_TOKEN"])
+	return doc.Jobs["health"].Steps
+}
+
+// TestGenerateGHACommand tests generating a gate workflow that checks the
+// given monitors with their own check commands
+func TestGenerateGHACommand(t *testing.T) {
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+	checkout := seedApis(srv)
+
+	out, errOut, err := runCommand(t, "generate", "gha", "--monitor", backup[:8], "--monitor", checkout[:8], "--fail-level", "warning")
+	require.NoError(t, err)
+	assert.Contains(t, errOut, "repository secret named GROOVEKIT_TOKEN")
+
+	steps := ghaSteps(t, out)
+	require.Len(t, steps, 3)
+	assert.Contains(t, steps[0]["run"], "gh release download --repo scookdev/groovekit-cli", "dev builds install the latest release")
+	assert.Equal(t, "Check job Backup", steps[1]["name"])
+	assert.Equal(t, "groovekit jobs check "+backup+" --format github --fail-level warning", steps[1]["run"])
+	assert.Equal(t, "Check API monitor Checkout", steps[2]["name"])
+	assert.Equal(t, "groovekit apis check "+checkout+" --format github --fail-level warning", steps[2]["run"])
+
+	_, _, err = runCommand(t, "generate", "gha", "--monitor", "ffffffff")
+	require.Error(t, err)
+	assert.Equal(t, exitNotFound, exitCode(err))
+}
+
+// TestGenerateGHAStatus tests gating on the whole account and writing the
+// workflow to a file
+func TestGenerateGHAStatus(t *testing.T) {
+	startAPI(t)
+	path := filepath.Join(t.TempDir(), "groovekit-gate.yml")
+
+	mustRun(t, "generate", "gha", "-o", path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	steps := ghaSteps(t, string(data))
+	require.Len(t, steps, 2)
+	assert.Equal(t, "groovekit status --format github", steps[1]["run"])
+}
+
+// TestGHAWorkflow tests pinning the installed CLI to a release build
+func TestGHAWorkflow(t *testing.T) {
+	workflow := ghaWorkflow("1.4.0", []ghaStep{{name: `Check job "nightly: backup"`, args: []string{"status"}}})
+	steps := ghaSteps(t, workflow)
+	assert.Contains(t, steps[0]["run"], "gh release download v1.4.0 --repo")
+	assert.Equal(t, `Check job "nightly: backup"`, steps[1]["name"])
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Values accepted by --format
+const (
+	formatText   = "text"
+	formatGitHub = "github"
+	formatSlack  = "slack"
+)
+
+// GitHub Actions workflow annotation levels
+const (
+	annotationError   = "error"
+	annotationWarning = "warning"
+	annotationNotice  = "notice"
+)
+
+// addFormatFlag registers --format on commands that can report to GitHub
+// Actions
+func addFormatFlag(c *cobra.Command) {
+	c.Flags().String("format", formatText, "Output format: text, or github for GitHub Actions workflow annotations")
+}
+
+// githubFormat reports whether --format github was given, rejecting
+// unknown formats and combining it with --json
+func githubFormat(cmd *cobra.Command) (bool, error) {
+	format, _ := cmd.Flags().GetString("format")
+	switch format {
+	case "", formatText:
+		return false, nil
+	case formatGitHub:
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return false, usageErrorf("--format github and --json can't be combined")
+		}
+		return true, nil
+	}
+	return false, usageErrorf("invalid --format %q: must be text or github", format)
+}
+
+// getFormat returns --format after checking it is one of allowed, isn't
+// combined with --json, and that --post-to is only used with slack
+func getFormat(cmd *cobra.Command, allowed ...string) (string, error) {
+	format, _ := cmd.Flags().GetString("format")
+	if !slices.Contains(allowed, format) {
+		return "", usageErrorf("invalid --format %q: must be one of %s", format, strings.Join(allowed, ", "))
+	}
+	if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput && format != formatText {
+		return "", usageErrorf("--format %s and --json can't be combined", format)
+	}
+
+	postTo, _ := cmd.Flags().GetString("post-to")
+	if postTo == "" {
+		return format, nil
+	}
+	if format != formatSlack {
+		return "", usageErrorf("--post-to needs --format slack")
+	}
+	if u, err := url.Parse(postTo); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", usageErrorf("invalid --post-to %q: must be a Slack incoming webhook URL", postTo)
+	}
+	return format, nil
+}
+
+// githubAnnotation writes a workflow command that GitHub Actions renders as
+// an annotation on the run and in its job summary, e.g.
+// "::error title=Job down::Backup (1a2b3c4d) missed its heartbeat"
+func githubAnnotation(w io.Writer, level, title, message string) {
+	if title != "" {
+		fmt.Fprintf(w, "::%s title=%s::%s\n", level, escapeAnnotationProperty(title), escapeAnnotationData(message))
+		return
+	}
+	fmt.Fprintf(w, "::%s::%s\n", level, escapeAnnotationData(message))
+}
+
+// escapeAnnotationData escapes an annotation message so newlines and
+// percent signs survive
+func escapeAnnotationData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeAnnotationProperty escapes an annotation property such as the
+// title, where colons and commas are also separators
+func escapeAnnotationProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
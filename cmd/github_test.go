@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGitHubAnnotation tests writing and escaping workflow annotations
+func TestGitHubAnnotation(t *testing.T) {
+	var buf bytes.Buffer
+	githubAnnotation(&buf, annotationError, "GrooveKit job down", "Backup is down: 100% failing\nsince 10:00")
+	githubAnnotation(&buf, annotationWarning, "a: b, c", "x")
+	githubAnnotation(&buf, annotationNotice, "", "ok")
+	assert.Equal(t, "::error title=GrooveKit job down::Backup is down: 100%25 failing%0Asince 10:00\n"+
+		"::warning title=a%3A b%2C c::x\n"+
+		"::notice::ok\n", buf.String())
+}
+
+// TestGitHubFormat tests validating --format
+func TestGitHubFormat(t *testing.T) {
+	newCmd := func(format string, json bool) *cobra.Command {
+		c := &cobra.Command{}
+		addFormatFlag(c)
+		c.Flags().Bool("json", false, "")
+		require.NoError(t, c.Flags().Set("format", format))
+		if json {
+			require.NoError(t, c.Flags().Set("json", "true"))
+		}
+		return c
+	}
+
+	github, err := githubFormat(newCmd("github", false))
+	require.NoError(t, err)
+	assert.True(t, github)
+
+	github, err = githubFormat(newCmd("text", false))
+	require.NoError(t, err)
+	assert.False(t, github)
+
+	_, err = githubFormat(newCmd("yaml", false))
+	assert.Equal(t, exitUsage, exitCode(err))
+	_, err = githubFormat(newCmd("github", true))
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestPrintStatusAnnotations tests annotation levels follow --fail-level
+func TestPrintStatusAnnotations(t *testing.T) {
+	summary := &statusSummary{
+		Down:     []statusItem{{Type: "job", ID: "11111111-aaaa", Name: "Backup", Detail: "missed heartbeat"}},
+		Expiring: []statusItem{{Type: "cert", ID: "44444444-dddd", Name: "x.io", Detail: "20 days left"}},
+	}
+
+	c := &cobra.Command{}
+	c.Flags().String("fail-level", failLevelDown, "")
+	var buf bytes.Buffer
+	c.SetOut(&buf)
+	printStatusAnnotations(c, summary)
+	assert.Equal(t, "::error title=GrooveKit job down::Backup (11111111) is down: missed heartbeat\n"+
+		"::warning title=GrooveKit cert expiring::x.io (44444444) expires soon: 20 days left\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, c.Flags().Set("fail-level", failLevelNone))
+	printStatusAnnotations(c, summary)
+	assert.NotContains(t, buf.String(), "::error")
+
+	buf.Reset()
+	printStatusAnnotations(c, &statusSummary{Healthy: true})
+	assert.Equal(t, "::notice title=GrooveKit status::All systems operational\n", buf.String())
+}
+
+// TestAnnotateHealth tests check results as annotations
+func TestAnnotateHealth(t *testing.T) {
+	var buf bytes.Buffer
+	annotateHealth(&buf, "job", "Backup", healthUp, "", false)
+	annotateHealth(&buf, "job", "Backup", healthDown, "missed heartbeat", true)
+	annotateHealth(&buf, "job", "Backup", healthUnknown, "paused", false)
+	assert.Equal(t, "::notice title=GrooveKit job check::job Backup is up\n"+
+		"::error title=GrooveKit job check::job Backup is down: missed heartbeat\n"+
+		"::warning title=GrooveKit job check::job Backup is paused\n", buf.String())
+}
+
+// TestPrintIncidentAnnotations tests ongoing and recovered incidents as
+// annotations
+func TestPrintIncidentAnnotations(t *testing.T) {
+	ended := "2026-03-09T11:00:00Z"
+	rows := []incidentRow{
+		{ResourceType: "job", ResourceID: "11111111-aaaa", ResourceName: "Backup"},
+		{ResourceType: "api", ResourceID: "22222222-bbbb", ResourceName: "Prod API"},
+	}
+	rows[0].StartedAt = "2026-03-09T10:00:00Z"
+	rows[1].StartedAt = "2026-03-09T10:00:00Z"
+	rows[1].EndedAt = &ended
+	rows[1].Duration = 3600
+
+	// Times are shown in the display time zone
+	useTimezone(t, "Europe/London")
+	var buf bytes.Buffer
+	printIncidentAnnotations(&buf, rows)
+	assert.Equal(t, "::error title=GrooveKit job incident::Backup (11111111) has been down since 2026-03-09 10:00:00 GMT\n"+
+		"::notice title=GrooveKit api incident::Prod API (22222222) was down from 2026-03-09 10:00:00 GMT to 2026-03-09 11:00:00 GMT (1.0h)\n", buf.String())
+}
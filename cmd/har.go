@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/har"
+	"github.com/spf13/cobra"
+)
+
+// harMonitor is an API monitor proposed from a captured request
+type harMonitor struct {
+	name    string
+	method  string
+	url     string
+	headers map[string]string
+	body    string
+	// status is the response status the browser saw, or 0 if none
+	status int
+}
+
+// harSkips counts the captured requests harMonitors left out, by reason
+type harSkips struct {
+	assets, unsupported, duplicates, monitored int
+}
+
+// harMonitors proposes a monitor for each request in the log, in capture
+// order, skipping page assets unless includeAssets is set, non-HTTP URLs,
+// repeats of the same method and URL, and URLs that are already monitored
+func harMonitors(log *har.Log, includeAssets bool, existing map[string]bool) ([]harMonitor, harSkips) {
+	var monitors []harMonitor
+	var skipped harSkips
+	seen := map[string]bool{}
+	for _, entry := range log.Entries {
+		req := entry.Request
+		switch {
+		case !includeAssets && entry.IsAsset():
+			skipped.assets++
+			continue
+		case !strings.HasPrefix(req.URL, "http://") && !strings.HasPrefix(req.URL, "https://"):
+			skipped.unsupported++
+			continue
+		}
+
+		method := strings.ToUpper(req.Method)
+		if method == "" {
+			method = "GET"
+		}
+		key := method + " " + req.URL
+		switch {
+		case seen[key]:
+			skipped.duplicates++
+			continue
+		case existing[req.URL]:
+			skipped.monitored++
+			continue
+		}
+		seen[key] = true
+
+		name := curlMonitorName(req.URL)
+		if method != "GET" {
+			name = method + " " + name
+		}
+		m := harMonitor{
+			name:    name,
+			method:  method,
+			url:     req.URL,
+			headers: harHeaders(req.ReplayHeaders()),
+			status:  entry.Response.Status,
+		}
+		if req.PostData != nil {
+			m.body = req.PostData.Text
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, skipped
+}
+
+// harHeaders turns captured headers into the map a monitor sends. HTTP/2
+// captures can split a header across entries, so repeats are joined the
+// way the HTTP client would, with cookies separated by "; ".
+func harHeaders(headers []har.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(headers))
+	names := map[string]string{}
+	for _, h := range headers {
+		lower := strings.ToLower(h.Name)
+		name, ok := names[lower]
+		if !ok {
+			names[lower] = h.Name
+			m[h.Name] = h.Value
+			continue
+		}
+		separator := ", "
+		if lower == "cookie" {
+			separator = "; "
+		}
+		m[name] += separator + h.Value
+	}
+	return m
+}
+
+// expectedStatusCodes returns the status a monitor should expect: the one
+// the browser saw when it was a success or redirect, or nil for the default
+func (m harMonitor) expectedStatusCodes() []int {
+	if m.status >= 200 && m.status < 400 {
+		return []int{m.status}
+	}
+	return nil
+}
+
+// promptHARMonitors lists the captured requests and asks which to monitor
+func promptHARMonitors(cmd *cobra.Command, monitors []harMonitor) ([]harMonitor, error) {
+	out := cmd.OutOrStdout()
+
+	width := 0
+	for _, m := range monitors {
+		width = max(width, len(m.method))
+	}
+	for i, m := range monitors {
+		fmt.Fprintf(out, "%3d %-*s %s%s\n", i+1, width, m.method, m.url, describeHARMonitor(m))
+	}
+	fmt.Fprintf(out, "\nRequests to monitor (e.g. 1,3-5 or all): ")
+
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil, nil
+	}
+	return selectHARMonitors(monitors, line)
+}
+
+// selectHARMonitors picks monitors by a selection such as "1,3-5" or "all"
+func selectHARMonitors(monitors []harMonitor, selection string) ([]harMonitor, error) {
+	indexes, err := parseSelection(selection, len(monitors))
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]harMonitor, 0, len(indexes))
+	for _, i := range indexes {
+		selected = append(selected, monitors[i])
+	}
+	return selected, nil
+}
+
+// describeHARMonitor summarises a captured request for the selection list
+func describeHARMonitor(m harMonitor) string {
+	var parts []string
+	if m.status > 0 {
+		parts = append(parts, strconv.Itoa(m.status))
+	}
+	if len(m.headers) > 0 {
+		parts = append(parts, countNoun(len(m.headers), "header", "headers"))
+	}
+	if m.body != "" {
+		parts = append(parts, "body")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "  (" + strings.Join(parts, " · ") + ")"
+}
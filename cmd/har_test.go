@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/har"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHarMonitors tests proposing monitors from captured requests
+func TestHarMonitors(t *testing.T) {
+	log := &har.Log{Entries: []har.Entry{
+		{Request: har.Request{Method: "GET", URL: "https://shop.example.com/"}, Response: har.Response{Status: 200}, ResourceType: "document"},
+		{Request: har.Request{Method: "GET", URL: "https://shop.example.com/app.js"}, ResourceType: "script"},
+		{Request: har.Request{Method: "post", URL: "https://api.example.com/cart", Headers: []har.Header{
+			{Name: "cookie", Value: "a=1"},
+			{Name: "cookie", Value: "b=2"},
+			{Name: "Accept", Value: "text/html"},
+			{Name: "accept", Value: "application/json"},
+			{Name: "Host", Value: "api.example.com"},
+		}, PostData: &har.PostData{Text: `{"sku":1}`}}, Response: har.Response{Status: 201}},
+		{Request: har.Request{Method: "POST", URL: "https://api.example.com/cart"}},
+		{Request: har.Request{Method: "GET", URL: "wss://api.example.com/live"}},
+		{Request: har.Request{Method: "GET", URL: "https://api.example.com/health"}},
+		{Request: har.Request{Method: "GET", URL: "https://api.example.com/missing"}, Response: har.Response{Status: 404}},
+	}}
+
+	monitors, skipped := harMonitors(log, false, map[string]bool{"https://api.example.com/health": true})
+	assert.Equal(t, harSkips{assets: 1, unsupported: 1, duplicates: 1, monitored: 1}, skipped)
+	require.Len(t, monitors, 3)
+
+	assert.Equal(t, "shop.example.com", monitors[0].name)
+	assert.Equal(t, []int{200}, monitors[0].expectedStatusCodes())
+
+	cart := monitors[1]
+	assert.Equal(t, "POST api.example.com/cart", cart.name)
+	assert.Equal(t, "POST", cart.method)
+	assert.Equal(t, map[string]string{"cookie": "a=1; b=2", "Accept": "text/html, application/json"}, cart.headers)
+	assert.Equal(t, `{"sku":1}`, cart.body)
+
+	assert.Nil(t, monitors[2].expectedStatusCodes())
+
+	monitors, skipped = harMonitors(log, true, nil)
+	assert.Len(t, monitors, 5)
+	assert.Zero(t, skipped.assets)
+}
+
+// TestPromptHARMonitors tests choosing captured requests to monitor
+func TestPromptHARMonitors(t *testing.T) {
+	monitors := []harMonitor{
+		{method: "GET", url: "https://shop.example.com/", status: 200},
+		{method: "POST", url: "https://api.example.com/cart", headers: map[string]string{"Accept": "*/*"}, body: "{}", status: 201},
+	}
+
+	prompt := func(input string) ([]harMonitor, string) {
+		var out bytes.Buffer
+		c := &cobra.Command{}
+		c.SetOut(&out)
+		c.SetIn(strings.NewReader(input))
+		selected, err := promptHARMonitors(c, monitors)
+		require.NoError(t, err)
+		return selected, out.String()
+	}
+
+	selected, text := prompt("\n")
+	assert.Empty(t, selected)
+	assert.Contains(t, text, "  1 GET  https://shop.example.com/  (200)")
+	assert.Contains(t, text, "  2 POST https://api.example.com/cart  (201 · 1 header · body)")
+
+	selected, _ = prompt("2\n")
+	assert.Equal(t, monitors[1:], selected)
+
+	_, err := selectHARMonitors(monitors, "3")
+	assert.ErrorContains(t, err, "invalid selection")
+}
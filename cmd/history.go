@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// historyOutcome classifies a history entry for filtering and stats
+type historyOutcome int
+
+const (
+	historyNeutral historyOutcome = iota // e.g. a job start ping
+	historyOK
+	historyFailed
+)
+
+// historyEntry is one check or ping reduced to what the shared renderer needs
+type historyEntry struct {
+	createdAt string
+	outcome   historyOutcome
+	duration  float64 // milliseconds, negative when unknown
+	group     string  // breakdown key, e.g. the HTTP status code
+	cells     []string
+	values    []interface{}
+}
+
+// historyView describes how to render one kind of history (API monitor
+// checks, job pings) with the shared filters, table, and stats
+type historyView[T any] struct {
+	noun          string // singular, e.g. "check"
+	headers       []string
+	hidden        []string
+	durationLabel string // stats label, e.g. "Response time"
+	groupLabel    string // breakdown label, e.g. "Status codes"; empty for none
+	entry         func(T) historyEntry
+}
+
+// historyFilter holds the shared history flags
+type historyFilter struct {
+	since      time.Time
+	until      time.Time
+	failedOnly bool
+	limit      int
+}
+
+// historyStats summarises a set of history entries
+type historyStats struct {
+	total       int
+	ok          int
+	failed      int
+	successRate *float64 // nil when nothing succeeded or failed
+	avgMs       *float64 // duration fields are nil when none are known
+	p95Ms       *float64
+	maxMs       *float64
+	groups      []historyGroup // most common first
+}
+
+// historyGroup counts the entries sharing a breakdown key
+type historyGroup struct {
+	key   string
+	count int
+}
+
+// addHistoryFlags registers the filters shared by check and ping history
+func addHistoryFlags(c *cobra.Command) {
+	c.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(c)
+	c.Flags().Bool("failed", false, "Only show failures")
+	c.Flags().Bool("failed-only", false, "Only show failures (same as --failed)")
+	c.Flags().String("since", "", "Only show entries after this time (e.g. 24h, 7d, 2026-01-02)")
+	c.Flags().String("until", "", "Only show entries before this time (e.g. 1h, 2026-01-02)")
+	c.Flags().Int("limit", 0, "Maximum number of entries to show")
+}
+
+// parseHistoryFlags reads the shared history flags
+func parseHistoryFlags(cmd *cobra.Command) (historyFilter, error) {
+	var filter historyFilter
+	failed, _ := cmd.Flags().GetBool("failed")
+	failedOnly, _ := cmd.Flags().GetBool("failed-only")
+	filter.failedOnly = failed || failedOnly
+	filter.limit, _ = cmd.Flags().GetInt("limit")
+
+	now := time.Now()
+	for _, f := range []struct {
+		name string
+		dest *time.Time
+	}{{"since", &filter.since}, {"until", &filter.until}} {
+		value, _ := cmd.Flags().GetString(f.name)
+		if value == "" {
+			continue
+		}
+		t, err := parseTimeFlag(f.name, value, now)
+		if err != nil {
+			return filter, err
+		}
+		*f.dest = t
+	}
+
+	if !filter.since.IsZero() && !filter.until.IsZero() && filter.until.Before(filter.since) {
+		return filter, usageErrorf("--until must be after --since")
+	}
+	return filter, nil
+}
+
+// listOptions asks the API for the filter's time range
+func (f historyFilter) listOptions() *api.ListOptions {
+	return &api.ListOptions{Since: f.since, Until: f.until}
+}
+
+// fetchLimit is how many entries to page through: the --limit when every
+// fetched entry will be shown, otherwise no cap, since client-side filters
+// may drop some
+func (f historyFilter) fetchLimit(filtered bool) int {
+	if f.failedOnly || filtered {
+		return 0
+	}
+	return f.limit
+}
+
+// keep reports whether an entry passes the filter
+func (f historyFilter) keep(entry historyEntry) bool {
+	if f.failedOnly && entry.outcome != historyFailed {
+		return false
+	}
+	if f.since.IsZero() && f.until.IsZero() {
+		return true
+	}
+	created, err := time.Parse(time.RFC3339, entry.createdAt)
+	if err != nil {
+		return true
+	}
+	return !created.Before(f.since) && (f.until.IsZero() || !created.After(f.until))
+}
+
+// renderHistory filters items with the shared history flags and prints them
+// as JSON or as a table followed by stats
+func renderHistory[T any](cmd *cobra.Command, filter historyFilter, items []T, view historyView[T]) error {
+	out := cmd.OutOrStdout()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var kept []T
+	var entries []historyEntry
+	for _, item := range items {
+		entry := view.entry(item)
+		if !filter.keep(entry) {
+			continue
+		}
+		kept = append(kept, item)
+		entries = append(entries, entry)
+	}
+	kept = limitItems(kept, filter.limit)
+	entries = limitItems(entries, filter.limit)
+
+	if jsonOutput {
+		if kept == nil {
+			kept = []T{}
+		}
+		return cmdutil.OutputJSON(out, kept)
+	}
+
+	if len(entries) == 0 {
+		output.InfoMessage(out, fmt.Sprintf("No %ss found", view.noun))
+		return nil
+	}
+
+	table, err := newListTable(cmd, view.headers, view.hidden...)
+	if err != nil {
+		return err
+	}
+	table.Render()
+	for _, entry := range entries {
+		table.AppendWithValues(entry.cells, entry.values)
+	}
+	table.Flush()
+
+	stats := computeHistoryStats(entries)
+	output.TotalMessage(out, fmt.Sprintf("Total: %d %s(s)", stats.total, view.noun))
+	if stats.successRate != nil {
+		fmt.Fprintf(out, "Success rate: %.1f%% (%d failed)\n", *stats.successRate, stats.failed)
+	}
+	if stats.avgMs != nil {
+		fmt.Fprintf(out, "%s: avg %.0fms, p95 %.0fms, max %.0fms\n", view.durationLabel, *stats.avgMs, *stats.p95Ms, *stats.maxMs)
+	}
+	if view.groupLabel != "" && len(stats.groups) > 0 {
+		fmt.Fprintf(out, "%s: %s\n", view.groupLabel, formatHistoryGroups(stats.groups, stats.total))
+	}
+	return nil
+}
+
+// formatHistoryGroups renders a breakdown as "200 ×42 (93.3%), 503 ×3 (6.7%)"
+func formatHistoryGroups(groups []historyGroup, total int) string {
+	parts := make([]string, len(groups))
+	for i, g := range groups {
+		parts[i] = fmt.Sprintf("%s ×%d (%.1f%%)", g.key, g.count, float64(g.count)/float64(total)*100)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// computeHistoryStats counts outcomes and summarises known durations.
+// Neutral entries are excluded from the success rate.
+func computeHistoryStats(entries []historyEntry) historyStats {
+	stats := historyStats{total: len(entries)}
+	var durations []float64
+	counts := map[string]int{}
+	for _, entry := range entries {
+		if entry.group != "" {
+			if counts[entry.group] == 0 {
+				stats.groups = append(stats.groups, historyGroup{key: entry.group})
+			}
+			counts[entry.group]++
+		}
+		switch entry.outcome {
+		case historyOK:
+			stats.ok++
+		case historyFailed:
+			stats.failed++
+		}
+		if entry.duration >= 0 {
+			durations = append(durations, entry.duration)
+		}
+	}
+
+	for i := range stats.groups {
+		stats.groups[i].count = counts[stats.groups[i].key]
+	}
+	sort.SliceStable(stats.groups, func(i, j int) bool {
+		if stats.groups[i].count != stats.groups[j].count {
+			return stats.groups[i].count > stats.groups[j].count
+		}
+		return stats.groups[i].key < stats.groups[j].key
+	})
+
+	if completed := stats.ok + stats.failed; completed > 0 {
+		rate := float64(stats.ok) / float64(completed) * 100
+		stats.successRate = &rate
+	}
+
+	if len(durations) > 0 {
+		sort.Float64s(durations)
+		var sum float64
+		for _, d := range durations {
+			sum += d
+		}
+		avg := sum / float64(len(durations))
+		p95 := durations[int(math.Ceil(0.95*float64(len(durations))))-1]
+		max := durations[len(durations)-1]
+		stats.avgMs, stats.p95Ms, stats.maxMs = &avg, &p95, &max
+	}
+	return stats
+}
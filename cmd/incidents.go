@@ -0,0 +1,733 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// incidentRow is an incident tagged with the resource it belongs to
+type incidentRow struct {
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	api.Incident
+}
+
+// incidentResource is a monitored resource whose incidents can be fetched
+type incidentResource struct {
+	kind  string
+	id    string
+	name  string
+	fetch func(string) ([]api.Incident, error)
+}
+
+// incidentTypeAliases maps accepted --type values to resource kinds
+var incidentTypeAliases = map[string]string{
+	"job": "jobs", "jobs": "jobs",
+	"api": "apis", "apis": "apis", "monitor": "apis", "monitors": "apis",
+	"cert": "certs", "certs": "certs",
+	"domain": "domains", "domains": "domains",
+	"dns": "dns",
+}
+
+var incidentsCmd = &cobra.Command{
+	Use:   "incidents",
+	Short: "View incidents across all resources",
+	Long:  "View incident history across jobs, API monitors, SSL certificates, domains, and DNS monitors",
+}
+
+// incidents list
+var incidentsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List incidents across all resources",
+	Long: `List incident history for every job, API monitor, SSL certificate, domain,
+and DNS monitor in a single table, newest first. With --format github, each
+incident is printed as a GitHub Actions workflow annotation: an error while
+it is ongoing and a notice once recovered. With --format slack, they are
+printed as Slack Block Kit JSON, or posted to an incoming webhook with
+--post-to.
+
+Examples:
+  groovekit incidents list --ongoing
+  groovekit incidents list --since 7d --type apis
+  groovekit incidents list --since 2026-01-01
+  groovekit incidents list --ongoing --format github
+  groovekit incidents list --since 24h --format slack --post-to "$SLACK_WEBHOOK_URL"`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		format, err := getFormat(cmd, formatText, formatGitHub, formatSlack)
+		if err != nil {
+			return err
+		}
+		ongoing, _ := cmd.Flags().GetBool("ongoing")
+		sinceFlag, _ := cmd.Flags().GetString("since")
+		typeFlag, _ := cmd.Flags().GetString("type")
+
+		kinds, err := parseIncidentTypes(typeFlag)
+		if err != nil {
+			return err
+		}
+
+		var since time.Time
+		if sinceFlag != "" {
+			if since, err = parseSince(sinceFlag, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput && format == formatText {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		rows, err := collectIncidents(cmd.Context(), client, kinds)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		rows = filterIncidents(rows, ongoing, since)
+		sortIncidents(rows)
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, rows)
+		}
+		switch format {
+		case formatGitHub:
+			printIncidentAnnotations(out, rows)
+			return nil
+		case formatSlack:
+			return emitSlack(cmd, incidentsSlackMessage(rows))
+		}
+
+		if len(rows) == 0 {
+			output.InfoMessage(out, "No incidents found")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{"INCIDENT", "TYPE", "ID", "NAME", "STARTED", "ENDED", "DURATION", "STATUS", "ACK", "NOTE"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+
+		for _, row := range rows {
+			status, ended := incidentStatus(row.Incident)
+
+			table.Append([]string{
+				output.Cyan(cmdutil.ShortID(row.ID)),
+				row.ResourceType,
+				cmdutil.ShortID(row.ResourceID),
+				row.ResourceName,
+				output.FormatTime(row.StartedAt),
+				ended,
+				cmdutil.FormatIncidentDuration(row.Duration),
+				status,
+				incidentAck(row.Incident),
+				incidentNote(row.Incident),
+			})
+		}
+
+		table.Flush()
+		output.TotalMessage(out, fmt.Sprintf("Total: %d", len(rows)))
+		return nil
+	},
+}
+
+// incidents show <incident-id>
+var incidentsShowCmd = &cobra.Command{
+	Use:   "show <incident-id>",
+	Short: "Show an incident's details and timeline",
+	Long: `Show an incident's details and a timeline of everything that happened
+during it: the first failed check, the error from each failed check, the
+alerts sent, acknowledgements and notes, and the check that recovered it.
+
+Repeated failed checks with the same error are collapsed into one line.
+Incident IDs are listed in the INCIDENT column of 'groovekit incidents list'.
+
+Examples:
+  groovekit incidents show abc12345
+  groovekit incidents show abc12345 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveIncidentID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		incident, err := client.GetIncident(fullID)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get incident: %w", err)
+		}
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, incident)
+		}
+		printIncidentDetail(out, incident)
+		return nil
+	},
+}
+
+// incidentEvent is one line of an incident timeline
+type incidentEvent struct {
+	at     string
+	event  string
+	detail string
+	// repeats and until describe identical failed checks collapsed into
+	// this event
+	repeats int
+	until   string
+}
+
+// incidentEventWidth is the width of the longest timeline event label
+const incidentEventWidth = len("First failed check")
+
+// buildIncidentTimeline orders everything that happened during an incident
+// into a timeline, oldest first
+func buildIncidentTimeline(incident *api.IncidentDetail) []incidentEvent {
+	var events []incidentEvent
+
+	recovered := false
+	lastFailure := -1
+	for _, check := range incident.Checks {
+		detail := incidentCheckDetail(check)
+		switch {
+		case check.Success:
+			events = append(events, incidentEvent{at: check.CreatedAt, event: "Recovered", detail: "check passed " + detail})
+			recovered = true
+			lastFailure = -1
+		case lastFailure >= 0 && events[lastFailure].detail == detail:
+			events[lastFailure].repeats++
+			events[lastFailure].until = check.CreatedAt
+		default:
+			event := "Check failed"
+			if len(events) == 0 {
+				event = "First failed check"
+			}
+			events = append(events, incidentEvent{at: check.CreatedAt, event: event, detail: detail})
+			lastFailure = len(events) - 1
+		}
+	}
+	if len(incident.Checks) == 0 || incident.Checks[0].Success {
+		detail := ""
+		if incident.ErrorMessage != nil {
+			detail = *incident.ErrorMessage
+		}
+		events = append(events, incidentEvent{at: incident.StartedAt, event: "Incident started", detail: detail})
+	}
+
+	for _, alert := range incident.Alerts {
+		event := "Alert sent"
+		detail := fmt.Sprintf("%s to %s (%s)", alert.AlertType, alert.Recipient, alert.Event)
+		if alert.Recipient == "" {
+			detail = fmt.Sprintf("%s (%s)", alert.AlertType, alert.Event)
+		}
+		if !alert.Delivered {
+			event = "Alert failed"
+			if alert.ErrorMessage != nil && *alert.ErrorMessage != "" {
+				detail += ": " + *alert.ErrorMessage
+			}
+		}
+		events = append(events, incidentEvent{at: alert.SentAt, event: event, detail: detail})
+	}
+
+	if incident.AcknowledgedAt != nil {
+		detail := ""
+		if incident.AcknowledgedBy != "" {
+			detail = "by " + incident.AcknowledgedBy
+		}
+		events = append(events, incidentEvent{at: *incident.AcknowledgedAt, event: "Acknowledged", detail: detail})
+	}
+
+	for _, note := range incident.Notes {
+		detail := note.Body
+		if note.Author != "" {
+			detail = fmt.Sprintf("%s: %s", note.Author, note.Body)
+		}
+		events = append(events, incidentEvent{at: note.CreatedAt, event: "Note", detail: detail})
+	}
+
+	if incident.EndedAt != nil && !recovered {
+		events = append(events, incidentEvent{at: *incident.EndedAt, event: "Recovered"})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		a, errA := time.Parse(time.RFC3339, events[i].at)
+		b, errB := time.Parse(time.RFC3339, events[j].at)
+		if errA != nil || errB != nil {
+			return events[i].at < events[j].at
+		}
+		return a.Before(b)
+	})
+	return events
+}
+
+// incidentCheckDetail summarizes a check's outcome, e.g.
+// "(503, 120ms): Service Unavailable"
+func incidentCheckDetail(check api.IncidentCheck) string {
+	var parts []string
+	if check.StatusCode > 0 {
+		parts = append(parts, strconv.Itoa(check.StatusCode))
+	}
+	if check.ResponseTime > 0 {
+		parts = append(parts, fmt.Sprintf("%.0fms", check.ResponseTime))
+	}
+	if check.Slow {
+		parts = append(parts, "slow")
+	}
+	detail := ""
+	if len(parts) > 0 {
+		detail = "(" + strings.Join(parts, ", ") + ")"
+	}
+	if check.ErrorMessage != nil && *check.ErrorMessage != "" {
+		if detail != "" {
+			detail += ": "
+		}
+		detail += *check.ErrorMessage
+	}
+	return detail
+}
+
+// printIncidentDetail prints an incident's details followed by its timeline
+func printIncidentDetail(out io.Writer, incident *api.IncidentDetail) {
+	status, ended := incidentStatus(incident.Incident)
+
+	fmt.Fprintf(out, "ID:           %s\n", output.Cyan(incident.ID))
+	fmt.Fprintf(out, "Resource:     %s (%s %s)\n", output.Bold(incident.ResourceName), incident.ResourceType, cmdutil.ShortID(incident.ResourceID))
+	fmt.Fprintf(out, "Status:       %s\n", status)
+	fmt.Fprintf(out, "Started:      %s\n", output.FormatTime(incident.StartedAt))
+	fmt.Fprintf(out, "Ended:        %s\n", ended)
+	fmt.Fprintf(out, "Duration:     %s\n", cmdutil.FormatIncidentDuration(incident.Duration))
+	fmt.Fprintf(out, "Acknowledged: %s\n", incidentAck(incident.Incident))
+
+	fmt.Fprintf(out, "\n%s\n", output.Bold("Timeline"))
+	for _, event := range buildIncidentTimeline(incident) {
+		label := event.event
+		switch label {
+		case "First failed check", "Check failed", "Alert failed":
+			label = output.Red(label)
+		case "Recovered":
+			label = output.Green(label)
+		}
+		line := fmt.Sprintf("  %-23s  %s", output.FormatTime(event.at), label)
+		if event.detail != "" {
+			// Pad by the uncolored label's width so details line up
+			line += strings.Repeat(" ", max(0, incidentEventWidth-len(event.event))) + "  " + event.detail
+		}
+		if event.repeats > 0 {
+			line += fmt.Sprintf(" (%d more until %s)", event.repeats, output.FormatTime(event.until))
+		}
+		fmt.Fprintln(out, line)
+	}
+}
+
+// incidents ack <incident-id>
+var incidentsAckCmd = &cobra.Command{
+	Use:   "ack <incident-id>",
+	Short: "Acknowledge an incident",
+	Long: `Acknowledge an incident so the rest of the team knows someone is on it,
+optionally leaving a note. Acknowledgements and the latest note are shown in
+incident tables.
+
+Incident IDs are listed in the INCIDENT column of 'groovekit incidents list'.
+
+Examples:
+  groovekit incidents ack abc12345
+  groovekit incidents ack abc12345 --note "deploying fix"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveIncidentID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		note, _ := cmd.Flags().GetString("note")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		acknowledged := true
+		incident, err := client.UpdateIncident(fullID, &api.UpdateIncidentRequest{Acknowledged: &acknowledged, Note: note})
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to acknowledge incident: %w", err)
+		}
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, incident)
+		}
+		output.SuccessMessage(out, fmt.Sprintf("Incident %s acknowledged", cmdutil.ShortID(fullID)))
+		if note != "" {
+			fmt.Fprintf(out, "Note: %s\n", note)
+		}
+		return nil
+	},
+}
+
+// incidentsBulkTarget lists incidents across every resource for ID
+// resolution
+var incidentsBulkTarget = bulkTarget{
+	noun:   "incident",
+	plural: "incidents",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		kinds, _ := parseIncidentTypes("")
+		rows, err := collectIncidents(context.Background(), client, kinds)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(rows))
+		for _, row := range rows {
+			if row.ID != "" {
+				items = append(items, bulkItem{id: row.ID, name: fmt.Sprintf("%s %s", row.ResourceName, output.FormatTime(row.StartedAt))})
+			}
+		}
+		return items, nil
+	},
+}
+
+// incidentAck describes whether an incident has been acknowledged, and by
+// whom, for incident tables
+func incidentAck(incident api.Incident) string {
+	switch {
+	case incident.AcknowledgedAt != nil && incident.AcknowledgedBy != "":
+		return output.Green("✓ " + cmdutil.Truncate(incident.AcknowledgedBy, 25))
+	case incident.AcknowledgedAt != nil:
+		return output.Green("✓ Yes")
+	case incident.EndedAt == nil:
+		return output.Yellow("No")
+	}
+	return "-"
+}
+
+// incidentNote returns an incident's latest note for tables
+func incidentNote(incident api.Incident) string {
+	if len(incident.Notes) == 0 {
+		return "-"
+	}
+	return incident.Notes[len(incident.Notes)-1].Body
+}
+
+// incidentState describes what was wrong during an incident: "slow" for
+// responses over an API monitor's max response time, otherwise "down"
+func incidentState(incident api.Incident) string {
+	if incident.Type == api.IncidentSlow {
+		return "slow"
+	}
+	return "down"
+}
+
+// incidentStatus colors an incident's status and end time, telling
+// incidents opened by slow responses apart from outages
+func incidentStatus(incident api.Incident) (status, ended string) {
+	slow := incident.Type == api.IncidentSlow
+	switch {
+	case incident.EndedAt != nil && slow:
+		return output.Green("Recovered (slow)"), output.FormatTime(*incident.EndedAt)
+	case incident.EndedAt != nil:
+		return output.Green("Recovered"), output.FormatTime(*incident.EndedAt)
+	case slow:
+		return output.Yellow("Slow"), output.Yellow("Still slow")
+	}
+	return output.Red("Ongoing"), output.Yellow("Still down")
+}
+
+// printIncidentAnnotations reports incidents as GitHub Actions annotations:
+// errors for ongoing incidents and notices for recovered ones
+func printIncidentAnnotations(out io.Writer, rows []incidentRow) {
+	for _, row := range rows {
+		title := fmt.Sprintf("GrooveKit %s incident", row.ResourceType)
+		if row.EndedAt == nil {
+			githubAnnotation(out, annotationError, title, fmt.Sprintf("%s (%s) has been %s since %s", row.ResourceName, cmdutil.ShortID(row.ResourceID), incidentState(row.Incident), output.FormatTime(row.StartedAt)))
+			continue
+		}
+		githubAnnotation(out, annotationNotice, title, fmt.Sprintf("%s (%s) was %s from %s to %s (%s)",
+			row.ResourceName, cmdutil.ShortID(row.ResourceID), incidentState(row.Incident), output.FormatTime(row.StartedAt), output.FormatTime(*row.EndedAt), cmdutil.FormatIncidentDuration(row.Duration)))
+	}
+}
+
+// parseIncidentTypes turns a comma-separated --type value into resource kinds.
+// An empty value selects every kind.
+func parseIncidentTypes(value string) (map[string]bool, error) {
+	kinds := map[string]bool{}
+	if value == "" {
+		for _, kind := range statusKinds {
+			kinds[kind] = true
+		}
+		return kinds, nil
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		kind, ok := incidentTypeAliases[strings.ToLower(strings.TrimSpace(part))]
+		if !ok {
+			return nil, usageErrorf("invalid --type %q: must be one of %s", part, strings.Join(statusKinds, ", "))
+		}
+		kinds[kind] = true
+	}
+	return kinds, nil
+}
+
+// parseSince parses a --since value: a duration ago ("24h", "7d") or a
+// date/timestamp ("2026-01-02", RFC 3339)
+func parseSince(value string, now time.Time) (time.Time, error) {
+	return parseTimeFlag("since", value, now)
+}
+
+// parseTimeFlag parses a time flag such as --since or --until, accepting
+// the same forms as parseSince
+func parseTimeFlag(name, value string, now time.Time) (time.Time, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, usageErrorf("invalid --%s %q: use a duration like 24h or 7d, or a date like 2026-01-02", name, value)
+}
+
+// incidentLister lists the resources of one kind
+type incidentLister struct {
+	kind string
+	list func() ([]incidentResource, error)
+}
+
+// collectIncidents lists resources of the selected kinds and fetches their
+// incidents, running the requests concurrently
+func collectIncidents(ctx context.Context, client api.APIClient, kinds map[string]bool) ([]incidentRow, error) {
+	resources, err := listIncidentResources(ctx, client, kinds)
+	if err != nil {
+		return nil, err
+	}
+	incidents, err := fetchIncidents(ctx, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []incidentRow{}
+	for i, res := range resources {
+		for _, incident := range incidents[i] {
+			rows = append(rows, incidentRow{
+				ResourceType: res.kind,
+				ResourceID:   res.id,
+				ResourceName: res.name,
+				Incident:     incident,
+			})
+		}
+	}
+	return rows, nil
+}
+
+// listIncidentResources lists resources of the selected kinds concurrently,
+// keeping them in display order
+func listIncidentResources(ctx context.Context, client api.APIClient, kinds map[string]bool) ([]incidentResource, error) {
+	listers := []incidentLister{
+		{"jobs", func() ([]incidentResource, error) {
+			resp, err := client.ListAllJobs(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list jobs: %w", err)
+			}
+			var resources []incidentResource
+			for _, job := range resp.Jobs {
+				resources = append(resources, incidentResource{"job", job.ID, job.Name, client.ListJobIncidents})
+			}
+			return resources, nil
+		}},
+		{"apis", func() ([]incidentResource, error) {
+			resp, err := client.ListAllApis(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list API monitors: %w", err)
+			}
+			var resources []incidentResource
+			for _, monitor := range resp.APIMonitors {
+				resources = append(resources, incidentResource{"api", monitor.ID, monitor.Name, client.ListApiIncidents})
+			}
+			return resources, nil
+		}},
+		{"certs", func() ([]incidentResource, error) {
+			resp, err := client.ListAllCerts(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list SSL monitors: %w", err)
+			}
+			var resources []incidentResource
+			for _, cert := range resp.SslMonitors {
+				resources = append(resources, incidentResource{"cert", cert.ID, cert.Name, client.ListCertIncidents})
+			}
+			return resources, nil
+		}},
+		{"domains", func() ([]incidentResource, error) {
+			resp, err := client.ListAllDomains(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list domain monitors: %w", err)
+			}
+			var resources []incidentResource
+			for _, domain := range resp.DomainMonitors {
+				resources = append(resources, incidentResource{"domain", domain.ID, domain.Name, client.ListDomainIncidents})
+			}
+			return resources, nil
+		}},
+		{"dns", func() ([]incidentResource, error) {
+			resp, err := client.ListAllDnsMonitors(nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list DNS monitors: %w", err)
+			}
+			var resources []incidentResource
+			for _, dns := range resp.DnsMonitors {
+				resources = append(resources, incidentResource{"dns", dns.ID, dns.Name, client.ListDnsMonitorIncidents})
+			}
+			return resources, nil
+		}},
+	}
+	listers = slices.DeleteFunc(listers, func(l incidentLister) bool { return !kinds[l.kind] })
+
+	listed := make([][]incidentResource, len(listers))
+	err := api.EachUntilError(ctx, len(listers), api.DefaultConcurrency, func(_ context.Context, i int) (err error) {
+		listed[i], err = listers[i].list()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resources []incidentResource
+	for _, list := range listed {
+		resources = append(resources, list...)
+	}
+	return resources, nil
+}
+
+// fetchIncidents fetches each resource's incidents concurrently, returning
+// them in the same order as resources
+func fetchIncidents(ctx context.Context, resources []incidentResource) ([][]api.Incident, error) {
+	incidents := make([][]api.Incident, len(resources))
+	err := api.EachUntilError(ctx, len(resources), api.DefaultConcurrency, func(_ context.Context, i int) (err error) {
+		res := resources[i]
+		if incidents[i], err = res.fetch(res.id); err != nil {
+			return fmt.Errorf("failed to get incidents for %s %s: %w", res.kind, cmdutil.ShortID(res.id), err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return incidents, nil
+}
+
+// filterIncidents keeps ongoing incidents and/or those started after since
+func filterIncidents(rows []incidentRow, ongoing bool, since time.Time) []incidentRow {
+	filtered := rows[:0]
+	for _, row := range rows {
+		if ongoing && row.EndedAt != nil {
+			continue
+		}
+		if !since.IsZero() {
+			started, err := time.Parse(time.RFC3339, row.StartedAt)
+			if err == nil && started.Before(since) {
+				continue
+			}
+		}
+		filtered = append(filtered, row)
+	}
+	return filtered
+}
+
+// sortIncidents orders incidents newest first
+func sortIncidents(rows []incidentRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, errA := time.Parse(time.RFC3339, rows[i].StartedAt)
+		b, errB := time.Parse(time.RFC3339, rows[j].StartedAt)
+		if errA != nil || errB != nil {
+			return rows[i].StartedAt > rows[j].StartedAt
+		}
+		return a.After(b)
+	})
+}
+
+func init() {
+	// Add flags to incidents list command
+	incidentsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(incidentsListCmd)
+	addReportFormatFlags(incidentsListCmd)
+	incidentsListCmd.Flags().Bool("ongoing", false, "Only show incidents that are still ongoing")
+	incidentsListCmd.Flags().String("since", "", "Only show incidents started after this time (e.g. 24h, 7d, 2026-01-02)")
+	incidentsListCmd.Flags().String("type", "", "Only show incidents for these resource types (comma-separated: jobs, apis, certs, domains, dns)")
+	registerFlagCompletion(incidentsListCmd, "type", completeList(statusKinds...))
+
+	// Add flags to incidents show command
+	incidentsShowCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to incidents ack command
+	incidentsAckCmd.Flags().String("note", "", "Note to leave on the incident, e.g. what is being done about it")
+	incidentsAckCmd.Flags().Bool("json", false, "Output as JSON")
+
+	incidentsCmd.AddCommand(incidentsListCmd)
+	incidentsCmd.AddCommand(incidentsShowCmd)
+	incidentsCmd.AddCommand(incidentsAckCmd)
+	rootCmd.AddCommand(incidentsCmd)
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedIncident adds a down job with an ongoing incident and the alert it
+// sent to the fake API, returning the incident's ID
+func seedIncident(srv *apitest.Server) string {
+	jobID, incidentID := srv.Fake.NewID(), srv.Fake.NewID()
+	srv.Fake.Jobs = []api.Job{{ID: jobID, Name: "Backup", Status: "active", Interval: 60, Down: true}}
+	srv.Fake.Incidents[jobID] = []api.Incident{{ID: incidentID, StartedAt: "2026-10-15T08:00:00Z"}}
+	srv.Fake.Alerts = []api.Alert{{ID: "alert-1", AlertType: "email", Event: "down", Recipient: "ops@example.com", ResourceType: "job", ResourceID: jobID, ResourceName: "Backup", Delivered: true, SentAt: "2026-10-15T08:01:00Z"}}
+	return incidentID
+}
+
+// TestIncidentsListCommand tests listing incidents across resources
+func TestIncidentsListCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedIncident(srv)
+
+	out := mustRun(t, "incidents", "list", "--ongoing")
+	assert.Contains(t, out, id[:8])
+	assert.Contains(t, out, "Backup")
+	assert.Contains(t, out, "Ongoing")
+
+	out = mustRun(t, "incidents", "list", "--type", "apis")
+	assert.Contains(t, out, "No incidents found")
+}
+
+// TestTimezone tests showing incident times in the zone chosen by
+// --timezone, GROOVEKIT_TIMEZONE, or the timezone setting
+func TestTimezone(t *testing.T) {
+	srv := startAPI(t)
+	seedIncident(srv)
+
+	out := mustRun(t, "incidents", "list")
+	assert.Contains(t, out, "2026-10-15 08:00:00 UTC")
+
+	mustRun(t, "config", "set", "timezone", "Asia/Tokyo")
+	t.Setenv("GROOVEKIT_TIMEZONE", "")
+	out = mustRun(t, "incidents", "list")
+	assert.Contains(t, out, "2026-10-15 17:00:00 JST")
+
+	t.Setenv("GROOVEKIT_TIMEZONE", "America/New_York")
+	out = mustRun(t, "incidents", "list")
+	assert.Contains(t, out, "2026-10-15 04:00:00 EDT")
+
+	out = mustRun(t, "incidents", "list", "--timezone", "Europe/London")
+	assert.Contains(t, out, "2026-10-15 09:00:00 BST")
+
+	// JSON keeps the API's timestamps
+	out = mustRun(t, "incidents", "list", "--json")
+	assert.Contains(t, out, "2026-10-15T08:00:00Z")
+
+	_, _, err := runCommand(t, "incidents", "list", "--timezone", "Mars/Olympus_Mons")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --timezone")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, _, err = runCommand(t, "config", "set", "timezone", "Mars/Olympus_Mons")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestIncidentsAckCommand tests acknowledging an incident with a note
+func TestIncidentsAckCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedIncident(srv)
+
+	out := mustRun(t, "incidents", "ack", id, "--note", "looking into it")
+	assert.Contains(t, out, "Incident "+id[:8]+" acknowledged")
+	assert.Contains(t, out, "Note: looking into it")
+
+	out = mustRun(t, "incidents", "list")
+	assert.Contains(t, out, "test@example.com")
+	assert.Contains(t, out, "looking into it")
+}
+
+// TestIncidentAckAndNote tests the ACK and NOTE incident table cells
+func TestIncidentAckAndNote(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	ackedAt := "2026-03-10T12:05:00Z"
+	endedAt := "2026-03-10T12:30:00Z"
+
+	assert.Equal(t, "No", incidentAck(api.Incident{}))
+	assert.Equal(t, "-", incidentAck(api.Incident{EndedAt: &endedAt}))
+	assert.Equal(t, "✓ Yes", incidentAck(api.Incident{AcknowledgedAt: &ackedAt}))
+	assert.Equal(t, "✓ ana@example.com", incidentAck(api.Incident{AcknowledgedAt: &ackedAt, AcknowledgedBy: "ana@example.com"}))
+
+	assert.Equal(t, "-", incidentNote(api.Incident{}))
+	incident := api.Incident{Notes: []api.IncidentNote{{Body: "looking into it"}, {Body: "deploying fix"}}}
+	assert.Equal(t, "deploying fix", incidentNote(incident))
+}
+
+// TestIncidentsShowCommand tests showing an incident's resource and
+// timeline
+func TestIncidentsShowCommand(t *testing.T) {
+	srv := startAPI(t)
+	id := seedIncident(srv)
+
+	out := mustRun(t, "incidents", "show", id[:8])
+	assert.Contains(t, out, "ID:           "+id)
+	assert.Contains(t, out, "Resource:     Backup (job ")
+	assert.Contains(t, out, "Incident started")
+	assert.Contains(t, out, "email to ops@example.com (down)")
+}
+
+// TestBuildIncidentTimeline tests ordering incident events and collapsing
+// repeated failed checks
+func TestBuildIncidentTimeline(t *testing.T) {
+	unavailable := "Service Unavailable"
+	refused := "connection refused"
+	ackedAt := "2026-03-01T10:07:00Z"
+	endedAt := "2026-03-01T11:00:00Z"
+	incident := &api.IncidentDetail{
+		Incident: api.Incident{
+			StartedAt:      "2026-03-01T10:00:00Z",
+			EndedAt:        &endedAt,
+			AcknowledgedAt: &ackedAt,
+			AcknowledgedBy: "ana@example.com",
+			Notes:          []api.IncidentNote{{Body: "deploying fix", CreatedAt: "2026-03-01T10:08:00Z"}},
+		},
+		Checks: []api.IncidentCheck{
+			{StatusCode: 503, ErrorMessage: &unavailable, CreatedAt: "2026-03-01T10:00:00Z"},
+			{StatusCode: 503, ErrorMessage: &unavailable, CreatedAt: "2026-03-01T10:01:00Z"},
+			{StatusCode: 503, ErrorMessage: &unavailable, CreatedAt: "2026-03-01T10:02:00Z"},
+			{ErrorMessage: &refused, CreatedAt: "2026-03-01T10:30:00Z"},
+			{Success: true, StatusCode: 200, ResponseTime: 95, CreatedAt: "2026-03-01T11:00:00Z"},
+		},
+		Alerts: []api.Alert{
+			{AlertType: "email", Event: "down", Recipient: "ops@example.com", Delivered: true, SentAt: "2026-03-01T10:01:00Z"},
+		},
+	}
+
+	events := buildIncidentTimeline(incident)
+	var names []string
+	for _, event := range events {
+		names = append(names, event.event)
+	}
+	assert.Equal(t, []string{"First failed check", "Alert sent", "Acknowledged", "Note", "Check failed", "Recovered"}, names)
+
+	assert.Equal(t, "(503): Service Unavailable", events[0].detail)
+	assert.Equal(t, 2, events[0].repeats)
+	assert.Equal(t, "2026-03-01T10:02:00Z", events[0].until)
+	assert.Equal(t, "email to ops@example.com (down)", events[1].detail)
+	assert.Equal(t, "by ana@example.com", events[2].detail)
+	assert.Equal(t, "connection refused", events[4].detail)
+	assert.Equal(t, "check passed (200, 95ms)", events[5].detail)
+}
+
+// TestBuildIncidentTimelineWithoutChecks tests falling back to the
+// incident's own start and end times
+func TestBuildIncidentTimelineWithoutChecks(t *testing.T) {
+	missed := "No ping received"
+	endedAt := "2026-03-01T11:00:00Z"
+	events := buildIncidentTimeline(&api.IncidentDetail{
+		Incident: api.Incident{StartedAt: "2026-03-01T10:00:00Z", EndedAt: &endedAt, ErrorMessage: &missed},
+	})
+
+	require.Len(t, events, 2)
+	assert.Equal(t, "Incident started", events[0].event)
+	assert.Equal(t, missed, events[0].detail)
+	assert.Equal(t, "Recovered", events[1].event)
+	assert.Equal(t, endedAt, events[1].at)
+}
+
+// TestIncidentStatus tests telling slow incidents apart from outages
+func TestIncidentStatus(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	useTimezone(t, "UTC")
+	endedAt := "2026-03-01T11:00:00Z"
+
+	status, ended := incidentStatus(api.Incident{})
+	assert.Equal(t, "Ongoing", status)
+	assert.Equal(t, "Still down", ended)
+
+	status, ended = incidentStatus(api.Incident{Type: api.IncidentSlow})
+	assert.Equal(t, "Slow", status)
+	assert.Equal(t, "Still slow", ended)
+
+	status, ended = incidentStatus(api.Incident{Type: api.IncidentSlow, EndedAt: &endedAt})
+	assert.Equal(t, "Recovered (slow)", status)
+	assert.Equal(t, "2026-03-01 11:00:00 UTC", ended)
+
+	assert.Equal(t, "slow", incidentState(api.Incident{Type: api.IncidentSlow}))
+	assert.Equal(t, "down", incidentState(api.Incident{Type: "down"}))
+	assert.Equal(t, "(200, 1450ms, slow)", incidentCheckDetail(api.IncidentCheck{StatusCode: 200, ResponseTime: 1450, Slow: true}))
+}
+
+// TestParseIncidentTypes tests --type parsing and aliases
+func TestParseIncidentTypes(t *testing.T) {
+	kinds, err := parseIncidentTypes("")
+	require.NoError(t, err)
+	assert.Len(t, kinds, len(statusKinds))
+
+	kinds, err = parseIncidentTypes("job, monitors,dns")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"jobs": true, "apis": true, "dns": true}, kinds)
+
+	_, err = parseIncidentTypes("servers")
+	assert.Error(t, err)
+}
+
+// TestParseSince tests relative and absolute --since values
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"7d", now.AddDate(0, 0, -7)},
+		{"24h", now.Add(-24 * time.Hour)},
+		{"2026-03-01T00:00:00Z", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := parseSince(tt.value, now)
+		require.NoError(t, err, tt.value)
+		assert.True(t, tt.want.Equal(got), "parseSince(%q) = %v, want %v", tt.value, got, tt.want)
+	}
+
+	got, err := parseSince("2026-03-01", now)
+	require.NoError(t, err)
+	assert.Equal(t, 2026, got.Year())
+
+	_, err = parseSince("last week", now)
+	assert.Error(t, err)
+}
+
+// TestFilterAndSortIncidents tests --ongoing/--since filtering and newest-first ordering
+func TestFilterAndSortIncidents(t *testing.T) {
+	ended := "2026-03-05T01:00:00Z"
+	rows := []incidentRow{
+		{ResourceType: "job", Incident: api.Incident{StartedAt: "2026-03-05T00:00:00Z", EndedAt: &ended}},
+		{ResourceType: "api", Incident: api.Incident{StartedAt: "2026-03-08T00:00:00Z"}},
+		{ResourceType: "dns", Incident: api.Incident{StartedAt: "2026-02-01T00:00:00Z"}},
+	}
+
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	filtered := filterIncidents(append([]incidentRow{}, rows...), false, since)
+	sortIncidents(filtered)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "api", filtered[0].ResourceType)
+	assert.Equal(t, "job", filtered[1].ResourceType)
+
+	ongoing := filterIncidents(append([]incidentRow{}, rows...), true, time.Time{})
+	require.Len(t, ongoing, 2)
+	for _, row := range ongoing {
+		assert.Nil(t, row.EndedAt)
+	}
+}
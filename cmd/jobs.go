@@ -1,15 +1,26 @@
 package cmd
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/briandowns/spinner"
 	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
 	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/cron"
 	"github.com/scookdev/groovekit-cli/internal/output"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var jobsCmd = &cobra.Command{
@@ -24,22 +35,32 @@ var jobsListCmd = &cobra.Command{
 	Short: "List all jobs",
 	Long:  "List all cron job monitors for your account",
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Check for --json flag first (don't show spinner for JSON output)
 		jsonOutput, _ := cmd.Flags().GetBool("json")
+		filter := getListFilter(cmd)
+		if err := filter.resolveProject(client); err != nil {
+			return err
+		}
 
 		// Start spinner
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
-		result, err := client.ListJobs()
+		list := client.ListJobs
+		if filter.all {
+			list = client.ListAllJobs
+		}
+		result, err := list(filter.options())
 
 		// Stop spinner
 		if s != nil {
@@ -49,50 +70,68 @@ var jobsListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed to list jobs: %w", err)
 		}
+
+		// Filter client-side too, in case the API ignored any of the options
+		if filter.active() {
+			result.Jobs = filterItems(result.Jobs, func(job api.Job) bool {
+				return filter.match(job.Status, job.Name, job.Down, job.Tags, job.ProjectID)
+			})
+			result.TotalCount = len(result.Jobs)
+		}
+
+		result.Jobs = limitItems(result.Jobs, filter.limit)
+
 		if jsonOutput {
-			return outputJSON(result)
+			return cmdutil.OutputJSON(out, result)
 		}
 
 		if len(result.Jobs) == 0 {
-			output.InfoMessage("No jobs found")
-			fmt.Println("\nCreate your first job:")
-			fmt.Println("  groovekit jobs create --name 'Daily Backup' --interval 1440")
+			if filter.active() {
+				output.InfoMessage(out, filter.emptyMessage("jobs"))
+				return nil
+			}
+			output.InfoMessage(out, "No jobs found")
+			fmt.Fprintln(out, "\nCreate your first job:")
+			fmt.Fprintln(out, "  groovekit jobs create --name 'Daily Backup' --interval 1440")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"ID", "NAME", "INTERVAL", "STATUS", "HEALTH"})
+		table, err := newListTable(cmd, []string{"ID", "NAME", "INTERVAL", "STATUS", "HEALTH", "GRACE PERIOD", "PING TOKEN", "WEBHOOK URL"}, "grace-period", "ping-token", "webhook-url")
+		if err != nil {
+			return err
+		}
 		table.Render()
 
 		// Add rows
 		for _, job := range result.Jobs {
-			status := job.Status
-			if job.Status == "active" {
-				status = output.Green(status)
-			}
+			status := formatListStatus(job.Status, job.SnoozedUntil, time.Now())
 
 			health := output.Green("✓ Up")
 			if job.Down {
 				health = output.Red("✗ Down")
 			}
 
-			// Truncate ID to first 8 chars (like Docker)
-			shortID := job.ID
-			if len(shortID) > 8 {
-				shortID = shortID[:8]
-			}
+			shortID := cmdutil.ShortID(job.ID)
 
-			table.Append([]string{
+			// Sort intervals by minutes rather than their display text
+			table.AppendWithValues([]string{
 				output.Cyan(shortID),
 				job.Name,
 				output.FormatDuration(job.Interval),
 				status,
 				health,
-			})
+				output.FormatDuration(job.GracePeriod),
+				valueOrDash(job.PingToken),
+				valueOrDash(job.WebhookURL),
+			}, []interface{}{nil, nil, job.Interval, nil, nil, job.GracePeriod})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d job(s)", result.TotalCount)))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d job(s)", result.TotalCount))
+		if result.HasMore && !filter.all {
+			output.InfoMessage(out, "More results are available, use --all to fetch every page")
+		}
 		return nil
 	},
 }
@@ -104,13 +143,15 @@ var jobsShowCmd = &cobra.Command{
 	Long:  "Display detailed information about a specific job",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -120,7 +161,7 @@ var jobsShowCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -134,72 +175,171 @@ var jobsShowCmd = &cobra.Command{
 			return fmt.Errorf("failed to get job: %w", err)
 		}
 		if jsonOutput {
-			return outputJSON(job)
+			return cmdutil.OutputJSON(out, job)
 		}
 
 		// Print job details
-		fmt.Printf("ID:            %s\n", job.ID)
-		fmt.Printf("Name:          %s\n", job.Name)
-		fmt.Printf("Status:        %s\n", job.Status)
-		fmt.Printf("Interval:      %s\n", output.FormatDuration(job.Interval))
-		fmt.Printf("Grace Period:  %s\n", output.FormatDuration(job.GracePeriod))
-		fmt.Printf("Down:          %t\n", job.Down)
+		fmt.Fprintf(out, "ID:            %s\n", job.ID)
+		fmt.Fprintf(out, "Name:          %s\n", job.Name)
+		fmt.Fprintf(out, "Status:        %s\n", job.Status)
+		if snoozed, ok := formatSnoozedUntil(job.SnoozedUntil, time.Now()); ok {
+			fmt.Fprintf(out, "Snoozed Until: %s\n", output.Yellow(snoozed))
+		}
+		if job.Schedule != "" {
+			fmt.Fprintf(out, "Schedule:      %s\n", job.Schedule)
+		}
+		fmt.Fprintf(out, "Interval:      %s\n", output.FormatDuration(job.Interval))
+		fmt.Fprintf(out, "Grace Period:  %s\n", output.FormatDuration(job.GracePeriod))
+		fmt.Fprintf(out, "Alerts:        %s\n", formatEscalation(job.AlertAfter, job.RealertEvery))
+		fmt.Fprintf(out, "Down:          %t\n", job.Down)
+		fmt.Fprintf(out, "Tags:          %s\n", formatTags(job.Tags))
+		fmt.Fprintf(out, "Notifications: %s\n", formatChannels(job.ChannelIDs))
 
 		if job.LastPingAt != nil {
-			fmt.Printf("Last Ping:     %s\n", *job.LastPingAt)
+			fmt.Fprintf(out, "Last Ping:     %s\n", output.FormatTime(*job.LastPingAt))
 		} else {
-			fmt.Printf("Last Ping:     Never\n")
+			fmt.Fprintf(out, "Last Ping:     Never\n")
 		}
 
 		if job.LastRunAt != nil {
-			fmt.Printf("Last Run:      %s\n", *job.LastRunAt)
+			fmt.Fprintf(out, "Last Run:      %s\n", output.FormatTime(*job.LastRunAt))
+		}
+
+		if next, ok := nextExpectedPing(job, time.Now()); ok {
+			fmt.Fprintf(out, "Next Expected: %s\n", formatNextPing(next, time.Now()))
 		}
 
-		fmt.Printf("\nPing URL:\n")
-		fmt.Printf("  curl https://api.groovekit.io/pings/%s\n", job.PingToken)
+		fmt.Fprintf(out, "\nPing URL:\n")
+		fmt.Fprintf(out, "  curl https://api.groovekit.io/pings/%s\n", job.PingToken)
+		fmt.Fprintf(out, "  groovekit jobs ping %s\n", job.PingToken)
 
 		if len(job.AllowedIPs) > 0 {
-			fmt.Printf("\nAllowed IPs:   %v\n", job.AllowedIPs)
+			fmt.Fprintf(out, "\nAllowed IPs:\n")
+			for _, ip := range job.AllowedIPs {
+				fmt.Fprintf(out, "  %s\n", ip)
+			}
 		}
 
 		if job.WebhookURL != "" {
-			fmt.Printf("\nWebhook URL:   %s\n", job.WebhookURL)
+			fmt.Fprintf(out, "\nWebhook URL:   %s\n", job.WebhookURL)
 		}
 
 		return nil
 	},
 }
 
+// jobsCreateWizard prompts for the jobs create settings, asking for an
+// interval only when the job has no cron schedule
+var jobsCreateWizard = []wizardField{
+	{flag: "name", prompt: "Job name", required: true},
+	{flag: "schedule", prompt: `Cron schedule, e.g. "0 3 * * *" (blank to give an interval instead)`, validate: func(answer string) error {
+		_, err := cron.Parse(answer)
+		return err
+	}, skip: func(cmd *cobra.Command) bool {
+		return cmd.Flags().Changed("interval")
+	}},
+	{flag: "interval", prompt: "Expected ping interval", required: true, skip: func(cmd *cobra.Command) bool {
+		return cmd.Flags().Changed("schedule")
+	}},
+	{flag: "grace-period", prompt: "Grace period", def: func(cmd *cobra.Command) string {
+		expr, _ := cmd.Flags().GetString("schedule")
+		if schedule, err := cron.Parse(expr); err == nil && expr != "" {
+			return formatDurationUnits(inferGracePeriod(scheduleInterval(schedule, time.Now())), time.Minute)
+		}
+		return cmd.Flags().Lookup("grace-period").DefValue
+	}},
+	tagsWizardField,
+}
+
 // jobs create
 var jobsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new job",
-	Long:  "Create a new cron job heartbeat monitor",
+	Long: `Create a new cron job heartbeat monitor.
+
+Give either --interval in minutes or --schedule with the job's cron
+expression. With a schedule, the interval is the longest gap between runs
+(so a weekday job allows for the weekend) and, unless --grace-period is
+set, the grace period is a tenth of it, between 5 and 60 minutes.
+
+Examples:
+  groovekit jobs create --name "Daily Backup" --interval 1440 --grace-period 5
+  groovekit jobs create --name "Nightly Report" --schedule "0 3 * * 1-5"
+  groovekit jobs create --name "Backup" --interval 1440 --allowed-ip 203.0.113.5 --allowed-ip 10.0.0.0/24`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
+		if err := runCreateWizard(cmd, jobsCreateWizard); err != nil {
+			return err
+		}
+
 		// Get flag values
 		name, _ := cmd.Flags().GetString("name")
-		interval, _ := cmd.Flags().GetInt("interval")
-		gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+		interval := getDurationFlag(cmd, "interval")
+		gracePeriod := getDurationFlag(cmd, "grace-period")
+		scheduleExpr, _ := cmd.Flags().GetString("schedule")
 
 		if name == "" {
-			return fmt.Errorf("--name is required")
+			return usageErrorf("--name is required")
 		}
-		if interval <= 0 {
-			return fmt.Errorf("--interval must be greater than 0")
+
+		if cmd.Flags().Changed("schedule") {
+			schedule, err := cron.Parse(scheduleExpr)
+			if err != nil {
+				return err
+			}
+			if interval = scheduleInterval(schedule, time.Now()); interval == 0 {
+				return fmt.Errorf("schedule %q never runs", scheduleExpr)
+			}
+			if !cmd.Flags().Changed("grace-period") {
+				gracePeriod = inferGracePeriod(interval)
+			}
+		} else if interval <= 0 {
+			return usageErrorf("--interval or --schedule is required, and --interval must be greater than 0")
 		}
 
 		req := &api.CreateJobRequest{
 			Name:        name,
 			Interval:    interval,
 			GracePeriod: gracePeriod,
+			Schedule:    scheduleExpr,
+		}
+
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil {
+			req.AlertAfter = *alertAfter
+		}
+		if realertEvery != nil {
+			req.RealertEvery = *realertEvery
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
+		}
+		req.Tags = tags
+
+		if req.AllowedIPs, err = getAllowedIPs(cmd); err != nil {
+			return err
+		}
+
+		if req.ProjectID, err = getProject(cmd, client); err != nil {
+			return err
+		}
+
+		if err := preflightLimit(cmd, client, limitJobs, 1); err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
 		s.Start()
 		job, err := client.CreateJob(req)
 		s.Stop()
@@ -208,18 +348,175 @@ var jobsCreateCmd = &cobra.Command{
 			return fmt.Errorf("failed to create job: %w", err)
 		}
 
-		output.SuccessMessage("Job created successfully\n")
-		fmt.Printf("ID:           %s\n", output.Cyan(job.ID))
-		fmt.Printf("Name:         %s\n", output.Bold(job.Name))
-		fmt.Printf("Interval:     %s\n", fmt.Sprintf("%d minutes", job.Interval))
-		fmt.Printf("Grace Period: %s\n", fmt.Sprintf("%d minutes", job.GracePeriod))
-		fmt.Printf("\n%s\n", output.Bold("Ping URL:"))
-		fmt.Printf("  %s\n", output.Cyan(fmt.Sprintf("curl https://api.groovekit.io/pings/%s", job.PingToken)))
+		output.SuccessMessage(out, "Job created successfully\n")
+		fmt.Fprintf(out, "ID:           %s\n", output.Cyan(job.ID))
+		fmt.Fprintf(out, "Name:         %s\n", output.Bold(job.Name))
+		if job.Schedule != "" {
+			fmt.Fprintf(out, "Schedule:     %s\n", job.Schedule)
+		}
+		fmt.Fprintf(out, "Interval:     %s\n", fmt.Sprintf("%d minutes", job.Interval))
+		fmt.Fprintf(out, "Grace Period: %s\n", fmt.Sprintf("%d minutes", job.GracePeriod))
+		if len(job.AllowedIPs) > 0 {
+			fmt.Fprintf(out, "Allowed IPs:  %s\n", strings.Join(job.AllowedIPs, ", "))
+		}
+		fmt.Fprintf(out, "\n%s\n", output.Bold("Ping URL:"))
+		fmt.Fprintf(out, "  %s\n", output.Cyan(fmt.Sprintf("curl https://api.groovekit.io/pings/%s", job.PingToken)))
 
 		return nil
 	},
 }
 
+// jobs clone <id>
+var jobsCloneCmd = &cobra.Command{
+	Use:   "clone <id>",
+	Short: "Create a copy of a job",
+	Long: `Create a new cron job monitor with the same interval, grace period, schedule,
+webhook, and allowed IPs as an existing one. The copy gets its own ping
+token.` + cloneLongHelp + `
+
+Examples:
+  groovekit jobs clone abc12345 --name "Staging backup"
+  groovekit jobs clone abc12345 --tag env=staging`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		job, err := client.GetJob(fullID)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+
+		tags, err := cloneTags(cmd, job.Tags)
+		if err != nil {
+			return err
+		}
+
+		if err := preflightLimit(cmd, client, limitJobs, 1); err != nil {
+			return err
+		}
+
+		s = newSpinner(cmd)
+		s.Start()
+		clone, err := client.CreateJob(&api.CreateJobRequest{
+			Name:          cloneName(cmd, job.Name),
+			Interval:      job.Interval,
+			GracePeriod:   job.GracePeriod,
+			AlertAfter:    job.AlertAfter,
+			RealertEvery:  job.RealertEvery,
+			Schedule:      job.Schedule,
+			Status:        job.Status,
+			WebhookURL:    job.WebhookURL,
+			WebhookSecret: job.WebhookSecret,
+			AllowedIPs:    job.AllowedIPs,
+			Tags:          tags,
+			ProjectID:     job.ProjectID,
+		})
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to create job: %w", err)
+		}
+
+		if err := finishClone(cmd, client, jobsNotifyTarget, job.Name, clone.Name, clone.ID, job.ChannelIDs); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n%s\n", output.Bold("Ping URL:"))
+		fmt.Fprintf(out, "  %s\n", output.Cyan(fmt.Sprintf("curl https://api.groovekit.io/pings/%s", clone.PingToken)))
+		return nil
+	},
+}
+
+// nextExpectedPing returns when a job should next ping: its schedule's next
+// run, or an interval after its last ping. Schedules are evaluated in UTC
+// like the API does, whatever zone now is in. It's false for jobs that have
+// never pinged and have no schedule, and for jobs that aren't active.
+func nextExpectedPing(job *api.Job, now time.Time) (time.Time, bool) {
+	if _, inactive := statusHealth(job.Status); inactive {
+		return time.Time{}, false
+	}
+	if job.Schedule != "" {
+		schedule, err := cron.Parse(job.Schedule)
+		if err != nil {
+			return time.Time{}, false
+		}
+		next := schedule.Next(now.UTC())
+		return next, !next.IsZero()
+	}
+	if job.LastPingAt == nil {
+		return time.Time{}, false
+	}
+	last, err := time.Parse(time.RFC3339, *job.LastPingAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return last.Add(time.Duration(job.Interval) * time.Minute), true
+}
+
+// formatNextPing shows a ping time in the display time zone along with how far off it
+// is, e.g. "2026-03-10 03:00 UTC (in 16.5h)"
+func formatNextPing(next, now time.Time) string {
+	when := output.InTimezone(next).Format("2006-01-02 15:04 MST")
+	wait := next.Sub(now)
+	if wait < 0 {
+		return fmt.Sprintf("%s (%s overdue)", when, cmdutil.FormatIncidentDuration(-wait.Seconds()))
+	}
+	return fmt.Sprintf("%s (in %s)", when, cmdutil.FormatIncidentDuration(wait.Seconds()))
+}
+
+// addAllowedIPFlag registers the repeatable --allowed-ip flag
+func addAllowedIPFlag(c *cobra.Command) {
+	c.Flags().StringArray("allowed-ip", nil, "IP address or CIDR range pings must come from, e.g. 203.0.113.5 or 10.0.0.0/24 (repeatable)")
+}
+
+// getAllowedIPs reads the --allowed-ip flags, checking each is an IP address
+// or CIDR range and dropping duplicates
+func getAllowedIPs(cmd *cobra.Command) ([]string, error) {
+	values, _ := cmd.Flags().GetStringArray("allowed-ip")
+	allowed := []string{}
+	for _, value := range values {
+		entry, err := parseAllowedIP(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(allowed, entry) {
+			allowed = append(allowed, entry)
+		}
+	}
+	return allowed, nil
+}
+
+// parseAllowedIP validates one --allowed-ip value and returns it in
+// canonical form. A CIDR range with host bits set is rejected rather than
+// silently widened or narrowed.
+func parseAllowedIP(value string) (string, error) {
+	if addr, err := netip.ParseAddr(value); err == nil && addr.Zone() == "" {
+		return addr.String(), nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return "", usageErrorf("invalid --allowed-ip %q: use an IP address or CIDR range, e.g. 203.0.113.5 or 10.0.0.0/24", value)
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return "", usageErrorf("invalid --allowed-ip %q: host bits are set, did you mean %s?", value, masked)
+	}
+	return prefix.String(), nil
+}
+
 // jobs update <id>
 var jobsUpdateCmd = &cobra.Command{
 	Use:   "update <id>",
@@ -227,13 +524,15 @@ var jobsUpdateCmd = &cobra.Command{
 	Long:  "Update an existing cron job monitor",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -249,13 +548,13 @@ var jobsUpdateCmd = &cobra.Command{
 		}
 
 		if cmd.Flags().Changed("interval") {
-			interval, _ := cmd.Flags().GetInt("interval")
+			interval := getDurationFlag(cmd, "interval")
 			req.Interval = &interval
 			hasUpdates = true
 		}
 
 		if cmd.Flags().Changed("grace-period") {
-			gracePeriod, _ := cmd.Flags().GetInt("grace-period")
+			gracePeriod := getDurationFlag(cmd, "grace-period")
 			req.GracePeriod = &gracePeriod
 			hasUpdates = true
 		}
@@ -278,11 +577,43 @@ var jobsUpdateCmd = &cobra.Command{
 			hasUpdates = true
 		}
 
+		alertAfter, realertEvery, err := getEscalation(cmd)
+		if err != nil {
+			return err
+		}
+		if alertAfter != nil || realertEvery != nil {
+			req.AlertAfter, req.RealertEvery = alertAfter, realertEvery
+			hasUpdates = true
+		}
+
+		if clearAllowedIPs, _ := cmd.Flags().GetBool("clear-allowed-ips"); clearAllowedIPs {
+			req.AllowedIPs = &[]string{}
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("allowed-ip") {
+			allowedIPs, err := getAllowedIPs(cmd)
+			if err != nil {
+				return err
+			}
+			req.AllowedIPs = &allowedIPs
+			hasUpdates = true
+		}
+
+		if cmd.Flags().Changed("tag") {
+			tags, err := getTags(cmd)
+			if err != nil {
+				return err
+			}
+			req.Tags = &tags
+			hasUpdates = true
+		}
+
 		if !hasUpdates {
-			return fmt.Errorf("no fields to update. Use --name, --interval, --grace-period, --status, --webhook-url, or --webhook-secret")
+			return fmt.Errorf("no fields to update. Use --name, --interval, --grace-period, --status, --webhook-url, --webhook-secret, --allowed-ip, --clear-allowed-ips, --alert-after, --realert-every, or --tag")
 		}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+		s := newSpinner(cmd)
 		s.Start()
 		job, err := client.UpdateJob(fullID, req)
 		s.Stop()
@@ -291,86 +622,77 @@ var jobsUpdateCmd = &cobra.Command{
 			return fmt.Errorf("failed to update job: %w", err)
 		}
 
-		output.SuccessMessage("Job updated successfully\n")
-		fmt.Printf("ID:           %s\n", output.Cyan(job.ID))
-		fmt.Printf("Name:         %s\n", output.Bold(job.Name))
-		fmt.Printf("Interval:     %s\n", output.FormatDuration(job.Interval))
-		fmt.Printf("Grace Period: %s\n", output.FormatDuration(job.GracePeriod))
-		fmt.Printf("Status:       %s\n", job.Status)
+		output.SuccessMessage(out, "Job updated successfully\n")
+		fmt.Fprintf(out, "ID:           %s\n", output.Cyan(job.ID))
+		fmt.Fprintf(out, "Name:         %s\n", output.Bold(job.Name))
+		fmt.Fprintf(out, "Interval:     %s\n", output.FormatDuration(job.Interval))
+		fmt.Fprintf(out, "Grace Period: %s\n", output.FormatDuration(job.GracePeriod))
+		fmt.Fprintf(out, "Status:       %s\n", job.Status)
 
 		return nil
 	},
 }
 
-// jobs pause <id>
+// jobs pause [id...]
 var jobsPauseCmd = &cobra.Command{
-	Use:   "pause <id>",
-	Short: "Pause a job",
-	Long:  "Pause a cron job monitor (sets status to paused)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to paused
-		status := "paused"
-		req := &api.UpdateJobRequest{Status: &status}
-
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateJob(fullID, req)
-		s.Stop()
-
-		if err != nil {
-			return fmt.Errorf("failed to pause job: %w", err)
-		}
-
-		output.SuccessMessage(fmt.Sprintf("Job %s paused successfully", args[0]))
-		return nil
+	Use:   "pause [id...]",
+	Short: "Pause one or more jobs",
+	Long: `Pause one or more cron job monitors (sets status to paused).` + bulkLongHelp + `
+
+Examples:
+  groovekit jobs pause abc12345 def67890
+  groovekit jobs pause --tag env=staging --dry-run
+  groovekit jobs pause --match 'name~staging'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, jobsBulkTarget, statusAction(jobsBulkTarget, "paused"))
 	},
 }
 
-// jobs resume <id>
+// jobs resume [id...]
 var jobsResumeCmd = &cobra.Command{
-	Use:   "resume <id>",
-	Short: "Resume a job",
-	Long:  "Resume a paused cron job monitor (sets status to active)",
-	Args:  cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
-		if err != nil {
-			return err
-		}
-
-		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
-		if err != nil {
-			return err
-		}
-
-		// Update status to active
-		status := "active"
-		req := &api.UpdateJobRequest{Status: &status}
+	Use:   "resume [id...]",
+	Short: "Resume one or more jobs",
+	Long: `Resume one or more paused cron job monitors (sets status to active).` + bulkLongHelp + `
+
+Examples:
+  groovekit jobs resume abc12345 def67890
+  groovekit jobs resume --tag env=staging
+  groovekit jobs resume --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, jobsBulkTarget, statusAction(jobsBulkTarget, "active"))
+	},
+}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		_, err = client.UpdateJob(fullID, req)
-		s.Stop()
+// jobs notify <id>
+var jobsNotifyCmd = &cobra.Command{
+	Use:   "notify <id>",
+	Short: "Manage where job alerts go",
+	Long: `Add or remove the notification channels that a job's alerts are
+routed to.` + notifyLongHelp + `
+
+Examples:
+  groovekit jobs notify abc12345
+  groovekit jobs notify abc12345 --add-channel ch_oncall --remove-channel ch_email`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runNotify(cmd, args[0], jobsNotifyTarget)
+	},
+}
 
+// jobsNotifyTarget reads and updates job notification channels
+var jobsNotifyTarget = notifyTarget{
+	noun:    "job",
+	resolve: cmdutil.ResolveJobID,
+	channels: func(client api.APIClient, id string) ([]string, error) {
+		job, err := client.GetJob(id)
 		if err != nil {
-			return fmt.Errorf("failed to resume job: %w", err)
+			return nil, err
 		}
-
-		output.SuccessMessage(fmt.Sprintf("Job %s resumed successfully", args[0]))
-		return nil
+		return job.ChannelIDs, nil
+	},
+	update: func(client api.APIClient, id string, channels []string) error {
+		_, err := client.UpdateJob(id, &api.UpdateJobRequest{ChannelIDs: &channels})
+		return err
 	},
 }
 
@@ -381,13 +703,15 @@ var jobsIncidentsCmd = &cobra.Command{
 	Long:  "Display incident history (downtime periods) for a job",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
 		if err != nil {
 			return err
 		}
 
 		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
 		if err != nil {
 			return err
 		}
@@ -397,7 +721,7 @@ var jobsIncidentsCmd = &cobra.Command{
 
 		var s *spinner.Spinner
 		if !jsonOutput {
-			s = spinner.New(spinner.CharSets[11], 100*time.Millisecond)
+			s = newSpinner(cmd)
 			s.Start()
 		}
 
@@ -412,16 +736,16 @@ var jobsIncidentsCmd = &cobra.Command{
 		}
 
 		if jsonOutput {
-			return outputJSON(incidents)
+			return cmdutil.OutputJSON(out, incidents)
 		}
 
 		if len(incidents) == 0 {
-			output.InfoMessage("No incidents found - this job has been running smoothly!")
+			output.InfoMessage(out, "No incidents found - this job has been running smoothly!")
 			return nil
 		}
 
 		// Create table
-		table := output.NewTable([]string{"STARTED", "ENDED", "DURATION", "STATUS"})
+		table := output.NewTable(out, []string{"ID", "STARTED", "ENDED", "DURATION", "STATUS", "ACK", "NOTE"})
 		table.Render()
 
 		// Add rows
@@ -431,187 +755,567 @@ var jobsIncidentsCmd = &cobra.Command{
 
 			if incident.EndedAt != nil {
 				status = output.Green("Recovered")
-				ended = *incident.EndedAt
+				ended = output.FormatTime(*incident.EndedAt)
 			}
 
 			// Format duration
-			duration := formatIncidentDuration(incident.Duration)
+			duration := cmdutil.FormatIncidentDuration(incident.Duration)
 
 			table.Append([]string{
-				incident.StartedAt,
+				output.Cyan(cmdutil.ShortID(incident.ID)),
+				output.FormatTime(incident.StartedAt),
 				ended,
 				duration,
 				status,
+				incidentAck(incident),
+				incidentNote(incident),
 			})
 		}
 
 		table.Flush()
-		fmt.Printf("\n%s\n", output.Bold(fmt.Sprintf("Total: %d incident(s)", len(incidents))))
+		output.TotalMessage(out, fmt.Sprintf("Total: %d incident(s)", len(incidents)))
 		return nil
 	},
 }
 
-// jobs delete <id>
-var jobsDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
-	Short: "Delete a job",
-	Long:  "Delete a cron job monitor",
-	Args:  cobra.ExactArgs(1),
+// jobs pings <id>
+var jobsPingsCmd = &cobra.Command{
+	Use:   "pings <id>",
+	Short: "Show ping history",
+	Long: `Display recent heartbeat pings for a job, with success rate and run
+duration stats.
+
+With --follow, print the last few pings and then each new one as it
+arrives, with its type, duration, and source IP, until interrupted. Handy
+for checking that a cron host is actually reaching GrooveKit. --type and
+--failed also apply to followed pings; with --json each ping is printed as
+a line of JSON.
+
+Examples:
+  groovekit jobs pings abc123 --failed
+  groovekit jobs pings abc123 --since 7d --type success --sort -duration
+  groovekit jobs pings abc123 --follow
+  groovekit jobs pings abc123 -f --interval 10s --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listJobPings(cmd, args[0])
+	},
+}
+
+// jobs ping <id|token>
+var jobsPingCmd = &cobra.Command{
+	Use:   "ping <id|token>",
+	Short: "Send a heartbeat ping",
+	Long: `Send a heartbeat ping for a job, by job ID or ping token.
+
+A job ID needs you to be logged in; a ping token works on any machine, so
+cron scripts can report in without credentials. Sends a success ping unless
+--start or --fail is given.
+
+Examples:
+  groovekit jobs ping abc12345 --start
+  groovekit jobs ping <ping-token> --success --duration 2m30s
+  groovekit jobs ping <ping-token> --fail`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, err := getAuthenticatedClient()
+		out := cmd.OutOrStdout()
+
+		cfg, err := config.Load()
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to load config: %w", err)
 		}
+		client := api.NewClient(cfg)
 
-		// Resolve short ID to full ID
-		fullID, err := resolveJobID(client, args[0])
-		if err != nil {
-			return err
+		pingType := api.PingSuccess
+		if start, _ := cmd.Flags().GetBool("start"); start {
+			pingType = api.PingStart
+		}
+		if fail, _ := cmd.Flags().GetBool("fail"); fail {
+			pingType = api.PingFail
 		}
+		duration, _ := cmd.Flags().GetDuration("duration")
 
-		// Confirm deletion
-		confirm, _ := cmd.Flags().GetBool("force")
-		if !confirm {
-			fmt.Printf("Are you sure you want to delete job %s? (y/N): ", args[0])
-			var response string
-			_, _ = fmt.Scanln(&response)
-			if response != "y" && response != "Y" {
-				fmt.Println("Cancelled")
-				return nil
+		token, name := pingTarget(client, cfg, args[0])
+
+		if err := client.SendPing(token, pingType, duration); err != nil {
+			return fmt.Errorf("failed to send ping: %w", err)
+		}
+
+		output.SuccessMessage(out, fmt.Sprintf("Sent %s ping for %s", pingType, name))
+		return nil
+	},
+}
+
+// pingTarget resolves a job ID to its ping token when logged in, and
+// otherwise treats the argument as a ping token. It also returns a label
+// for messages.
+func pingTarget(client api.APIClient, cfg *config.Config, arg string) (token, label string) {
+	if cfg.IsAuthenticated() {
+		if fullID, err := cmdutil.ResolveJobID(client, arg); err == nil {
+			if job, err := client.GetJob(fullID); err == nil && job.PingToken != "" {
+				return job.PingToken, "job " + output.Bold(job.Name)
 			}
 		}
+	}
+	return arg, "token " + output.Bold(cmdutil.ShortID(arg))
+}
 
-		s := spinner.New(spinner.CharSets[11], 100*time.Millisecond)
-		s.Start()
-		err = client.DeleteJob(fullID)
-		s.Stop()
+// jobs run <id|token> -- <command...>
+var jobsRunCmd = &cobra.Command{
+	Use:   "run <id|token> -- <command> [args...]",
+	Short: "Run a command and report it as a job run",
+	Long: `Run a command as a monitored job: send a start ping, run the command,
+then send a success or fail ping with the measured duration depending on its
+exit status. Exits with the command's exit status, so it can wrap cron
+entries directly.
+
+The command's output passes through untouched. Ping failures are reported on
+stderr but never stop the command from running.
+
+Examples:
+  groovekit jobs run abc12345 -- /usr/local/bin/backup.sh --full
+  0 3 * * * groovekit jobs run <ping-token> -- pg_dump -f /backups/db.sql mydb`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.ArgsLenAtDash() != 1 || len(args) < 2 {
+			return fmt.Errorf("usage: groovekit jobs run <id|token> -- <command> [args...]")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		errOut := cmd.ErrOrStderr()
 
+		cfg, err := config.Load()
 		if err != nil {
-			return fmt.Errorf("failed to delete job: %w", err)
+			return fmt.Errorf("failed to load config: %w", err)
 		}
+		client := api.NewClient(cfg)
+
+		token, _ := pingTarget(client, cfg, args[0])
 
-		output.SuccessMessage(fmt.Sprintf("Job %s deleted successfully", args[0]))
+		if noStart, _ := cmd.Flags().GetBool("no-start"); !noStart {
+			if err := client.SendPing(token, api.PingStart, 0); err != nil {
+				fmt.Fprintln(errOut, output.Yellow(fmt.Sprintf("groovekit: failed to send start ping: %v", err)))
+			}
+		}
+
+		started := time.Now()
+		code, runErr := runWrapped(cmd, args[1:])
+		duration := time.Since(started)
+
+		pingType := api.PingSuccess
+		if code != 0 {
+			pingType = api.PingFail
+		}
+		if err := client.SendPing(token, pingType, duration); err != nil {
+			fmt.Fprintln(errOut, output.Yellow(fmt.Sprintf("groovekit: failed to send %s ping: %v", pingType, err)))
+		}
+
+		if code != 0 {
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return &exitCodeError{code: code, err: runErr}
+		}
 		return nil
 	},
 }
 
-// Helper function to get authenticated client
-func getAuthenticatedClient() (*api.Client, error) {
-	cfg, err := config.Load()
-	if err != nil {
-		return nil, fmt.Errorf("failed to load config: %w", err)
+// runWrapped runs argv with the CLI's stdio, forwarding interrupt and
+// terminate signals to it, and returns its exit status. A command that
+// cannot be started returns 127 like a shell would, along with the error.
+func runWrapped(cmd *cobra.Command, argv []string) (int, error) {
+	child := exec.Command(argv[0], argv[1:]...)
+	child.Stdin = cmd.InOrStdin()
+	child.Stdout = cmd.OutOrStdout()
+	child.Stderr = cmd.ErrOrStderr()
+
+	if err := child.Start(); err != nil {
+		return 127, fmt.Errorf("failed to run %s: %w", argv[0], err)
 	}
 
-	if !cfg.IsAuthenticated() {
-		return nil, fmt.Errorf("not logged in. Run 'groovekit auth login' first")
-	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		for sig := range signals {
+			_ = child.Process.Signal(sig)
+		}
+	}()
 
-	return api.NewClient(cfg), nil
-}
+	err := child.Wait()
+	signal.Stop(signals)
+	close(signals)
 
-// Helper function to truncate strings
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if code := exitErr.ExitCode(); code > 0 {
+			return code, nil
+		}
+		// Killed by a signal
+		return 1, fmt.Errorf("%s: %w", argv[0], err)
 	}
-	return s[:maxLen-3] + "..."
-}
-
-// Helper function to output JSON
-func outputJSON(v interface{}) error {
-	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+		return 1, fmt.Errorf("%s: %w", argv[0], err)
 	}
-	fmt.Println(string(data))
-	return nil
+	return 0, nil
 }
 
-// Helper function to resolve a short ID to a full ID
-func resolveJobID(client *api.Client, shortID string) (string, error) {
-	// If it looks like a full UUID, use it as-is
-	if len(shortID) >= 32 {
-		return shortID, nil
-	}
+// jobs import-crontab
+var jobsImportCrontabCmd = &cobra.Command{
+	Use:   "import-crontab",
+	Short: "Create jobs from the entries in a crontab",
+	Long: `Read a crontab and create a job for each scheduled entry, with an interval
+and grace period worked out from its cron expression. The interval is the
+longest gap between runs, so a weekday job allows for the weekend, and the
+grace period is a tenth of it (between 5 and 60 minutes).
+
+A comment directly above an entry names its job; otherwise the job is named
+after the command. Entries whose job already exists are skipped, as are
+@reboot entries. Once the jobs are created, the entries are printed again
+wrapped in "groovekit jobs run", ready to paste back into the crontab.
+
+Without --file the current user's crontab (crontab -l) is read; use - to
+read from stdin. /etc/crontab and files in /etc/cron.d have a user field
+before the command; --system reads other files that way too.
+
+Examples:
+  groovekit jobs import-crontab --dry-run
+  groovekit jobs import-crontab --file /etc/crontab --tag server:web-1
+  ssh web-1 crontab -l | groovekit jobs import-crontab --file -`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
 
-	// Otherwise, fetch all jobs and match by prefix
-	result, err := client.ListJobs()
-	if err != nil {
-		return "", fmt.Errorf("failed to list jobs: %w", err)
-	}
+		file, _ := cmd.Flags().GetString("file")
+		system, _ := cmd.Flags().GetBool("system")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
 
-	var matches []string
-	for _, job := range result.Jobs {
-		if len(job.ID) >= len(shortID) && job.ID[:len(shortID)] == shortID {
-			matches = append(matches, job.ID)
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
 		}
-	}
 
-	if len(matches) == 0 {
-		return "", fmt.Errorf("no job found with ID prefix '%s'", shortID)
-	}
+		var data []byte
+		switch file {
+		case "":
+			data, err = exec.Command("crontab", "-l").Output()
+		case "-":
+			data, err = io.ReadAll(cmd.InOrStdin())
+		default:
+			data, err = os.ReadFile(file)
+			system = system || isSystemCrontab(file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read crontab: %w", err)
+		}
 
-	if len(matches) > 1 {
-		return "", fmt.Errorf("ambiguous ID prefix '%s' matches multiple jobs", shortID)
-	}
+		entries, warnings := parseCrontab(string(data), system, time.Now())
+		for _, warning := range warnings {
+			output.InfoMessage(out, warning)
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
 
-	return matches[0], nil
+		s := newSpinner(cmd)
+		s.Start()
+		items, err := resolverFor(client, jobsBulkTarget).Items()
+		s.Stop()
+
+		if err != nil {
+			return err
+		}
+
+		existing := map[string]bool{}
+		for _, item := range items {
+			existing[item.name] = true
+		}
+		entries = filterItems(entries, func(e crontabEntry) bool {
+			if existing[e.name] {
+				output.InfoMessage(out, fmt.Sprintf("Skipping %s, a job with that name already exists", e.name))
+				return false
+			}
+			existing[e.name] = true
+			return true
+		})
+		if len(entries) == 0 {
+			output.InfoMessage(out, "No crontab entries to import")
+			return nil
+		}
+
+		if dryRun {
+			fmt.Fprintf(out, "Would create %s:\n", countNoun(len(entries), "job", "jobs"))
+			table := output.NewTable(out, []string{"NAME", "SCHEDULE", "INTERVAL", "GRACE PERIOD"})
+			table.Render()
+			for _, e := range entries {
+				table.Append([]string{e.name, e.schedule.String(), output.FormatDuration(e.interval), output.FormatDuration(e.gracePeriod)})
+			}
+			table.Flush()
+			return nil
+		}
+
+		if err := preflightLimit(cmd, client, limitJobs, len(entries)); err != nil {
+			return err
+		}
+
+		paceBulk(cmd, client)
+
+		var lines []string
+		failed := 0
+		for _, e := range entries {
+			s := newSpinner(cmd)
+			s.Start()
+			job, err := client.CreateJob(&api.CreateJobRequest{
+				Name:        e.name,
+				Interval:    e.interval,
+				GracePeriod: e.gracePeriod,
+				Schedule:    e.schedule.String(),
+				Tags:        tags,
+			})
+			s.Stop()
+
+			if err != nil {
+				failed++
+				output.ErrorMessage(out, fmt.Sprintf("Failed to create job %s: %v", e.name, err))
+				continue
+			}
+			output.SuccessMessage(out, fmt.Sprintf("Created job %s (%s): every %s, %s grace period", e.name, cmdutil.ShortID(job.ID), output.FormatDuration(e.interval), output.FormatDuration(e.gracePeriod)))
+			lines = append(lines, wrapperLine(e, job.PingToken))
+		}
+
+		if len(lines) > 0 {
+			fmt.Fprintf(out, "\n%s\n", output.Bold("Replace these entries in your crontab:"))
+			for _, line := range lines {
+				fmt.Fprintln(out, line)
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to create %d of %s", failed, countNoun(len(entries), "job", "jobs"))
+		}
+		return nil
+	},
 }
 
-// Helper function to format incident duration (seconds to human readable)
-func formatIncidentDuration(seconds float64) string {
-	if seconds < 60 {
-		return fmt.Sprintf("%.0fs", seconds)
-	}
-	minutes := seconds / 60
-	if minutes < 60 {
-		return fmt.Sprintf("%.0fm", minutes)
-	}
-	hours := minutes / 60
-	if hours < 24 {
-		return fmt.Sprintf("%.1fh", hours)
+// jobs check <id>
+var jobsCheckCmd = &cobra.Command{
+	Use:   "check <id>",
+	Short: "Check job health",
+	Long:  "Query the current health of a cron job monitor for use in scripts and CI gates" + checkLongHelp,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
+		if err != nil {
+			return checkFailed(cmd, err)
+		}
+
+		job, err := client.GetJob(fullID)
+		if err != nil {
+			return checkFailed(cmd, fmt.Errorf("failed to get job: %w", err))
+		}
+
+		if state, ok := statusHealth(job.Status); ok {
+			return reportHealth(cmd, "job", job.Name, state, job.Status)
+		}
+		if job.Down {
+			return reportHealth(cmd, "job", job.Name, healthDown, "missed heartbeat")
+		}
+		if job.LastPingAt == nil {
+			return reportHealth(cmd, "job", job.Name, healthUnknown, "waiting for first ping")
+		}
+		return reportHealth(cmd, "job", job.Name, healthUp, "")
+	},
+}
+
+// jobs move [id...]
+var jobsMoveCmd = &cobra.Command{
+	Use:   "move [id...]",
+	Short: "Move one or more jobs to a project",
+	Long: `Move one or more cron job monitors to a project. Their check history,
+incidents, and settings are kept.` + bulkLongHelp + `
+
+Examples:
+  groovekit jobs move abc12345 --project def67890
+  groovekit jobs move --match 'name~checkout-*' --project def67890 --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMove(cmd, args, jobsBulkTarget)
+	},
+}
+
+// jobs delete [id...]
+var jobsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more jobs",
+	Long: `Delete one or more cron job monitors. Everything that will be deleted is listed
+for confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit jobs delete abc12345 def67890
+  groovekit jobs delete --match 'name~tmp-*' --dry-run
+  groovekit jobs delete --tag env=preview --force`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, jobsBulkTarget, deleteAction(jobsBulkTarget))
+	},
+}
+
+// Helper function to create a progress spinner. It draws on the command's
+// stderr so it never mixes with data written to stdout, and stays disabled
+// when stderr isn't a file (e.g. captured in tests), stdout isn't a
+// terminal (e.g. piped to another command), or with --quiet.
+func newSpinner(cmd *cobra.Command) *spinner.Spinner {
+	w := cmd.ErrOrStderr()
+	if f, ok := w.(*os.File); ok && !debugging && !quiet && isTerminal(cmd.OutOrStdout()) {
+		return spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriterFile(f))
 	}
-	days := hours / 24
-	return fmt.Sprintf("%.1fd", days)
+	s := spinner.New(spinner.CharSets[11], 100*time.Millisecond, spinner.WithWriter(w))
+	s.Disable()
+	return s
+}
+
+// isTerminal reports whether a command's input or output stream is a
+// terminal
+func isTerminal(stream any) bool {
+	f, ok := stream.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// jobsBulkTarget lists jobs for bulk actions and ID resolution
+var jobsBulkTarget = bulkTarget{
+	noun:    "job",
+	plural:  "jobs",
+	command: "jobs",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		result, err := client.ListAllJobs(nil)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(result.Jobs))
+		for _, job := range result.Jobs {
+			items = append(items, bulkItem{id: job.ID, name: job.Name, status: job.Status, tags: job.Tags, fields: map[string]string{"project": job.ProjectID}})
+		}
+		return items, nil
+	},
+	setStatus: func(client api.APIClient, id, status string) error {
+		_, err := client.UpdateJob(id, &api.UpdateJobRequest{Status: &status})
+		return err
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteJob(id)
+	},
+	move: func(client api.APIClient, id, projectID string) error {
+		return client.MoveJob(id, projectID)
+	},
+	snooze: func(client api.APIClient, id string, until time.Time) error {
+		return client.SnoozeJob(id, until)
+	},
 }
 
 func init() {
 	// Add flags to list command
 	jobsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(jobsListCmd)
+	addListFilterFlags(jobsListCmd)
+	addPageFlags(jobsListCmd)
 
 	// Add flags to show command
 	jobsShowCmd.Flags().Bool("json", false, "Output as JSON")
 
 	// Add flags to create command
 	jobsCreateCmd.Flags().String("name", "", "Job name (required)")
-	jobsCreateCmd.Flags().Int("interval", 0, "Check interval in minutes (required)")
-	jobsCreateCmd.Flags().Int("grace-period", 5, "Grace period in minutes")
-	_ = jobsCreateCmd.MarkFlagRequired("name")
-	_ = jobsCreateCmd.MarkFlagRequired("interval")
+	addDurationFlag(jobsCreateCmd, "interval", 0, time.Minute, "Check interval")
+	jobsCreateCmd.Flags().String("schedule", "", `Cron expression the job runs on, e.g. "0 3 * * *" (sets the interval)`)
+	addDurationFlag(jobsCreateCmd, "grace-period", 5, time.Minute, "Grace period")
+	jobsCreateCmd.MarkFlagsMutuallyExclusive("interval", "schedule")
+	addAllowedIPFlag(jobsCreateCmd)
+	addEscalationFlags(jobsCreateCmd)
+	addTagFlag(jobsCreateCmd)
+	addProjectFlag(jobsCreateCmd)
+	addInteractiveFlag(jobsCreateCmd)
+
+	// Add flags to clone command
+	addCloneFlags(jobsCloneCmd)
 
 	// Add flags to update command
 	jobsUpdateCmd.Flags().String("name", "", "Job name")
-	jobsUpdateCmd.Flags().Int("interval", 0, "Check interval in minutes")
-	jobsUpdateCmd.Flags().Int("grace-period", 0, "Grace period in minutes")
+	addDurationFlag(jobsUpdateCmd, "interval", 0, time.Minute, "Check interval")
+	addDurationFlag(jobsUpdateCmd, "grace-period", 0, time.Minute, "Grace period")
 	jobsUpdateCmd.Flags().String("status", "", "Job status (active, inactive, paused)")
+	registerFlagCompletion(jobsUpdateCmd, "status", completeValues(monitorStatuses...))
 	jobsUpdateCmd.Flags().String("webhook-url", "", "Webhook URL")
 	jobsUpdateCmd.Flags().String("webhook-secret", "", "Webhook secret")
+	addAllowedIPFlag(jobsUpdateCmd)
+	jobsUpdateCmd.Flags().Bool("clear-allowed-ips", false, "Accept pings from any IP address")
+	jobsUpdateCmd.MarkFlagsMutuallyExclusive("allowed-ip", "clear-allowed-ips")
+	addEscalationFlags(jobsUpdateCmd)
+	addTagFlag(jobsUpdateCmd)
+
+	// Add flags to notify command
+	addNotifyFlags(jobsNotifyCmd)
+
+	// Add flags to pause and resume commands
+	addBulkFlags(jobsPauseCmd)
+	addBulkFlags(jobsResumeCmd)
 
 	// Add flags to incidents command
 	jobsIncidentsCmd.Flags().Bool("json", false, "Output as JSON")
 
+	// Add flags to pings command
+	addHistoryFlags(jobsPingsCmd)
+	jobsPingsCmd.Flags().String("type", "", "Only show pings of this type (start, success, fail, heartbeat)")
+	registerFlagCompletion(jobsPingsCmd, "type", completeValues(pingTypes...))
+	jobsPingsCmd.Flags().BoolP("follow", "f", false, "Keep printing new pings as they arrive")
+	jobsPingsCmd.Flags().Duration("interval", 5*time.Second, "How often to poll for new pings with --follow")
+
+	// Add flags to check command
+	jobsCheckCmd.Flags().BoolP("verbose", "v", false, "Print the health result")
+	addFormatFlag(jobsCheckCmd)
+
+	// Add flags to ping command
+	jobsPingCmd.Flags().Bool("start", false, "Send a start ping (the job has begun running)")
+	jobsPingCmd.Flags().Bool("success", false, "Send a success ping (default)")
+	jobsPingCmd.Flags().Bool("fail", false, "Send a failure ping")
+	jobsPingCmd.Flags().Duration("duration", 0, "How long the run took, e.g. 90s or 2m30s")
+	jobsPingCmd.MarkFlagsMutuallyExclusive("start", "success", "fail")
+
+	// Add flags to run command
+	jobsRunCmd.Flags().Bool("no-start", false, "Don't send a start ping before running the command")
+
+	// Add flags to import-crontab command
+	jobsImportCrontabCmd.Flags().String("file", "", "Crontab to read, or - for stdin (default: crontab -l)")
+	jobsImportCrontabCmd.Flags().Bool("system", false, "Read the file as a system crontab, with a user field")
+	jobsImportCrontabCmd.Flags().Bool("dry-run", false, "Show the jobs that would be created without creating them")
+	addTagFlag(jobsImportCrontabCmd)
+
 	// Add flags to delete command
 	jobsDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(jobsDeleteCmd)
+	addProjectFlag(jobsMoveCmd)
+	addBulkFlags(jobsMoveCmd)
 
 	// Add subcommands
 	jobsCmd.AddCommand(jobsListCmd)
 	jobsCmd.AddCommand(jobsShowCmd)
 	jobsCmd.AddCommand(jobsCreateCmd)
+	jobsCmd.AddCommand(jobsCloneCmd)
 	jobsCmd.AddCommand(jobsUpdateCmd)
 	jobsCmd.AddCommand(jobsPauseCmd)
 	jobsCmd.AddCommand(jobsResumeCmd)
 	jobsCmd.AddCommand(jobsIncidentsCmd)
+	jobsCmd.AddCommand(jobsNotifyCmd)
+	jobsCmd.AddCommand(jobsPingsCmd)
+	jobsCmd.AddCommand(jobsPingCmd)
+	jobsCmd.AddCommand(jobsRunCmd)
+	jobsCmd.AddCommand(jobsImportCrontabCmd)
+	jobsCmd.AddCommand(jobsCheckCmd)
 	jobsCmd.AddCommand(jobsDeleteCmd)
+	jobsCmd.AddCommand(jobsMoveCmd)
 
 	// Add jobs command to root
 	rootCmd.AddCommand(jobsCmd)
@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"runtime"
 	"testing"
+	"time"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/apitest"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,109 +21,183 @@ func TestJobsCommand(t *testing.T) {
 	assert.NotEmpty(t, jobsCmd.Long)
 }
 
-// TestJobsListCommand tests the jobs list command
+// seedJobs adds an up and a down job to the fake API, returning their IDs
+func seedJobs(srv *apitest.Server) (backup, sync string) {
+	backup, sync = srv.Fake.NewID(), srv.Fake.NewID()
+	srv.Fake.Jobs = []api.Job{
+		{ID: backup, Name: "Backup", Status: "active", Interval: 60, GracePeriod: 5, PingToken: "backup-token", Tags: []string{"env=prod"}},
+		{ID: sync, Name: "Sync", Status: "active", Interval: 15, GracePeriod: 5, PingToken: "sync-token", Down: true},
+	}
+	return backup, sync
+}
+
+// TestJobsListCommand tests listing jobs as a table and as JSON
 func TestJobsListCommand(t *testing.T) {
-	assert.Equal(t, "list", jobsListCmd.Use)
-	assert.Equal(t, "List all jobs", jobsListCmd.Short)
-	assert.NotEmpty(t, jobsListCmd.Long)
-	require.NotNil(t, jobsListCmd.RunE, "jobs list command should have a RunE function")
+	srv := startAPI(t)
+	seedJobs(srv)
+
+	out := mustRun(t, "jobs", "list")
+	assert.Contains(t, out, "Backup")
+	assert.Contains(t, out, "Sync")
+	assert.Contains(t, out, "Total: 2 job(s)")
 
-	// Verify --json flag exists
-	jsonFlag := jobsListCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "jobs list command should have --json flag")
-	assert.Equal(t, "bool", jsonFlag.Value.Type())
+	var result api.JobsResponse
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "jobs", "list", "--json")), &result))
+	assert.Len(t, result.Jobs, 2)
+
+	out = mustRun(t, "jobs", "list", "--tag", "env=prod")
+	assert.Contains(t, out, "Backup")
+	assert.NotContains(t, out, "Sync")
 }
 
-// TestJobsShowCommand tests the jobs show command
+// TestJobsShowCommand tests showing a job by short ID
 func TestJobsShowCommand(t *testing.T) {
-	assert.Equal(t, "show <id>", jobsShowCmd.Use)
-	assert.Equal(t, "Show job details", jobsShowCmd.Short)
-	assert.NotEmpty(t, jobsShowCmd.Long)
-	require.NotNil(t, jobsShowCmd.RunE, "jobs show command should have a RunE function")
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+
+	out := mustRun(t, "jobs", "show", backup[:8])
+	assert.Contains(t, out, "ID:            "+backup)
+	assert.Contains(t, out, "Name:          Backup")
+	assert.Contains(t, out, "Interval:      1 hour")
+	assert.Contains(t, out, "backup-token")
+
+	var job api.Job
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "jobs", "show", backup, "--json")), &job))
+	assert.Equal(t, "Backup", job.Name)
 
-	// Verify --json flag exists
-	jsonFlag := jobsShowCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "jobs show command should have --json flag")
+	_, _, err := runCommand(t, "jobs", "show", "ffffffff-0000-4000-8000-000000000000")
+	assert.Equal(t, exitNotFound, exitCode(err))
 }
 
-// TestJobsCreateCommand tests the jobs create command
+// TestJobsCreateCommand tests creating a job and the request it sends
 func TestJobsCreateCommand(t *testing.T) {
-	assert.Equal(t, "create", jobsCreateCmd.Use)
-	assert.Equal(t, "Create a new job", jobsCreateCmd.Short)
-	assert.NotEmpty(t, jobsCreateCmd.Long)
-	require.NotNil(t, jobsCreateCmd.RunE, "jobs create command should have a RunE function")
+	srv := startAPI(t)
 
-	// Verify required flags
-	nameFlag := jobsCreateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "jobs create command should have --name flag")
-	assert.Equal(t, "string", nameFlag.Value.Type())
+	out := mustRun(t, "jobs", "create", "--name", "Nightly", "--interval", "1d", "--grace-period", "30m", "--tag", "team=ops")
+	assert.Contains(t, out, "Job created successfully")
+	assert.Contains(t, out, "Name:         Nightly")
 
-	intervalFlag := jobsCreateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "jobs create command should have --interval flag")
-	assert.Equal(t, "int", intervalFlag.Value.Type())
+	require.Len(t, srv.Fake.Jobs, 1)
+	job := srv.Fake.Jobs[0]
+	assert.Equal(t, 1440, job.Interval)
+	assert.Equal(t, 30, job.GracePeriod)
+	assert.Equal(t, []string{"team=ops"}, job.Tags)
+	assert.Contains(t, out, job.PingToken)
 
-	// Verify optional flags
-	gracePeriodFlag := jobsCreateCmd.Flags().Lookup("grace-period")
-	require.NotNil(t, gracePeriodFlag, "jobs create command should have --grace-period flag")
+	_, _, err := runCommand(t, "jobs", "create", "--interval", "1h")
+	assert.ErrorContains(t, err, "--name is required")
+	assert.Len(t, srv.Fake.Jobs, 1)
 }
 
-// TestJobsUpdateCommand tests the jobs update command
+// TestJobsUpdateCommand tests updating only the fields given
 func TestJobsUpdateCommand(t *testing.T) {
-	assert.Equal(t, "update <id>", jobsUpdateCmd.Use)
-	assert.Equal(t, "Update a job", jobsUpdateCmd.Short)
-	assert.NotEmpty(t, jobsUpdateCmd.Long)
-	require.NotNil(t, jobsUpdateCmd.RunE, "jobs update command should have a RunE function")
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
 
-	// Verify flags exist
-	nameFlag := jobsUpdateCmd.Flags().Lookup("name")
-	require.NotNil(t, nameFlag, "jobs update command should have --name flag")
+	out := mustRun(t, "jobs", "update", backup[:8], "--name", "Nightly backup", "--interval", "2h")
+	assert.Contains(t, out, "Job updated successfully")
+	assert.Contains(t, out, "Name:         Nightly backup")
+	assert.Equal(t, "Nightly backup", srv.Fake.Jobs[0].Name)
+	assert.Equal(t, 120, srv.Fake.Jobs[0].Interval)
+	assert.Equal(t, 5, srv.Fake.Jobs[0].GracePeriod)
 
-	intervalFlag := jobsUpdateCmd.Flags().Lookup("interval")
-	require.NotNil(t, intervalFlag, "jobs update command should have --interval flag")
-
-	statusFlag := jobsUpdateCmd.Flags().Lookup("status")
-	require.NotNil(t, statusFlag, "jobs update command should have --status flag")
+	_, _, err := runCommand(t, "jobs", "update", backup[:8])
+	assert.ErrorContains(t, err, "no fields to update")
 }
 
-// TestJobsPauseCommand tests the jobs pause command
+// TestJobsPauseCommand tests pausing and resuming a job
 func TestJobsPauseCommand(t *testing.T) {
-	assert.Equal(t, "pause <id>", jobsPauseCmd.Use)
-	assert.Equal(t, "Pause a job", jobsPauseCmd.Short)
-	assert.NotEmpty(t, jobsPauseCmd.Long)
-	require.NotNil(t, jobsPauseCmd.RunE, "jobs pause command should have a RunE function")
-}
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+
+	out := mustRun(t, "jobs", "pause", backup[:8])
+	assert.Contains(t, out, "Job Backup ("+backup[:8]+") paused successfully")
+	assert.Equal(t, "paused", srv.Fake.Jobs[0].Status)
 
-// TestJobsResumeCommand tests the jobs resume command
-func TestJobsResumeCommand(t *testing.T) {
-	assert.Equal(t, "resume <id>", jobsResumeCmd.Use)
-	assert.Equal(t, "Resume a job", jobsResumeCmd.Short)
-	assert.NotEmpty(t, jobsResumeCmd.Long)
-	require.NotNil(t, jobsResumeCmd.RunE, "jobs resume command should have a RunE function")
+	out = mustRun(t, "jobs", "resume", backup[:8])
+	assert.Contains(t, out, "resumed successfully")
+	assert.Equal(t, "active", srv.Fake.Jobs[0].Status)
 }
 
-// TestJobsIncidentsCommand tests the jobs incidents command
+// TestJobsIncidentsCommand tests listing a job's incidents
 func TestJobsIncidentsCommand(t *testing.T) {
-	assert.Equal(t, "incidents <id>", jobsIncidentsCmd.Use)
-	assert.Equal(t, "Show incident history", jobsIncidentsCmd.Short)
-	assert.NotEmpty(t, jobsIncidentsCmd.Long)
-	require.NotNil(t, jobsIncidentsCmd.RunE, "jobs incidents command should have a RunE function")
+	srv := startAPI(t)
+	_, sync := seedJobs(srv)
+	srv.Fake.Incidents[sync] = []api.Incident{{ID: "inc-1", StartedAt: "2026-10-15T08:00:00Z"}}
 
-	// Verify --json flag exists
-	jsonFlag := jobsIncidentsCmd.Flags().Lookup("json")
-	require.NotNil(t, jsonFlag, "jobs incidents command should have --json flag")
+	out := mustRun(t, "jobs", "incidents", sync[:8])
+	assert.Contains(t, out, "inc-1")
+	assert.Contains(t, out, "Ongoing")
+	assert.Contains(t, out, "Total: 1 incident(s)")
 }
 
-// TestJobsDeleteCommand tests the jobs delete command
+// TestJobsRunCommand tests the jobs run command
+func TestJobsRunCommand(t *testing.T) {
+	assert.Equal(t, "run <id|token> -- <command> [args...]", jobsRunCmd.Use)
+	assert.NotEmpty(t, jobsRunCmd.Long)
+	require.NotNil(t, jobsRunCmd.RunE, "jobs run command should have a RunE function")
+	assert.NotNil(t, jobsRunCmd.Flags().Lookup("no-start"))
+}
+
+// TestRunWrapped tests that the wrapped command's output and exit status pass through
+func TestRunWrapped(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+
+	code, err := runWrapped(cmd, []string{"sh", "-c", "echo hello; exit 3"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, code)
+	assert.Equal(t, "hello\n", stdout.String())
+
+	code, err = runWrapped(cmd, []string{"true"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, code)
+
+	code, err = runWrapped(cmd, []string{"groovekit-no-such-command"})
+	assert.Error(t, err)
+	assert.Equal(t, 127, code)
+}
+
+// TestJobsPingCommand tests sending pings by job ID and by ping token
+func TestJobsPingCommand(t *testing.T) {
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+
+	out := mustRun(t, "jobs", "ping", backup[:8])
+	assert.Contains(t, out, "Sent success ping for job Backup")
+
+	mustRun(t, "jobs", "ping", "sync-token", "--fail")
+
+	require.Len(t, srv.Fake.SentPings, 2)
+	assert.Equal(t, "backup-token", srv.Fake.SentPings[0].Token)
+	assert.Equal(t, "sync-token", srv.Fake.SentPings[1].Token)
+	assert.Equal(t, api.PingFail, srv.Fake.SentPings[1].PingType)
+}
+
+// TestJobsDeleteCommand tests that deleting asks for confirmation unless
+// forced
 func TestJobsDeleteCommand(t *testing.T) {
-	assert.Equal(t, "delete <id>", jobsDeleteCmd.Use)
-	assert.Equal(t, "Delete a job", jobsDeleteCmd.Short)
-	assert.NotEmpty(t, jobsDeleteCmd.Long)
-	require.NotNil(t, jobsDeleteCmd.RunE, "jobs delete command should have a RunE function")
+	srv := startAPI(t)
+	backup, sync := seedJobs(srv)
+
+	out, _, err := runCommandWithInput(t, "n\n", "jobs", "delete", backup[:8])
+	require.NoError(t, err)
+	assert.Contains(t, out, "Are you sure you want to delete job Backup")
+	assert.Contains(t, out, "Cancelled")
+	assert.Len(t, srv.Fake.Jobs, 2)
 
-	// Verify --force flag exists
-	forceFlag := jobsDeleteCmd.Flags().Lookup("force")
-	require.NotNil(t, forceFlag, "jobs delete command should have --force flag")
-	assert.Equal(t, "bool", forceFlag.Value.Type())
+	out, _, err = runCommandWithInput(t, "y\n", "jobs", "delete", backup[:8])
+	require.NoError(t, err)
+	assert.Contains(t, out, "deleted successfully")
+	assert.Len(t, srv.Fake.Jobs, 1)
+
+	mustRun(t, "jobs", "delete", sync[:8], "--force")
+	assert.Empty(t, srv.Fake.Jobs)
 }
 
 // TestJobsCommandHasSubcommands verifies all subcommands are registered
@@ -124,7 +205,7 @@ func TestJobsCommandHasSubcommands(t *testing.T) {
 	commands := jobsCmd.Commands()
 
 	// Should have 8 subcommands
-	expectedSubcommands := []string{"list", "show", "create", "update", "pause", "resume", "incidents", "delete"}
+	expectedSubcommands := []string{"list", "show", "create", "clone", "update", "pause", "resume", "incidents", "notify", "pings", "ping", "run", "import-crontab", "webhook", "delete", "move"}
 	assert.GreaterOrEqual(t, len(commands), len(expectedSubcommands))
 
 	// Verify all expected subcommands exist
@@ -152,5 +233,89 @@ func TestResolveJobID(t *testing.T) {
 	// In a real scenario, you'd mock the API client
 	// For now, we just verify the function exists by checking if it's referenced
 	// A full integration test would require a mock API server
-	assert.NotNil(t, jobsShowCmd.RunE, "resolveJobID is used by show command")
+	assert.NotNil(t, jobsShowCmd.RunE, "cmdutil.ResolveJobID is used by show command")
+}
+
+// TestJobsCreateSchedule tests the --schedule flag on jobs create
+func TestJobsCreateSchedule(t *testing.T) {
+	require.NotNil(t, jobsCreateCmd.Flags().Lookup("schedule"))
+	assert.Empty(t, jobsCreateCmd.Flags().Lookup("interval").Annotations[cobra.BashCompOneRequiredFlag])
+}
+
+// TestNextExpectedPing tests working out when a job should next ping
+func TestNextExpectedPing(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 30, 0, 0, time.UTC)
+
+	next, ok := nextExpectedPing(&api.Job{Status: "active", Schedule: "0 3 * * *"}, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC), next)
+
+	// The API runs schedules in UTC, so a local now of 01:30 in Tokyo
+	// (16:30 UTC the day before) next runs at 03:00 UTC, not 03:00 Tokyo
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	next, ok = nextExpectedPing(&api.Job{Status: "active", Schedule: "0 3 * * *"}, time.Date(2026, 3, 10, 1, 30, 0, 0, tokyo))
+	require.True(t, ok)
+	assert.True(t, time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC).Equal(next), next)
+
+	lastPing := "2026-03-09T10:00:00Z"
+	next, ok = nextExpectedPing(&api.Job{Status: "active", Interval: 60, LastPingAt: &lastPing}, now)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 9, 11, 0, 0, 0, time.UTC), next)
+
+	_, ok = nextExpectedPing(&api.Job{Status: "active", Interval: 60}, now)
+	assert.False(t, ok)
+	_, ok = nextExpectedPing(&api.Job{Status: "paused", Schedule: "0 3 * * *"}, now)
+	assert.False(t, ok)
+}
+
+// TestFormatNextPing tests showing how far off the next ping is
+func TestFormatNextPing(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 30, 0, 0, time.UTC)
+	assert.Contains(t, formatNextPing(now.Add(90*time.Minute), now), "(in 1.5h)")
+	assert.Contains(t, formatNextPing(now.Add(-10*time.Minute), now), "(10m overdue)")
+}
+
+// TestGetAllowedIPs tests validating --allowed-ip as IP addresses or CIDR
+// ranges
+func TestGetAllowedIPs(t *testing.T) {
+	newCmd := func(values ...string) *cobra.Command {
+		c := &cobra.Command{}
+		addAllowedIPFlag(c)
+		for _, value := range values {
+			require.NoError(t, c.Flags().Set("allowed-ip", value))
+		}
+		return c
+	}
+
+	ips, err := getAllowedIPs(newCmd("203.0.113.5", " 10.0.0.0/24", "2001:DB8::/32", "203.0.113.5"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.5", "10.0.0.0/24", "2001:db8::/32"}, ips)
+
+	ips, err = getAllowedIPs(newCmd())
+	require.NoError(t, err)
+	assert.Empty(t, ips)
+
+	_, err = getAllowedIPs(newCmd("10.0.0.5/24"))
+	assert.Equal(t, exitUsage, exitCode(err))
+	assert.Contains(t, err.Error(), "did you mean 10.0.0.0/24?")
+
+	for _, value := range []string{"cron.example.com", "10.0.0.0/33", "300.1.1.1", "fe80::1%eth0"} {
+		_, err = getAllowedIPs(newCmd(value))
+		assert.Equal(t, exitUsage, exitCode(err), value)
+	}
+}
+
+// TestJobsListUnknownColumn tests that an unknown --sort or --columns value
+// is a usage error
+func TestJobsListUnknownColumn(t *testing.T) {
+	srv := startAPI(t)
+	seedJobs(srv)
+
+	for _, args := range [][]string{{"--sort", "-bogus"}, {"--columns", "id,bogus"}} {
+		_, _, err := runCommand(t, append([]string{"jobs", "list"}, args...)...)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, `"bogus"`)
+		assert.Equal(t, exitUsage, exitCode(err))
+	}
 }
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/k8s"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// k8sMonitor is a monitor proposed for an Ingress host: an API monitor for
+// its URL or an SSL monitor for its certificate
+type k8sMonitor struct {
+	ssl    bool
+	name   string
+	target string
+	tags   []string
+}
+
+// noun names the type of monitor
+func (m k8sMonitor) noun() string {
+	if m.ssl {
+		return "SSL monitor"
+	}
+	return "API monitor"
+}
+
+// k8sMonitors proposes an API monitor for each Ingress host, and an SSL
+// monitor for each host the Ingress terminates TLS for, skipping hosts
+// already seen and URLs and domains that are already monitored
+func k8sMonitors(hosts []k8s.Host, tags []string, urls, domains map[string]bool) (monitors []k8sMonitor, monitored int) {
+	seen := map[string]bool{}
+	for _, host := range hosts {
+		if seen[host.Host] {
+			continue
+		}
+		seen[host.Host] = true
+
+		scheme := "http"
+		if host.TLS {
+			scheme = "https"
+		}
+		url := scheme + "://" + host.Host + host.Path
+		hostTags := k8sTags(host, tags)
+
+		if urls[url] {
+			monitored++
+		} else {
+			monitors = append(monitors, k8sMonitor{name: curlMonitorName(url), target: url, tags: hostTags})
+		}
+		if !host.TLS {
+			continue
+		}
+		if domains[host.Host] {
+			monitored++
+		} else {
+			monitors = append(monitors, k8sMonitor{ssl: true, name: host.Host, target: host.Host, tags: hostTags})
+		}
+	}
+	return monitors, monitored
+}
+
+// k8sTags adds namespace and app tags for an Ingress host to the given
+// tags, unless they already set those keys
+func k8sTags(host k8s.Host, tags []string) []string {
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		key, _, _ := strings.Cut(tag, "=")
+		keys[strings.ToLower(strings.TrimSpace(key))] = true
+	}
+	hostTags := append([]string{}, tags...)
+	if !keys["namespace"] {
+		hostTags = append(hostTags, "namespace="+host.Namespace)
+	}
+	if !keys["app"] && host.App != "" {
+		hostTags = append(hostTags, "app="+host.App)
+	}
+	return hostTags
+}
+
+// readIngresses reads Ingress manifests from a file, from stdin when file
+// is -, or from the cluster of a kubectl context
+func readIngresses(cmd *cobra.Command, file, context string) ([]byte, error) {
+	switch {
+	case context != "":
+		data, err := exec.CommandContext(cmd.Context(), "kubectl", "get", "ingress", "--all-namespaces", "--output", "yaml", "--context", context).Output()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			err = errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Ingresses with kubectl: %w", err)
+		}
+		return data, nil
+	case file == "-":
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests: %w", err)
+		}
+		return data, nil
+	default:
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests: %w", err)
+		}
+		return data, nil
+	}
+}
+
+// monitors import-k8s
+var monitorsImportK8sCmd = &cobra.Command{
+	Use:   "import-k8s",
+	Short: "Create monitors for the hosts Kubernetes Ingresses expose",
+	Long: `Read Kubernetes Ingress resources and create an API monitor for each
+external host they route, plus an SSL monitor for each host they terminate
+TLS for. The API monitor checks the first path routed for the host, or /
+when that path is a regular expression.
+
+Each monitor is tagged namespace=<namespace>, and app=<name> when the
+Ingress has an app.kubernetes.io/name or app label, along with any --tag
+flags. Rules without a host or with a wildcard host are skipped, as are
+URLs and domains that are already monitored.
+
+Read manifests with --file (- for stdin), which may hold several YAML
+documents and other kinds of resource, or read every Ingress in a cluster
+with --context, which runs kubectl with that context.
+
+Examples:
+  groovekit monitors import-k8s -f ingress.yaml --dry-run
+  groovekit monitors import-k8s --context prod --tag env=prod
+  helm template shop ./chart | groovekit monitors import-k8s -f -`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		file, _ := cmd.Flags().GetString("file")
+		context, _ := cmd.Flags().GetString("context")
+		interval := getDurationFlag(cmd, "interval")
+		sslInterval := getDurationFlag(cmd, "ssl-interval")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if (file == "") == (context == "") {
+			return usageErrorf("use one of --file or --context")
+		}
+		if interval <= 0 || sslInterval <= 0 {
+			return usageErrorf("--interval and --ssl-interval must be greater than 0")
+		}
+		tags, err := getTags(cmd)
+		if err != nil {
+			return err
+		}
+
+		data, err := readIngresses(cmd, file, context)
+		if err != nil {
+			return err
+		}
+		hosts, wildcards, err := k8s.Parse(data)
+		if err != nil {
+			return err
+		}
+		if wildcards > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s without a host or with a wildcard host", countNoun(wildcards, "rule", "rules")))
+		}
+		if len(hosts) == 0 {
+			output.InfoMessage(out, "No Ingress hosts found")
+			return nil
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		apis, err := resolverFor(client, apisBulkTarget).Items()
+		var certs []bulkItem
+		if err == nil {
+			certs, err = resolverFor(client, certsBulkTarget).Items()
+		}
+		s.Stop()
+
+		if err != nil {
+			return err
+		}
+
+		urls := map[string]bool{}
+		for _, item := range apis {
+			urls[item.fields["url"]] = true
+		}
+		domains := map[string]bool{}
+		for _, item := range certs {
+			domains[strings.ToLower(item.fields["domain"])] = true
+		}
+
+		monitors, monitored := k8sMonitors(hosts, tags, urls, domains)
+		if monitored > 0 {
+			output.InfoMessage(out, fmt.Sprintf("Skipping %s already monitored", countNoun(monitored, "URL or domain", "URLs and domains")))
+		}
+		if len(monitors) == 0 {
+			output.InfoMessage(out, "No monitors to create")
+			return nil
+		}
+
+		if dryRun {
+			fmt.Fprintf(out, "Would create %s:\n", countNoun(len(monitors), "monitor", "monitors"))
+			table := output.NewTable(out, []string{"TYPE", "NAME", "TARGET", "TAGS"})
+			table.Render()
+			for _, m := range monitors {
+				table.Append([]string{m.noun(), m.name, m.target, strings.Join(m.tags, ", ")})
+			}
+			table.Flush()
+			return nil
+		}
+
+		if err := preflightLimit(cmd, client, limitMonitors, len(monitors)); err != nil {
+			return err
+		}
+
+		paceBulk(cmd, client)
+
+		failed := 0
+		for _, m := range monitors {
+			s := newSpinner(cmd)
+			s.Start()
+			var id string
+			if m.ssl {
+				var cert *api.SslMonitor
+				cert, err = client.CreateCert(&api.CreateSslMonitorRequest{
+					Name:     m.name,
+					Domain:   m.target,
+					Interval: sslInterval,
+					Tags:     m.tags,
+				})
+				if err == nil {
+					id = cert.ID
+				}
+			} else {
+				var monitor *api.ApiMonitor
+				monitor, err = client.CreateApi(&api.CreateApiRequest{
+					Name:     m.name,
+					URL:      m.target,
+					Interval: interval,
+					Tags:     m.tags,
+				})
+				if err == nil {
+					id = monitor.ID
+				}
+			}
+			s.Stop()
+
+			if err != nil {
+				failed++
+				output.ErrorMessage(out, fmt.Sprintf("Failed to create %s for %s: %v", m.noun(), m.target, err))
+				continue
+			}
+			output.SuccessMessage(out, fmt.Sprintf("Created %s %s (%s) for %s", m.noun(), m.name, cmdutil.ShortID(id), m.target))
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to create %d of %s", failed, countNoun(len(monitors), "monitor", "monitors"))
+		}
+		return nil
+	},
+}
+
+func init() {
+	// Add flags to import-k8s command
+	monitorsImportK8sCmd.Flags().StringP("file", "f", "", "Manifest file to read Ingresses from, or - for stdin")
+	monitorsImportK8sCmd.Flags().String("context", "", "kubectl context of the cluster to read Ingresses from")
+	addDurationFlag(monitorsImportK8sCmd, "interval", 60, time.Minute, "API monitor check interval")
+	addDurationFlag(monitorsImportK8sCmd, "ssl-interval", 1440, time.Minute, "SSL monitor check interval")
+	addTagFlag(monitorsImportK8sCmd)
+	monitorsImportK8sCmd.Flags().Bool("dry-run", false, "Show what would be created without creating anything")
+
+	// Add subcommands
+	monitorsCmd.AddCommand(monitorsImportK8sCmd)
+}
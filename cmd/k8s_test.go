@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ingressManifest routes a TLS host and a plain HTTP host
+const ingressManifest = `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: shop
+  namespace: store
+  labels:
+    app.kubernetes.io/name: shop
+spec:
+  tls:
+    - hosts: [shop.example.com]
+  rules:
+    - host: shop.example.com
+      http:
+        paths:
+          - path: /health
+    - host: "*.example.com"
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: docs
+spec:
+  rules:
+    - host: docs.example.com
+`
+
+// TestMonitorsImportK8sCommand tests creating API and SSL monitors for the
+// hosts in an Ingress manifest
+func TestMonitorsImportK8sCommand(t *testing.T) {
+	srv := startAPI(t)
+	path := filepath.Join(t.TempDir(), "ingress.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(ingressManifest), 0o600))
+
+	out := mustRun(t, "monitors", "import-k8s", "-f", path, "--dry-run")
+	assert.Contains(t, out, "Skipping 1 rule without a host or with a wildcard host")
+	assert.Contains(t, out, "Would create 3 monitors")
+	assert.Contains(t, out, "https://shop.example.com/health")
+	assert.Contains(t, out, "namespace=store, app=shop")
+	assert.Empty(t, srv.Fake.Apis)
+
+	out = mustRun(t, "monitors", "import-k8s", "-f", path, "--tag", "env=prod")
+	assert.Contains(t, out, "Created API monitor shop.example.com/health")
+	assert.Contains(t, out, "Created SSL monitor shop.example.com")
+	assert.Contains(t, out, "Created API monitor docs.example.com")
+	require.Len(t, srv.Fake.Apis, 2)
+	require.Len(t, srv.Fake.Certs, 1)
+	assert.Equal(t, "https://shop.example.com/health", srv.Fake.Apis[0].URL)
+	assert.Equal(t, []string{"env=prod", "namespace=store", "app=shop"}, srv.Fake.Apis[0].Tags)
+	assert.Equal(t, "http://docs.example.com/", srv.Fake.Apis[1].URL)
+	assert.Equal(t, []string{"env=prod", "namespace=default"}, srv.Fake.Apis[1].Tags)
+	assert.Equal(t, "shop.example.com", srv.Fake.Certs[0].Domain)
+
+	out = mustRun(t, "monitors", "import-k8s", "-f", path)
+	assert.Contains(t, out, "Skipping 3 URLs and domains already monitored")
+	assert.Contains(t, out, "No monitors to create")
+
+	_, _, err := runCommand(t, "monitors", "import-k8s")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestMonitorsImportK8sContext tests reading Ingresses from a cluster with
+// kubectl
+func TestMonitorsImportK8sContext(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as kubectl")
+	}
+	startAPI(t)
+	dir := t.TempDir()
+	script := "#!/bin/sh\n" +
+		"if [ \"$7\" != prod ]; then echo \"error: context \\\"$7\\\" does not exist\" >&2; exit 1; fi\n" +
+		"cat <<'EOF'\napiVersion: v1\nkind: List\nitems:\n" +
+		"  - kind: Ingress\n    metadata: {name: docs, namespace: web}\n    spec: {rules: [{host: docs.example.com}]}\nEOF\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kubectl"), []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	out := mustRun(t, "monitors", "import-k8s", "--context", "prod", "--dry-run")
+	assert.Contains(t, out, "http://docs.example.com/")
+	assert.Contains(t, out, "namespace=web")
+
+	_, _, err := runCommand(t, "monitors", "import-k8s", "--context", "staging")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to get Ingresses with kubectl: error: context "staging" does not exist`)
+}
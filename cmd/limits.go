@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// pricingURL is where plan upgrade hints point
+const pricingURL = "https://groovekit.io/pricing"
+
+// limitWarnPercent is how full a plan limit gets before it is flagged
+const limitWarnPercent = 90
+
+// Plan limits. Monitor slots are shared by API, SSL, domain, and DNS
+// monitors.
+const (
+	limitJobs     = "jobs"
+	limitMonitors = "monitors"
+)
+
+// planLimit is one of the plan's slot limits and how much of it is used.
+// Max is 0 when the plan has no limit.
+type planLimit struct {
+	Kind      string `json:"kind"`
+	Used      int    `json:"used"`
+	Max       int    `json:"max"`
+	Remaining *int   `json:"remaining,omitempty"`
+}
+
+// limitsReport is the JSON output of account show --check-limits
+type limitsReport struct {
+	Plan   string      `json:"plan"`
+	Limits []planLimit `json:"limits"`
+}
+
+// planLimits returns the account's job and monitor limits
+func planLimits(account *api.Account) []planLimit {
+	limits := []planLimit{
+		{Kind: limitJobs, Used: account.JobCount},
+		{Kind: limitMonitors, Used: account.MonitorCount},
+	}
+	if account.Subscription != nil {
+		limits[0].Max = account.Subscription.MaxJobs
+		limits[1].Max = account.Subscription.MaxMonitors
+	}
+	for i, limit := range limits {
+		if limit.Max > 0 {
+			remaining := max(limit.Max-limit.Used, 0)
+			limits[i].Remaining = &remaining
+		}
+	}
+	return limits
+}
+
+// limitFor returns the named limit
+func limitFor(account *api.Account, kind string) planLimit {
+	for _, limit := range planLimits(account) {
+		if limit.Kind == kind {
+			return limit
+		}
+	}
+	return planLimit{Kind: kind}
+}
+
+// percent returns how much of the limit is used, counting adding more
+func (l planLimit) percent(adding int) float64 {
+	if l.Max <= 0 {
+		return 0
+	}
+	return float64(l.Used+adding) / float64(l.Max) * 100
+}
+
+// full reports whether there is no room for adding more
+func (l planLimit) full(adding int) bool {
+	return l.Max > 0 && l.Used+adding > l.Max
+}
+
+// noun names count resources of the limit's kind
+func (l planLimit) noun(count int) string {
+	return countNoun(count, l.Kind[:len(l.Kind)-1], l.Kind)
+}
+
+// planName names the account's plan for messages
+func planName(account *api.Account) string {
+	if account.Subscription == nil || account.Subscription.PlanName == "" {
+		return "current"
+	}
+	return account.Subscription.PlanName
+}
+
+// preflightLimit checks that the plan has room for adding more resources of
+// a kind before creating them, so a full plan is explained up front rather
+// than by an opaque API error. It warns when the create leaves the limit
+// nearly full. If the account can't be fetched the create goes ahead and
+// the API enforces the limit.
+func preflightLimit(cmd *cobra.Command, client api.APIClient, kind string, adding int) error {
+	account, err := client.GetAccount()
+	if err != nil {
+		return nil
+	}
+
+	limit := limitFor(account, kind)
+	if limit.full(adding) {
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitRateLimited, err: errors.New(limitExceeded(account, limit, adding))}
+	}
+	if limit.Max > 0 && limit.percent(adding) >= limitWarnPercent {
+		fmt.Fprintln(cmd.OutOrStdout(), output.Yellow(fmt.Sprintf("This will use %d of the %s on your %s plan",
+			limit.Used+adding, limit.noun(limit.Max), planName(account))))
+	}
+	return nil
+}
+
+// limitExceeded explains why adding more resources would exceed a limit
+func limitExceeded(account *api.Account, limit planLimit, adding int) string {
+	return fmt.Sprintf("Can't create %s: your %s plan allows %s and %d are in use.\nDelete ones you no longer need or upgrade at %s",
+		limit.noun(adding), planName(account), limit.noun(limit.Max), limit.Used, pricingURL)
+}
+
+// limitsFull reports whether any plan limit has no room left
+func limitsFull(account *api.Account) bool {
+	for _, limit := range planLimits(account) {
+		if limit.full(1) {
+			return true
+		}
+	}
+	return false
+}
+
+// printLimits reports usage against each plan limit, with a hint for any
+// that are full or nearly so
+func printLimits(out io.Writer, account *api.Account) {
+	fmt.Fprintf(out, "%s\n\n", output.Bold(fmt.Sprintf("Plan Limits (%s)", planName(account))))
+
+	var hints []string
+	for _, limit := range planLimits(account) {
+		label := capitalize(limit.Kind) + ":"
+		if limit.Max <= 0 {
+			fmt.Fprintf(out, "%-17s %d (no limit)\n", label, limit.Used)
+			continue
+		}
+		fmt.Fprintf(out, "%-17s %d / %d %s\n", label, limit.Used, limit.Max, formatUsageBar(limit.percent(0)))
+
+		switch {
+		case limit.full(1):
+			hints = append(hints, output.Red(fmt.Sprintf("✗ %s limit reached: delete %s you no longer need or upgrade at %s", capitalize(limit.Kind[:len(limit.Kind)-1]), limit.Kind, pricingURL)))
+		case limit.percent(0) >= limitWarnPercent:
+			hints = append(hints, output.Yellow(fmt.Sprintf("! %s left; upgrade at %s for more", limit.noun(*limit.Remaining), pricingURL)))
+		}
+	}
+
+	if len(hints) > 0 {
+		fmt.Fprintln(out)
+		for _, hint := range hints {
+			fmt.Fprintln(out, hint)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLimitsAccount has 4 of 5 monitors and 2 of 10 jobs in use
+func testLimitsAccount() *api.Account {
+	return &api.Account{
+		JobCount:     2,
+		MonitorCount: 4,
+		Subscription: &api.AccountSubscription{PlanName: "Pro", MaxJobs: 10, MaxMonitors: 5},
+	}
+}
+
+// TestPlanLimits tests reading usage and remaining slots from an account
+func TestPlanLimits(t *testing.T) {
+	limits := planLimits(testLimitsAccount())
+	require.Len(t, limits, 2)
+	assert.Equal(t, limitJobs, limits[0].Kind)
+	require.NotNil(t, limits[0].Remaining)
+	assert.Equal(t, 8, *limits[0].Remaining)
+	assert.Equal(t, 1, *limits[1].Remaining)
+
+	unlimited := planLimits(&api.Account{JobCount: 3})
+	assert.Nil(t, unlimited[0].Remaining)
+	assert.False(t, unlimited[0].full(100))
+}
+
+// TestPlanLimitFull tests whether a limit has room for more resources
+func TestPlanLimitFull(t *testing.T) {
+	monitors := limitFor(testLimitsAccount(), limitMonitors)
+	assert.False(t, monitors.full(1))
+	assert.True(t, monitors.full(2))
+	assert.InDelta(t, 100.0, monitors.percent(1), 0.01)
+	assert.False(t, limitsFull(testLimitsAccount()))
+
+	account := testLimitsAccount()
+	account.MonitorCount = 5
+	assert.True(t, limitsFull(account))
+}
+
+// TestLimitExceeded tests the message shown when a create would exceed a limit
+func TestLimitExceeded(t *testing.T) {
+	account := testLimitsAccount()
+	msg := limitExceeded(account, limitFor(account, limitMonitors), 3)
+	assert.Contains(t, msg, "Can't create 3 monitors: your Pro plan allows 5 monitors and 4 are in use")
+	assert.Contains(t, msg, pricingURL)
+}
+
+// TestPrintLimits tests the account show --check-limits report
+func TestPrintLimits(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	account := testLimitsAccount()
+	account.MonitorCount = 5
+
+	var out bytes.Buffer
+	printLimits(&out, account)
+	assert.Contains(t, out.String(), "Plan Limits (Pro)")
+	assert.Contains(t, out.String(), "Jobs:             2 / 10")
+	assert.Contains(t, out.String(), "✗ Monitor limit reached")
+	assert.NotContains(t, out.String(), "jobs left")
+}
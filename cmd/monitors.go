@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
+	"github.com/spf13/cobra"
+)
+
+// monitorTargets are the resource types monitors commands look IDs up in
+var monitorTargets = []bulkTarget{jobsBulkTarget, apisBulkTarget, certsBulkTarget, domainsBulkTarget, dnsBulkTarget}
+
+// monitorMatch is a resource of any type found by ID
+type monitorMatch struct {
+	target bulkTarget
+	item   bulkItem
+}
+
+// describe names the resource with its type, e.g. "job Backup (1a2b3c4d)"
+func (m monitorMatch) describe() string {
+	return m.target.noun + " " + describeBulkItem(m.item)
+}
+
+var monitorsCmd = &cobra.Command{
+	Use:   "monitors",
+	Short: "Act on monitors of any type",
+	Long: `Act on jobs, API monitors, SSL certificates, domains, and DNS monitors
+by ID, without saying which type each one is.`,
+}
+
+// monitors snooze <id...>
+var monitorsSnoozeCmd = &cobra.Command{
+	Use:   "snooze <id...>",
+	Short: "Hold back a monitor's alerts for a while",
+	Long: `Snooze one or more monitors: their checks keep running and incidents are
+still recorded, but no alerts are sent until the snooze ends. Unlike pause,
+nothing is missed in the check history, so it suits a monitor you're
+actively working on. List commands mark snoozed monitors SNOOZED.
+
+IDs can be any type of monitor, and short IDs work as elsewhere. Snoozing
+again replaces the end time.
+
+Examples:
+  groovekit monitors snooze abc12345 --for 1h
+  groovekit monitors snooze abc12345 def67890 --for 30m`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snoozeFor := getDurationFlag(cmd, "for")
+		if snoozeFor <= 0 {
+			return usageErrorf("--for must be longer than 0")
+		}
+		until := time.Now().Add(time.Duration(snoozeFor) * time.Minute).Truncate(time.Second)
+
+		return runSnooze(cmd, args, "snooze", until, func(m monitorMatch) string {
+			return fmt.Sprintf("Snoozed alerts for %s until %s", m.describe(), output.FormatTime(until.Format(time.RFC3339)))
+		})
+	},
+}
+
+// monitors unsnooze <id...>
+var monitorsUnsnoozeCmd = &cobra.Command{
+	Use:   "unsnooze <id...>",
+	Short: "Send a snoozed monitor's alerts again",
+	Long: `End the snooze of one or more monitors, so their alerts are sent again.
+
+Examples:
+  groovekit monitors unsnooze abc12345`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSnooze(cmd, args, "unsnooze", time.Time{}, func(m monitorMatch) string {
+			return fmt.Sprintf("Alerts for %s are no longer snoozed", m.describe())
+		})
+	},
+}
+
+// runSnooze snoozes the monitors with the given IDs until the given time,
+// or ends their snoozes when until is zero. Like bulk commands it keeps
+// going after a failure and returns an error if any failed.
+func runSnooze(cmd *cobra.Command, ids []string, verb string, until time.Time, done func(monitorMatch) string) error {
+	out := cmd.OutOrStdout()
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	s := newSpinner(cmd)
+	s.Start()
+	var matches []monitorMatch
+	for _, id := range ids {
+		var m monitorMatch
+		if m, err = resolveMonitor(client, id); err != nil {
+			break
+		}
+		matches = append(matches, m)
+	}
+	s.Stop()
+
+	if err != nil {
+		return err
+	}
+
+	paceBulk(cmd, client)
+	failed := 0
+	for _, m := range matches {
+		s := newSpinner(cmd)
+		s.Start()
+		err := m.target.snooze(client, m.item.id, until)
+		s.Stop()
+
+		if err != nil {
+			failed++
+			output.ErrorMessage(out, fmt.Sprintf("Failed to %s %s: %v", verb, m.describe(), err))
+			continue
+		}
+		output.SuccessMessage(out, done(m))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %s", verb, failed, countNoun(len(matches), "monitor", "monitors"))
+	}
+	return nil
+}
+
+// resolveMonitor finds the resource of any monitor type with a full ID or
+// unique ID prefix
+func resolveMonitor(client api.APIClient, query string) (monitorMatch, error) {
+	var matches []monitorMatch
+	for _, target := range monitorTargets {
+		item, err := resolverFor(client, target).Resource(query)
+		var notFound *resolve.NotFoundError
+		if errors.As(err, &notFound) {
+			continue
+		}
+		if err != nil {
+			return monitorMatch{}, err
+		}
+		matches = append(matches, monitorMatch{target: target, item: item})
+	}
+
+	switch len(matches) {
+	case 0:
+		return monitorMatch{}, &resolve.NotFoundError{Noun: "monitor", Query: query}
+	case 1:
+		return matches[0], nil
+	}
+	described := make([]string, 0, len(matches))
+	for _, m := range matches {
+		described = append(described, m.describe())
+	}
+	return monitorMatch{}, &resolve.AmbiguousError{Plural: "monitors", Query: query, Matches: described}
+}
+
+// snoozeLeft returns how long a resource's snooze has left. It's false
+// when the resource isn't snoozed or the snooze has run out.
+func snoozeLeft(snoozedUntil *string, now time.Time) (time.Duration, bool) {
+	if snoozedUntil == nil {
+		return 0, false
+	}
+	until, err := time.Parse(time.RFC3339, *snoozedUntil)
+	if err != nil || !until.After(now) {
+		return 0, false
+	}
+	return until.Sub(now), true
+}
+
+// formatListStatus colors a resource's status for list tables, marking
+// snoozed resources with how long their alerts stay held back, e.g.
+// "active SNOOZED 45m"
+func formatListStatus(status string, snoozedUntil *string, now time.Time) string {
+	if status == "active" {
+		status = output.Green(status)
+	}
+	if left, ok := snoozeLeft(snoozedUntil, now); ok {
+		status += " " + output.Yellow("SNOOZED "+cmdutil.FormatIncidentDuration(left.Seconds()))
+	}
+	return status
+}
+
+// formatSnoozedUntil shows when a snooze ends for show commands, e.g.
+// "2026-10-15 11:00:00 UTC (45m left)"
+func formatSnoozedUntil(snoozedUntil *string, now time.Time) (string, bool) {
+	left, ok := snoozeLeft(snoozedUntil, now)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%s (%s left)", output.FormatTime(*snoozedUntil), cmdutil.FormatIncidentDuration(left.Seconds())), true
+}
+
+func init() {
+	// Add flags to snooze command
+	addDurationFlag(monitorsSnoozeCmd, "for", 60, time.Minute, "How long to hold back alerts")
+
+	// Add subcommands
+	monitorsCmd.AddCommand(monitorsSnoozeCmd)
+	monitorsCmd.AddCommand(monitorsUnsnoozeCmd)
+
+	// Add monitors command to root
+	rootCmd.AddCommand(monitorsCmd)
+}
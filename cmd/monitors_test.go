@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMonitorsSnoozeCommand tests snoozing and unsnoozing monitors of
+// different types by ID
+func TestMonitorsSnoozeCommand(t *testing.T) {
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+	checkout := seedApis(srv)
+
+	before := time.Now()
+	out := mustRun(t, "monitors", "snooze", backup[:8], checkout[:8], "--for", "2h")
+	assert.Contains(t, out, "Snoozed alerts for job Backup ("+backup[:8]+") until")
+	assert.Contains(t, out, "Snoozed alerts for API monitor Checkout ("+checkout[:8]+") until")
+
+	require.NotNil(t, srv.Fake.Jobs[0].SnoozedUntil)
+	until, err := time.Parse(time.RFC3339, *srv.Fake.Jobs[0].SnoozedUntil)
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(2*time.Hour), until, time.Minute)
+	assert.Equal(t, "active", srv.Fake.Jobs[0].Status, "snoozing doesn't pause checks")
+	assert.Nil(t, srv.Fake.Jobs[1].SnoozedUntil)
+
+	out = mustRun(t, "jobs", "list")
+	assert.Contains(t, out, "active SNOOZED 2.0h")
+	out = mustRun(t, "apis", "show", checkout[:8])
+	assert.Contains(t, out, "Snoozed Until:")
+	assert.Contains(t, out, "(2.0h left)")
+
+	out = mustRun(t, "monitors", "unsnooze", backup[:8])
+	assert.Contains(t, out, "Alerts for job Backup ("+backup[:8]+") are no longer snoozed")
+	assert.Nil(t, srv.Fake.Jobs[0].SnoozedUntil)
+	assert.NotContains(t, mustRun(t, "jobs", "list"), "SNOOZED")
+}
+
+// TestMonitorsSnoozeErrors tests unknown and ambiguous IDs and an empty
+// snooze
+func TestMonitorsSnoozeErrors(t *testing.T) {
+	srv := startAPI(t)
+	backup, _ := seedJobs(srv)
+	srv.Fake.Jobs = srv.Fake.Jobs[:1]
+	seedApis(srv)
+
+	_, _, err := runCommand(t, "monitors", "snooze", "ffffffff")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no monitor found with ID prefix 'ffffffff'")
+
+	// The job's and the API monitor's IDs share a prefix
+	_, _, err = runCommand(t, "monitors", "snooze", "0000000")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ambiguous ID prefix '0000000' matches multiple monitors")
+	assert.Contains(t, err.Error(), "job Backup")
+	assert.Contains(t, err.Error(), "API monitor Checkout")
+
+	_, _, err = runCommand(t, "monitors", "snooze", backup[:8], "--for", "0")
+	require.Error(t, err)
+	assert.Equal(t, exitUsage, exitCode(err))
+	assert.Nil(t, srv.Fake.Jobs[0].SnoozedUntil)
+}
+
+// TestFormatListStatus tests marking snoozed resources in list tables
+func TestFormatListStatus(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	until := "2026-03-01T12:45:00Z"
+	ended := "2026-03-01T11:00:00Z"
+
+	assert.Equal(t, "active", formatListStatus("active", nil, now))
+	assert.Equal(t, "active SNOOZED 45m", formatListStatus("active", &until, now))
+	assert.Equal(t, "paused SNOOZED 45m", formatListStatus("paused", &until, now))
+	assert.Equal(t, "active", formatListStatus("active", &ended, now), "expired snoozes aren't shown")
+
+	useTimezone(t, "UTC")
+	snoozed, ok := formatSnoozedUntil(&until, now)
+	assert.True(t, ok)
+	assert.Equal(t, "2026-03-01 12:45:00 UTC (45m left)", snoozed)
+	_, ok = formatSnoozedUntil(&ended, now)
+	assert.False(t, ok)
+}
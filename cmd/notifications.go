@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// quietHoursLayout is the format of quiet hours start and end times
+const quietHoursLayout = "15:04"
+
+var accountNotificationsCmd = &cobra.Command{
+	Use:   "notifications",
+	Short: "Manage SMS and email alert preferences",
+	Long: `View and change when and how your account is alerted: whether SMS alerts
+are sent, quiet hours during which SMS alerts are held, and how long an
+incident stays open before escalating from email to SMS.
+
+Which channels each resource alerts is set per resource with its notify
+subcommand, e.g. 'groovekit jobs notify <id>'.`,
+}
+
+// account notifications show
+var accountNotificationsShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show alert preferences",
+	Long: `Show the account's alert preferences and this month's SMS usage.
+
+Examples:
+  groovekit account notifications show
+  groovekit account notifications show --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		prefs, err := client.GetNotificationPreferences()
+		var account *api.Account
+		if err != nil {
+			err = fmt.Errorf("failed to get notification preferences: %w", err)
+		} else if !jsonOutput {
+			account, err = client.GetAccount()
+			if err != nil {
+				err = fmt.Errorf("failed to get account: %w", err)
+			}
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if jsonOutput {
+			return cmdutil.OutputJSON(out, prefs)
+		}
+		printNotificationPreferences(out, prefs, account)
+		return nil
+	},
+}
+
+// account notifications update
+var accountNotificationsUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update alert preferences",
+	Long: `Update the account's alert preferences. Only the given flags are changed.
+
+Quiet hours are a start and end time in 24-hour format, in --timezone (or
+the timezone already set), and may cross midnight. SMS alerts raised during
+quiet hours are held until they end; email alerts are sent as usual. Pass
+--quiet-hours off to turn them off.
+
+Examples:
+  groovekit account notifications update --sms=false
+  groovekit account notifications update --quiet-hours 22:00-07:00 --timezone Europe/Berlin
+  groovekit account notifications update --escalation-delay 15m
+  groovekit account notifications update --quiet-hours off`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		req, err := notificationPreferencesUpdate(cmd)
+		if err != nil {
+			return err
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		prefs, err := client.UpdateNotificationPreferences(req)
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to update notification preferences: %w", err)
+		}
+
+		output.SuccessMessage(out, "Notification preferences updated\n")
+		printNotificationPreferences(out, prefs, nil)
+		return nil
+	},
+}
+
+// addNotificationPreferenceFlags registers the flags of notifications update
+func addNotificationPreferenceFlags(c *cobra.Command) {
+	c.Flags().Bool("sms", true, "Send SMS alerts (--sms=false to opt out)")
+	c.Flags().String("quiet-hours", "", "Hold SMS alerts between these times, e.g. 22:00-07:00, or off")
+	c.Flags().String("timezone", "", "IANA timezone for quiet hours, e.g. Europe/Berlin")
+	addDurationFlag(c, "escalation-delay", 0, time.Minute, "How long an incident stays open before escalating to SMS")
+}
+
+// notificationPreferencesUpdate builds an update request from the flags
+// that were given
+func notificationPreferencesUpdate(cmd *cobra.Command) (*api.UpdateNotificationPreferencesRequest, error) {
+	req := &api.UpdateNotificationPreferencesRequest{}
+	hasUpdates := false
+
+	if cmd.Flags().Changed("sms") {
+		sms, _ := cmd.Flags().GetBool("sms")
+		req.SMSEnabled = &sms
+		hasUpdates = true
+	}
+
+	if cmd.Flags().Changed("quiet-hours") {
+		value, _ := cmd.Flags().GetString("quiet-hours")
+		enabled := value != "off"
+		req.QuietHoursEnabled = &enabled
+		if enabled {
+			start, end, err := parseQuietHours(value)
+			if err != nil {
+				return nil, err
+			}
+			req.QuietHoursStart = &start
+			req.QuietHoursEnd = &end
+		}
+		hasUpdates = true
+	}
+
+	if cmd.Flags().Changed("timezone") {
+		timezone, _ := cmd.Flags().GetString("timezone")
+		if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+			return nil, usageErrorf("invalid --timezone %q: must be an IANA timezone such as Europe/Berlin", timezone)
+		}
+		req.Timezone = &timezone
+		hasUpdates = true
+	}
+
+	if cmd.Flags().Changed("escalation-delay") {
+		delay := getDurationFlag(cmd, "escalation-delay")
+		req.EscalationDelay = &delay
+		hasUpdates = true
+	}
+
+	if !hasUpdates {
+		return nil, usageErrorf("no preferences to update. Use --sms, --quiet-hours, --timezone, or --escalation-delay")
+	}
+	return req, nil
+}
+
+// parseQuietHours parses a quiet hours window such as "22:00-07:00" into
+// normalized start and end times
+func parseQuietHours(value string) (string, string, error) {
+	startText, endText, ok := strings.Cut(value, "-")
+	if ok {
+		start, startErr := time.Parse(quietHoursLayout, strings.TrimSpace(startText))
+		end, endErr := time.Parse(quietHoursLayout, strings.TrimSpace(endText))
+		if startErr == nil && endErr == nil {
+			if start.Equal(end) {
+				return "", "", usageErrorf("invalid --quiet-hours %q: start and end must differ", value)
+			}
+			return start.Format(quietHoursLayout), end.Format(quietHoursLayout), nil
+		}
+	}
+	return "", "", usageErrorf("invalid --quiet-hours %q: must be a range like 22:00-07:00, or off", value)
+}
+
+// printNotificationPreferences prints alert preferences, with this month's
+// SMS usage when the account is known
+func printNotificationPreferences(out io.Writer, prefs *api.NotificationPreferences, account *api.Account) {
+	fmt.Fprintf(out, "%s\n\n", output.Bold("Notification Preferences"))
+
+	sms := output.Yellow("off")
+	if prefs.SMSEnabled {
+		sms = output.Green("on")
+	}
+	if account != nil && account.Subscription != nil {
+		if limit := account.Subscription.SMSLimit; limit > 0 {
+			sms += fmt.Sprintf(" (%d / %d sent this month)", account.SMSUsed, limit)
+		} else {
+			sms += fmt.Sprintf(" (%s)", output.Yellow("not available on this plan"))
+		}
+	}
+	fmt.Fprintf(out, "SMS alerts:       %s\n", sms)
+
+	quietHours := "off"
+	if prefs.QuietHoursEnabled {
+		quietHours = fmt.Sprintf("%s–%s", prefs.QuietHoursStart, prefs.QuietHoursEnd)
+		if prefs.Timezone != "" {
+			quietHours += " " + prefs.Timezone
+		}
+	}
+	fmt.Fprintf(out, "Quiet hours:      %s\n", quietHours)
+
+	escalation := "immediately"
+	if prefs.EscalationDelay > 0 {
+		escalation = "after " + output.FormatDuration(prefs.EscalationDelay)
+	}
+	fmt.Fprintf(out, "Escalate to SMS:  %s\n", escalation)
+}
+
+func init() {
+	// Add flags to notifications subcommands
+	accountNotificationsShowCmd.Flags().Bool("json", false, "Output as JSON")
+	addNotificationPreferenceFlags(accountNotificationsUpdateCmd)
+
+	// Add subcommands
+	accountNotificationsCmd.AddCommand(accountNotificationsShowCmd)
+	accountNotificationsCmd.AddCommand(accountNotificationsUpdateCmd)
+	accountCmd.AddCommand(accountNotificationsCmd)
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseQuietHours tests parsing quiet hours windows
+func TestParseQuietHours(t *testing.T) {
+	start, end, err := parseQuietHours("22:00-07:00")
+	require.NoError(t, err)
+	assert.Equal(t, "22:00", start)
+	assert.Equal(t, "07:00", end)
+
+	start, end, err = parseQuietHours("9:30 - 17:00")
+	require.NoError(t, err)
+	assert.Equal(t, "09:30", start)
+	assert.Equal(t, "17:00", end)
+
+	for _, bad := range []string{"", "22:00", "22:00-25:00", "10pm-7am", "08:00-08:00"} {
+		_, _, err := parseQuietHours(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// TestNotificationPreferencesUpdate tests building an update from flags
+func TestNotificationPreferencesUpdate(t *testing.T) {
+	cmd := &cobra.Command{}
+	addNotificationPreferenceFlags(cmd)
+
+	_, err := notificationPreferencesUpdate(cmd)
+	assert.Error(t, err, "no flags should be an error")
+
+	require.NoError(t, cmd.Flags().Set("sms", "false"))
+	require.NoError(t, cmd.Flags().Set("quiet-hours", "off"))
+	require.NoError(t, cmd.Flags().Set("escalation-delay", "1h"))
+	req, err := notificationPreferencesUpdate(cmd)
+	require.NoError(t, err)
+	require.NotNil(t, req.SMSEnabled)
+	assert.False(t, *req.SMSEnabled)
+	require.NotNil(t, req.QuietHoursEnabled)
+	assert.False(t, *req.QuietHoursEnabled)
+	assert.Nil(t, req.QuietHoursStart)
+	require.NotNil(t, req.EscalationDelay)
+	assert.Equal(t, 60, *req.EscalationDelay)
+	assert.Nil(t, req.Timezone)
+
+	require.NoError(t, cmd.Flags().Set("timezone", "Mars/Olympus"))
+	_, err = notificationPreferencesUpdate(cmd)
+	assert.Error(t, err)
+}
+
+// TestPrintNotificationPreferences tests the preferences summary
+func TestPrintNotificationPreferences(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	var buf bytes.Buffer
+	printNotificationPreferences(&buf, &api.NotificationPreferences{
+		SMSEnabled:        true,
+		QuietHoursEnabled: true,
+		QuietHoursStart:   "22:00",
+		QuietHoursEnd:     "07:00",
+		Timezone:          "Europe/Berlin",
+		EscalationDelay:   15,
+	}, &api.Account{SMSUsed: 12, Subscription: &api.AccountSubscription{SMSLimit: 100}})
+	out := buf.String()
+	assert.Contains(t, out, "on (12 / 100 sent this month)")
+	assert.Contains(t, out, "22:00–07:00 Europe/Berlin")
+	assert.Contains(t, out, "after 15 minutes")
+
+	buf.Reset()
+	printNotificationPreferences(&buf, &api.NotificationPreferences{}, nil)
+	out = buf.String()
+	assert.Contains(t, out, "SMS alerts:       off")
+	assert.Contains(t, out, "Quiet hours:      off")
+	assert.Contains(t, out, "immediately")
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// notifyTarget adapts a resource type for the notify subcommands
+type notifyTarget struct {
+	noun     string
+	resolve  func(client api.APIClient, id string) (string, error)
+	channels func(client api.APIClient, id string) ([]string, error)
+	update   func(client api.APIClient, id string, channels []string) error
+}
+
+// notifyLongHelp is shared by every notify subcommand
+const notifyLongHelp = `
+
+Without --add-channel or --remove-channel, the current channels are listed.
+Both flags can be repeated or given comma-separated channel IDs.`
+
+// addNotifyFlags registers the flags shared by notify subcommands
+func addNotifyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("add-channel", nil, "Notification channel ID to route alerts to")
+	cmd.Flags().StringSlice("remove-channel", nil, "Notification channel ID to stop routing alerts to")
+	cmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+// runNotify lists or changes the notification channels a resource alerts
+func runNotify(cmd *cobra.Command, idArg string, target notifyTarget) error {
+	out := cmd.OutOrStdout()
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	// Resolve short ID to full ID
+	fullID, err := target.resolve(client, idArg)
+	if err != nil {
+		return err
+	}
+
+	add, _ := cmd.Flags().GetStringSlice("add-channel")
+	remove, _ := cmd.Flags().GetStringSlice("remove-channel")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var s *spinner.Spinner
+	if !jsonOutput {
+		s = newSpinner(cmd)
+		s.Start()
+	}
+
+	current, err := target.channels(client, fullID)
+	if err != nil {
+		if s != nil {
+			s.Stop()
+		}
+		return fmt.Errorf("failed to get %s: %w", target.noun, err)
+	}
+
+	channels, changed := editChannels(current, add, remove)
+	if changed {
+		err = target.update(client, fullID, channels)
+	}
+
+	if s != nil {
+		s.Stop()
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update %s notifications: %w", target.noun, err)
+	}
+
+	if jsonOutput {
+		return cmdutil.OutputJSON(out, map[string]interface{}{
+			"id":       fullID,
+			"channels": channels,
+			"changed":  changed,
+		})
+	}
+
+	switch {
+	case changed:
+		output.SuccessMessage(out, fmt.Sprintf("Updated notification channels for %s %s", target.noun, idArg))
+	case len(add) > 0 || len(remove) > 0:
+		output.InfoMessage(out, fmt.Sprintf("Notification channels for %s %s are already up to date", target.noun, idArg))
+	}
+	fmt.Fprintf(out, "Notifications:   %s\n", formatChannels(channels))
+	return nil
+}
+
+// editChannels adds and removes channel IDs, keeping the existing order and
+// reporting whether anything changed
+func editChannels(current, add, remove []string) ([]string, bool) {
+	removed := map[string]bool{}
+	for _, id := range remove {
+		removed[strings.TrimSpace(id)] = true
+	}
+
+	channels := []string{}
+	seen := map[string]bool{}
+	for _, id := range current {
+		if removed[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		channels = append(channels, id)
+	}
+	for _, id := range add {
+		id = strings.TrimSpace(id)
+		if id == "" || removed[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		channels = append(channels, id)
+	}
+
+	changed := len(channels) != len(current)
+	for i := 0; !changed && i < len(channels); i++ {
+		changed = channels[i] != current[i]
+	}
+	return channels, changed
+}
+
+// formatChannels renders notification channel IDs for show output
+func formatChannels(channels []string) string {
+	if len(channels) == 0 {
+		return "none"
+	}
+	return strings.Join(channels, ", ")
+}
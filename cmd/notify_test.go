@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNotifyCommands verifies every resource type has a notify subcommand
+func TestNotifyCommands(t *testing.T) {
+	for _, notify := range []*cobra.Command{jobsNotifyCmd, apisNotifyCmd, certsNotifyCmd, domainsNotifyCmd, dnsNotifyCmd} {
+		assert.Equal(t, "notify <id>", notify.Use)
+		assert.NotNil(t, notify.Flags().Lookup("add-channel"))
+		assert.NotNil(t, notify.Flags().Lookup("remove-channel"))
+		assert.NotNil(t, notify.Flags().Lookup("json"))
+	}
+}
+
+// TestEditChannels tests adding and removing notification channels
+func TestEditChannels(t *testing.T) {
+	channels, changed := editChannels([]string{"a", "b"}, []string{"c", " a "}, []string{"b"})
+	assert.True(t, changed)
+	assert.Equal(t, []string{"a", "c"}, channels)
+
+	channels, changed = editChannels([]string{"a"}, []string{"a"}, []string{"missing"})
+	assert.False(t, changed)
+	assert.Equal(t, []string{"a"}, channels)
+
+	channels, changed = editChannels([]string{"a"}, nil, []string{"a"})
+	assert.True(t, changed)
+	assert.Empty(t, channels)
+	assert.NotNil(t, channels, "an empty list clears the channels rather than being omitted")
+
+	channels, changed = editChannels(nil, nil, nil)
+	assert.False(t, changed)
+	assert.Empty(t, channels)
+}
+
+// TestFormatChannels tests rendering channels for show output
+func TestFormatChannels(t *testing.T) {
+	assert.Equal(t, "none", formatChannels(nil))
+	assert.Equal(t, "ch1, ch2", formatChannels([]string{"ch1", "ch2"}))
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+// healthSegments are path segments that suggest a health or status endpoint
+var healthSegments = []string{"status", "ping", "ready", "readyz", "readiness", "live", "livez", "liveness", "heartbeat", "version", "up"}
+
+// generatedMonitor is an API monitor proposed from an OpenAPI endpoint
+type generatedMonitor struct {
+	endpoint  openapi.Endpoint
+	name      string
+	url       string
+	suggested bool
+}
+
+// isHealthEndpoint reports whether a path looks like a health check, e.g.
+// /health, /healthz, /api/status, or /ping
+func isHealthEndpoint(p string) bool {
+	for _, segment := range strings.Split(strings.ToLower(p), "/") {
+		if strings.Contains(segment, "health") || slices.Contains(healthSegments, segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateMonitors proposes a monitor for every GET endpoint that can be
+// requested as-is, skipping URLs that are already monitored. It returns the
+// proposals and how many endpoints were skipped for each reason.
+func generateMonitors(doc *openapi.Document, baseURL string, existing map[string]bool) (monitors []generatedMonitor, needsInput, monitored int) {
+	for _, endpoint := range doc.GetEndpoints() {
+		if endpoint.NeedsInput {
+			needsInput++
+			continue
+		}
+		url := baseURL + endpoint.Path
+		if existing[url] {
+			monitored++
+			continue
+		}
+		name := endpoint.Path
+		if doc.Info.Title != "" {
+			name = doc.Info.Title + " " + endpoint.Path
+		}
+		monitors = append(monitors, generatedMonitor{
+			endpoint:  endpoint,
+			name:      name,
+			url:       url,
+			suggested: isHealthEndpoint(endpoint.Path),
+		})
+	}
+	return monitors, needsInput, monitored
+}
+
+// filterMonitorPaths keeps monitors whose path equals or glob-matches one
+// of patterns, e.g. /health or /v1/*/status
+func filterMonitorPaths(monitors []generatedMonitor, patterns []string) ([]generatedMonitor, error) {
+	var selected []generatedMonitor
+	for _, m := range monitors {
+		for _, pattern := range patterns {
+			ok, err := path.Match(pattern, m.endpoint.Path)
+			if err != nil {
+				return nil, usageErrorf("invalid --paths pattern %q: %w", pattern, err)
+			}
+			if ok {
+				selected = append(selected, m)
+				break
+			}
+		}
+	}
+	return selected, nil
+}
+
+// suggestedMonitors keeps the monitors for health-like endpoints
+func suggestedMonitors(monitors []generatedMonitor) []generatedMonitor {
+	return filterItems(monitors, func(m generatedMonitor) bool { return m.suggested })
+}
+
+// promptMonitors lists the proposed monitors and asks which to create.
+// Pressing Enter picks the suggested health endpoints.
+func promptMonitors(cmd *cobra.Command, monitors []generatedMonitor) ([]generatedMonitor, error) {
+	out := cmd.OutOrStdout()
+
+	width := 0
+	for _, m := range monitors {
+		width = max(width, len(m.endpoint.Path))
+	}
+	for i, m := range monitors {
+		marker := " "
+		if m.suggested {
+			marker = "*"
+		}
+		fmt.Fprintf(out, "%3d %s %-*s  %s\n", i+1, marker, width, m.endpoint.Path, describeEndpoint(m.endpoint))
+	}
+	fmt.Fprintf(out, "\nEndpoints to monitor (e.g. 1,3-5 or all; Enter for the ones marked *): ")
+
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return suggestedMonitors(monitors), nil
+	}
+
+	indexes, err := parseSelection(line, len(monitors))
+	if err != nil {
+		return nil, err
+	}
+	selected := make([]generatedMonitor, 0, len(indexes))
+	for _, i := range indexes {
+		selected = append(selected, monitors[i])
+	}
+	return selected, nil
+}
+
+// describeEndpoint summarises an endpoint for the selection list
+func describeEndpoint(e openapi.Endpoint) string {
+	var parts []string
+	if e.Summary != "" {
+		parts = append(parts, e.Summary)
+	} else if e.OperationID != "" {
+		parts = append(parts, e.OperationID)
+	}
+	if len(e.StatusCodes) > 0 {
+		codes := make([]string, len(e.StatusCodes))
+		for i, code := range e.StatusCodes {
+			codes[i] = strconv.Itoa(code)
+		}
+		parts = append(parts, "expects "+strings.Join(codes, ", "))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// parseSelection parses a list of 1-based numbers and ranges such as
+// "1,3-5" or "all" into 0-based indexes, in order and without repeats
+func parseSelection(input string, n int) ([]int, error) {
+	if strings.EqualFold(input, "all") {
+		indexes := make([]int, n)
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes, nil
+	}
+
+	var indexes []int
+	seen := map[int]bool{}
+	for _, part := range strings.Split(input, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(part, "-")
+		from, err := strconv.Atoi(strings.TrimSpace(lo))
+		to := from
+		if err == nil && isRange {
+			to, err = strconv.Atoi(strings.TrimSpace(hi))
+		}
+		if err != nil || from < 1 || to > n || from > to {
+			return nil, fmt.Errorf("invalid selection %q: use numbers from 1 to %d, e.g. 1,3-5", part, n)
+		}
+		for i := from - 1; i < to; i++ {
+			if !seen[i] {
+				seen[i] = true
+				indexes = append(indexes, i)
+			}
+		}
+	}
+	return indexes, nil
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/openapi"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shopSpec = `
+openapi: 3.0.0
+info: {title: Shop}
+paths:
+  /healthz: {get: {responses: {"200": {description: ok}}}}
+  /orders: {get: {summary: List orders, responses: {"200": {description: ok}}}}
+  /orders/{id}: {get: {responses: {"200": {description: ok}}}}
+  /v1/status: {get: {responses: {"204": {description: ok}}}}
+`
+
+// TestIsHealthEndpoint tests recognising health and status paths
+func TestIsHealthEndpoint(t *testing.T) {
+	for _, p := range []string{"/health", "/healthz", "/api/v1/Health-Check", "/status", "/ping", "/readyz"} {
+		assert.True(t, isHealthEndpoint(p), p)
+	}
+	for _, p := range []string{"/orders", "/statuses", "/users/ping-history"} {
+		assert.False(t, isHealthEndpoint(p), p)
+	}
+}
+
+// TestGenerateMonitors tests proposing monitors from a spec
+func TestGenerateMonitors(t *testing.T) {
+	doc, err := openapi.Parse([]byte(shopSpec))
+	require.NoError(t, err)
+
+	monitors, needsInput, monitored := generateMonitors(doc, "https://x.io", map[string]bool{"https://x.io/orders": true})
+	assert.Equal(t, 1, needsInput)
+	assert.Equal(t, 1, monitored)
+	require.Len(t, monitors, 2)
+	assert.Equal(t, "Shop /healthz", monitors[0].name)
+	assert.Equal(t, "https://x.io/healthz", monitors[0].url)
+	assert.True(t, monitors[0].suggested)
+	assert.Equal(t, []int{204}, monitors[1].endpoint.StatusCodes)
+
+	selected, err := filterMonitorPaths(monitors, []string{"/v1/*"})
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	assert.Equal(t, "/v1/status", selected[0].endpoint.Path)
+
+	_, err = filterMonitorPaths(monitors, []string{"[oops"})
+	assert.ErrorContains(t, err, `invalid --paths pattern "[oops"`)
+}
+
+// TestParseSelection tests numbers, ranges, and "all"
+func TestParseSelection(t *testing.T) {
+	got, err := parseSelection("3, 1-2,2", 4)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 0, 1}, got)
+
+	got, err = parseSelection("ALL", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, got)
+
+	for _, bad := range []string{"0", "5", "2-1", "x", "1-"} {
+		_, err := parseSelection(bad, 4)
+		assert.Error(t, err, bad)
+	}
+}
+
+// TestPromptMonitors tests interactive selection, with Enter taking the
+// suggested endpoints
+func TestPromptMonitors(t *testing.T) {
+	monitors := []generatedMonitor{
+		{endpoint: openapi.Endpoint{Path: "/health", StatusCodes: []int{200}}, suggested: true},
+		{endpoint: openapi.Endpoint{Path: "/orders", Summary: "List orders"}},
+	}
+
+	prompt := func(input string) ([]generatedMonitor, string) {
+		var out bytes.Buffer
+		c := &cobra.Command{}
+		c.SetOut(&out)
+		c.SetIn(strings.NewReader(input))
+		selected, err := promptMonitors(c, monitors)
+		require.NoError(t, err)
+		return selected, out.String()
+	}
+
+	selected, text := prompt("\n")
+	assert.Equal(t, monitors[:1], selected)
+	assert.Contains(t, text, "  1 * /health  expects 200")
+	assert.Contains(t, text, "  2   /orders  List orders")
+
+	selected, _ = prompt("2\n")
+	assert.Equal(t, monitors[1:], selected)
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// addOutputFileFlag registers --output-file on a command and wraps its RunE
+// so rendered output is written atomically to the file instead of stdout.
+// Must be called after RunE is set.
+func addOutputFileFlag(c *cobra.Command) {
+	c.Flags().StringP("output-file", "o", "", "Write output to a file (format inferred from extension: .json for JSON, anything else for text)")
+
+	run := c.RunE
+	c.RunE = func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("output-file")
+		if path == "" {
+			return run(cmd, args)
+		}
+		return runToOutputFile(cmd, path, func() error { return run(cmd, args) })
+	}
+}
+
+// retargetableWriter is an output writer that renders what commands write,
+// such as --template's, and can be pointed at another writer
+type retargetableWriter interface {
+	io.Writer
+	to(out io.Writer) io.Writer
+}
+
+// redirectOutput sends cmd's output through the writer wrap returns and
+// returns a function that undoes it. Output the command inherits from its
+// parents is left inherited, so later runs pick up changes to the root's.
+func redirectOutput(cmd *cobra.Command, wrap func(out io.Writer) io.Writer) (restore func()) {
+	out := cmd.OutOrStdout()
+	previous := out
+	if cmd.HasParent() && out == cmd.Parent().OutOrStdout() {
+		previous = nil
+	}
+	cmd.SetOut(wrap(out))
+	return func() { cmd.SetOut(previous) }
+}
+
+// runToOutputFile runs fn with the command's output redirected to a temp file
+// next to path, then renames it into place so readers never see a partial file
+func runToOutputFile(cmd *cobra.Command, path string, fn func() error) error {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if cmd.Flags().Lookup("json") == nil {
+			return fmt.Errorf("JSON output is not supported by '%s'", cmd.CommandPath())
+		}
+		_ = cmd.Flags().Set("json", "true")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	// CreateTemp's files are owner-only; give the file the mode the target
+	// already has, or the one a plain create would
+	mode := 0666 &^ umask()
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	// Files never get ANSI colors
+	noColor := color.NoColor
+	color.NoColor = true
+	// Output rendered through --template or --query goes to the file too
+	var w io.Writer = tmp
+	if rw, ok := cmd.OutOrStdout().(retargetableWriter); ok {
+		w = rw.to(tmp)
+	}
+	cmd.SetOut(w)
+	runErr := fn()
+	cmd.SetOut(nil)
+	color.NoColor = noColor
+
+	// Commands that report an outcome through their exit code (status,
+	// check) still produced complete output worth keeping
+	var exitErr *exitCodeError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		_ = tmp.Close()
+		return runErr
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	output.SuccessMessage(cmd.ErrOrStderr(), fmt.Sprintf("Output written to %s", path))
+	return runErr
+}
+
+func init() {
+	for _, c := range []*cobra.Command{
+		jobsListCmd, jobsShowCmd, jobsIncidentsCmd, jobsPingsCmd,
+		apisListCmd, apisShowCmd, apisIncidentsCmd, apisChecksCmd,
+		certsListCmd, certsShowCmd, certsIncidentsCmd,
+		domainsListCmd, domainsShowCmd, domainsIncidentsCmd,
+		dnsListCmd, dnsShowCmd, dnsIncidentsCmd,
+		checksListCmd, accountShowCmd, accountQuotaCmd, statusCmd, incidentsListCmd, recommendCmd,
+	} {
+		addOutputFileFlag(c)
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+)
+
+// newOutputFileTestCmd builds a command that prints JSON or text depending on --json
+func newOutputFileTestCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use: "test",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+			if jsonOutput {
+				return cmdutil.OutputJSON(cmd.OutOrStdout(), map[string]string{"name": "backup"})
+			}
+			cmd.Println("name: backup")
+			return nil
+		},
+	}
+	c.Flags().Bool("json", false, "")
+	addOutputFileFlag(c)
+	return c
+}
+
+// TestOutputFileFlagRegistered verifies list and show commands have --output-file
+func TestOutputFileFlagRegistered(t *testing.T) {
+	for _, c := range []*cobra.Command{jobsListCmd, apisShowCmd, dnsIncidentsCmd, statusCmd} {
+		require.NotNil(t, c.Flags().Lookup("output-file"), "%s should have --output-file flag", c.CommandPath())
+	}
+}
+
+// TestOutputFileInfersJSON verifies a .json extension switches on JSON output
+func TestOutputFileInfersJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+
+	c := newOutputFileTestCmd()
+	c.SetArgs([]string{"--output-file", path})
+	c.SetErr(&discard{})
+	require.NoError(t, c.Execute())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name": "backup"}`, string(data))
+}
+
+// TestOutputFileText verifies other extensions get the text rendering
+func TestOutputFileText(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.txt")
+
+	c := newOutputFileTestCmd()
+	c.SetArgs([]string{"-o", path})
+	c.SetErr(&discard{})
+	require.NoError(t, c.Execute())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "name: backup\n", string(data))
+
+	// No temp files are left behind
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+// discard is an io.Writer that drops everything written to it
+type discard struct{}
+
+func (discard) Write(p []byte) (int, error) { return len(p), nil }
+
+// TestOutputFileMode verifies the file gets the usual permissions rather
+// than a temp file's owner-only ones, and keeps an existing file's
+func TestOutputFileMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.txt")
+
+	c := newOutputFileTestCmd()
+	c.SetArgs([]string{"-o", path})
+	c.SetErr(&discard{})
+	require.NoError(t, c.Execute())
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, 0666&^umask(), info.Mode().Perm())
+
+	require.NoError(t, os.Chmod(path, 0640))
+	c = newOutputFileTestCmd()
+	c.SetArgs([]string{"-o", path})
+	c.SetErr(&discard{})
+	require.NoError(t, c.Execute())
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// projectsBulkTarget lists projects for deletion and ID resolution
+var projectsBulkTarget = bulkTarget{
+	noun:   "project",
+	plural: "projects",
+	list: func(client api.APIClient) ([]bulkItem, error) {
+		projects, err := client.ListProjects()
+		if err != nil {
+			return nil, err
+		}
+		items := make([]bulkItem, 0, len(projects))
+		for _, project := range projects {
+			items = append(items, bulkItem{id: project.ID, name: project.Name})
+		}
+		return items, nil
+	},
+	remove: func(client api.APIClient, id string) error {
+		return client.DeleteProject(id)
+	},
+}
+
+// addProjectFlag registers --project on create and move commands
+func addProjectFlag(c *cobra.Command) {
+	c.Flags().String("project", "", "Project to add the resource to (ID)")
+}
+
+// getProject returns the full ID of the --project given, or "" for none
+func getProject(cmd *cobra.Command, client api.APIClient) (string, error) {
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		return "", nil
+	}
+	return cmdutil.ResolveProjectID(client, project)
+}
+
+// runMove moves the selected resources to the --project given
+func runMove(cmd *cobra.Command, args []string, target bulkTarget) error {
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		return usageErrorf("--project is required")
+	}
+
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return err
+	}
+
+	// Resolve short ID to full ID
+	projectID, err := cmdutil.ResolveProjectID(client, project)
+	if err != nil {
+		return err
+	}
+	return runBulk(cmd, args, target, moveAction(target, projectID))
+}
+
+var projectsCmd = &cobra.Command{
+	Use:   "projects",
+	Short: "Group jobs and monitors into projects",
+	Long: `Group jobs and monitors into projects, e.g. one per service, and check the
+health of a project on its own.
+
+Add a resource to a project with --project when creating it, and list a
+project's resources with --project on any list command.`,
+}
+
+// projects list
+var projectsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all projects",
+	Long: `List all projects with how many jobs and monitors each contains.
+
+Examples:
+  groovekit projects list
+  groovekit projects list --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		projects, err := client.ListProjects()
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+
+		if jsonOutput {
+			if projects == nil {
+				projects = []api.Project{}
+			}
+			return cmdutil.OutputJSON(out, projects)
+		}
+
+		if len(projects) == 0 {
+			output.InfoMessage(out, "No projects found")
+			fmt.Fprintln(out, "\nCreate your first project:")
+			fmt.Fprintln(out, "  groovekit projects create --name 'Checkout'")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{"ID", "NAME", "JOBS", "MONITORS", "DESCRIPTION"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for _, project := range projects {
+			table.AppendWithValues([]string{
+				output.Cyan(cmdutil.ShortID(project.ID)),
+				project.Name,
+				fmt.Sprintf("%d", project.JobCount),
+				fmt.Sprintf("%d", project.MonitorCount),
+				project.Description,
+			}, []interface{}{nil, nil, project.JobCount, project.MonitorCount})
+		}
+		table.Flush()
+		return nil
+	},
+}
+
+// projects create
+var projectsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new project",
+	Long: `Create a new project to group jobs and monitors.
+
+Examples:
+  groovekit projects create --name "Checkout"
+  groovekit projects create --name "Checkout" --description "Cart, payments, and order emails"`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		name, _ := cmd.Flags().GetString("name")
+		description, _ := cmd.Flags().GetString("description")
+		if name == "" {
+			return usageErrorf("--name is required")
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		s := newSpinner(cmd)
+		s.Start()
+		project, err := client.CreateProject(&api.CreateProjectRequest{Name: name, Description: description})
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+
+		output.SuccessMessage(out, "Project created successfully\n")
+		fmt.Fprintf(out, "ID:           %s\n", output.Cyan(project.ID))
+		fmt.Fprintf(out, "Name:         %s\n", output.Bold(project.Name))
+		fmt.Fprintf(out, "\nAdd resources with --project %s, e.g.:\n", cmdutil.ShortID(project.ID))
+		fmt.Fprintf(out, "  groovekit apis create --name 'Checkout API' --url https://example.com/health --project %s\n", cmdutil.ShortID(project.ID))
+		return nil
+	},
+}
+
+// projects delete [id...]
+var projectsDeleteCmd = &cobra.Command{
+	Use:   "delete [id...]",
+	Short: "Delete one or more projects",
+	Long: `Delete one or more projects. The jobs and monitors in them are kept and no
+longer belong to a project. Everything that will be deleted is listed for
+confirmation first; --force skips the prompt.` + bulkLongHelp + `
+
+Examples:
+  groovekit projects delete abc12345
+  groovekit projects delete --match 'name~legacy-*' --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulk(cmd, args, projectsBulkTarget, deleteAction(projectsBulkTarget))
+	},
+}
+
+// projects status <id>
+var projectsStatusCmd = &cobra.Command{
+	Use:   "status <id>",
+	Short: "Show a health overview of a project",
+	Long: `Show the same health overview as 'groovekit status', for the jobs and
+monitors in one project: counts by type, anything currently down,
+certificates and domains expiring soon, and ongoing incidents.
+
+Exits with status 6 when anything in the project is down, following
+--fail-level, so a service's deploy can be gated on its own monitors.
+
+Examples:
+  groovekit projects status abc12345
+  groovekit projects status abc12345 --fail-level warning
+  groovekit projects status abc12345 --format github`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveProjectID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		format, err := getFormat(cmd, formatText, formatGitHub, formatSlack)
+		if err != nil {
+			return err
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput && format == formatText {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		project, err := client.GetProject(fullID)
+		var summary *statusSummary
+		if err == nil {
+			summary = buildStatusSummary(cmd.Context(), client, &api.ListOptions{Project: fullID})
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+
+		if !jsonOutput && format == formatText {
+			fmt.Fprintf(out, "%s\n\n", output.Bold(fmt.Sprintf("Project: %s", project.Name)))
+		}
+		return reportStatus(cmd, summary, format)
+	},
+}
+
+func init() {
+	// Add flags to projects subcommands
+	projectsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(projectsListCmd)
+	projectsCreateCmd.Flags().String("name", "", "Project name (required)")
+	projectsCreateCmd.Flags().String("description", "", "What the project covers")
+	projectsDeleteCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+	addBulkFlags(projectsDeleteCmd)
+	projectsStatusCmd.Flags().Bool("json", false, "Output as JSON")
+	addReportFormatFlags(projectsStatusCmd)
+	addWideFlag(projectsStatusCmd)
+
+	// Add subcommands
+	projectsCmd.AddCommand(projectsListCmd)
+	projectsCmd.AddCommand(projectsCreateCmd)
+	projectsCmd.AddCommand(projectsDeleteCmd)
+	projectsCmd.AddCommand(projectsStatusCmd)
+
+	// Add projects command to root
+	rootCmd.AddCommand(projectsCmd)
+}
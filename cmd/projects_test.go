@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProjectsCommand tests that the projects command has its subcommands
+func TestProjectsCommand(t *testing.T) {
+	names := map[string]bool{}
+	for _, c := range projectsCmd.Commands() {
+		names[c.Name()] = true
+	}
+	for _, name := range []string{"list", "create", "delete", "status"} {
+		assert.True(t, names[name], "projects should have %s", name)
+	}
+}
+
+// TestProjectsCreateCommand tests creating a project, adding a job to it
+// with --project, and seeing it counted
+func TestProjectsCreateCommand(t *testing.T) {
+	srv := startAPI(t)
+
+	out := mustRun(t, "projects", "create", "--name", "Payments", "--description", "Checkout and billing")
+	assert.Contains(t, out, "Project created successfully")
+	require.Len(t, srv.Fake.Projects, 1)
+	project := srv.Fake.Projects[0]
+
+	mustRun(t, "jobs", "create", "--name", "Invoices", "--interval", "1h", "--project", project.ID[:8])
+	require.Len(t, srv.Fake.Jobs, 1)
+	assert.Equal(t, project.ID, srv.Fake.Jobs[0].ProjectID)
+
+	out = mustRun(t, "projects", "list")
+	assert.Regexp(t, `Payments\s+│\s+1\s+│\s+0\s+│\s+Checkout and billing`, out)
+
+	mustRun(t, "projects", "delete", project.ID[:8], "--force")
+	assert.Empty(t, srv.Fake.Projects)
+}
+
+// TestCreateCommandsHaveProjectFlag verifies every create command takes --project
+func TestCreateCommandsHaveProjectFlag(t *testing.T) {
+	for _, c := range []*cobra.Command{jobsCreateCmd, apisCreateCmd, certsCreateCmd, domainsCreateCmd, dnsCreateCmd} {
+		assert.NotNil(t, c.Flags().Lookup("project"), "%s should have --project", c.CommandPath())
+	}
+}
+
+// TestKeepProject tests dropping resources outside a project
+func TestKeepProject(t *testing.T) {
+	res := &accountResources{
+		jobs: &api.JobsResponse{Jobs: []api.Job{{ID: "j1", ProjectID: "p1"}, {ID: "j2", ProjectID: "p2"}, {ID: "j3"}}},
+		apis: &api.ApisResponse{APIMonitors: []api.ApiMonitor{{ID: "a1", ProjectID: "p2"}}},
+	}
+	res.keepProject("p1")
+
+	assert.Len(t, res.jobs.Jobs, 1)
+	assert.Equal(t, "j1", res.jobs.Jobs[0].ID)
+	assert.Empty(t, res.apis.APIMonitors)
+	assert.Nil(t, res.certs, "kinds that failed to fetch stay nil")
+}
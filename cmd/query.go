@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// queryWriter narrows the values a command outputs as JSON with a JMESPath
+// expression, writing the result as JSON
+type queryWriter struct {
+	io.Writer
+	query *jmespath.JMESPath
+}
+
+// WriteValue applies the query to v's JSON form, so expressions use the
+// same field names as --json output
+func (w *queryWriter) WriteValue(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	result, err := w.query.Search(doc)
+	if err != nil {
+		return fmt.Errorf("failed to apply --query: %w", err)
+	}
+	return cmdutil.OutputJSON(w.Writer, result)
+}
+
+// to returns a copy of w that writes to out
+func (w *queryWriter) to(out io.Writer) io.Writer {
+	copied := *w
+	copied.Writer = out
+	return &copied
+}
+
+// restoreQueryOutput undoes configureQuery once the command has finished
+var restoreQueryOutput func()
+
+// configureQuery applies --query, turning on the command's JSON output and
+// filtering it through the expression. Commands that stream JSON lines,
+// such as --follow, aren't filtered.
+func configureQuery(cmd *cobra.Command) error {
+	expression, _ := cmd.Flags().GetString("query")
+	if expression == "" {
+		return nil
+	}
+	if cmd.Flags().Lookup("json") == nil {
+		return usageErrorf("--query needs JSON output, which '%s' doesn't support", cmd.CommandPath())
+	}
+	if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+		return usageErrorf("--query and --json can't be used together; --query outputs JSON")
+	}
+	if flag := cmd.Flags().Lookup("template"); flag != nil && flag.Changed {
+		return usageErrorf("--query and --template can't be used together")
+	}
+	query, err := jmespath.Compile(expression)
+	if err != nil {
+		return usageErrorf("invalid --query %q: %v", expression, err)
+	}
+	_ = cmd.Flags().Set("json", "true")
+
+	restoreQueryOutput = redirectOutput(cmd, func(out io.Writer) io.Writer {
+		return &queryWriter{Writer: out, query: query}
+	})
+	return nil
+}
+
+func init() {
+	cobra.OnFinalize(func() {
+		if restoreQueryOutput != nil {
+			restoreQueryOutput()
+			restoreQueryOutput = nil
+		}
+	})
+}
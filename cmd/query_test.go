@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryFlag tests filtering commands' JSON output with --query
+func TestQueryFlag(t *testing.T) {
+	srv := startAPI(t)
+	id := seedApis(srv)
+	srv.Fake.Apis = append(srv.Fake.Apis, api.ApiMonitor{ID: srv.Fake.NewID(), Name: "Search", URL: "https://shop.example.com/search", HTTPMethod: "GET", Status: "active", Interval: 10, Down: true})
+
+	out := mustRun(t, "apis", "list", "--query", "api_monitors[?down].name")
+	assert.Equal(t, "[\n  \"Search\"\n]\n", out)
+
+	out = mustRun(t, "apis", "show", id[:8], "--query", "{name: name, codes: expected_status_codes}")
+	assert.JSONEq(t, `{"name": "Checkout", "codes": [200]}`, out)
+
+	out = mustRun(t, "apis", "list", "--query", "api_monitors[?status=='paused']")
+	assert.Equal(t, "[]\n", out)
+
+	path := filepath.Join(t.TempDir(), "names.json")
+	mustRun(t, "apis", "list", "--query", "api_monitors[].name", "-o", path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["Checkout", "Search"]`, string(data))
+
+	// Later runs aren't filtered
+	out = mustRun(t, "apis", "list")
+	assert.Contains(t, out, "Checkout")
+	assert.Contains(t, out, "Search")
+}
+
+// TestQueryFlag_Errors tests invalid expressions and commands without JSON
+// output
+func TestQueryFlag_Errors(t *testing.T) {
+	srv := startAPI(t)
+	seedApis(srv)
+
+	tests := map[string][]string{
+		"invalid --query":                     {"apis", "list", "--query", "api_monitors[?"},
+		"--query and --json":                  {"apis", "list", "--query", "api_monitors", "--json"},
+		"--query and --template":              {"apis", "list", "--query", "api_monitors", "--template", "{{.Name}}"},
+		"'groovekit version' doesn't support": {"version", "--query", "a"},
+	}
+	for expected, args := range tests {
+		_, _, err := runCommand(t, args...)
+		assert.Equal(t, exitUsage, exitCode(err), expected)
+		assert.ErrorContains(t, err, expected)
+	}
+}
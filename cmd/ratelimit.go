@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var apiCmd = &cobra.Command{
+	Use:   "api",
+	Short: "Inspect the GrooveKit API",
+	Long:  "Inspect how the CLI is using the GrooveKit API",
+}
+
+// rateLimitReport is the JSON output of api rate-limit
+type rateLimitReport struct {
+	Reported bool `json:"reported"`
+	*api.RateLimit
+}
+
+// api rate-limit
+var apiRateLimitCmd = &cobra.Command{
+	Use:   "rate-limit",
+	Short: "Show the API rate limit",
+	Long: `Show how many API requests your account may make, how many remain, and
+when the allowance resets, as reported by the API's rate limit headers.
+
+Bulk commands such as jobs import-crontab, apis generate, and pause, resume,
+or delete with --all slow down on their own when the allowance is nearly
+used up, waiting for it to reset rather than failing partway through.
+
+Examples:
+  groovekit api rate-limit
+  groovekit api rate-limit --json`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		// Any request reports the rate limit; the account is a cheap one
+		s := newSpinner(cmd)
+		s.Start()
+		_, err = client.GetAccount()
+		s.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to get rate limit: %w", err)
+		}
+
+		rl, ok := client.RateLimit()
+		if jsonOutput {
+			report := rateLimitReport{Reported: ok}
+			if ok {
+				report.RateLimit = &rl
+			}
+			return cmdutil.OutputJSON(out, report)
+		}
+
+		if !ok {
+			output.InfoMessage(out, "The API didn't report a rate limit")
+			return nil
+		}
+		printRateLimit(out, rl, time.Now())
+		return nil
+	},
+}
+
+// printRateLimit reports the rate limit and when it resets
+func printRateLimit(out io.Writer, rl api.RateLimit, now time.Time) {
+	fmt.Fprintf(out, "%s\n\n", output.Bold("API Rate Limit"))
+	fmt.Fprintf(out, "Limit:      %s\n", countNoun(rl.Limit, "request", "requests"))
+	fmt.Fprintf(out, "Remaining:  %d\n", rl.Remaining)
+	if !rl.Reset.IsZero() {
+		wait := max(rl.Reset.Sub(now).Round(time.Second), 0)
+		fmt.Fprintf(out, "Resets:     %s (in %s)\n", output.InTimezone(rl.Reset).Format("2006-01-02 15:04:05 MST"), wait)
+	}
+}
+
+// paceBulk makes a bulk command wait for the rate limit to reset when it is
+// nearly used up, saying so, rather than failing partway through
+func paceBulk(cmd *cobra.Command, client api.APIClient) {
+	out := cmd.OutOrStdout()
+	client.PaceRequests(func(wait time.Duration) {
+		fmt.Fprintln(out, output.Yellow(fmt.Sprintf("Rate limit nearly used up; waiting %s for it to reset", wait.Round(time.Second))))
+	})
+}
+
+func init() {
+	// Add flags to rate-limit command
+	apiRateLimitCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add subcommands
+	apiCmd.AddCommand(apiRateLimitCmd)
+
+	// Add api command to root
+	rootCmd.AddCommand(apiCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestApiCommand tests that the api command has its subcommands
+func TestApiCommand(t *testing.T) {
+	assert.Equal(t, "api", apiCmd.Use)
+
+	cmd, _, err := rootCmd.Find([]string{"api", "rate-limit"})
+	require.NoError(t, err)
+	assert.Equal(t, apiRateLimitCmd, cmd)
+	assert.NotNil(t, apiRateLimitCmd.Flags().Lookup("json"))
+}
+
+// TestPrintRateLimit tests reporting the rate limit and its reset
+func TestPrintRateLimit(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	now := time.Now()
+
+	var buf bytes.Buffer
+	printRateLimit(&buf, api.RateLimit{Limit: 1000, Remaining: 987, Reset: now.Add(90 * time.Second)}, now)
+	out := buf.String()
+	assert.Contains(t, out, "Limit:      1000 requests")
+	assert.Contains(t, out, "Remaining:  987")
+	assert.Contains(t, out, "(in 1m30s)")
+
+	buf.Reset()
+	printRateLimit(&buf, api.RateLimit{Limit: 1, Remaining: 1}, now)
+	assert.Contains(t, buf.String(), "Limit:      1 request\n")
+	assert.NotContains(t, buf.String(), "Resets")
+}
+
+// TestRateLimitReportJSON tests the JSON output with and without a
+// reported rate limit
+func TestRateLimitReportJSON(t *testing.T) {
+	data, err := json.Marshal(rateLimitReport{Reported: false})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"reported":false}`, string(data))
+
+	reset := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	data, err = json.Marshal(rateLimitReport{Reported: true, RateLimit: &api.RateLimit{Limit: 100, Remaining: 5, Reset: reset}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"reported":true,"limit":100,"remaining":5,"reset":"2026-03-09T10:00:00Z"}`, string(data))
+}
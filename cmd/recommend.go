@@ -0,0 +1,348 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Recommendation priorities, most urgent first
+const (
+	priorityHigh   = "high"
+	priorityMedium = "medium"
+	priorityLow    = "low"
+)
+
+// priorityRank orders priorities for sorting
+var priorityRank = map[string]int{priorityHigh: 0, priorityMedium: 1, priorityLow: 2}
+
+// priorityWeight is how much of a resource's health a recommendation costs
+var priorityWeight = map[string]float64{priorityHigh: 1, priorityMedium: 0.5, priorityLow: 0.2}
+
+// defaultExpiryWarningDays is used for domains that have no warning threshold
+const defaultExpiryWarningDays = 30
+
+// recommendation is a single suggested improvement to the account
+type recommendation struct {
+	Priority string `json:"priority"`
+	Type     string `json:"type"`
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Issue    string `json:"issue"`
+	Fix      string `json:"fix"`
+	Command  string `json:"command,omitempty"`
+}
+
+// recommendReport is the account's health score with the recommendations
+// behind it
+type recommendReport struct {
+	Score           int              `json:"score"`
+	Recommendations []recommendation `json:"recommendations"`
+}
+
+// hostLookup resolves a domain to its addresses for suggested DNS monitors
+type hostLookup func(domain string) []string
+
+var recommendCmd = &cobra.Command{
+	Use:   "recommend",
+	Short: "Suggest improvements to your monitoring setup",
+	Long: `Analyze the account and print a health score from 0 to 100, with
+prioritized recommendations, each with the command that fixes it:
+
+  - domains inside their expiry warning threshold
+  - API, SSL, domain, and DNS monitors with no notification channels
+  - jobs with no grace period, which alert on the slightest delay
+  - jobs with no webhook for alerts
+  - SSL monitors without warning or critical thresholds
+  - monitored domains with no DNS monitor
+
+Every resource counts toward the score, and loses some of its share for
+each recommendation against it: all of it for high priority, half for
+medium, and a fifth for low.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		res, errs := fetchAccountResources(cmd.Context(), client, nil)
+		var recs []recommendation
+		if len(errs) == 0 {
+			recs = buildRecommendations(res, lookupHost)
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		// Recommendations from a partial view of the account would be misleading
+		for _, kind := range statusKinds {
+			if err := errs[kind]; err != nil {
+				return err
+			}
+		}
+
+		report := recommendReport{Score: healthScore(res, recs), Recommendations: recs}
+		if jsonOutput {
+			if report.Recommendations == nil {
+				report.Recommendations = []recommendation{}
+			}
+			return cmdutil.OutputJSON(out, report)
+		}
+
+		printRecommendations(out, report)
+		return nil
+	},
+}
+
+// buildRecommendations checks every resource against the recommendations,
+// most urgent first
+func buildRecommendations(res *accountResources, lookup hostLookup) []recommendation {
+	var recs []recommendation
+
+	for _, domain := range res.domains.DomainMonitors {
+		expiry := domainExpiry(domain)
+		if !expiry.expiring() {
+			continue
+		}
+
+		priority := priorityMedium
+		if expiry.level() == expiryCritical {
+			priority = priorityHigh
+		}
+		fix := "Renew the domain with your registrar"
+		if domain.Registrar != "" {
+			fix = fmt.Sprintf("Renew the domain with %s", domain.Registrar)
+		}
+		if domain.RegistrarURL != nil && *domain.RegistrarURL != "" {
+			fix += fmt.Sprintf(" (%s)", *domain.RegistrarURL)
+		}
+		recs = append(recs, recommendation{
+			Priority: priority,
+			Type:     "domain",
+			ID:       domain.ID,
+			Name:     domain.Name,
+			Issue:    fmt.Sprintf("Expires in %d days", domain.DaysUntilExpiration),
+			Fix:      fix + ", then confirm the new expiry date",
+			Command:  "groovekit domains show " + cmdutil.ShortID(domain.ID),
+		})
+	}
+
+	// Monitors with no channels have nowhere to send their alerts
+	noChannels := func(kind, command, id, name string, channels []string) {
+		if len(channels) > 0 {
+			return
+		}
+		recs = append(recs, recommendation{
+			Priority: priorityMedium,
+			Type:     kind,
+			ID:       id,
+			Name:     name,
+			Issue:    "No notification channels, so its alerts reach no one",
+			Fix:      "Route its alerts to a notification channel",
+			Command:  fmt.Sprintf("groovekit %s notify %s --add-channel <channel-id>", command, cmdutil.ShortID(id)),
+		})
+	}
+	for _, monitor := range res.apis.APIMonitors {
+		noChannels("api", "apis", monitor.ID, monitor.Name, monitor.ChannelIDs)
+	}
+	for _, cert := range res.certs.SslMonitors {
+		noChannels("cert", "certs", cert.ID, cert.Name, cert.ChannelIDs)
+	}
+	for _, domain := range res.domains.DomainMonitors {
+		noChannels("domain", "domains", domain.ID, domain.Name, domain.ChannelIDs)
+	}
+	for _, dns := range res.dns.DnsMonitors {
+		noChannels("dns", "dns", dns.ID, dns.Name, dns.ChannelIDs)
+	}
+
+	for _, job := range res.jobs.Jobs {
+		if job.GracePeriod == 0 {
+			recs = append(recs, recommendation{
+				Priority: priorityMedium,
+				Type:     "job",
+				ID:       job.ID,
+				Name:     job.Name,
+				Issue:    "No grace period, so any delay in the run raises an alert",
+				Fix:      "Allow for normal variation in run time",
+				Command:  fmt.Sprintf("groovekit jobs update %s --grace-period %d", cmdutil.ShortID(job.ID), inferGracePeriod(job.Interval)),
+			})
+		}
+		if job.WebhookURL == "" {
+			recs = append(recs, recommendation{
+				Priority: priorityLow,
+				Type:     "job",
+				ID:       job.ID,
+				Name:     job.Name,
+				Issue:    "No webhook configured for alerts",
+				Fix:      "Send alerts to a webhook (chat, paging, or incident tooling)",
+				Command:  fmt.Sprintf("groovekit jobs update %s --webhook-url <url>", cmdutil.ShortID(job.ID)),
+			})
+		}
+	}
+
+	for _, cert := range res.certs.SslMonitors {
+		var flags []string
+		if cert.WarningThreshold <= 0 {
+			flags = append(flags, "--warning-threshold 30")
+		}
+		if cert.CriticalThreshold <= 0 {
+			flags = append(flags, "--critical-threshold 7")
+		}
+		if len(flags) == 0 {
+			continue
+		}
+		recs = append(recs, recommendation{
+			Priority: priorityMedium,
+			Type:     "cert",
+			ID:       cert.ID,
+			Name:     cert.Name,
+			Issue:    "Missing expiry thresholds, so there is no early warning before the certificate expires",
+			Fix:      "Set warning and critical thresholds",
+			Command:  fmt.Sprintf("groovekit certs update %s %s", cmdutil.ShortID(cert.ID), strings.Join(flags, " ")),
+		})
+	}
+
+	dnsDomains := map[string]bool{}
+	for _, dns := range res.dns.DnsMonitors {
+		dnsDomains[normalizeDomain(dns.Domain)] = true
+	}
+	seen := map[string]bool{}
+	for _, domain := range res.domains.DomainMonitors {
+		name := normalizeDomain(domain.Domain)
+		if name == "" || dnsDomains[name] || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		expected := "<ip>"
+		if addrs := lookup(name); len(addrs) > 0 {
+			expected = strings.Join(addrs, ",")
+		}
+		recs = append(recs, recommendation{
+			Priority: priorityLow,
+			Type:     "domain",
+			ID:       domain.ID,
+			Name:     domain.Name,
+			Issue:    "Domain is monitored but its DNS records are not",
+			Fix:      "Monitor the A record to catch hijacks and accidental changes",
+			Command:  fmt.Sprintf("groovekit dns create --name %q --domain %s --type A --expected %s", name+" A", name, expected),
+		})
+	}
+
+	sort.SliceStable(recs, func(i, j int) bool {
+		return priorityRank[recs[i].Priority] < priorityRank[recs[j].Priority]
+	})
+	return recs
+}
+
+// healthScore rates the account from 0 to 100. Each resource has an equal
+// share, and loses the weight of the recommendations against it, up to all
+// of its share. An empty account scores 100.
+func healthScore(res *accountResources, recs []recommendation) int {
+	total := len(res.jobs.Jobs) + len(res.apis.APIMonitors) + len(res.certs.SslMonitors) +
+		len(res.domains.DomainMonitors) + len(res.dns.DnsMonitors)
+	if total == 0 {
+		return 100
+	}
+
+	lost := map[string]float64{}
+	for _, rec := range recs {
+		key := rec.Type + "/" + rec.ID
+		lost[key] = min(1, lost[key]+priorityWeight[rec.Priority])
+	}
+	var penalty float64
+	for _, weight := range lost {
+		penalty += weight
+	}
+	return int(math.Round(100 * (1 - penalty/float64(total))))
+}
+
+// normalizeDomain lowercases a domain and drops any trailing dot
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// lookupHost resolves a domain's IPv4 addresses, returning none on failure
+func lookupHost(domain string) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip4", domain)
+	if err != nil {
+		return nil
+	}
+	addrs := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addrs = append(addrs, ip.String())
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// printRecommendations prints the health score and numbered
+// recommendations with their fixes
+func printRecommendations(w io.Writer, report recommendReport) {
+	score := fmt.Sprintf("%d/100", report.Score)
+	switch {
+	case report.Score >= 90:
+		score = output.Green(score)
+	case report.Score >= 70:
+		score = output.Yellow(score)
+	default:
+		score = output.Red(score)
+	}
+	fmt.Fprintf(w, "%s %s\n\n", output.Bold("Health score:"), score)
+
+	recs := report.Recommendations
+	if len(recs) == 0 {
+		output.SuccessMessage(w, "No recommendations - your monitoring setup looks good!")
+		return
+	}
+
+	for i, rec := range recs {
+		label := strings.ToUpper(rec.Priority)
+		switch rec.Priority {
+		case priorityHigh:
+			label = output.Red(label)
+		case priorityMedium:
+			label = output.Yellow(label)
+		default:
+			label = output.Cyan(label)
+		}
+
+		fmt.Fprintf(w, "%d. [%s] %s %s: %s\n", i+1, label, rec.Type, output.Bold(rec.Name), rec.Issue)
+		fmt.Fprintf(w, "   %s\n", rec.Fix)
+		if rec.Command != "" {
+			fmt.Fprintf(w, "   $ %s\n", rec.Command)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "%s %d\n", output.Bold("Total:"), len(recs))
+}
+
+func init() {
+	recommendCmd.Flags().Bool("json", false, "Output as JSON")
+	rootCmd.AddCommand(recommendCmd)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecommendCommand tests the basic structure of the recommend command
+func TestRecommendCommand(t *testing.T) {
+	assert.Equal(t, "recommend", recommendCmd.Use)
+	assert.NotEmpty(t, recommendCmd.Short)
+	require.NotNil(t, recommendCmd.RunE)
+	assert.NotNil(t, recommendCmd.Flags().Lookup("json"))
+}
+
+// TestBuildRecommendations tests which resources get recommendations and their order
+func TestBuildRecommendations(t *testing.T) {
+	res := &accountResources{
+		jobs: &api.JobsResponse{Jobs: []api.Job{
+			{ID: "job-ok", Name: "ok", Interval: 60, GracePeriod: 5, WebhookURL: "https://hooks.example.com"},
+			{ID: "job-nograce", Name: "nightly", Interval: 1440, WebhookURL: "https://hooks.example.com"},
+		}},
+		apis: &api.ApisResponse{APIMonitors: []api.ApiMonitor{
+			{ID: "api-nochan", Name: "Checkout"},
+		}},
+		certs: &api.SslMonitorsResponse{SslMonitors: []api.SslMonitor{
+			{ID: "cert-ok", Name: "ok", WarningThreshold: 30, CriticalThreshold: 7, ChannelIDs: []string{"ch_ops"}},
+			{ID: "cert-nothresh", Name: "bare", WarningThreshold: 30, ChannelIDs: []string{"ch_ops"}},
+		}},
+		domains: &api.DomainMonitorsResponse{DomainMonitors: []api.DomainMonitor{
+			{ID: "dom-expiring", Name: "example.com", Domain: "example.com", LastCheckAt: "2026-10-15T06:00:00Z", ExpiresAt: "2026-10-20", DaysUntilExpiration: 5, WarningThreshold: 30, CriticalThreshold: 7, Registrar: "Namecheap", ChannelIDs: []string{"ch_ops"}},
+			{ID: "dom-fine", Name: "example.org", Domain: "Example.org.", ExpiresAt: "2027-10-20", DaysUntilExpiration: 370, WarningThreshold: 30, CriticalThreshold: 7, ChannelIDs: []string{"ch_ops"}},
+		}},
+		dns: &api.DnsMonitorsResponse{DnsMonitors: []api.DnsMonitor{
+			{ID: "dns-1", Domain: "example.com", ChannelIDs: []string{"ch_ops"}},
+		}},
+	}
+	lookup := func(domain string) []string { return []string{"192.0.2.1"} }
+
+	recs := buildRecommendations(res, lookup)
+	require.Len(t, recs, 5)
+
+	assert.Equal(t, priorityHigh, recs[0].Priority)
+	assert.Equal(t, "dom-expiring", recs[0].ID)
+	assert.Contains(t, recs[0].Fix, "Namecheap")
+
+	assert.Equal(t, "api-nochan", recs[1].ID)
+	assert.Equal(t, "groovekit apis notify api-noch --add-channel <channel-id>", recs[1].Command)
+	assert.Equal(t, "groovekit jobs update job-nogr --grace-period 60", recs[2].Command)
+	assert.Equal(t, "groovekit certs update cert-not --critical-threshold 7", recs[3].Command)
+
+	// Priorities never increase down the list
+	for i := 1; i < len(recs); i++ {
+		assert.LessOrEqual(t, priorityRank[recs[i-1].Priority], priorityRank[recs[i].Priority])
+	}
+
+	dnsRec := recs[len(recs)-1]
+	assert.Equal(t, "dom-fine", dnsRec.ID)
+	assert.Equal(t, `groovekit dns create --name "example.org A" --domain example.org --type A --expected 192.0.2.1`, dnsRec.Command)
+
+	// 8 resources lose 1 + 0.5 + 0.5 + 0.5 + 0.2 of their shares
+	assert.Equal(t, 66, healthScore(res, recs))
+}
+
+// TestHealthScore tests capping each resource's loss at its share
+func TestHealthScore(t *testing.T) {
+	res := &accountResources{
+		jobs:    &api.JobsResponse{Jobs: []api.Job{{ID: "job-1"}, {ID: "job-2"}}},
+		apis:    &api.ApisResponse{},
+		certs:   &api.SslMonitorsResponse{},
+		domains: &api.DomainMonitorsResponse{},
+		dns:     &api.DnsMonitorsResponse{},
+	}
+	assert.Equal(t, 100, healthScore(res, nil))
+
+	recs := []recommendation{
+		{Priority: priorityHigh, Type: "job", ID: "job-1"},
+		{Priority: priorityMedium, Type: "job", ID: "job-1"},
+	}
+	assert.Equal(t, 50, healthScore(res, recs))
+
+	empty := &accountResources{jobs: &api.JobsResponse{}, apis: &api.ApisResponse{}, certs: &api.SslMonitorsResponse{},
+		domains: &api.DomainMonitorsResponse{}, dns: &api.DnsMonitorsResponse{}}
+	assert.Equal(t, 100, healthScore(empty, nil))
+}
+
+// TestRecommendCommandRun tests the score and recommendations for the
+// account
+func TestRecommendCommandRun(t *testing.T) {
+	srv := startAPI(t)
+	seedApis(srv)
+
+	var report recommendReport
+	require.NoError(t, json.Unmarshal([]byte(mustRun(t, "recommend", "--json")), &report))
+	require.NotEmpty(t, report.Recommendations)
+	assert.Equal(t, "api", report.Recommendations[0].Type)
+	assert.Equal(t, 50, report.Score)
+
+	out := mustRun(t, "recommend")
+	assert.Contains(t, out, "Health score: 50/100")
+	assert.Contains(t, out, "No notification channels")
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var regionsCmd = &cobra.Command{
+	Use:   "regions",
+	Short: "List the locations monitors are checked from",
+	Long: `List the probe locations API monitors can be checked from.
+
+Choose them for a monitor with --regions on "groovekit apis create" or
+"groovekit apis update" to measure latency and availability from specific
+geographies. Monitors that don't choose any use the default regions.`,
+}
+
+// regions list
+var regionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available probe regions",
+	Long: `List the probe regions API monitors can be checked from, marking the ones
+used by monitors that don't choose any.
+
+Examples:
+  groovekit regions list
+  groovekit regions list --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		regions, err := client.ListRegions()
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to list regions: %w", err)
+		}
+
+		if jsonOutput {
+			if regions == nil {
+				regions = []api.Region{}
+			}
+			return cmdutil.OutputJSON(out, regions)
+		}
+
+		if len(regions) == 0 {
+			output.InfoMessage(out, "No regions available")
+			return nil
+		}
+
+		table, err := newListTable(cmd, []string{"ID", "NAME", "LOCATION", "DEFAULT"})
+		if err != nil {
+			return err
+		}
+		table.Render()
+		for _, region := range regions {
+			isDefault := ""
+			if region.Default {
+				isDefault = output.Green("✓")
+			}
+			table.Append([]string{output.Cyan(region.ID), region.Name, valueOrDash(region.Location), isDefault})
+		}
+		table.Flush()
+		output.TotalMessage(out, fmt.Sprintf("Total: %s", countNoun(len(regions), "region", "regions")))
+		return nil
+	},
+}
+
+// getRegions reads --regions, lower-casing IDs and dropping duplicates. An
+// empty list means the default regions.
+func getRegions(cmd *cobra.Command) []string {
+	values, _ := cmd.Flags().GetStringSlice("regions")
+	regions := []string{}
+	for _, value := range values {
+		region := strings.ToLower(strings.TrimSpace(value))
+		if region != "" && !slices.Contains(regions, region) {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// completeRegions completes --regions to the account's probe regions,
+// offering nothing when not logged in or the API can't be reached
+func completeRegions(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client, err := cmdutil.AuthenticatedClient()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	regions, err := client.ListRegions()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	values := make([]string, 0, len(regions))
+	for _, region := range regions {
+		values = append(values, region.ID+"\t"+region.Name)
+	}
+	return listCompletions(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// formatRegions renders a monitor's regions, or "default" when it uses the
+// default ones
+func formatRegions(regions []string) string {
+	if len(regions) == 0 {
+		return "default"
+	}
+	return strings.Join(regions, ", ")
+}
+
+func init() {
+	// Add flags to regions list command
+	regionsListCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(regionsListCmd)
+
+	// Add subcommands
+	regionsCmd.AddCommand(regionsListCmd)
+
+	// Add regions command to root
+	rootCmd.AddCommand(regionsCmd)
+}
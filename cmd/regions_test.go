@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegionsCommand tests the regions command structure
+func TestRegionsCommand(t *testing.T) {
+	assert.Equal(t, "regions", regionsCmd.Use)
+	assert.Contains(t, regionsCmd.Commands(), regionsListCmd)
+	assert.NotNil(t, regionsListCmd.Flags().Lookup("json"))
+
+	for _, c := range []*cobra.Command{apisCreateCmd, apisUpdateCmd} {
+		assert.NotNil(t, c.Flags().Lookup("regions"), "%s should have --regions", c.CommandPath())
+	}
+}
+
+// TestGetRegions tests normalizing --regions
+func TestGetRegions(t *testing.T) {
+	c := &cobra.Command{Use: "test"}
+	c.Flags().StringSlice("regions", nil, "")
+	assert.Equal(t, []string{}, getRegions(c))
+
+	require.NoError(t, c.ParseFlags([]string{"--regions", "US-East, eu-west,us-east,"}))
+	assert.Equal(t, []string{"us-east", "eu-west"}, getRegions(c))
+}
+
+// TestFormatRegions tests showing a monitor's regions
+func TestFormatRegions(t *testing.T) {
+	assert.Equal(t, "default", formatRegions(nil))
+	assert.Equal(t, "us-east, eu-west", formatRegions([]string{"us-east", "eu-west"}))
+}
@@ -0,0 +1,445 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// SLA report formats accepted by --format
+const (
+	reportMarkdown = "md"
+	reportHTML     = "html"
+	reportCSV      = "csv"
+)
+
+// reportMonthPattern matches an explicit --period month such as 2026-09
+var reportMonthPattern = regexp.MustCompile(`^\d{4}-\d{2}$`)
+
+// slaPeriod is the span of time an SLA report covers
+type slaPeriod struct {
+	label string
+	title string
+	from  time.Time
+	to    time.Time
+}
+
+// slaRow is one resource's availability over a report period
+type slaRow struct {
+	kind          string
+	id            string
+	name          string
+	uptimePercent float64
+	downtime      time.Duration
+	incidents     int
+	// recovered counts the incidents that ended, and mttr is their mean
+	// time to recovery
+	recovered int
+	mttr      time.Duration
+}
+
+// slaReport is the availability of every selected resource over a period
+type slaReport struct {
+	period    slaPeriod
+	generated time.Time
+	rows      []slaRow
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate shareable reports",
+	Long:  "Generate reports on your resources to share with customers or stakeholders.",
+}
+
+// report sla
+var reportSLACmd = &cobra.Command{
+	Use:   "sla",
+	Short: "Generate an uptime SLA report",
+	Long: `Generate a report of each job and monitor's uptime, downtime, incident
+count, and mean time to recovery (MTTR) over a period, and write it to a
+file to share, e.g. for monthly customer SLA reporting.
+
+Uptime is the share of the period not covered by an incident. Incidents
+count when they overlap the period, and MTTR averages those that ended.
+
+--period is the last complete month, week (Monday to Sunday), or quarter,
+or a month such as 2026-09, in local time. The report is written to
+sla-<period>.<format> unless --output-file is given; pass -o - to print it
+instead.
+
+Examples:
+  groovekit report sla
+  groovekit report sla --period quarter --format html
+  groovekit report sla --period 2026-09 --type apis --format csv -o september.csv`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		periodFlag, _ := cmd.Flags().GetString("period")
+		format, _ := cmd.Flags().GetString("format")
+		typeFlag, _ := cmd.Flags().GetString("type")
+		path, _ := cmd.Flags().GetString("output-file")
+
+		now := time.Now()
+		period, err := parseSLAPeriod(periodFlag, now)
+		if err != nil {
+			return err
+		}
+		if format != reportMarkdown && format != reportHTML && format != reportCSV {
+			return usageErrorf("invalid --format %q: must be md, html, or csv", format)
+		}
+		kinds, err := parseIncidentTypes(typeFlag)
+		if err != nil {
+			return err
+		}
+		if path == "" {
+			path = fmt.Sprintf("sla-%s.%s", period.label, format)
+		}
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		var s *spinner.Spinner
+		if path != "-" {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		resources, err := listIncidentResources(cmd.Context(), client, kinds)
+		var incidents [][]api.Incident
+		if err == nil {
+			incidents, err = fetchIncidents(cmd.Context(), resources)
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		report := buildSLAReport(period, resources, incidents, now)
+		render := func() error {
+			return renderSLAReport(cmd.OutOrStdout(), report, format)
+		}
+		if path == "-" {
+			return render()
+		}
+		return runToOutputFile(cmd, path, render)
+	},
+}
+
+// parseSLAPeriod resolves --period to the last complete month, week, or
+// quarter before now, or to an explicit month
+func parseSLAPeriod(value string, now time.Time) (slaPeriod, error) {
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	switch {
+	case value == "month":
+		from := time.Date(year, month, 1, 0, 0, 0, 0, now.Location()).AddDate(0, -1, 0)
+		return monthPeriod(from), nil
+	case value == "week":
+		// Weeks start on Monday
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		from := monday.AddDate(0, 0, -7)
+		isoYear, week := from.ISOWeek()
+		return slaPeriod{
+			label: fmt.Sprintf("%d-W%02d", isoYear, week),
+			title: fmt.Sprintf("Week %d, %d", week, isoYear),
+			from:  from,
+			to:    monday,
+		}, nil
+	case value == "quarter":
+		to := time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, now.Location())
+		from := to.AddDate(0, -3, 0)
+		quarter := (int(from.Month())-1)/3 + 1
+		return slaPeriod{
+			label: fmt.Sprintf("%d-Q%d", from.Year(), quarter),
+			title: fmt.Sprintf("Q%d %d", quarter, from.Year()),
+			from:  from,
+			to:    to,
+		}, nil
+	case reportMonthPattern.MatchString(value):
+		from, err := time.ParseInLocation("2006-01", value, now.Location())
+		if err != nil {
+			break
+		}
+		if from.After(now) {
+			return slaPeriod{}, usageErrorf("invalid --period %q: month is in the future", value)
+		}
+		return monthPeriod(from), nil
+	}
+	return slaPeriod{}, usageErrorf("invalid --period %q: must be month, week, quarter, or a month like 2026-09", value)
+}
+
+// monthPeriod is the calendar month starting at from
+func monthPeriod(from time.Time) slaPeriod {
+	return slaPeriod{
+		label: from.Format("2006-01"),
+		title: from.Format("January 2006"),
+		from:  from,
+		to:    from.AddDate(0, 1, 0),
+	}
+}
+
+// buildSLAReport computes each resource's availability over the period from
+// its incidents, which are in the same order as resources. A period that
+// hasn't ended yet is measured up to now.
+func buildSLAReport(period slaPeriod, resources []incidentResource, incidents [][]api.Incident, now time.Time) slaReport {
+	end := period.to
+	if now.Before(end) {
+		end = now
+	}
+	length := end.Sub(period.from)
+
+	report := slaReport{period: period, generated: now}
+	for i, res := range resources {
+		row := slaRow{kind: res.kind, id: res.id, name: res.name, uptimePercent: 100}
+
+		var recoveryTotal time.Duration
+		for _, incident := range incidents[i] {
+			started, err := time.Parse(time.RFC3339, incident.StartedAt)
+			if err != nil {
+				continue
+			}
+			ended := end
+			resolved := incident.EndedAt != nil
+			if resolved {
+				if ended, err = time.Parse(time.RFC3339, *incident.EndedAt); err != nil {
+					continue
+				}
+			}
+			if !started.Before(end) || ended.Before(period.from) {
+				continue
+			}
+
+			row.incidents++
+			row.downtime += minTime(ended, end).Sub(maxTime(started, period.from))
+			if resolved {
+				row.recovered++
+				recoveryTotal += ended.Sub(started)
+			}
+		}
+
+		if row.recovered > 0 {
+			row.mttr = recoveryTotal / time.Duration(row.recovered)
+		}
+		if length > 0 {
+			row.uptimePercent = max(0, 100*(1-row.downtime.Seconds()/length.Seconds()))
+		}
+		report.rows = append(report.rows, row)
+	}
+	return report
+}
+
+// summary returns the mean uptime, total incidents, and overall MTTR across
+// the report's resources
+func (r slaReport) summary() (uptime float64, incidents int, mttr time.Duration) {
+	if len(r.rows) == 0 {
+		return 100, 0, 0
+	}
+	var recoveries int
+	var recoveryTotal time.Duration
+	for _, row := range r.rows {
+		uptime += row.uptimePercent
+		incidents += row.incidents
+		recoveries += row.recovered
+		recoveryTotal += row.mttr * time.Duration(row.recovered)
+	}
+	if recoveries > 0 {
+		mttr = recoveryTotal / time.Duration(recoveries)
+	}
+	return uptime / float64(len(r.rows)), incidents, mttr
+}
+
+// dates returns the first and last days the report covers, which ends
+// today for a period that hasn't ended yet
+func (r slaReport) dates() (string, string) {
+	through := minTime(r.period.to, r.generated).Add(-time.Nanosecond)
+	return r.period.from.Format("2006-01-02"), through.Format("2006-01-02")
+}
+
+// minTime returns the earlier of two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// maxTime returns the later of two times
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// formatMTTR renders an MTTR, or "-" when no incidents ended
+func formatMTTR(mttr time.Duration) string {
+	if mttr <= 0 {
+		return "-"
+	}
+	return cmdutil.FormatIncidentDuration(mttr.Seconds())
+}
+
+// renderSLAReport writes a report in the given format
+func renderSLAReport(w io.Writer, report slaReport, format string) error {
+	switch format {
+	case reportHTML:
+		return renderSLAHTML(w, report)
+	case reportCSV:
+		return renderSLACSV(w, report)
+	}
+	return renderSLAMarkdown(w, report)
+}
+
+// renderSLAMarkdown writes a report as a Markdown document
+func renderSLAMarkdown(w io.Writer, report slaReport) error {
+	uptime, incidents, mttr := report.summary()
+	cell := strings.NewReplacer("|", `\|`, "\n", " ")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# SLA Report: %s\n\n", report.period.title)
+	from, through := report.dates()
+	fmt.Fprintf(&b, "%s to %s. Generated %s.\n\n", from, through, report.generated.Format(time.RFC3339))
+	fmt.Fprintf(&b, "- **Resources:** %d\n", len(report.rows))
+	fmt.Fprintf(&b, "- **Mean uptime:** %s\n", formatUptime(uptime))
+	fmt.Fprintf(&b, "- **Incidents:** %d\n", incidents)
+	fmt.Fprintf(&b, "- **MTTR:** %s\n\n", formatMTTR(mttr))
+
+	b.WriteString("| Type | Name | ID | Uptime | Downtime | Incidents | MTTR |\n")
+	b.WriteString("|------|------|----|-------:|---------:|----------:|-----:|\n")
+	for _, row := range report.rows {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %d | %s |\n", row.kind, cell.Replace(row.name), cmdutil.ShortID(row.id),
+			formatUptime(row.uptimePercent), cmdutil.FormatIncidentDuration(row.downtime.Seconds()), row.incidents, formatMTTR(row.mttr))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// renderSLACSV writes a report as CSV with a row per resource, in seconds
+// and percent so it can be processed further
+func renderSLACSV(w io.Writer, report slaReport) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"type", "id", "name", "uptime_percent", "downtime_seconds", "incidents", "mttr_seconds"})
+	for _, row := range report.rows {
+		mttr := ""
+		if row.mttr > 0 {
+			mttr = strconv.FormatInt(int64(row.mttr.Seconds()), 10)
+		}
+		_ = cw.Write([]string{
+			row.kind,
+			row.id,
+			row.name,
+			strconv.FormatFloat(row.uptimePercent, 'f', 3, 64),
+			strconv.FormatInt(int64(row.downtime.Seconds()), 10),
+			strconv.Itoa(row.incidents),
+			mttr,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// slaHTMLTemplate is a self-contained page so the report can be emailed or
+// attached as is
+var slaHTMLTemplate = template.Must(template.New("sla").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>SLA Report: {{.Title}}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; }
+table { border-collapse: collapse; }
+th, td { padding: 0.4rem 0.8rem; border-bottom: 1px solid #d0d7de; text-align: left; }
+td.num, th.num { text-align: right; }
+.bad { color: #cf222e; }
+</style>
+</head>
+<body>
+<h1>SLA Report: {{.Title}}</h1>
+<p>{{.From}} to {{.To}}. Generated {{.Generated}}.</p>
+<ul>
+<li><strong>Resources:</strong> {{len .Rows}}</li>
+<li><strong>Mean uptime:</strong> {{.Uptime}}</li>
+<li><strong>Incidents:</strong> {{.Incidents}}</li>
+<li><strong>MTTR:</strong> {{.MTTR}}</li>
+</ul>
+<table>
+<thead><tr><th>Type</th><th>Name</th><th>ID</th><th class="num">Uptime</th><th class="num">Downtime</th><th class="num">Incidents</th><th class="num">MTTR</th></tr></thead>
+<tbody>
+{{- range .Rows}}
+<tr><td>{{.Type}}</td><td>{{.Name}}</td><td>{{.ID}}</td><td class="num{{if .Breached}} bad{{end}}">{{.Uptime}}</td><td class="num">{{.Downtime}}</td><td class="num">{{.Incidents}}</td><td class="num">{{.MTTR}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// renderSLAHTML writes a report as an HTML page
+func renderSLAHTML(w io.Writer, report slaReport) error {
+	type htmlRow struct {
+		Type, Name, ID, Uptime, Downtime, MTTR string
+		Incidents                              int
+		Breached                               bool
+	}
+	uptime, incidents, mttr := report.summary()
+	from, through := report.dates()
+	data := struct {
+		Title, From, To, Generated, Uptime, MTTR string
+		Incidents                                int
+		Rows                                     []htmlRow
+	}{
+		Title:     report.period.title,
+		From:      from,
+		To:        through,
+		Generated: report.generated.Format(time.RFC3339),
+		Uptime:    formatUptime(uptime),
+		MTTR:      formatMTTR(mttr),
+		Incidents: incidents,
+	}
+	for _, row := range report.rows {
+		data.Rows = append(data.Rows, htmlRow{
+			Type:      row.kind,
+			Name:      row.name,
+			ID:        cmdutil.ShortID(row.id),
+			Uptime:    formatUptime(row.uptimePercent),
+			Downtime:  cmdutil.FormatIncidentDuration(row.downtime.Seconds()),
+			MTTR:      formatMTTR(row.mttr),
+			Incidents: row.incidents,
+			// Highlighted like uptime below 99% in the terminal
+			Breached: row.uptimePercent < 99,
+		})
+	}
+	return slaHTMLTemplate.Execute(w, data)
+}
+
+func init() {
+	// Add flags to report sla command
+	reportSLACmd.Flags().String("period", "month", "Period to report on: month, week, quarter, or a month like 2026-09")
+	reportSLACmd.Flags().String("format", reportMarkdown, "Report format: md, html, or csv")
+	reportSLACmd.Flags().String("type", "", "Only include these resource types (comma-separated: jobs, apis, certs, domains, dns)")
+	registerFlagCompletion(reportSLACmd, "type", completeList(statusKinds...))
+	reportSLACmd.Flags().StringP("output-file", "o", "", "File to write the report to, or - for stdout (default sla-<period>.<format>)")
+
+	// Add subcommands
+	reportCmd.AddCommand(reportSLACmd)
+
+	// Add report command to root
+	rootCmd.AddCommand(reportCmd)
+}
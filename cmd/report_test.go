@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReportCommand tests the report command structure
+func TestReportCommand(t *testing.T) {
+	names := map[string]bool{}
+	for _, c := range reportCmd.Commands() {
+		names[c.Name()] = true
+	}
+	assert.True(t, names["sla"], "should have sla subcommand")
+
+	for _, name := range []string{"period", "format", "type", "output-file"} {
+		assert.NotNil(t, reportSLACmd.Flags().Lookup(name), "should have --%s flag", name)
+	}
+}
+
+// TestParseSLAPeriod tests resolving --period to the last complete period
+func TestParseSLAPeriod(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2026, 5, 13, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value    string
+		label    string
+		from, to time.Time
+	}{
+		{"month", "2026-04", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"week", "2026-W19", time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 11, 0, 0, 0, 0, time.UTC)},
+		{"quarter", "2026-Q1", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"2026-02", "2026-02", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		period, err := parseSLAPeriod(tt.value, now)
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.label, period.label, tt.value)
+		assert.True(t, tt.from.Equal(period.from), "%s: from = %v", tt.value, period.from)
+		assert.True(t, tt.to.Equal(period.to), "%s: to = %v", tt.value, period.to)
+	}
+
+	period, err := parseSLAPeriod("month", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, "2025-12", period.label)
+
+	for _, value := range []string{"year", "2026-13", "2026-06"} {
+		_, err := parseSLAPeriod(value, now)
+		assert.Error(t, err, value)
+	}
+}
+
+// TestBuildSLAReport tests computing uptime, downtime, and MTTR from
+// incidents overlapping the period
+func TestBuildSLAReport(t *testing.T) {
+	period := monthPeriod(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	now := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+	str := func(s string) *string { return &s }
+
+	resources := []incidentResource{
+		{kind: "api", id: "api-1", name: "Checkout"},
+		{kind: "job", id: "job-1", name: "Backup"},
+	}
+	incidents := [][]api.Incident{
+		{
+			// 3 hours, entirely within the period
+			{StartedAt: "2026-04-10T10:00:00Z", EndedAt: str("2026-04-10T13:00:00Z")},
+			// Started the month before; only the first hour counts as downtime
+			{StartedAt: "2026-03-31T23:00:00Z", EndedAt: str("2026-04-01T01:00:00Z")},
+			// Before the period
+			{StartedAt: "2026-03-01T00:00:00Z", EndedAt: str("2026-03-01T01:00:00Z")},
+		},
+		nil,
+	}
+
+	report := buildSLAReport(period, resources, incidents, now)
+	require.Len(t, report.rows, 2)
+
+	row := report.rows[0]
+	assert.Equal(t, 2, row.incidents)
+	assert.Equal(t, 4*time.Hour, row.downtime)
+	assert.Equal(t, 150*time.Minute, row.mttr)
+	assert.InDelta(t, 100*(1-4.0/(30*24)), row.uptimePercent, 0.0001)
+
+	assert.Equal(t, 100.0, report.rows[1].uptimePercent)
+	assert.Equal(t, time.Duration(0), report.rows[1].mttr)
+
+	uptime, total, mttr := report.summary()
+	assert.InDelta(t, (row.uptimePercent+100)/2, uptime, 0.0001)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 150*time.Minute, mttr)
+}
+
+// TestBuildSLAReportOngoing tests that ongoing incidents count as downtime
+// until the end of the period, or now for the current period
+func TestBuildSLAReportOngoing(t *testing.T) {
+	period := monthPeriod(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	now := time.Date(2026, 4, 11, 0, 0, 0, 0, time.UTC)
+
+	report := buildSLAReport(period, []incidentResource{{kind: "api", id: "api-1"}},
+		[][]api.Incident{{{StartedAt: "2026-04-10T00:00:00Z"}}}, now)
+
+	row := report.rows[0]
+	assert.Equal(t, 24*time.Hour, row.downtime)
+	assert.Equal(t, 0, row.recovered)
+	assert.InDelta(t, 90.0, row.uptimePercent, 0.0001)
+
+	from, through := report.dates()
+	assert.Equal(t, "2026-04-01", from)
+	assert.Equal(t, "2026-04-10", through)
+}
+
+// TestRenderSLAReport tests each report format
+func TestRenderSLAReport(t *testing.T) {
+	report := slaReport{
+		period:    monthPeriod(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)),
+		generated: time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC),
+		rows: []slaRow{
+			{kind: "api", id: "api-12345678", name: "Checkout | EU <prod>", uptimePercent: 99.5, downtime: time.Hour, incidents: 1, recovered: 1, mttr: time.Hour},
+		},
+	}
+
+	var md bytes.Buffer
+	require.NoError(t, renderSLAReport(&md, report, reportMarkdown))
+	assert.Contains(t, md.String(), "# SLA Report: April 2026")
+	assert.Contains(t, md.String(), "2026-04-01 to 2026-04-30")
+	assert.Contains(t, md.String(), `| api | Checkout \| EU <prod> | api-1234 | 99.50% | 1.0h | 1 | 1.0h |`)
+
+	var html bytes.Buffer
+	require.NoError(t, renderSLAReport(&html, report, reportHTML))
+	assert.Contains(t, html.String(), "<title>SLA Report: April 2026</title>")
+	assert.Contains(t, html.String(), "Checkout | EU &lt;prod&gt;")
+
+	var csv bytes.Buffer
+	require.NoError(t, renderSLAReport(&csv, report, reportCSV))
+	assert.Equal(t, "type,id,name,uptime_percent,downtime_seconds,incidents,mttr_seconds\n"+
+		"api,api-12345678,Checkout | EU <prod>,99.500,3600,1,3600\n", csv.String())
+}
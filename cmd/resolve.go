@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
+)
+
+// resolvableTargets are the resource types whose short IDs commands
+// resolve, registered with cmdutil under their plural nouns
+var resolvableTargets = []bulkTarget{
+	jobsBulkTarget,
+	apisBulkTarget,
+	certsBulkTarget,
+	domainsBulkTarget,
+	dnsBulkTarget,
+	projectsBulkTarget,
+	incidentsBulkTarget,
+}
+
+// resolverFor returns the resolver for a resource type, which cmdutil
+// caches per client
+func resolverFor(client api.APIClient, target bulkTarget) *resolve.Resolver[bulkItem] {
+	return cmdutil.ResolverFor(client, target.plural).(*resolve.Resolver[bulkItem])
+}
+
+// newBulkResolver returns a factory for a resource type's resolvers. Unless
+// --no-cache is given, short IDs are first looked up in the listing cached
+// on disk by an earlier command, and live listings are cached for later
+// ones.
+func newBulkResolver(target bulkTarget) cmdutil.ResolverFactory {
+	return func(client api.APIClient) cmdutil.Resolver {
+		store := listCacheFor(client)
+		r := resolve.New(target.noun, target.plural, func() ([]bulkItem, error) {
+			items, err := target.list(client)
+			if err == nil && store != nil {
+				storeListing(store, target, items)
+			}
+			return items, err
+		})
+		if store != nil {
+			r.WithCache(func() ([]bulkItem, bool) {
+				return loadListing(store, target)
+			})
+		}
+		return r
+	}
+}
+
+func init() {
+	for _, target := range resolvableTargets {
+		cmdutil.RegisterResolver(target.plural, newBulkResolver(target))
+	}
+}
@@ -1,19 +1,90 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
 	"github.com/spf13/cobra"
 )
 
+// Exit codes returned by the CLI. These are part of the public contract and
+// are documented in the root command's help.
+const (
+	exitOK           = 0
+	exitGeneric      = 1
+	exitUsage        = 2
+	exitAuthError    = 3
+	exitNotFound     = 4
+	exitRateLimited  = 5
+	exitResourceDown = 6
+)
+
+// Values accepted by --fail-level, from strictest to most lenient
+const (
+	failLevelWarning = "warning"
+	failLevelDown    = "down"
+	failLevelNone    = "none"
+)
+
 var rootCmd = &cobra.Command{
 	Use:   "groovekit",
 	Short: "Monitor cron jobs and APIs from your terminal",
 	Long: `GrooveKit CLI - Monitor your cron jobs and API endpoints before users notice.
 
 Verify your services are working correctly with heartbeat monitoring,
-JSON Schema validation, GraphQL support, and instant alerts.`,
+JSON Schema validation, GraphQL support, and instant alerts.
+
+Exit codes:
+  0  success
+  1  generic failure (API error, API unreachable, or anything else)
+  2  usage error (unknown command, missing or invalid flag or argument)
+  3  authentication error (not logged in, invalid or expired token)
+  4  not found (no resource with that ID)
+  5  rate or plan limit exceeded
+  6  resource down (see --fail-level)`,
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		// Arguments and flags have been validated by the time this runs, so
+		// errors from here on aren't cobra usage errors
+		commandStarted = true
+		if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+			config.SetProfile(profile)
+		}
+		configureDebug(cmd)
+		if err := applySettings(cmd); err != nil {
+			return err
+		}
+		if err := configureTransport(cmd); err != nil {
+			return err
+		}
+		if err := configureVerbosity(cmd.Root()); err != nil {
+			return err
+		}
+		if err := configureAPIURL(cmd); err != nil {
+			return err
+		}
+		if err := configureQuery(cmd); err != nil {
+			return err
+		}
+		listCacheEnabled = usesListCache(cmd)
+		startUpdateCheck(cmd)
+
+		level, _ := cmd.Flags().GetString("fail-level")
+		switch level {
+		case failLevelWarning, failLevelDown, failLevelNone:
+			return nil
+		}
+		return usageErrorf("invalid --fail-level %q: must be warning, down, or none", level)
+	},
 }
 
 // RootCmd returns the root command, used by tools such as doc generators.
@@ -21,14 +92,283 @@ func RootCmd() *cobra.Command {
 	return rootCmd
 }
 
+// exitCodeError ends the process with a specific exit code. Commands return
+// it after they have already reported their outcome; err, when set, is
+// printed to stderr.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+// usageError marks a mistake in how a command was invoked, such as a
+// missing or invalid flag
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// usageErrorf formats a usage error
+func usageErrorf(format string, args ...interface{}) error {
+	return &usageError{err: fmt.Errorf(format, args...)}
+}
+
+// commandStarted is set once cobra has validated a command's arguments and
+// flags. Errors returned before then (unknown commands, bad flags, wrong
+// argument counts, missing required flags) are usage errors.
+var commandStarted bool
+
+// exitCode maps an error returned by a command onto the documented exit
+// codes. This is the one place the contract is implemented; commands return
+// typed errors (usageError, api.Error, resolve.NotFoundError) or an
+// exitCodeError and never exit themselves.
+func exitCode(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	var exitErr *exitCodeError
+	var usageErr *usageError
+	var notFound *resolve.NotFoundError
+	var ambiguous *resolve.AmbiguousError
+	var apiErr *api.Error
+	switch {
+	case errors.As(err, &exitErr):
+		return exitErr.code
+	case errors.As(err, &usageErr), errors.As(err, &ambiguous):
+		return exitUsage
+	case errors.Is(err, cmdutil.ErrNotLoggedIn), errors.Is(err, api.ErrUnauthorized):
+		return exitAuthError
+	case errors.Is(err, api.ErrNotFound), errors.As(err, &notFound):
+		return exitNotFound
+	case errors.As(err, &apiErr) && apiErr.IsQuota():
+		return exitRateLimited
+	}
+	return exitGeneric
+}
+
+// failOn reports whether a condition of the given severity (failLevelWarning
+// or failLevelDown) should fail the command under --fail-level
+func failOn(cmd *cobra.Command, severity string) bool {
+	level, _ := cmd.Flags().GetString("fail-level")
+	switch level {
+	case failLevelWarning:
+		return true
+	case failLevelNone:
+		return false
+	}
+	return severity == failLevelDown
+}
+
+// resourceDown ends the command with exitResourceDown once it has reported
+// its outcome
+func resourceDown(cmd *cobra.Command) error {
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	return &exitCodeError{code: exitResourceDown}
+}
+
+// debugging is set when API requests are logged to stderr, where spinners
+// would garble them
+var debugging bool
+
+// configureDebug turns on API request logging to stderr for --debug,
+// --debug-body, or GROOVEKIT_DEBUG ("1" or "true" for requests, "body" to
+// include bodies)
+func configureDebug(cmd *cobra.Command) {
+	debug, _ := cmd.Flags().GetBool("debug")
+	bodies, _ := cmd.Flags().GetBool("debug-body")
+	switch strings.ToLower(os.Getenv("GROOVEKIT_DEBUG")) {
+	case "1", "true":
+		debug = true
+	case "body":
+		bodies = true
+	}
+	if debug || bodies {
+		debugging = true
+		api.SetDebug(cmd.ErrOrStderr(), bodies)
+	}
+}
+
+// configureTransport trusts the certificates in GROOVEKIT_CA_BUNDLE and
+// turns off certificate verification for --insecure-skip-verify, for API
+// servers behind TLS interception
+func configureTransport(cmd *cobra.Command) error {
+	insecure, _ := cmd.Flags().GetBool("insecure-skip-verify")
+	opts := api.TransportOptions{
+		CABundle:           os.Getenv("GROOVEKIT_CA_BUNDLE"),
+		InsecureSkipVerify: insecure,
+	}
+	if err := api.ConfigureTransport(opts); err != nil {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("invalid GROOVEKIT_CA_BUNDLE: %w", err)
+	}
+	if insecure {
+		fmt.Fprintln(cmd.ErrOrStderr(), output.Yellow("Warning: TLS certificate verification is disabled (--insecure-skip-verify)"))
+	}
+	return nil
+}
+
+// configureAPIURL points commands at --api-url, overriding GROOVEKIT_API_URL
+// and the config file, and warns when either overrides the hosted API so a
+// one-off command against staging is never mistaken for production
+func configureAPIURL(cmd *cobra.Command) error {
+	apiURL, _ := cmd.Flags().GetString("api-url")
+	source := "--api-url"
+	if apiURL == "" {
+		apiURL, source = os.Getenv("GROOVEKIT_API_URL"), "GROOVEKIT_API_URL"
+	}
+	if apiURL == "" {
+		return nil
+	}
+	if err := validateAPIURL(apiURL); err != nil {
+		return usageErrorf("invalid %s %q: %v", source, apiURL, err)
+	}
+
+	apiURL = strings.TrimSuffix(apiURL, "/")
+	config.SetAPIURL(apiURL)
+	if apiURL != config.DefaultAPIBaseURL && !quiet {
+		fmt.Fprintln(cmd.ErrOrStderr(), output.Yellow(fmt.Sprintf("Using API at %s (from %s)", apiURL, source)))
+	}
+	return nil
+}
+
+// Set by --quiet and --verbose
+var (
+	quiet   bool
+	verbose bool
+	// commandStart is when the command began, for --verbose timings
+	commandStart time.Time
+)
+
+// configureVerbosity applies --quiet, which leaves only essential data such
+// as IDs, or --verbose, which reports timings and request counts. They are
+// read from the root so commands with their own --verbose keep it.
+func configureVerbosity(root *cobra.Command) error {
+	q, _ := root.PersistentFlags().GetBool("quiet")
+	v, _ := root.PersistentFlags().GetBool("verbose")
+	if q && v {
+		return usageErrorf("--quiet and --verbose can't be used together")
+	}
+	quiet, verbose = q, v
+	output.SetQuiet(quiet)
+	commandStart = time.Now()
+	return nil
+}
+
+// printVerboseSummary reports how long the command took and how many API
+// requests it made
+func printVerboseSummary(w io.Writer) {
+	if !verbose {
+		return
+	}
+	elapsed := time.Since(commandStart).Round(time.Millisecond)
+	requests := int(api.RequestCount())
+	fmt.Fprintf(w, "Completed in %s with %s\n", elapsed, countNoun(requests, "API request", "API requests"))
+}
+
+// errorHint suggests what to do about an API error, or returns "" when
+// the error message says it all
+func errorHint(cmd *cobra.Command, err error) string {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || cmd == loginCmd {
+		return ""
+	}
+
+	switch {
+	case errors.Is(err, api.ErrUnauthorized):
+		if apiErr.StatusCode == http.StatusForbidden {
+			return "Your token doesn't have access to this. Check you're using the right --profile, or run 'groovekit auth login' as another user"
+		}
+		return "Your token is invalid or has expired. Run 'groovekit auth login' to log in again"
+	case errors.Is(err, api.ErrNotFound):
+		if list := resourceListCmd(cmd); list != nil {
+			return fmt.Sprintf("It may have been deleted. Run '%s' to see what exists", list.CommandPath())
+		}
+		return "It may have been deleted"
+	case errors.Is(err, api.ErrRateLimited):
+		if apiErr.RetryAfter > 0 {
+			return fmt.Sprintf("Too many requests. Try again in %s", apiErr.RetryAfter)
+		}
+		return "Too many requests. Run 'groovekit api rate-limit' to see when the limit resets"
+	case errors.Is(err, api.ErrValidation):
+		return fmt.Sprintf("Check the values given; run '%s --help' for the accepted flags", cmd.CommandPath())
+	}
+	return ""
+}
+
+// resourceListCmd returns the list command of the resource type a command
+// belongs to, e.g. jobs list for jobs webhook show, or nil if there is none
+func resourceListCmd(cmd *cobra.Command) *cobra.Command {
+	resource := cmd
+	for resource.HasParent() && resource.Parent() != rootCmd {
+		resource = resource.Parent()
+	}
+	if resource == cmd || resource.Parent() != rootCmd {
+		return nil
+	}
+	for _, c := range resource.Commands() {
+		if c.Name() == "list" {
+			return c
+		}
+	}
+	return nil
+}
+
 // Execute runs the root command
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+	cmd, err := rootCmd.ExecuteC()
+	if err != nil {
+		if !commandStarted {
+			err = &usageError{err: err}
+		}
+		var exitErr *exitCodeError
+		if errors.As(err, &exitErr) {
+			if exitErr.err != nil {
+				fmt.Fprintln(os.Stderr, exitErr.err)
+			}
+		} else {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		if hint := errorHint(cmd, err); hint != "" {
+			fmt.Fprintln(os.Stderr, hint)
+		}
+	}
+	printVerboseSummary(os.Stderr)
+	printUpdateHint(os.Stderr)
+	if err != nil {
+		os.Exit(exitCode(err))
 	}
 }
 
 func init() {
-	// Global flags can be added here
+	rootCmd.PersistentFlags().String("profile", "", "Config profile to use (default from GROOVEKIT_PROFILE, else \"default\")")
+	rootCmd.PersistentFlags().String("api-url", "", "API URL to use for this command, overriding GROOVEKIT_API_URL and the config file")
+	rootCmd.PersistentFlags().String("fail-level", failLevelDown, "Resource conditions that cause a non-zero exit: warning, down, or none")
+	rootCmd.PersistentFlags().Bool("debug", false, "Log API requests (method, URL, status, timing) to stderr; also GROOVEKIT_DEBUG=1")
+	rootCmd.PersistentFlags().Bool("debug-body", false, "Log API requests with redacted request and response bodies; also GROOVEKIT_DEBUG=body")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Don't use or update the local cache of resource listings")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Print only essential data such as IDs, without spinners, messages, or totals")
+	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "Report how long the command took and how many API requests it made")
+	rootCmd.PersistentFlags().Bool("insecure-skip-verify", false, "Don't verify the API server's TLS certificate (for testing only); trust a private CA with GROOVEKIT_CA_BUNDLE instead")
+	rootCmd.PersistentFlags().String("query", "", "Filter JSON output with a JMESPath expression, e.g. 'api_monitors[?down].name'")
+	rootCmd.PersistentFlags().String("timezone", "", "Time zone to show times in: local, UTC, or a name such as Europe/London (default the timezone setting, else local; also GROOVEKIT_TIMEZONE)")
+	rootCmd.PersistentFlags().Bool("no-color", false, "Disable colored output; also NO_COLOR=1 or CLICOLOR=0")
 }
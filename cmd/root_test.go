@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/resolve"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExitCode tests the mapping from errors to documented exit codes
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"success", nil, exitOK},
+		{"generic error", errors.New("something went wrong"), exitGeneric},
+		{"usage error", usageErrorf("--interval is required"), exitUsage},
+		{"ambiguous ID", &resolve.AmbiguousError{Plural: "jobs", Query: "a"}, exitUsage},
+		{"not logged in", cmdutil.ErrNotLoggedIn, exitAuthError},
+		{"unauthorized", fmt.Errorf("failed to list jobs: %w", &api.Error{StatusCode: 401}), exitAuthError},
+		{"not found", fmt.Errorf("failed to get job: %w", &api.Error{StatusCode: 404}), exitNotFound},
+		{"unknown ID prefix", &resolve.NotFoundError{Noun: "job", Query: "abc"}, exitNotFound},
+		{"plan limit", &api.Error{StatusCode: 402}, exitRateLimited},
+		{"rate limited", &api.Error{StatusCode: 429}, exitRateLimited},
+		{"server error", &api.Error{StatusCode: 500}, exitGeneric},
+		{"unreachable", &url.Error{Op: "Get", URL: "https://api.groovekit.io", Err: errors.New("refused")}, exitGeneric},
+		{"explicit code", &exitCodeError{code: exitResourceDown}, exitResourceDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, exitCode(tt.err))
+		})
+	}
+}
+
+// TestFailLevelFlag tests the global --fail-level flag
+func TestFailLevelFlag(t *testing.T) {
+	flag := rootCmd.PersistentFlags().Lookup("fail-level")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, failLevelDown, flag.DefValue)
+	}
+
+	c := &cobra.Command{}
+	c.Flags().String("fail-level", "sometimes", "")
+	assert.Error(t, rootCmd.PersistentPreRunE(c, nil))
+
+	_ = c.Flags().Set("fail-level", failLevelWarning)
+	assert.NoError(t, rootCmd.PersistentPreRunE(c, nil))
+}
+
+// TestErrorHint tests the guidance printed after API errors
+func TestErrorHint(t *testing.T) {
+	assert.Contains(t, errorHint(jobsShowCmd, fmt.Errorf("failed to get job: %w", &api.Error{StatusCode: 401})), "groovekit auth login")
+	assert.Contains(t, errorHint(jobsShowCmd, &api.Error{StatusCode: 403}), "--profile")
+	assert.Contains(t, errorHint(jobsShowCmd, &api.Error{StatusCode: 404}), "groovekit jobs list")
+	assert.Contains(t, errorHint(jobsWebhookShowCmd, &api.Error{StatusCode: 404}), "'groovekit jobs list'")
+	assert.Equal(t, "It may have been deleted", errorHint(apiRateLimitCmd, &api.Error{StatusCode: 404}))
+	assert.Contains(t, errorHint(jobsShowCmd, &api.Error{StatusCode: 429, RetryAfter: 30 * time.Second}), "Try again in 30s")
+	assert.Contains(t, errorHint(jobsCreateCmd, &api.Error{StatusCode: 422}), "groovekit jobs create --help")
+
+	assert.Empty(t, errorHint(loginCmd, &api.Error{StatusCode: 401}), "a failed login needs no hint to log in")
+	assert.Empty(t, errorHint(jobsShowCmd, &api.Error{StatusCode: 500}))
+	assert.Empty(t, errorHint(jobsShowCmd, errors.New("--name is required")))
+}
+
+// TestNoColorFlag tests --no-color is available on every command
+func TestNoColorFlag(t *testing.T) {
+	flag := rootCmd.PersistentFlags().Lookup("no-color")
+	if assert.NotNil(t, flag) {
+		assert.Equal(t, "false", flag.DefValue)
+	}
+}
+
+// TestConfigureVerbosity tests --quiet and --verbose are exclusive
+func TestConfigureVerbosity(t *testing.T) {
+	newRoot := func(args ...string) *cobra.Command {
+		root := &cobra.Command{}
+		root.PersistentFlags().BoolP("quiet", "q", false, "")
+		root.PersistentFlags().BoolP("verbose", "v", false, "")
+		require.NoError(t, root.ParseFlags(args))
+		return root
+	}
+	t.Cleanup(func() {
+		quiet, verbose = false, false
+		output.SetQuiet(false)
+	})
+
+	require.NoError(t, configureVerbosity(newRoot("-q")))
+	assert.True(t, quiet)
+	assert.True(t, output.Quiet())
+
+	require.NoError(t, configureVerbosity(newRoot("--verbose")))
+	assert.True(t, verbose)
+	assert.False(t, output.Quiet())
+
+	err := configureVerbosity(newRoot("-q", "-v"))
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestConfigureAPIURL tests validating --api-url and warning about overrides
+func TestConfigureAPIURL(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("GROOVEKIT_API_URL", "")
+	t.Cleanup(func() { config.SetAPIURL("") })
+	run := func(args ...string) (string, error) {
+		c := &cobra.Command{}
+		c.Flags().String("api-url", "", "")
+		require.NoError(t, c.ParseFlags(args))
+		var stderr bytes.Buffer
+		c.SetErr(&stderr)
+		err := configureAPIURL(c)
+		return stderr.String(), err
+	}
+
+	banner, err := run("--api-url", "https://staging.example.com/")
+	require.NoError(t, err)
+	assert.Contains(t, banner, "Using API at https://staging.example.com (from --api-url)")
+
+	banner, err = run("--api-url", config.DefaultAPIBaseURL)
+	require.NoError(t, err)
+	assert.Empty(t, banner)
+
+	t.Setenv("GROOVEKIT_API_URL", "http://localhost:3000")
+	banner, err = run()
+	require.NoError(t, err)
+	assert.Contains(t, banner, "from GROOVEKIT_API_URL")
+
+	_, err = run("--api-url", "staging.example.com")
+	assert.Equal(t, exitUsage, exitCode(err))
+}
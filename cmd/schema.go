@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/jsonschema"
+	"github.com/scookdev/groovekit-cli/internal/output"
+)
+
+// schemaValidation is the JSON output of apis schema validate
+type schemaValidation struct {
+	Valid  bool               `json:"valid"`
+	Errors []jsonschema.Error `json:"errors"`
+}
+
+// readJSONSchema reads and compiles a JSON schema file, returning it as the
+// text to store on a monitor
+func readJSONSchema(path string) (string, *jsonschema.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read JSON schema: %w", err)
+	}
+	schema, err := jsonschema.Compile(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return strings.TrimSpace(string(data)), schema, nil
+}
+
+// readJSONDocument decodes a JSON file, or stdin when path is "-"
+func readJSONDocument(path string, stdin io.Reader) (interface{}, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s is not valid JSON: %w", path, err)
+	}
+	return doc, nil
+}
+
+// formatSchema pretty-prints a stored schema, falling back to the text as
+// stored when it isn't valid JSON
+func formatSchema(schema string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(schema), "", "  "); err != nil {
+		return schema
+	}
+	return buf.String()
+}
+
+// printSchemaValidation reports whether a document matched a schema
+func printSchemaValidation(w io.Writer, name string, errs []jsonschema.Error) {
+	if len(errs) == 0 {
+		output.SuccessMessage(w, fmt.Sprintf("%s matches the schema", name))
+		return
+	}
+	output.ErrorMessage(w, fmt.Sprintf("%s does not match the schema (%s):", name, countNoun(len(errs), "problem", "problems")))
+	for _, e := range errs {
+		fmt.Fprintf(w, "  %s  %s\n", output.Bold(e.Path), e.Message)
+	}
+}
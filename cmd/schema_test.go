@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadJSONSchema tests loading and checking a schema file
+func TestReadJSONSchema(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte("{\"type\": \"object\"}\n"), 0o600))
+
+	text, schema, err := readJSONSchema(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"type": "object"}`, text)
+	assert.Len(t, schema.Validate([]interface{}{}), 1)
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"pattern": "("}`), 0o600))
+	_, _, err = readJSONSchema(bad)
+	assert.ErrorContains(t, err, "bad.json: invalid JSON schema: invalid pattern")
+}
+
+// TestReadJSONDocument tests reading payloads from files and stdin
+func TestReadJSONDocument(t *testing.T) {
+	doc, err := readJSONDocument("-", strings.NewReader(`{"ok": true}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"ok": true}, doc)
+
+	_, err = readJSONDocument("-", strings.NewReader(`<html>`))
+	assert.ErrorContains(t, err, "- is not valid JSON")
+}
+
+// TestPrintSchemaValidation tests reporting matches and mismatches
+func TestPrintSchemaValidation(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	_, schema, err := readJSONSchema(writeTemp(t, `{"required": ["id"], "properties": {"id": {"type": "integer"}}}`))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	printSchemaValidation(&buf, "response.json", schema.Validate(map[string]interface{}{"id": 1.0}))
+	assert.Contains(t, buf.String(), "response.json matches the schema")
+
+	buf.Reset()
+	printSchemaValidation(&buf, "response.json", schema.Validate(map[string]interface{}{}))
+	assert.Contains(t, buf.String(), "response.json does not match the schema (1 problem):")
+	assert.Contains(t, buf.String(), `$  missing required property "id"`)
+}
+
+// TestFormatSchema tests pretty-printing stored schemas
+func TestFormatSchema(t *testing.T) {
+	assert.Equal(t, "{\n  \"type\": \"object\"\n}", formatSchema(`{"type":"object"}`))
+	assert.Equal(t, "not json", formatSchema("not json"))
+}
+
+func writeTemp(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// searchTargets are the resource types searched, keyed by kind (see
+// statusKinds)
+var searchTargets = map[string]bulkTarget{
+	"jobs":    jobsBulkTarget,
+	"apis":    apisBulkTarget,
+	"certs":   certsBulkTarget,
+	"domains": domainsBulkTarget,
+	"dns":     dnsBulkTarget,
+}
+
+// searchFieldOrder is the order fields are tried in, so a match on the name
+// is reported over an equally good match on the URL
+var searchFieldOrder = []string{"name", "url", "domain", "tag"}
+
+// Match scores, best first
+const (
+	searchExact = iota
+	searchPrefix
+	searchContains
+	searchFuzzy
+)
+
+// minFuzzyQuery is the shortest query matched fuzzily; shorter ones would
+// match most names
+const minFuzzyQuery = 3
+
+// searchResult is a resource matching a search query
+type searchResult struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Field string `json:"field"`
+	Value string `json:"value"`
+	score int
+}
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Find resources by name, URL, domain, or tag",
+	Long: `Search jobs, API monitors, SSL certificates, domains, and DNS monitors at
+once for resources whose name, URL, domain, or tag matches the query.
+
+Matching ignores case. Exact matches are listed first, then names starting
+with the query, then those containing it, then fuzzy matches where the
+query's letters appear in order (so "pmtapi" finds "payments-api").
+
+Examples:
+  groovekit search payments
+  groovekit search api.example.com --type apis,certs
+  groovekit search checkout --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		query := strings.TrimSpace(args[0])
+		if query == "" {
+			return usageErrorf("search query can't be empty")
+		}
+		typeFlag, _ := cmd.Flags().GetString("type")
+		kinds, err := parseIncidentTypes(typeFlag)
+		if err != nil {
+			return err
+		}
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		results, errs := searchResources(cmd.Context(), client, kinds, query)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		// Nothing could be searched at all
+		if len(errs) == len(kinds) {
+			return errs[0]
+		}
+		for _, err := range errs {
+			output.ErrorMessage(cmd.ErrOrStderr(), err.Error())
+		}
+
+		results = limitItems(results, limit)
+
+		if jsonOutput {
+			err = cmdutil.OutputJSON(out, results)
+		} else if len(results) == 0 {
+			output.InfoMessage(out, fmt.Sprintf("No resources match %q", query))
+		} else {
+			err = printSearchResults(cmd, results)
+		}
+		if err != nil || len(errs) == 0 {
+			return err
+		}
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	},
+}
+
+// searchResources lists the given kinds concurrently and returns the
+// resources matching query, best matches first, along with an error for
+// each kind that couldn't be listed
+func searchResources(ctx context.Context, client api.APIClient, kinds map[string]bool, query string) ([]searchResult, []error) {
+	var searched []string
+	for _, kind := range statusKinds {
+		if kinds[kind] {
+			searched = append(searched, kind)
+		}
+	}
+
+	found := make([][]searchResult, len(searched))
+	fetchErrs := api.Each(ctx, len(searched), api.DefaultConcurrency, func(_ context.Context, i int) error {
+		items, err := resolverFor(client, searchTargets[searched[i]]).Items()
+		if err != nil {
+			return err
+		}
+		found[i] = searchItems(searched[i], items, query)
+		return nil
+	})
+
+	results := []searchResult{}
+	var errs []error
+	for i, err := range fetchErrs {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		results = append(results, found[i]...)
+	}
+	sortSearchResults(results)
+	return results, errs
+}
+
+// searchItems returns the items of one kind matching query, each with the
+// field it matched best
+func searchItems(kind string, items []bulkItem, query string) []searchResult {
+	q := strings.ToLower(query)
+	var results []searchResult
+	for _, item := range items {
+		best := searchResult{score: -1}
+		consider := func(field, value string) {
+			score, ok := searchScore(q, strings.ToLower(value))
+			if ok && (best.score < 0 || score < best.score) {
+				best = searchResult{Type: kind, ID: item.id, Name: item.name, Field: field, Value: value, score: score}
+			}
+		}
+		for _, field := range searchFieldOrder {
+			switch field {
+			case "name":
+				consider(field, item.name)
+			case "tag":
+				for _, tag := range item.tags {
+					consider(field, tag)
+				}
+			default:
+				if value := item.fields[field]; value != "" {
+					consider(field, value)
+				}
+			}
+		}
+		if best.score >= 0 {
+			results = append(results, best)
+		}
+	}
+	return results
+}
+
+// searchScore rates how well a lower-cased value matches a lower-cased
+// query, reporting false when it doesn't match at all
+func searchScore(query, value string) (int, bool) {
+	switch {
+	case value == "":
+		return 0, false
+	case value == query:
+		return searchExact, true
+	case strings.HasPrefix(value, query):
+		return searchPrefix, true
+	case strings.Contains(value, query):
+		return searchContains, true
+	case len(query) >= minFuzzyQuery && isSubsequence(query, value):
+		return searchFuzzy, true
+	}
+	return 0, false
+}
+
+// isSubsequence reports whether every character of query appears in value,
+// in order
+func isSubsequence(query, value string) bool {
+	rest := value
+	for _, r := range query {
+		i := strings.IndexRune(rest, r)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(string(r)):]
+	}
+	return true
+}
+
+// sortSearchResults orders results by match quality, then by type (in
+// statusKinds order) and name
+func sortSearchResults(results []searchResult) {
+	kindOrder := map[string]int{}
+	for i, kind := range statusKinds {
+		kindOrder[kind] = i
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.score != b.score {
+			return a.score < b.score
+		}
+		if a.Type != b.Type {
+			return kindOrder[a.Type] < kindOrder[b.Type]
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+}
+
+// printSearchResults renders search results as a table
+func printSearchResults(cmd *cobra.Command, results []searchResult) error {
+	table, err := newListTable(cmd, []string{"TYPE", "ID", "NAME", "MATCHED"})
+	if err != nil {
+		return err
+	}
+	table.Render()
+	for _, result := range results {
+		matched := "name"
+		if result.Field != "name" {
+			matched = fmt.Sprintf("%s: %s", result.Field, result.Value)
+		}
+		table.Append([]string{result.Type, output.Cyan(cmdutil.ShortID(result.ID)), result.Name, matched})
+	}
+	table.Flush()
+	output.TotalMessage(cmd.OutOrStdout(), fmt.Sprintf("Found %s", countNoun(len(results), "resource", "resources")))
+	return nil
+}
+
+func init() {
+	searchCmd.Flags().Bool("json", false, "Output as JSON")
+	addTableFlags(searchCmd)
+	searchCmd.Flags().String("type", "", "Only search these resource types (comma-separated: jobs, apis, certs, domains, dns)")
+	registerFlagCompletion(searchCmd, "type", completeList(statusKinds...))
+	searchCmd.Flags().Int("limit", 0, "Maximum number of results to show")
+
+	rootCmd.AddCommand(searchCmd)
+}
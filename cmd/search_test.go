@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSearchScore tests ranking a value against a query
+func TestSearchScore(t *testing.T) {
+	tests := []struct {
+		query, value string
+		want         int
+		ok           bool
+	}{
+		{"payments", "payments", searchExact, true},
+		{"pay", "payments-api", searchPrefix, true},
+		{"api", "payments-api", searchContains, true},
+		{"pmtapi", "payments-api", searchFuzzy, true},
+		{"pa", "pxa", 0, false},
+		{"ipa", "payments-api", 0, false},
+		{"x", "", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := searchScore(tt.query, tt.value)
+		assert.Equal(t, tt.ok, ok, "%q in %q", tt.query, tt.value)
+		if tt.ok {
+			assert.Equal(t, tt.want, got, "%q in %q", tt.query, tt.value)
+		}
+	}
+}
+
+// TestSearchItems tests matching names, fields, and tags, best match first
+func TestSearchItems(t *testing.T) {
+	items := []bulkItem{
+		{id: "aaaa1111", name: "Checkout API", fields: map[string]string{"url": "https://payments.example.com/health"}},
+		{id: "bbbb2222", name: "Payments", tags: []string{"team=payments"}},
+		{id: "cccc3333", name: "Nightly backup", tags: []string{"team=data"}},
+	}
+
+	results := searchItems("apis", items, "Payments")
+	sortSearchResults(results)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "bbbb2222", results[0].ID)
+		assert.Equal(t, "name", results[0].Field)
+		assert.Equal(t, "aaaa1111", results[1].ID)
+		assert.Equal(t, "url", results[1].Field)
+		assert.Equal(t, "apis", results[1].Type)
+	}
+
+	results = searchItems("jobs", items, "team=data")
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, "tag", results[0].Field)
+	}
+}
+
+// TestSortSearchResults tests ordering by score, then type, then name
+func TestSortSearchResults(t *testing.T) {
+	results := []searchResult{
+		{Type: "dns", Name: "b", score: searchContains},
+		{Type: "jobs", Name: "z", score: searchContains},
+		{Type: "jobs", Name: "a", score: searchContains},
+		{Type: "certs", Name: "x", score: searchExact},
+	}
+	sortSearchResults(results)
+	var names []string
+	for _, r := range results {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"x", "a", "z", "b"}, names)
+}
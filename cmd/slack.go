@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/slack"
+	"github.com/spf13/cobra"
+)
+
+// slackPostTimeout bounds posting a message to a Slack webhook
+const slackPostTimeout = 15 * time.Second
+
+// addReportFormatFlags registers --format (text, github, or slack) and
+// --post-to on summary commands that can report to GitHub Actions or Slack
+func addReportFormatFlags(c *cobra.Command) {
+	c.Flags().String("format", formatText, "Output format: text, github for GitHub Actions workflow annotations, or slack for Slack Block Kit JSON")
+	addPostToFlag(c)
+}
+
+// addPostToFlag registers --post-to on commands with --format slack
+func addPostToFlag(c *cobra.Command) {
+	c.Flags().String("post-to", "", "Slack incoming webhook URL to send the --format slack message to instead of printing it")
+}
+
+// emitSlack prints a Slack message as JSON, or posts it to the --post-to
+// webhook
+func emitSlack(cmd *cobra.Command, msg slack.Message) error {
+	msg.Trim()
+
+	postTo, _ := cmd.Flags().GetString("post-to")
+	if postTo == "" {
+		return cmdutil.OutputJSON(cmd.OutOrStdout(), msg)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), slackPostTimeout)
+	defer cancel()
+	if err := slack.Post(ctx, http.DefaultClient, postTo, msg); err != nil {
+		return fmt.Errorf("failed to post to Slack: %w", err)
+	}
+	output.SuccessMessage(cmd.OutOrStdout(), "Posted to Slack")
+	return nil
+}
+
+// slackResource formats a resource in mrkdwn, e.g. "*Backup* (`1a2b3c4d`) job"
+func slackResource(kind, id, name string) string {
+	return fmt.Sprintf("*%s* (`%s`) %s", slack.Escape(name), cmdutil.ShortID(id), kind)
+}
+
+// statusSlackMessage renders a status summary as a Slack message
+func statusSlackMessage(summary *statusSummary) slack.Message {
+	var counts []string
+	for _, kind := range statusKinds {
+		if _, failed := summary.Errors[kind]; failed {
+			counts = append(counts, fmt.Sprintf("%s: ?", kind))
+			continue
+		}
+		counts = append(counts, fmt.Sprintf("%s: %d", kind, summary.Counts[kind]))
+	}
+
+	msg := slack.Message{Blocks: []slack.Block{slack.Header("GrooveKit status"), slack.Context(strings.Join(counts, " · "))}}
+
+	if len(summary.Errors) > 0 {
+		var lines []string
+		for _, kind := range statusKinds {
+			if err, failed := summary.Errors[kind]; failed {
+				lines = append(lines, "• "+slack.Escape(err))
+			}
+		}
+		msg.Blocks = append(msg.Blocks, slack.List(":warning: Couldn't fetch", lines)...)
+	}
+	if len(summary.Down) > 0 {
+		var lines []string
+		for _, item := range summary.Down {
+			lines = append(lines, fmt.Sprintf("• %s: %s", slackResource(item.Type, item.ID, item.Name), slack.Escape(item.Detail)))
+		}
+		msg.Blocks = append(msg.Blocks, slack.List(":red_circle: Down", lines)...)
+	}
+	if len(summary.Expiring) > 0 {
+		var lines []string
+		for _, item := range summary.Expiring {
+			line := fmt.Sprintf("• %s: %s", slackResource(item.Type, item.ID, item.Name), item.Detail)
+			if item.Critical {
+				line += " :bangbang:"
+			}
+			lines = append(lines, line)
+		}
+		msg.Blocks = append(msg.Blocks, slack.List(":hourglass_flowing_sand: Expiring soon", lines)...)
+	}
+	if len(summary.OngoingIncidents) > 0 {
+		var lines []string
+		for _, incident := range summary.OngoingIncidents {
+			lines = append(lines, fmt.Sprintf("• %s: since %s", slackResource(incident.Type, incident.ID, incident.Name), output.FormatTime(incident.StartedAt)))
+		}
+		msg.Blocks = append(msg.Blocks, slack.List(":rotating_light: Ongoing incidents", lines)...)
+	}
+
+	if summary.Healthy && len(summary.Expiring) == 0 {
+		msg.Text = "GrooveKit: all systems operational"
+		msg.Blocks = append(msg.Blocks, slack.Section(":white_check_mark: All systems operational"))
+	} else {
+		msg.Text = fmt.Sprintf("GrooveKit: %d down, %d expiring, %d ongoing incident(s)",
+			len(summary.Down), len(summary.Expiring), len(summary.OngoingIncidents))
+	}
+	return msg
+}
+
+// incidentsSlackMessage renders incidents as a Slack message, ongoing ones
+// first
+func incidentsSlackMessage(rows []incidentRow) slack.Message {
+	var ongoing, recovered []string
+	for _, row := range rows {
+		resource := slackResource(row.ResourceType, row.ResourceID, row.ResourceName)
+		if row.EndedAt == nil {
+			ongoing = append(ongoing, fmt.Sprintf("• %s: %s since %s", resource, incidentState(row.Incident), output.FormatTime(row.StartedAt)))
+			continue
+		}
+		recovered = append(recovered, fmt.Sprintf("• %s: %s to %s (%s)", resource, output.FormatTime(row.StartedAt), output.FormatTime(*row.EndedAt), cmdutil.FormatIncidentDuration(row.Duration)))
+	}
+
+	msg := slack.Message{
+		Text:   fmt.Sprintf("GrooveKit: %d ongoing, %d recovered incident(s)", len(ongoing), len(recovered)),
+		Blocks: []slack.Block{slack.Header("GrooveKit incidents")},
+	}
+	if len(rows) == 0 {
+		msg.Text = "GrooveKit: no incidents"
+		msg.Blocks = append(msg.Blocks, slack.Section(":white_check_mark: No incidents"))
+		return msg
+	}
+	if len(ongoing) > 0 {
+		msg.Blocks = append(msg.Blocks, slack.List(":rotating_light: Ongoing", ongoing)...)
+	}
+	if len(recovered) > 0 {
+		msg.Blocks = append(msg.Blocks, slack.List(":large_green_circle: Recovered", recovered)...)
+	}
+	return msg
+}
+
+// expiringSlackMessage renders the certs and domains expiring within the
+// window as a Slack message
+func expiringSlackMessage(items []expiringResource, within int) slack.Message {
+	window := countNoun(within, "day", "days")
+	var lines []string
+	for _, item := range items {
+		if !item.InWindow {
+			continue
+		}
+		icon := ":large_yellow_circle:"
+		if item.Level == expiryCritical {
+			icon = ":red_circle:"
+		}
+		expires := item.ExpiresAt
+		if len(expires) >= 10 {
+			expires = expires[:10]
+		}
+		lines = append(lines, fmt.Sprintf("%s %s on %s (%s)", icon, slackResource(item.Type, item.ID, item.Name),
+			expires, countNoun(item.DaysLeft, "day left", "days left")))
+	}
+
+	msg := slack.Message{Blocks: []slack.Block{slack.Header("GrooveKit expirations")}}
+	if len(lines) == 0 {
+		msg.Text = "GrooveKit: nothing expires within " + window
+		msg.Blocks = append(msg.Blocks, slack.Section(":white_check_mark: Nothing expires within "+window))
+		return msg
+	}
+	msg.Text = fmt.Sprintf("GrooveKit: %s expiring within %s", countNoun(len(lines), "certificate or domain", "certificates and domains"), window)
+	msg.Blocks = append(msg.Blocks, slack.List("Expiring within "+window, lines)...)
+	msg.Blocks = append(msg.Blocks, slack.Context(fmt.Sprintf("%d monitored in total", len(items))))
+	return msg
+}
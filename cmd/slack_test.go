@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/slack"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReportFormatCmd returns a command with the summary --format flags set
+func newReportFormatCmd(t *testing.T, flags map[string]string) *cobra.Command {
+	c := &cobra.Command{}
+	addReportFormatFlags(c)
+	c.Flags().Bool("json", false, "")
+	for name, value := range flags {
+		require.NoError(t, c.Flags().Set(name, value))
+	}
+	return c
+}
+
+// TestGetFormat tests validating --format and --post-to
+func TestGetFormat(t *testing.T) {
+	allowed := []string{formatText, formatGitHub, formatSlack}
+
+	format, err := getFormat(newReportFormatCmd(t, nil), allowed...)
+	require.NoError(t, err)
+	assert.Equal(t, formatText, format)
+
+	format, err = getFormat(newReportFormatCmd(t, map[string]string{"format": "slack", "post-to": "https://hooks.slack.com/services/T/B/X"}), allowed...)
+	require.NoError(t, err)
+	assert.Equal(t, formatSlack, format)
+
+	for _, flags := range []map[string]string{
+		{"format": "yaml"},
+		{"format": "slack", "json": "true"},
+		{"post-to": "https://hooks.slack.com/services/T/B/X"},
+		{"format": "slack", "post-to": "hooks.slack.com/services/T/B/X"},
+	} {
+		_, err = getFormat(newReportFormatCmd(t, flags), allowed...)
+		assert.Equal(t, exitUsage, exitCode(err), "%v", flags)
+	}
+}
+
+// TestStatusSlackMessage tests rendering a status summary for Slack
+func TestStatusSlackMessage(t *testing.T) {
+	summary := &statusSummary{
+		Counts:           map[string]int{"jobs": 2},
+		Down:             []statusItem{{Type: "job", ID: "11111111-aaaa", Name: "Backup <nightly>", Detail: "missed heartbeat"}},
+		Expiring:         []statusItem{{Type: "cert", ID: "44444444-dddd", Name: "x.io", Detail: "5 days left", Critical: true}},
+		OngoingIncidents: []statusIncident{{Type: "job", ID: "11111111-aaaa", Name: "Backup", StartedAt: "2026-10-15T08:00:00Z"}},
+	}
+
+	msg := statusSlackMessage(summary)
+	assert.Equal(t, "GrooveKit: 1 down, 1 expiring, 1 ongoing incident(s)", msg.Text)
+	require.Len(t, msg.Blocks, 5)
+	assert.Equal(t, "header", msg.Blocks[0].Type)
+	assert.Contains(t, msg.Blocks[1].Elements[0].Text, "jobs: 2")
+	assert.Equal(t, "*:red_circle: Down*\n• *Backup &lt;nightly&gt;* (`11111111`) job: missed heartbeat", msg.Blocks[2].Text.Text)
+	assert.Contains(t, msg.Blocks[3].Text.Text, ":bangbang:")
+
+	msg = statusSlackMessage(&statusSummary{Counts: map[string]int{}, Healthy: true})
+	assert.Equal(t, "GrooveKit: all systems operational", msg.Text)
+	assert.Equal(t, ":white_check_mark: All systems operational", msg.Blocks[len(msg.Blocks)-1].Text.Text)
+}
+
+// TestIncidentsSlackMessage tests listing ongoing incidents before recovered
+// ones
+func TestIncidentsSlackMessage(t *testing.T) {
+	ended := "2026-10-15T09:00:00Z"
+	rows := []incidentRow{
+		{ResourceType: "api", ResourceID: "22222222-bbbb", ResourceName: "Checkout", Incident: api.Incident{StartedAt: "2026-10-15T08:00:00Z", EndedAt: &ended, Duration: 3600}},
+		{ResourceType: "job", ResourceID: "11111111-aaaa", ResourceName: "Backup", Incident: api.Incident{StartedAt: "2026-10-15T10:00:00Z"}},
+	}
+
+	useTimezone(t, "Europe/London")
+	msg := incidentsSlackMessage(rows)
+	assert.Equal(t, "GrooveKit: 1 ongoing, 1 recovered incident(s)", msg.Text)
+	require.Len(t, msg.Blocks, 3)
+	assert.True(t, strings.HasPrefix(msg.Blocks[1].Text.Text, "*:rotating_light: Ongoing*\n• *Backup*"))
+	assert.True(t, strings.HasSuffix(msg.Blocks[1].Text.Text, "since 2026-10-15 11:00:00 BST"))
+	assert.True(t, strings.HasPrefix(msg.Blocks[2].Text.Text, "*:large_green_circle: Recovered*\n• *Checkout*"))
+	assert.Contains(t, msg.Blocks[2].Text.Text, "2026-10-15 09:00:00 BST to 2026-10-15 10:00:00 BST (1.0h)")
+
+	msg = incidentsSlackMessage(nil)
+	assert.Equal(t, "GrooveKit: no incidents", msg.Text)
+}
+
+// TestExpiringSlackMessage tests listing only what expires within the window
+func TestExpiringSlackMessage(t *testing.T) {
+	items := []expiringResource{
+		newExpiringResource("cert", "c1234567-aaaa", "Checkout", "api.example.com", "2026-10-20T12:00:00Z", monitorExpiry{daysLeft: 5, warning: 30, urgent: 14, critical: 7}, 30),
+		newExpiringResource("domain", "d1234567-bbbb", "Example", "example.com", "2027-05-03", monitorExpiry{daysLeft: 200, warning: 30, urgent: 14, critical: 7}, 30),
+	}
+
+	msg := expiringSlackMessage(items, 30)
+	assert.Equal(t, "GrooveKit: 1 certificate or domain expiring within 30 days", msg.Text)
+	require.Len(t, msg.Blocks, 3)
+	assert.Equal(t, "*Expiring within 30 days*\n:red_circle: *Checkout* (`c1234567`) cert on 2026-10-20 (5 days left)", msg.Blocks[1].Text.Text)
+
+	msg = expiringSlackMessage(items[1:], 30)
+	assert.Equal(t, "GrooveKit: nothing expires within 30 days", msg.Text)
+}
+
+// TestEmitSlack tests printing a message, or posting it with --post-to
+func TestEmitSlack(t *testing.T) {
+	msg := slack.Message{Text: "hi", Blocks: []slack.Block{slack.Section("hi")}}
+
+	c := newReportFormatCmd(t, map[string]string{"format": "slack"})
+	var buf bytes.Buffer
+	c.SetOut(&buf)
+	require.NoError(t, emitSlack(c, msg))
+	var printed slack.Message
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &printed))
+	assert.Equal(t, msg, printed)
+
+	var posted []byte
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		posted, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	t.Setenv("NO_COLOR", "1")
+	c = newReportFormatCmd(t, map[string]string{"format": "slack", "post-to": server.URL})
+	c.SetContext(context.Background())
+	buf.Reset()
+	c.SetOut(&buf)
+	require.NoError(t, emitSlack(c, msg))
+	assert.JSONEq(t, `{"text":"hi","blocks":[{"type":"section","text":{"type":"mrkdwn","text":"hi"}}]}`, string(posted))
+	assert.Contains(t, buf.String(), "Posted to Slack")
+}
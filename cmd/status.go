@@ -0,0 +1,426 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// statusKinds are the resource types included in the overview, in display order
+var statusKinds = []string{"jobs", "apis", "certs", "domains", "dns"}
+
+// statusSummary is the aggregated health of every resource in the account
+type statusSummary struct {
+	Counts           map[string]int    `json:"counts"`
+	Down             []statusItem      `json:"down"`
+	Expiring         []statusItem      `json:"expiring"`
+	OngoingIncidents []statusIncident  `json:"ongoing_incidents"`
+	Errors           map[string]string `json:"errors,omitempty"`
+	Healthy          bool              `json:"healthy"`
+}
+
+// statusItem is a single resource that needs attention
+type statusItem struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Detail   string `json:"detail"`
+	Critical bool   `json:"critical"`
+}
+
+// statusIncident is an ongoing incident for a resource
+type statusIncident struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	StartedAt string `json:"started_at"`
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show a health overview of all monitors",
+	Long: `Show a single health overview across jobs, API monitors, SSL certificates,
+domains, and DNS monitors: counts by type, anything currently down,
+certificates and domains expiring soon, and ongoing incidents.
+
+Exits with status 6 when anything is down, has an ongoing incident, or is
+inside its critical expiry threshold, so it can gate deploy scripts. Use
+--fail-level warning to also fail on expiry warnings, or none to only fail
+on errors. Exits with status 1 when some resources could not be fetched.
+
+With --format github, everything that needs attention is printed as GitHub
+Actions workflow annotations, as errors when it fails the command and
+warnings otherwise. With --format slack, the overview is printed as Slack
+Block Kit JSON, or posted to an incoming webhook with --post-to for a daily
+health digest from cron.
+
+Examples:
+  groovekit status
+  groovekit status --fail-level warning
+  groovekit status --format github
+  groovekit status --format slack --post-to https://hooks.slack.com/services/T000/B000/XXXX`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		format, err := getFormat(cmd, formatText, formatGitHub, formatSlack)
+		if err != nil {
+			return err
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput && format == formatText {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		summary := buildStatusSummary(cmd.Context(), client, nil)
+
+		if s != nil {
+			s.Stop()
+		}
+
+		return reportStatus(cmd, summary, format)
+	},
+}
+
+// reportStatus prints a status summary as JSON, annotations, a Slack
+// message, or text, and returns the exit status it calls for
+func reportStatus(cmd *cobra.Command, summary *statusSummary, format string) error {
+	out := cmd.OutOrStdout()
+
+	// Nothing could be fetched at all, so there is no overview to show
+	if len(summary.Errors) == len(statusKinds) {
+		return errors.New(summary.Errors[statusKinds[0]])
+	}
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	switch {
+	case jsonOutput:
+		if err := cmdutil.OutputJSON(out, summary); err != nil {
+			return err
+		}
+	case format == formatGitHub:
+		printStatusAnnotations(cmd, summary)
+	case format == formatSlack:
+		if err := emitSlack(cmd, statusSlackMessage(summary)); err != nil {
+			return err
+		}
+	default:
+		if err := printStatusSummary(cmd, summary); err != nil {
+			return err
+		}
+	}
+
+	if len(summary.Errors) > 0 {
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	}
+	if !summary.Healthy && failOn(cmd, failLevelDown) {
+		return resourceDown(cmd)
+	}
+	if len(summary.Expiring) > 0 && failOn(cmd, failLevelWarning) {
+		return resourceDown(cmd)
+	}
+	return nil
+}
+
+// accountResources holds every resource in the account. A nil field means
+// that kind could not be fetched.
+type accountResources struct {
+	jobs    *api.JobsResponse
+	apis    *api.ApisResponse
+	certs   *api.SslMonitorsResponse
+	domains *api.DomainMonitorsResponse
+	dns     *api.DnsMonitorsResponse
+}
+
+// fetchAccountResources lists every resource type concurrently, narrowed by
+// opts (which may be nil). Errors are keyed by kind (see statusKinds).
+func fetchAccountResources(ctx context.Context, client api.APIClient, opts *api.ListOptions) (*accountResources, map[string]error) {
+	res := &accountResources{}
+	fetches := []struct {
+		kind  string
+		fetch func() error
+	}{
+		{"jobs", func() (err error) { res.jobs, err = client.ListAllJobs(opts); return err }},
+		{"apis", func() (err error) { res.apis, err = client.ListAllApis(opts); return err }},
+		{"certs", func() (err error) { res.certs, err = client.ListAllCerts(opts); return err }},
+		{"domains", func() (err error) { res.domains, err = client.ListAllDomains(opts); return err }},
+		{"dns", func() (err error) { res.dns, err = client.ListAllDnsMonitors(opts); return err }},
+	}
+
+	errs := map[string]error{}
+	fetchErrs := api.Each(ctx, len(fetches), api.DefaultConcurrency, func(_ context.Context, i int) error {
+		return fetches[i].fetch()
+	})
+	for i, err := range fetchErrs {
+		if err != nil {
+			errs[fetches[i].kind] = fmt.Errorf("failed to list %s: %w", fetches[i].kind, err)
+		}
+	}
+	if opts != nil && opts.Project != "" {
+		res.keepProject(opts.Project)
+	}
+	return res, errs
+}
+
+// keepProject drops resources outside a project, in case the API ignored
+// the project filter
+func (res *accountResources) keepProject(projectID string) {
+	if res.jobs != nil {
+		res.jobs.Jobs = filterItems(res.jobs.Jobs, func(job api.Job) bool { return job.ProjectID == projectID })
+	}
+	if res.apis != nil {
+		res.apis.APIMonitors = filterItems(res.apis.APIMonitors, func(monitor api.ApiMonitor) bool { return monitor.ProjectID == projectID })
+	}
+	if res.certs != nil {
+		res.certs.SslMonitors = filterItems(res.certs.SslMonitors, func(cert api.SslMonitor) bool { return cert.ProjectID == projectID })
+	}
+	if res.domains != nil {
+		res.domains.DomainMonitors = filterItems(res.domains.DomainMonitors, func(domain api.DomainMonitor) bool { return domain.ProjectID == projectID })
+	}
+	if res.dns != nil {
+		res.dns.DnsMonitors = filterItems(res.dns.DnsMonitors, func(dns api.DnsMonitor) bool { return dns.ProjectID == projectID })
+	}
+}
+
+// buildStatusSummary fetches every resource type concurrently, narrowed by
+// opts (which may be nil), and aggregates health
+func buildStatusSummary(ctx context.Context, client api.APIClient, opts *api.ListOptions) *statusSummary {
+	summary := &statusSummary{
+		Counts: map[string]int{},
+		Errors: map[string]string{},
+	}
+
+	res, errs := fetchAccountResources(ctx, client, opts)
+	for kind, err := range errs {
+		summary.Errors[kind] = err.Error()
+	}
+	jobs, apis, certs, domains, dnsMons := res.jobs, res.apis, res.certs, res.domains, res.dns
+
+	// incidentSources are down resources whose ongoing incidents are fetched below
+	type incidentSource struct {
+		item  statusItem
+		fetch func(string) ([]api.Incident, error)
+	}
+	var sources []incidentSource
+
+	if jobs != nil {
+		summary.Counts["jobs"] = len(jobs.Jobs)
+		for _, job := range jobs.Jobs {
+			if job.Down {
+				item := statusItem{Type: "job", ID: job.ID, Name: job.Name, Detail: "missed heartbeat", Critical: true}
+				summary.Down = append(summary.Down, item)
+				sources = append(sources, incidentSource{item, client.ListJobIncidents})
+			}
+		}
+	}
+	if apis != nil {
+		summary.Counts["apis"] = len(apis.APIMonitors)
+		for _, monitor := range apis.APIMonitors {
+			if monitor.Down {
+				item := statusItem{Type: "api", ID: monitor.ID, Name: monitor.Name, Detail: monitor.URL, Critical: true}
+				summary.Down = append(summary.Down, item)
+				sources = append(sources, incidentSource{item, client.ListApiIncidents})
+			}
+		}
+	}
+	if dnsMons != nil {
+		summary.Counts["dns"] = len(dnsMons.DnsMonitors)
+		for _, dns := range dnsMons.DnsMonitors {
+			if dns.HasMismatch {
+				item := statusItem{Type: "dns", ID: dns.ID, Name: dns.Name, Detail: dns.RecordType + " mismatch", Critical: true}
+				summary.Down = append(summary.Down, item)
+				sources = append(sources, incidentSource{item, client.ListDnsMonitorIncidents})
+			}
+		}
+	}
+	if certs != nil {
+		summary.Counts["certs"] = len(certs.SslMonitors)
+		for _, cert := range certs.SslMonitors {
+			if item, ok := expiringItem("cert", cert.ID, cert.Name, certExpiry(cert)); ok {
+				summary.Expiring = append(summary.Expiring, item)
+			}
+		}
+	}
+	if domains != nil {
+		summary.Counts["domains"] = len(domains.DomainMonitors)
+		for _, domain := range domains.DomainMonitors {
+			if item, ok := expiringItem("domain", domain.ID, domain.Name, domainExpiry(domain)); ok {
+				summary.Expiring = append(summary.Expiring, item)
+			}
+		}
+	}
+
+	// Fetch incidents for down resources concurrently. A resource whose
+	// incidents can't be fetched is still listed as down.
+	incidents := make([][]statusIncident, len(sources))
+	api.Each(ctx, len(sources), api.DefaultConcurrency, func(_ context.Context, i int) error {
+		src := sources[i]
+		list, err := src.fetch(src.item.ID)
+		if err != nil {
+			return err
+		}
+		for _, incident := range list {
+			if incident.EndedAt == nil {
+				incidents[i] = append(incidents[i], statusIncident{
+					Type:      src.item.Type,
+					ID:        src.item.ID,
+					Name:      src.item.Name,
+					StartedAt: incident.StartedAt,
+				})
+			}
+		}
+		return nil
+	})
+	for _, list := range incidents {
+		summary.OngoingIncidents = append(summary.OngoingIncidents, list...)
+	}
+
+	summary.Healthy = len(summary.Errors) == 0 && len(summary.Down) == 0 && len(summary.OngoingIncidents) == 0
+	for _, item := range summary.Expiring {
+		if item.Critical {
+			summary.Healthy = false
+		}
+	}
+	if len(summary.Errors) == 0 {
+		summary.Errors = nil
+	}
+
+	return summary
+}
+
+// expiringItem reports a checked, active cert or domain inside its warning
+// threshold. Items inside the critical threshold are marked critical.
+func expiringItem(kind, id, name string, expiry monitorExpiry) (statusItem, bool) {
+	if !expiry.expiring() {
+		return statusItem{}, false
+	}
+	return statusItem{
+		Type:     kind,
+		ID:       id,
+		Name:     name,
+		Detail:   fmt.Sprintf("%d days left", expiry.daysLeft),
+		Critical: expiry.level() == expiryCritical,
+	}, true
+}
+
+// printStatusSummary renders the status overview as text
+func printStatusSummary(cmd *cobra.Command, summary *statusSummary) error {
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "%s\n\n", output.Bold("Resources"))
+	for _, kind := range statusKinds {
+		if msg, failed := summary.Errors[kind]; failed {
+			fmt.Fprintf(out, "  %-10s %s\n", kind, output.Red(msg))
+			continue
+		}
+		fmt.Fprintf(out, "  %-10s %d\n", kind, summary.Counts[kind])
+	}
+
+	if len(summary.Down) > 0 {
+		fmt.Fprintf(out, "\n%s\n\n", output.Bold("Down"))
+		table, err := newListTable(cmd, []string{"TYPE", "ID", "NAME", "DETAIL"})
+		if err != nil {
+			return err
+		}
+		for _, item := range summary.Down {
+			table.Append([]string{item.Type, output.Cyan(cmdutil.ShortID(item.ID)), item.Name, output.Red(item.Detail)})
+		}
+		table.Flush()
+	}
+
+	if len(summary.Expiring) > 0 {
+		fmt.Fprintf(out, "\n%s\n\n", output.Bold("Expiring Soon"))
+		table, err := newListTable(cmd, []string{"TYPE", "ID", "NAME", "EXPIRES"})
+		if err != nil {
+			return err
+		}
+		for _, item := range summary.Expiring {
+			detail := output.Yellow(item.Detail)
+			if item.Critical {
+				detail = output.Red(item.Detail)
+			}
+			table.Append([]string{item.Type, output.Cyan(cmdutil.ShortID(item.ID)), item.Name, detail})
+		}
+		table.Flush()
+	}
+
+	if len(summary.OngoingIncidents) > 0 {
+		fmt.Fprintf(out, "\n%s\n\n", output.Bold("Ongoing Incidents"))
+		table, err := newListTable(cmd, []string{"TYPE", "ID", "NAME", "STARTED"})
+		if err != nil {
+			return err
+		}
+		for _, incident := range summary.OngoingIncidents {
+			table.Append([]string{incident.Type, output.Cyan(cmdutil.ShortID(incident.ID)), incident.Name, output.FormatTime(incident.StartedAt)})
+		}
+		table.Flush()
+	}
+
+	fmt.Fprintln(out)
+	if summary.Healthy {
+		output.SuccessMessage(out, "All systems operational")
+	} else {
+		output.ErrorMessage(out, fmt.Sprintf("%d down, %d expiring, %d ongoing incident(s)",
+			len(summary.Down), len(summary.Expiring), len(summary.OngoingIncidents)))
+	}
+	return nil
+}
+
+// printStatusAnnotations reports everything that needs attention as GitHub
+// Actions annotations: errors for whatever fails the command under
+// --fail-level, warnings for the rest
+func printStatusAnnotations(cmd *cobra.Command, summary *statusSummary) {
+	out := cmd.OutOrStdout()
+	level := func(severity string) string {
+		if failOn(cmd, severity) {
+			return annotationError
+		}
+		return annotationWarning
+	}
+
+	for _, kind := range statusKinds {
+		if msg, failed := summary.Errors[kind]; failed {
+			githubAnnotation(out, annotationError, "GrooveKit status incomplete", msg)
+		}
+	}
+	for _, item := range summary.Down {
+		githubAnnotation(out, level(failLevelDown), fmt.Sprintf("GrooveKit %s down", item.Type),
+			fmt.Sprintf("%s (%s) is down: %s", item.Name, cmdutil.ShortID(item.ID), item.Detail))
+	}
+	for _, item := range summary.Expiring {
+		severity := failLevelWarning
+		if item.Critical {
+			severity = failLevelDown
+		}
+		githubAnnotation(out, level(severity), fmt.Sprintf("GrooveKit %s expiring", item.Type),
+			fmt.Sprintf("%s (%s) expires soon: %s", item.Name, cmdutil.ShortID(item.ID), item.Detail))
+	}
+	for _, incident := range summary.OngoingIncidents {
+		githubAnnotation(out, level(failLevelDown), fmt.Sprintf("GrooveKit %s incident", incident.Type),
+			fmt.Sprintf("%s (%s) has had an ongoing incident since %s", incident.Name, cmdutil.ShortID(incident.ID), output.FormatTime(incident.StartedAt)))
+	}
+
+	if summary.Healthy && len(summary.Expiring) == 0 {
+		githubAnnotation(out, annotationNotice, "GrooveKit status", "All systems operational")
+	}
+}
+
+func init() {
+	statusCmd.Flags().Bool("json", false, "Output as JSON")
+	addWideFlag(statusCmd)
+	addReportFormatFlags(statusCmd)
+
+	rootCmd.AddCommand(statusCmd)
+}
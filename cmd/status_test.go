@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStatusCommand tests the basic structure of the status command
+func TestStatusCommand(t *testing.T) {
+	assert.Equal(t, "status", statusCmd.Use)
+	assert.Equal(t, "Show a health overview of all monitors", statusCmd.Short)
+	assert.NotEmpty(t, statusCmd.Long)
+	require.NotNil(t, statusCmd.RunE, "status command should have a RunE function")
+
+	jsonFlag := statusCmd.Flags().Lookup("json")
+	require.NotNil(t, jsonFlag, "status command should have --json flag")
+}
+
+// TestExpiringItem tests classification of certs and domains by threshold
+func TestExpiringItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		daysLeft   int
+		warning    int
+		critical   int
+		expiring   bool
+		isCritical bool
+	}{
+		{"outside warning", 60, 30, 7, false, false},
+		{"inside warning", 20, 30, 7, true, false},
+		{"inside critical", 5, 30, 7, true, true},
+		{"default warning", 25, 0, 7, true, false},
+	}
+	checked := "2026-03-01T00:00:00Z"
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, ok := expiringItem("cert", "abc", "example", monitorExpiry{status: "active", lastCheckAt: checked, daysLeft: tt.daysLeft, warning: tt.warning, critical: tt.critical})
+			assert.Equal(t, tt.expiring, ok)
+			assert.Equal(t, tt.isCritical, item.Critical)
+		})
+	}
+
+	// Unchecked and paused monitors don't count
+	_, ok := expiringItem("cert", "abc", "example", monitorExpiry{status: "active", critical: 7})
+	assert.False(t, ok)
+	_, ok = expiringItem("cert", "abc", "example", monitorExpiry{status: "paused", lastCheckAt: checked, daysLeft: 3, critical: 7})
+	assert.False(t, ok)
+}
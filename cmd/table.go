@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// addTableFlags registers --sort, --columns, and --wide on a list command
+func addTableFlags(c *cobra.Command) {
+	c.Flags().String("sort", "", "Sort rows by a column, prefix with - for descending (e.g. name, days-left, -uptime)")
+	c.Flags().StringSlice("columns", nil, "Comma-separated columns to show, in order (e.g. id,name,status)")
+	addWideFlag(c)
+}
+
+// addWideFlag registers only --wide, for commands printing several tables
+// with different columns, such as the status overview
+func addWideFlag(c *cobra.Command) {
+	c.Flags().Bool("wide", false, "Show every column at full width instead of fitting the table to the terminal")
+}
+
+// newListTable creates a table that honours --sort, --columns, and --wide.
+// Hidden columns are left out unless selected with --columns or shown with
+// --wide, but can be sorted on. Without --wide the table is fitted to the
+// terminal's width by shortening its widest columns.
+func newListTable(cmd *cobra.Command, headers []string, hidden ...string) (*output.Table, error) {
+	sortBy, _ := cmd.Flags().GetString("sort")
+	columns, _ := cmd.Flags().GetStringSlice("columns")
+	for i, col := range columns {
+		columns[i] = output.ColumnKey(col)
+	}
+	wide, _ := cmd.Flags().GetBool("wide")
+
+	table := output.NewTable(cmd.OutOrStdout(), headers)
+	table.Hide(hidden...)
+	opts := output.TableOptions{
+		Sort:    strings.ToLower(sortBy),
+		Columns: columns,
+		Wide:    wide,
+		Width:   output.TerminalWidth(cmd.OutOrStdout()),
+	}
+	if err := table.SetOptions(opts); err != nil {
+		return nil, usageErrorf("%v", err)
+	}
+	return table, nil
+}
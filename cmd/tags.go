@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+)
+
+// addTagFlag registers --tag on create and update commands
+func addTagFlag(c *cobra.Command) {
+	c.Flags().StringArray("tag", nil, "Tag as key=value, e.g. team=payments (repeatable; on update, replaces all tags)")
+}
+
+// getTags reads and validates --tag values. Empty values are dropped, so
+// `--tag ""` clears a resource's tags on update.
+func getTags(cmd *cobra.Command) ([]string, error) {
+	values, _ := cmd.Flags().GetStringArray("tag")
+	return parseTags(values)
+}
+
+// parseTags validates tags, which are either key=value pairs or bare
+// labels. Keys may not contain whitespace or commas, and each key may only
+// appear once.
+func parseTags(values []string) ([]string, error) {
+	tags := []string{}
+	keys := map[string]bool{}
+	for _, value := range values {
+		tag := strings.TrimSpace(value)
+		if tag == "" {
+			continue
+		}
+
+		key, _, _ := strings.Cut(tag, "=")
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("invalid tag %q: missing key", value)
+		}
+		if strings.Contains(key, ",") || strings.IndexFunc(key, unicode.IsSpace) >= 0 {
+			return nil, fmt.Errorf("invalid tag %q: keys cannot contain spaces or commas", value)
+		}
+		if keys[strings.ToLower(key)] {
+			return nil, fmt.Errorf("duplicate tag key %q", key)
+		}
+		keys[strings.ToLower(key)] = true
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// tagMatches reports whether a resource's tag satisfies a filter: "env=prod"
+// matches that exact tag, while a bare "env" matches the label "env" or any
+// env=<value> tag. Comparison ignores case.
+func tagMatches(tag, filter string) bool {
+	if strings.EqualFold(tag, filter) {
+		return true
+	}
+	if strings.Contains(filter, "=") {
+		return false
+	}
+	key, _, ok := strings.Cut(tag, "=")
+	return ok && strings.EqualFold(key, filter)
+}
+
+// hasTags reports whether tags satisfy every filter
+func hasTags(tags, filters []string) bool {
+	for _, filter := range filters {
+		matched := false
+		for _, tag := range tags {
+			if tagMatches(tag, filter) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// formatTags renders tags for show output
+func formatTags(tags []string) string {
+	if len(tags) == 0 {
+		return "none"
+	}
+	return strings.Join(tags, ", ")
+}
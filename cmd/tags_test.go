@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseTags tests validating --tag values
+func TestParseTags(t *testing.T) {
+	tags, err := parseTags([]string{" team=payments ", "env=prod", "critical", ""})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team=payments", "env=prod", "critical"}, tags)
+
+	tags, err = parseTags([]string{""})
+	require.NoError(t, err)
+	assert.NotNil(t, tags, "an empty list clears tags rather than being omitted")
+	assert.Empty(t, tags)
+
+	_, err = parseTags([]string{"=prod"})
+	assert.ErrorContains(t, err, "missing key")
+
+	_, err = parseTags([]string{"my team=payments"})
+	assert.ErrorContains(t, err, "cannot contain spaces or commas")
+
+	_, err = parseTags([]string{"env=prod", "ENV=staging"})
+	assert.ErrorContains(t, err, "duplicate tag key")
+}
+
+// TestTagMatches tests matching tags against list filters
+func TestTagMatches(t *testing.T) {
+	assert.True(t, tagMatches("env=prod", "env=prod"))
+	assert.True(t, tagMatches("env=prod", "ENV=Prod"))
+	assert.True(t, tagMatches("env=prod", "env"))
+	assert.True(t, tagMatches("critical", "critical"))
+	assert.False(t, tagMatches("env=prod", "env=staging"))
+	assert.False(t, tagMatches("environment=prod", "env"))
+	assert.False(t, tagMatches("critical", "critical=yes"))
+
+	assert.True(t, hasTags([]string{"env=prod", "team=payments"}, []string{"env", "team=payments"}))
+	assert.False(t, hasTags([]string{"env=prod"}, []string{"env", "team"}))
+	assert.True(t, hasTags(nil, nil))
+}
+
+// TestFormatTags tests rendering tags for show output
+func TestFormatTags(t *testing.T) {
+	assert.Equal(t, "none", formatTags(nil))
+	assert.Equal(t, "env=prod, critical", formatTags([]string{"env=prod", "critical"}))
+}
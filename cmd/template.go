@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// templateFuncs are the functions available to --template, beyond Go's
+// built-ins such as printf, index, len, and eq
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"join":     func(sep string, items []string) string { return strings.Join(items, sep) },
+	"truncate": func(n int, s string) string { return cmdutil.Truncate(s, max(n, 3)) },
+	"shortid":  cmdutil.ShortID,
+}
+
+// templateWriter renders the values a command outputs as JSON through a
+// Go template instead. For list commands the template is applied to each
+// item in turn; otherwise to the whole value. Each rendering ends with a
+// newline, as with docker's --format.
+type templateWriter struct {
+	io.Writer
+	tmpl *template.Template
+	list bool
+}
+
+// WriteValue renders v through the template
+func (w *templateWriter) WriteValue(v interface{}) error {
+	values := []interface{}{v}
+	if w.list {
+		values = templateItems(v)
+	}
+	for _, value := range values {
+		var buf strings.Builder
+		if err := w.tmpl.Execute(&buf, value); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+		if _, err := fmt.Fprintln(w.Writer, buf.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// to returns a copy of w that writes to out
+func (w *templateWriter) to(out io.Writer) io.Writer {
+	copied := *w
+	copied.Writer = out
+	return &copied
+}
+
+// templateItems returns the items in a list command's output: the elements
+// of a slice, or of the first slice field of a response such as
+// api.ApisResponse
+func templateItems(v interface{}) []interface{} {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Struct {
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).IsExported() && rv.Field(i).Kind() == reflect.Slice {
+				rv = rv.Field(i)
+				break
+			}
+		}
+	}
+	if rv.Kind() != reflect.Slice {
+		return []interface{}{v}
+	}
+	items := make([]interface{}, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items
+}
+
+// addTemplateFlag registers --template on a command that has --json and
+// wraps its RunE so its JSON output is rendered through the template.
+// Templates see the API's structs, so fields use Go names such as .Name
+// and .LastCheckAt. Must be called after RunE is set.
+func addTemplateFlag(c *cobra.Command, list bool) {
+	usage := "Format output with a Go template, e.g. '{{.Name}} {{.Status}}'"
+	if list {
+		usage += ", applied to each item"
+	}
+	c.Flags().String("template", "", usage)
+
+	run := c.RunE
+	c.RunE = func(cmd *cobra.Command, args []string) error {
+		text, _ := cmd.Flags().GetString("template")
+		if text == "" {
+			return run(cmd, args)
+		}
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return usageErrorf("--template and --json can't be used together")
+		}
+		tmpl, err := template.New("template").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return usageErrorf("invalid --template: %w", err)
+		}
+		_ = cmd.Flags().Set("json", "true")
+
+		defer redirectOutput(cmd, func(out io.Writer) io.Writer {
+			return &templateWriter{Writer: out, tmpl: tmpl, list: list}
+		})()
+		return run(cmd, args)
+	}
+}
+
+func init() {
+	for _, c := range []*cobra.Command{
+		jobsListCmd, jobsIncidentsCmd, jobsPingsCmd,
+		apisListCmd, apisIncidentsCmd, apisChecksCmd,
+		certsListCmd, certsIncidentsCmd,
+		domainsListCmd, domainsIncidentsCmd,
+		dnsListCmd, dnsIncidentsCmd,
+		incidentsListCmd, alertsListCmd, projectsListCmd, regionsListCmd,
+	} {
+		addTemplateFlag(c, true)
+	}
+	for _, c := range []*cobra.Command{
+		jobsShowCmd, apisShowCmd, certsShowCmd, domainsShowCmd, dnsShowCmd,
+		incidentsShowCmd, accountShowCmd, accountNotificationsShowCmd, jobsWebhookShowCmd,
+		configListCmd,
+	} {
+		addTemplateFlag(c, false)
+	}
+}
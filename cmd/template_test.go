@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTemplateItems tests finding the items in list commands' output
+func TestTemplateItems(t *testing.T) {
+	monitors := []api.ApiMonitor{{Name: "a"}, {Name: "b"}}
+	assert.Equal(t, []interface{}{monitors[0], monitors[1]}, templateItems(&api.ApisResponse{APIMonitors: monitors, TotalCount: 2}))
+	assert.Equal(t, []interface{}{monitors[0], monitors[1]}, templateItems(monitors))
+	assert.Empty(t, templateItems(api.ApisResponse{}))
+	assert.Equal(t, []interface{}{"plain"}, templateItems("plain"))
+}
+
+// TestTemplateFlag tests rendering list and show output through --template
+func TestTemplateFlag(t *testing.T) {
+	srv := startAPI(t)
+	id := seedApis(srv)
+	srv.Fake.Apis = append(srv.Fake.Apis, api.ApiMonitor{ID: srv.Fake.NewID(), Name: "Search", URL: "https://shop.example.com/search", HTTPMethod: "GET", Status: "paused", Interval: 10})
+
+	out := mustRun(t, "apis", "list", "--template", "{{.Name}} {{.Status}}")
+	assert.Equal(t, "Checkout active\nSearch paused\n", out)
+
+	out = mustRun(t, "apis", "show", id[:8], "--template", `{{shortid .ID}} {{upper .HTTPMethod}} {{.URL}} {{json .ExpectedStatusCodes}}`)
+	assert.Equal(t, id[:8]+" GET https://shop.example.com/health [200]\n", out)
+
+	path := filepath.Join(t.TempDir(), "monitors.txt")
+	mustRun(t, "apis", "list", "--template", "{{.URL}}", "--output-file", path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://shop.example.com/health\nhttps://shop.example.com/search\n", string(data))
+
+	// Flags from earlier runs don't leak into later ones
+	out = mustRun(t, "apis", "list", "--status", "paused")
+	assert.Contains(t, out, "Search")
+	assert.NotContains(t, out, "Checkout")
+}
+
+// TestTemplateFlag_Errors tests invalid and conflicting templates
+func TestTemplateFlag_Errors(t *testing.T) {
+	srv := startAPI(t)
+	seedApis(srv)
+
+	_, _, err := runCommand(t, "apis", "list", "--template", "{{.Name")
+	assert.Equal(t, exitUsage, exitCode(err))
+	assert.ErrorContains(t, err, "invalid --template")
+
+	_, _, err = runCommand(t, "apis", "list", "--template", "{{.Name}}", "--json")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, _, err = runCommand(t, "apis", "list", "--template", "{{.Nmae}}")
+	assert.ErrorContains(t, err, "failed to render template")
+	assert.ErrorContains(t, err, "can't evaluate field Nmae")
+}
+
+// TestTemplateFlag_HasJSON tests that every command with --template can
+// output JSON, which the template renders
+func TestTemplateFlag_HasJSON(t *testing.T) {
+	var check func(c *cobra.Command)
+	check = func(c *cobra.Command) {
+		if c.Flags().Lookup("template") != nil {
+			assert.NotNil(t, c.Flags().Lookup("json"), c.CommandPath())
+		}
+		for _, sub := range c.Commands() {
+			check(sub)
+		}
+	}
+	check(rootCmd)
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package cmd
+
+import "os"
+
+// umask returns no mask where the platform has none
+func umask() os.FileMode {
+	return 0
+}
@@ -0,0 +1,16 @@
+//go:build unix
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+)
+
+// umask returns the process's file mode creation mask. Reading it means
+// setting it, so it is set straight back.
+func umask() os.FileMode {
+	mask := syscall.Umask(0)
+	syscall.Umask(mask)
+	return os.FileMode(mask)
+}
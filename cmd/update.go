@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/update"
+	"github.com/spf13/cobra"
+)
+
+// Version check timing
+const (
+	// updateCheckInterval is how long the result of a check is reused
+	updateCheckInterval = 24 * time.Hour
+	updateCheckTimeout  = 5 * time.Second
+	// updateCheckWait is how long a command waits on exit for a check
+	// that is still running
+	updateCheckWait = time.Second
+)
+
+var (
+	// updateHintEnabled is set when the command that ran may print a hint
+	// about a new version
+	updateHintEnabled bool
+	// pendingUpdateCheck receives the latest version from a check started
+	// in the background, or "" if it failed
+	pendingUpdateCheck chan string
+)
+
+// startUpdateCheck looks up the latest release in the background when the
+// update channel is set and the last check is more than a day old
+func startUpdateCheck(cmd *cobra.Command) {
+	if !updateCheckAllowed(cmd) || config.UpdateChannel() != config.UpdateChannelStable {
+		return
+	}
+	updateHintEnabled = true
+	if !updateCheckDue(config.LastUpdateCheck(), time.Now()) {
+		return
+	}
+
+	pending := make(chan string, 1)
+	pendingUpdateCheck = pending
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), updateCheckTimeout)
+		defer cancel()
+		latest, err := update.Latest(ctx, http.DefaultClient, update.LatestReleaseURL)
+		check := config.UpdateCheck{CheckedAt: time.Now(), Latest: latest}
+		if err != nil {
+			// Keep the last known version and try again tomorrow
+			if last := config.LastUpdateCheck(); last != nil {
+				check.Latest = last.Latest
+			}
+		}
+		_ = config.RecordUpdateCheck(check)
+		pending <- latest
+	}()
+}
+
+// updateCheckAllowed reports whether a hint may follow the command: not in
+// CI, not for scripts reading stderr, and not for shell completion
+func updateCheckAllowed(cmd *cobra.Command) bool {
+	if Version == "dev" || os.Getenv("CI") != "" || os.Getenv("GROOVEKIT_NO_UPDATE_CHECK") != "" {
+		return false
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		switch c.Name() {
+		case cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd, "completion":
+			return false
+		}
+	}
+	return isTerminal(cmd.ErrOrStderr())
+}
+
+// updateCheckDue reports whether the last version check is stale
+func updateCheckDue(last *config.UpdateCheck, now time.Time) bool {
+	return last == nil || now.Sub(last.CheckedAt) >= updateCheckInterval
+}
+
+// printUpdateHint prints a one-line hint when a newer version is known,
+// waiting briefly for a background check that hasn't finished
+func printUpdateHint(w io.Writer) {
+	if !updateHintEnabled || quiet {
+		return
+	}
+	latest := ""
+	if pendingUpdateCheck != nil {
+		select {
+		case latest = <-pendingUpdateCheck:
+		case <-time.After(updateCheckWait):
+		}
+	}
+	if latest == "" {
+		if last := config.LastUpdateCheck(); last != nil {
+			latest = last.Latest
+		}
+	}
+	if hint := updateHint(Version, latest); hint != "" {
+		fmt.Fprintln(w, output.Yellow(hint))
+	}
+}
+
+// updateHint returns the new version hint, or "" when current is the
+// latest version
+func updateHint(current, latest string) string {
+	if latest == "" || compareVersions(latest, current) <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("A new version of groovekit is available: %s → %s (%s)", current, latest, update.ReleasesURL)
+}
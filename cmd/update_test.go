@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUpdateCheckDue tests checking for a new version at most once a day
+func TestUpdateCheckDue(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, updateCheckDue(nil, now))
+	assert.False(t, updateCheckDue(&config.UpdateCheck{CheckedAt: now.Add(-time.Hour)}, now))
+	assert.True(t, updateCheckDue(&config.UpdateCheck{CheckedAt: now.Add(-25 * time.Hour)}, now))
+}
+
+// TestUpdateHint tests the hint is only shown for newer versions
+func TestUpdateHint(t *testing.T) {
+	hint := updateHint("1.7.2", "v1.8.0")
+	assert.Contains(t, hint, "1.7.2 → v1.8.0")
+	assert.Contains(t, hint, "releases/latest")
+
+	assert.Empty(t, updateHint("1.8.0", "v1.8.0"))
+	assert.Empty(t, updateHint("1.9.0", "v1.8.0"))
+	assert.Empty(t, updateHint("1.8.0", ""))
+}
+
+// TestUpdateCheckAllowed tests the check is skipped in CI and completion
+func TestUpdateCheckAllowed(t *testing.T) {
+	orig := Version
+	Version = "1.7.2"
+	t.Cleanup(func() { Version = orig })
+
+	t.Setenv("CI", "true")
+	assert.False(t, updateCheckAllowed(statusCmd))
+
+	t.Setenv("CI", "")
+	// Test output isn't a terminal
+	assert.False(t, updateCheckAllowed(statusCmd))
+}
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/chart"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+)
+
+// uptimePeriods are the accepted --period values, in days
+var uptimePeriods = map[string]int{"7d": 7, "30d": 30, "90d": 90}
+
+// uptimeReport summarises an API monitor's availability over a period
+type uptimeReport struct {
+	MonitorID      string      `json:"monitor_id"`
+	Name           string      `json:"name"`
+	Period         string      `json:"period"`
+	From           time.Time   `json:"from"`
+	To             time.Time   `json:"to"`
+	Checks         int         `json:"checks"`
+	Failed         int         `json:"failed"`
+	UptimePercent  *float64    `json:"uptime_percent"`
+	MeanResponseMs *float64    `json:"mean_response_ms"`
+	P95ResponseMs  *float64    `json:"p95_response_ms"`
+	Incidents      int         `json:"incidents"`
+	Days           []uptimeDay `json:"days"`
+}
+
+// uptimeDay is one calendar day of an uptime report
+type uptimeDay struct {
+	Date          string   `json:"date"`
+	Checks        int      `json:"checks"`
+	Failed        int      `json:"failed"`
+	UptimePercent *float64 `json:"uptime_percent"`
+}
+
+// parseUptimePeriod validates --period and returns its length in days
+func parseUptimePeriod(period string) (int, error) {
+	days, ok := uptimePeriods[period]
+	if !ok {
+		return 0, usageErrorf("invalid --period %q: must be 7d, 30d, or 90d", period)
+	}
+	return days, nil
+}
+
+// fetchUptimeHistory fetches an API monitor with its checks since the
+// given time and its incidents, concurrently
+func fetchUptimeHistory(ctx context.Context, client api.APIClient, id string, since time.Time) (*api.ApiMonitor, []api.Check, []api.Incident, error) {
+	var (
+		monitor   *api.ApiMonitor
+		checks    []api.Check
+		incidents []api.Incident
+	)
+	fetches := []func() error{
+		func() (err error) {
+			if monitor, err = client.GetApi(id); err != nil {
+				return fmt.Errorf("failed to get API monitor: %w", err)
+			}
+			return nil
+		},
+		func() (err error) {
+			if checks, err = client.ListAllApiChecks(id, &api.ListOptions{Since: since}, 0); err != nil {
+				return fmt.Errorf("failed to list checks: %w", err)
+			}
+			return nil
+		},
+		func() (err error) {
+			if incidents, err = client.ListApiIncidents(id); err != nil {
+				return fmt.Errorf("failed to get incidents: %w", err)
+			}
+			return nil
+		},
+	}
+	err := api.EachUntilError(ctx, len(fetches), len(fetches), func(_ context.Context, i int) error {
+		return fetches[i]()
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return monitor, checks, incidents, nil
+}
+
+// uptimeStart is midnight at the start of a period of days ending today
+func uptimeStart(days int, now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, now.Location()).AddDate(0, 0, -(days - 1))
+}
+
+// buildUptimeReport summarises checks and incidents over the last days
+// calendar days, ending with today in now's location. Checks outside the
+// period are ignored, and incidents count when they overlap it.
+func buildUptimeReport(monitor *api.ApiMonitor, period string, days int, checks []api.Check, incidents []api.Incident, now time.Time) uptimeReport {
+	from := uptimeStart(days, now)
+
+	report := uptimeReport{
+		MonitorID: monitor.ID,
+		Name:      monitor.Name,
+		Period:    period,
+		From:      from,
+		To:        now,
+		Days:      make([]uptimeDay, days),
+	}
+
+	index := map[string]int{}
+	for i := range report.Days {
+		date := from.AddDate(0, 0, i).Format("2006-01-02")
+		report.Days[i].Date = date
+		index[date] = i
+	}
+
+	var entries []historyEntry
+	for _, check := range checks {
+		created, err := time.Parse(time.RFC3339, check.CreatedAt)
+		if err != nil || created.Before(from) || created.After(now) {
+			continue
+		}
+		i, ok := index[created.In(now.Location()).Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		report.Days[i].Checks++
+		if !check.Success {
+			report.Days[i].Failed++
+		}
+		entries = append(entries, monitorCheckView.entry(check))
+	}
+
+	stats := computeHistoryStats(entries)
+	report.Checks, report.Failed = stats.total, stats.failed
+	report.UptimePercent = stats.successRate
+	report.MeanResponseMs, report.P95ResponseMs = stats.avgMs, stats.p95Ms
+
+	for i := range report.Days {
+		d := &report.Days[i]
+		if d.Checks > 0 {
+			uptime := float64(d.Checks-d.Failed) / float64(d.Checks) * 100
+			d.UptimePercent = &uptime
+		}
+	}
+
+	for _, incident := range incidents {
+		started, err := time.Parse(time.RFC3339, incident.StartedAt)
+		if err != nil || started.After(now) {
+			continue
+		}
+		if incident.EndedAt != nil {
+			ended, err := time.Parse(time.RFC3339, *incident.EndedAt)
+			if err == nil && ended.Before(from) {
+				continue
+			}
+		}
+		report.Incidents++
+	}
+	return report
+}
+
+// printUptimeReport renders a report as a summary, a sparkline of daily
+// uptime, and a bar per day
+func printUptimeReport(w io.Writer, report uptimeReport) {
+	name := report.Name
+	if name == "" {
+		name = cmdutil.ShortID(report.MonitorID)
+	}
+	fmt.Fprintf(w, "%s %s (%s), last %s\n\n", output.Bold("Uptime for"), name, output.Cyan(cmdutil.ShortID(report.MonitorID)), report.Period)
+
+	if report.UptimePercent == nil {
+		fmt.Fprintf(w, "Uptime:          no checks\n")
+	} else {
+		fmt.Fprintf(w, "Uptime:          %s (%d of %d checks failed)\n", formatUptime(*report.UptimePercent), report.Failed, report.Checks)
+	}
+	if report.MeanResponseMs != nil {
+		fmt.Fprintf(w, "Response time:   mean %.0fms, p95 %.0fms\n", *report.MeanResponseMs, *report.P95ResponseMs)
+	}
+	fmt.Fprintf(w, "Incidents:       %d\n", report.Incidents)
+	fmt.Fprintf(w, "Daily:           %s\n\n", uptimeSparkline(report.Days))
+
+	for _, day := range report.Days {
+		if day.UptimePercent == nil {
+			fmt.Fprintf(w, "%s  %s  no checks\n", day.Date, uptimeBar(nil))
+			continue
+		}
+		fmt.Fprintf(w, "%s  %s  %s  %s\n", day.Date, uptimeBar(day.UptimePercent),
+			formatUptime(*day.UptimePercent), countNoun(day.Checks, "check", "checks"))
+	}
+}
+
+// uptimeLevels maps uptime to sparkline characters, best first. Uptime sits
+// close to 100%, so the steps are uneven to keep small dips visible.
+var uptimeLevels = []struct {
+	min  float64
+	char string
+}{
+	{100, "█"}, {99.9, "▇"}, {99, "▆"}, {98, "▅"}, {95, "▄"}, {90, "▃"}, {75, "▂"}, {0, "▁"},
+}
+
+// uptimeSparkline renders one character per day, with "·" for days
+// without checks
+func uptimeSparkline(days []uptimeDay) string {
+	var b strings.Builder
+	for _, day := range days {
+		if day.UptimePercent == nil {
+			b.WriteString("·")
+			continue
+		}
+		for _, level := range uptimeLevels {
+			if *day.UptimePercent >= level.min {
+				b.WriteString(uptimeColor(*day.UptimePercent, level.char))
+				break
+			}
+		}
+	}
+	return b.String()
+}
+
+// uptimeBar renders a day's uptime as a bar; nil draws an empty bar
+func uptimeBar(uptime *float64) string {
+	if uptime == nil {
+		return chart.Bar(0, 20, nil)
+	}
+	return chart.Bar(*uptime/100, 20, func(s string) string { return uptimeColor(*uptime, s) })
+}
+
+// uptimeColor colors s green at 99.9% uptime or better, yellow at 99% or
+// better, and red below that
+func uptimeColor(uptime float64, s string) string {
+	switch {
+	case uptime >= 99.9:
+		return output.Green(s)
+	case uptime >= 99:
+		return output.Yellow(s)
+	default:
+		return output.Red(s)
+	}
+}
+
+// formatUptime renders an uptime percentage, keeping two decimals so that
+// 99.95% does not round up to 100%
+func formatUptime(uptime float64) string {
+	if uptime < 100 && uptime >= 99.995 {
+		return "99.99%"
+	}
+	return fmt.Sprintf("%.2f%%", uptime)
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseUptimePeriod tests validating --period
+func TestParseUptimePeriod(t *testing.T) {
+	days, err := parseUptimePeriod("90d")
+	require.NoError(t, err)
+	assert.Equal(t, 90, days)
+
+	_, err = parseUptimePeriod("14d")
+	assert.EqualError(t, err, `invalid --period "14d": must be 7d, 30d, or 90d`)
+}
+
+// TestBuildUptimeReport tests summarising checks and incidents per day
+func TestBuildUptimeReport(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	monitor := &api.ApiMonitor{ID: "mon-1", Name: "Prod API"}
+	ended := "2026-03-09T10:30:00Z"
+	longAgo := "2026-02-01T01:00:00Z"
+
+	checks := []api.Check{
+		{Success: true, ResponseTime: 100, CreatedAt: "2026-03-10T08:00:00Z"},
+		{Success: true, ResponseTime: 200, CreatedAt: "2026-03-10T09:00:00Z"},
+		{Success: true, ResponseTime: 100, CreatedAt: "2026-03-09T08:00:00Z"},
+		{Success: false, ResponseTime: 400, CreatedAt: "2026-03-09T10:00:00Z"},
+		// Outside the period
+		{Success: false, ResponseTime: 900, CreatedAt: "2026-03-01T10:00:00Z"},
+	}
+	incidents := []api.Incident{
+		{StartedAt: "2026-03-09T10:00:00Z", EndedAt: &ended},
+		{StartedAt: "2026-02-01T00:00:00Z", EndedAt: &longAgo},
+	}
+
+	report := buildUptimeReport(monitor, "7d", 7, checks, incidents, now)
+
+	assert.Equal(t, time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), report.From)
+	assert.Equal(t, 4, report.Checks)
+	assert.Equal(t, 1, report.Failed)
+	require.NotNil(t, report.UptimePercent)
+	assert.InDelta(t, 75, *report.UptimePercent, 0.001)
+	require.NotNil(t, report.MeanResponseMs)
+	assert.InDelta(t, 200, *report.MeanResponseMs, 0.001)
+	assert.InDelta(t, 400, *report.P95ResponseMs, 0.001)
+	assert.Equal(t, 1, report.Incidents)
+
+	require.Len(t, report.Days, 7)
+	assert.Equal(t, "2026-03-04", report.Days[0].Date)
+	assert.Nil(t, report.Days[0].UptimePercent)
+	assert.Equal(t, "2026-03-09", report.Days[5].Date)
+	assert.InDelta(t, 50, *report.Days[5].UptimePercent, 0.001)
+	assert.InDelta(t, 100, *report.Days[6].UptimePercent, 0.001)
+}
+
+// TestUptimeSparkline tests mapping daily uptime to sparkline characters
+func TestUptimeSparkline(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	pct := func(v float64) *float64 { return &v }
+	days := []uptimeDay{
+		{UptimePercent: pct(100)},
+		{UptimePercent: pct(99.95)},
+		{},
+		{UptimePercent: pct(96)},
+		{UptimePercent: pct(10)},
+	}
+	assert.Equal(t, "█▇·▄▁", uptimeSparkline(days))
+}
+
+// TestPrintUptimeReport tests the text output
+func TestPrintUptimeReport(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	uptime, mean, p95 := 99.5, 120.0, 300.0
+	report := uptimeReport{
+		MonitorID:      "7f00aaaa-0000",
+		Name:           "Prod API",
+		Period:         "7d",
+		Checks:         200,
+		Failed:         1,
+		UptimePercent:  &uptime,
+		MeanResponseMs: &mean,
+		P95ResponseMs:  &p95,
+		Incidents:      1,
+		Days: []uptimeDay{
+			{Date: "2026-03-09"},
+			{Date: "2026-03-10", Checks: 200, Failed: 1, UptimePercent: &uptime},
+		},
+	}
+
+	var buf bytes.Buffer
+	printUptimeReport(&buf, report)
+	text := buf.String()
+	assert.Contains(t, text, "Uptime:          99.50% (1 of 200 checks failed)")
+	assert.Contains(t, text, "Response time:   mean 120ms, p95 300ms")
+	assert.Contains(t, text, "Incidents:       1")
+	assert.Contains(t, text, "Daily:           ·▆")
+	assert.Contains(t, text, "2026-03-09  [░░░░░░░░░░░░░░░░░░░░]  no checks")
+	assert.Contains(t, text, "2026-03-10  [███████████████████░]  99.50%  200 checks")
+}
+
+// TestFormatUptime tests that near-perfect uptime is not rounded to 100%
+func TestFormatUptime(t *testing.T) {
+	assert.Equal(t, "100.00%", formatUptime(100))
+	assert.Equal(t, "99.99%", formatUptime(99.999))
+	assert.Equal(t, "98.50%", formatUptime(98.5))
+}
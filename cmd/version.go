@@ -18,10 +18,11 @@ var (
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
-	Run: func(_ *cobra.Command, _ []string) {
-		fmt.Printf("groovekit version %s\n", Version)
-		fmt.Printf("commit: %s\n", Commit)
-		fmt.Printf("built: %s\n", Date)
+	Run: func(cmd *cobra.Command, _ []string) {
+		out := cmd.OutOrStdout()
+		fmt.Fprintf(out, "groovekit version %s\n", Version)
+		fmt.Fprintf(out, "commit: %s\n", Commit)
+		fmt.Fprintf(out, "built: %s\n", Date)
 	},
 }
 
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVersionCommandOutput verifies output is written to the command's writer
+func TestVersionCommandOutput(t *testing.T) {
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	defer rootCmd.SetOut(nil)
+
+	rootCmd.SetArgs([]string{"version"})
+	defer rootCmd.SetArgs(nil)
+
+	require.NoError(t, rootCmd.Execute())
+	assert.Contains(t, out.String(), "groovekit version "+Version)
+	assert.Contains(t, out.String(), "commit: "+Commit)
+}
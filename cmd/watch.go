@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval keeps watch from hammering the API
+const minWatchInterval = 10 * time.Second
+
+// Watch events passed to hooks in GROOVEKIT_EVENT
+const (
+	watchEventIncident = "incident"
+	watchEventRecovery = "recovery"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Run local hooks when incidents start or recover",
+	Long: `Poll incident state across every resource and run local commands when an
+incident starts or recovers. Incidents that are already ongoing when watch
+starts don't trigger hooks.
+
+Hooks run through the shell with incident details in the environment:
+
+  GROOVEKIT_EVENT                 incident or recovery
+  GROOVEKIT_RESOURCE_TYPE         job, api, cert, domain, or dns
+  GROOVEKIT_RESOURCE_ID           full resource ID
+  GROOVEKIT_RESOURCE_NAME         resource name
+  GROOVEKIT_INCIDENT_TYPE         incident type reported by the API
+  GROOVEKIT_INCIDENT_STARTED_AT   RFC 3339 start time
+  GROOVEKIT_INCIDENT_ENDED_AT     RFC 3339 end time (recovery only)
+  GROOVEKIT_INCIDENT_DURATION     duration in seconds (recovery only)
+  GROOVEKIT_INCIDENT_ERROR        error message, when there is one
+
+Examples:
+  groovekit watch --exec-on-incident ./page-me.sh --exec-on-recovery ./all-clear.sh
+  groovekit watch --type apis,jobs --interval 30s --exec-on-incident 'notify-send "$GROOVEKIT_RESOURCE_NAME is down"'`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		onIncident, _ := cmd.Flags().GetString("exec-on-incident")
+		onRecovery, _ := cmd.Flags().GetString("exec-on-recovery")
+		interval, _ := cmd.Flags().GetDuration("interval")
+		hookTimeout, _ := cmd.Flags().GetDuration("hook-timeout")
+		typeFlag, _ := cmd.Flags().GetString("type")
+
+		if interval < minWatchInterval {
+			return usageErrorf("--interval must be at least %s", minWatchInterval)
+		}
+		kinds, err := parseIncidentTypes(typeFlag)
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		watcher := newIncidentWatcher()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// The first successful poll is the baseline, so incidents that were
+		// already ongoing don't trigger hooks
+		baselined := false
+		for {
+			rows, err := collectIncidents(ctx, client, kinds)
+			switch {
+			case err != nil:
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s %s\n", watchTimestamp(), output.Yellow(fmt.Sprintf("poll failed: %v", err)))
+			case !baselined:
+				watcher.update(rows)
+				baselined = true
+				fmt.Fprintf(out, "%s Watching for incidents every %s (%d ongoing). Press Ctrl+C to stop.\n", watchTimestamp(), interval, len(watcher.ongoing))
+			default:
+				opened, recovered := watcher.update(rows)
+				for _, row := range opened {
+					fmt.Fprintf(out, "%s %s %s %s (%s)\n", watchTimestamp(), output.Red("INCIDENT"), row.ResourceType, output.Bold(row.ResourceName), cmdutil.ShortID(row.ResourceID))
+					runWatchHook(ctx, cmd, onIncident, hookTimeout, watchEventIncident, row)
+				}
+				for _, row := range recovered {
+					fmt.Fprintf(out, "%s %s %s %s (%s)\n", watchTimestamp(), output.Green("RECOVERED"), row.ResourceType, output.Bold(row.ResourceName), cmdutil.ShortID(row.ResourceID))
+					runWatchHook(ctx, cmd, onRecovery, hookTimeout, watchEventRecovery, row)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+// incidentWatcher tracks ongoing incidents between polls
+type incidentWatcher struct {
+	ongoing map[string]incidentRow
+}
+
+func newIncidentWatcher() *incidentWatcher {
+	return &incidentWatcher{ongoing: map[string]incidentRow{}}
+}
+
+// incidentKey identifies an incident across polls
+func incidentKey(row incidentRow) string {
+	return row.ResourceType + "/" + row.ResourceID + "/" + row.StartedAt
+}
+
+// update records the latest incidents and returns incidents that started
+// and recovered since the previous poll. An ongoing incident that is no
+// longer listed, e.g. because its resource was deleted, counts as recovered.
+func (w *incidentWatcher) update(rows []incidentRow) (opened, recovered []incidentRow) {
+	current := map[string]incidentRow{}
+	ended := map[string]incidentRow{}
+	for _, row := range rows {
+		if row.EndedAt == nil {
+			current[incidentKey(row)] = row
+		} else {
+			ended[incidentKey(row)] = row
+		}
+	}
+
+	for key, row := range current {
+		if _, ok := w.ongoing[key]; !ok {
+			opened = append(opened, row)
+		}
+	}
+	for key, row := range w.ongoing {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		if endedRow, ok := ended[key]; ok {
+			row = endedRow
+		}
+		recovered = append(recovered, row)
+	}
+
+	sortIncidents(opened)
+	sortIncidents(recovered)
+	w.ongoing = current
+	return opened, recovered
+}
+
+// runWatchHook runs a hook command through the shell with incident details in
+// its environment. Failures are reported but don't stop watching.
+func runWatchHook(ctx context.Context, cmd *cobra.Command, hook string, timeout time.Duration, event string, row incidentRow) {
+	if hook == "" {
+		return
+	}
+	errOut := cmd.ErrOrStderr()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	child := exec.CommandContext(ctx, shell, flag, hook)
+	child.Env = append(os.Environ(), watchHookEnv(event, row)...)
+	child.Stdout = cmd.OutOrStdout()
+	child.Stderr = errOut
+
+	if err := child.Run(); err != nil {
+		fmt.Fprintf(errOut, "%s %s\n", watchTimestamp(), output.Yellow(fmt.Sprintf("%s hook failed: %v", event, err)))
+	}
+}
+
+// watchHookEnv describes an incident as environment variables for hooks
+func watchHookEnv(event string, row incidentRow) []string {
+	env := []string{
+		"GROOVEKIT_EVENT=" + event,
+		"GROOVEKIT_RESOURCE_TYPE=" + row.ResourceType,
+		"GROOVEKIT_RESOURCE_ID=" + row.ResourceID,
+		"GROOVEKIT_RESOURCE_NAME=" + row.ResourceName,
+		"GROOVEKIT_INCIDENT_TYPE=" + row.Type,
+		"GROOVEKIT_INCIDENT_STARTED_AT=" + row.StartedAt,
+	}
+	if row.EndedAt != nil {
+		env = append(env,
+			"GROOVEKIT_INCIDENT_ENDED_AT="+*row.EndedAt,
+			"GROOVEKIT_INCIDENT_DURATION="+strconv.FormatFloat(row.Duration, 'f', -1, 64),
+		)
+	}
+	if row.ErrorMessage != nil {
+		env = append(env, "GROOVEKIT_INCIDENT_ERROR="+*row.ErrorMessage)
+	}
+	return env
+}
+
+// watchTimestamp prefixes watch log lines
+func watchTimestamp() string {
+	return time.Now().Format(time.RFC3339)
+}
+
+func init() {
+	watchCmd.Flags().String("exec-on-incident", "", "Command to run when an incident starts")
+	watchCmd.Flags().String("exec-on-recovery", "", "Command to run when an incident recovers")
+	watchCmd.Flags().Duration("interval", time.Minute, "How often to poll for incidents")
+	watchCmd.Flags().Duration("hook-timeout", time.Minute, "Kill hooks that run longer than this (0 for no limit)")
+	watchCmd.Flags().String("type", "", "Only watch these resource types (comma-separated: jobs, apis, certs, domains, dns)")
+	registerFlagCompletion(watchCmd, "type", completeList(statusKinds...))
+
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchCommand tests the basic structure of the watch command
+func TestWatchCommand(t *testing.T) {
+	assert.Equal(t, "watch", watchCmd.Use)
+	assert.NotEmpty(t, watchCmd.Long)
+	require.NotNil(t, watchCmd.RunE)
+
+	for _, name := range []string{"exec-on-incident", "exec-on-recovery", "interval", "hook-timeout", "type"} {
+		assert.NotNil(t, watchCmd.Flags().Lookup(name), "watch command should have --%s flag", name)
+	}
+}
+
+// TestIncidentWatcherUpdate tests detecting new and recovered incidents between polls
+func TestIncidentWatcherUpdate(t *testing.T) {
+	ended := "2026-01-01T11:00:00Z"
+	apiDown := incidentRow{ResourceType: "api", ResourceID: "a1", Incident: api.Incident{StartedAt: "2026-01-01T10:00:00Z"}}
+	jobDown := incidentRow{ResourceType: "job", ResourceID: "j1", Incident: api.Incident{StartedAt: "2026-01-01T10:30:00Z"}}
+	apiRecovered := apiDown
+	apiRecovered.EndedAt = &ended
+
+	w := newIncidentWatcher()
+	opened, recovered := w.update([]incidentRow{apiDown})
+	assert.Len(t, opened, 1)
+	assert.Empty(t, recovered)
+
+	// Nothing changed
+	opened, recovered = w.update([]incidentRow{apiDown})
+	assert.Empty(t, opened)
+	assert.Empty(t, recovered)
+
+	// The API recovers as the job goes down
+	opened, recovered = w.update([]incidentRow{apiRecovered, jobDown})
+	require.Len(t, opened, 1)
+	assert.Equal(t, "j1", opened[0].ResourceID)
+	require.Len(t, recovered, 1)
+	assert.Equal(t, "a1", recovered[0].ResourceID)
+	assert.Equal(t, &ended, recovered[0].EndedAt)
+
+	// An incident that disappears entirely counts as recovered
+	opened, recovered = w.update(nil)
+	assert.Empty(t, opened)
+	require.Len(t, recovered, 1)
+	assert.Equal(t, "j1", recovered[0].ResourceID)
+}
+
+// TestWatchHookEnv tests the environment passed to hooks
+func TestWatchHookEnv(t *testing.T) {
+	ended := "2026-01-01T11:00:00Z"
+	msg := "timeout"
+	row := incidentRow{
+		ResourceType: "api",
+		ResourceID:   "a1",
+		ResourceName: "Prod API",
+		Incident:     api.Incident{StartedAt: "2026-01-01T10:00:00Z", EndedAt: &ended, Duration: 3600, Type: "down", ErrorMessage: &msg},
+	}
+
+	env := watchHookEnv(watchEventRecovery, row)
+	assert.Contains(t, env, "GROOVEKIT_EVENT=recovery")
+	assert.Contains(t, env, "GROOVEKIT_RESOURCE_NAME=Prod API")
+	assert.Contains(t, env, "GROOVEKIT_INCIDENT_ENDED_AT=2026-01-01T11:00:00Z")
+	assert.Contains(t, env, "GROOVEKIT_INCIDENT_DURATION=3600")
+	assert.Contains(t, env, "GROOVEKIT_INCIDENT_ERROR=timeout")
+
+	row.EndedAt = nil
+	row.ErrorMessage = nil
+	env = watchHookEnv(watchEventIncident, row)
+	assert.Len(t, env, 6)
+}
+
+// TestRunWatchHook tests that hooks see the incident environment
+func TestRunWatchHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	var stdout bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stdout)
+
+	row := incidentRow{ResourceType: "job", ResourceName: "Backup"}
+	runWatchHook(context.Background(), cmd, `echo "$GROOVEKIT_EVENT $GROOVEKIT_RESOURCE_NAME"`, time.Minute, watchEventIncident, row)
+	assert.Equal(t, "incident Backup\n", stdout.String())
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/briandowns/spinner"
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// webhookDeliveriesShown is how many recent deliveries webhook show lists
+// by default
+const webhookDeliveriesShown = 10
+
+// jobWebhook is the JSON output of jobs webhook show
+type jobWebhook struct {
+	JobID      string                `json:"job_id"`
+	URL        string                `json:"url"`
+	HasSecret  bool                  `json:"has_secret"`
+	Deliveries []api.WebhookDelivery `json:"deliveries"`
+}
+
+var jobsWebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Inspect and test a job's webhook",
+	Long: `Inspect and test the webhook a job calls when it goes down or recovers.
+Set the URL with 'groovekit jobs update <id> --webhook-url <url>'.`,
+}
+
+// jobs webhook show <id>
+var jobsWebhookShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show a job's webhook and recent deliveries",
+	Long: `Show the URL a job's webhook is delivered to, whether payloads are signed
+with a secret, and the most recent delivery attempts with their status and
+latency.
+
+Examples:
+  groovekit jobs webhook show abc12345
+  groovekit jobs webhook show abc12345 --limit 25`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		limit, _ := cmd.Flags().GetInt("limit")
+		if limit < 1 {
+			return usageErrorf("invalid --limit %d: must be at least 1", limit)
+		}
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		job, err := client.GetJob(fullID)
+		var deliveries []api.WebhookDelivery
+		if err == nil && job.WebhookURL != "" {
+			deliveries, err = client.ListJobWebhookDeliveries(fullID)
+			if err != nil {
+				err = fmt.Errorf("failed to get webhook deliveries: %w", err)
+			}
+		} else if err != nil {
+			err = fmt.Errorf("failed to get job: %w", err)
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(deliveries) > limit {
+			deliveries = deliveries[:limit]
+		}
+
+		if jsonOutput {
+			if deliveries == nil {
+				deliveries = []api.WebhookDelivery{}
+			}
+			return cmdutil.OutputJSON(out, jobWebhook{JobID: job.ID, URL: job.WebhookURL, HasSecret: job.WebhookSecret != "", Deliveries: deliveries})
+		}
+
+		if job.WebhookURL == "" {
+			output.InfoMessage(out, fmt.Sprintf("Job %s has no webhook. Set one with 'groovekit jobs update %s --webhook-url <url>'", job.Name, cmdutil.ShortID(job.ID)))
+			return nil
+		}
+
+		fmt.Fprintf(out, "%s\n\n", output.Bold(fmt.Sprintf("Webhook for %s", job.Name)))
+		fmt.Fprintf(out, "URL:     %s\n", job.WebhookURL)
+		if job.WebhookSecret != "" {
+			fmt.Fprintf(out, "Signed:  yes (secret set)\n")
+		} else {
+			fmt.Fprintf(out, "Signed:  no (set one with --webhook-secret)\n")
+		}
+
+		fmt.Fprintf(out, "\n%s\n\n", output.Bold("Recent Deliveries"))
+		if len(deliveries) == 0 {
+			output.InfoMessage(out, fmt.Sprintf("No deliveries yet. Send a test with 'groovekit jobs webhook test %s'", cmdutil.ShortID(job.ID)))
+			return nil
+		}
+		printWebhookDeliveries(out, deliveries)
+		return nil
+	},
+}
+
+// jobs webhook test <id>
+var jobsWebhookTestCmd = &cobra.Command{
+	Use:   "test <id>",
+	Short: "Send a test webhook",
+	Long: `Ask GrooveKit to deliver a sample payload to a job's webhook URL now, and
+report whether it was delivered, the response status, and the latency. The
+payload is marked as a test so receivers can tell it from a real alert.
+
+Exits with status 1 when the delivery fails, so it can verify a receiver
+in scripts.
+
+Examples:
+  groovekit jobs webhook test abc12345
+  groovekit jobs webhook test abc12345 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := cmd.OutOrStdout()
+
+		client, err := cmdutil.AuthenticatedClient()
+		if err != nil {
+			return err
+		}
+
+		// Resolve short ID to full ID
+		fullID, err := cmdutil.ResolveJobID(client, args[0])
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		var s *spinner.Spinner
+		if !jsonOutput {
+			s = newSpinner(cmd)
+			s.Start()
+		}
+
+		job, err := client.GetJob(fullID)
+		var delivery *api.WebhookDelivery
+		switch {
+		case err != nil:
+			err = fmt.Errorf("failed to get job: %w", err)
+		case job.WebhookURL == "":
+			err = fmt.Errorf("job %s has no webhook; set one with 'groovekit jobs update %s --webhook-url <url>'", job.Name, cmdutil.ShortID(job.ID))
+		default:
+			delivery, err = client.TestJobWebhook(fullID)
+			if err != nil {
+				err = fmt.Errorf("failed to send test webhook: %w", err)
+			}
+		}
+
+		if s != nil {
+			s.Stop()
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if jsonOutput {
+			err = cmdutil.OutputJSON(out, delivery)
+		} else if delivery.Success {
+			output.SuccessMessage(out, fmt.Sprintf("Delivered test webhook to %s: %s in %.0fms", job.WebhookURL, deliveryStatus(*delivery), delivery.ResponseTime))
+		} else {
+			output.ErrorMessage(out, fmt.Sprintf("Test webhook to %s failed: %s", job.WebhookURL, deliveryResult(*delivery)))
+		}
+		if err != nil || delivery.Success {
+			return err
+		}
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	},
+}
+
+// printWebhookDeliveries renders delivery attempts as a table
+func printWebhookDeliveries(out io.Writer, deliveries []api.WebhookDelivery) {
+	table := output.NewTable(out, []string{"TIME", "EVENT", "STATUS", "LATENCY", "RESULT"})
+	table.Render()
+	for _, d := range deliveries {
+		result := output.Green("Delivered")
+		if !d.Success {
+			result = output.Red(cmdutil.Truncate(deliveryResult(d), 50))
+		}
+		table.Append([]string{output.FormatTime(d.CreatedAt), d.Event, deliveryStatus(d), fmt.Sprintf("%.0fms", d.ResponseTime), result})
+	}
+	table.Flush()
+}
+
+// deliveryStatus describes the response to a delivery, e.g. "200 OK"
+func deliveryStatus(d api.WebhookDelivery) string {
+	if d.StatusCode == nil {
+		return "no response"
+	}
+	if text := http.StatusText(*d.StatusCode); text != "" {
+		return fmt.Sprintf("%d %s", *d.StatusCode, text)
+	}
+	return fmt.Sprintf("%d", *d.StatusCode)
+}
+
+// deliveryResult explains a failed delivery, preferring the API's error
+func deliveryResult(d api.WebhookDelivery) string {
+	if d.ErrorMessage != nil && *d.ErrorMessage != "" {
+		return *d.ErrorMessage
+	}
+	return deliveryStatus(d)
+}
+
+func init() {
+	// Add flags to webhook subcommands
+	jobsWebhookShowCmd.Flags().Int("limit", webhookDeliveriesShown, "Number of recent deliveries to show")
+	jobsWebhookShowCmd.Flags().Bool("json", false, "Output as JSON")
+	jobsWebhookTestCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add subcommands
+	jobsWebhookCmd.AddCommand(jobsWebhookShowCmd)
+	jobsWebhookCmd.AddCommand(jobsWebhookTestCmd)
+	jobsCmd.AddCommand(jobsWebhookCmd)
+}
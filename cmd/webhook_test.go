@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestJobsWebhookCommand tests that the webhook command has its subcommands
+func TestJobsWebhookCommand(t *testing.T) {
+	names := map[string]bool{}
+	for _, c := range jobsWebhookCmd.Commands() {
+		names[c.Name()] = true
+		assert.NotNil(t, c.Flags().Lookup("json"), "%s should have --json", c.CommandPath())
+	}
+	assert.True(t, names["show"])
+	assert.True(t, names["test"])
+}
+
+// TestDeliveryStatus tests describing a delivery's response
+func TestDeliveryStatus(t *testing.T) {
+	ok, custom := 200, 599
+	assert.Equal(t, "200 OK", deliveryStatus(api.WebhookDelivery{StatusCode: &ok}))
+	assert.Equal(t, "599", deliveryStatus(api.WebhookDelivery{StatusCode: &custom}))
+	assert.Equal(t, "no response", deliveryStatus(api.WebhookDelivery{}))
+
+	msg := "connection refused"
+	assert.Equal(t, msg, deliveryResult(api.WebhookDelivery{ErrorMessage: &msg}))
+	assert.Equal(t, "599", deliveryResult(api.WebhookDelivery{StatusCode: &custom}))
+}
+
+// TestPrintWebhookDeliveries tests the deliveries table
+func TestPrintWebhookDeliveries(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	ok := 200
+	msg := "connection timed out"
+	var buf bytes.Buffer
+	printWebhookDeliveries(&buf, []api.WebhookDelivery{
+		{Event: "job.down", StatusCode: &ok, ResponseTime: 84.4, Success: true, CreatedAt: "2026-03-09T10:00:00Z"},
+		{Event: "job.test", ResponseTime: 10000, ErrorMessage: &msg, CreatedAt: "2026-03-08T10:00:00Z"},
+	})
+	out := buf.String()
+	assert.Contains(t, out, "200 OK")
+	assert.Contains(t, out, "84ms")
+	assert.Contains(t, out, "Delivered")
+	assert.Contains(t, out, "connection timed out")
+}
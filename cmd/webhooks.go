@@ -0,0 +1,395 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/cmdutil"
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/scookdev/groovekit-cli/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Debug webhook receivers",
+	Long: `Tools for building and debugging services that receive GrooveKit webhooks.
+
+Deliveries from a job or monitor with a webhook secret carry an
+X-GrooveKit-Signature header of the form sha256=<hex>: the HMAC-SHA256 of
+the raw request body, keyed with the secret.`,
+}
+
+// webhookVerification is the JSON output of webhooks verify
+type webhookVerification struct {
+	Valid     bool   `json:"valid"`
+	Signature string `json:"signature"`
+	Expected  string `json:"expected"`
+	Error     string `json:"error,omitempty"`
+}
+
+// webhooks verify --secret <secret> --signature <sig> --body <body>
+var webhooksVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check a webhook signature",
+	Long: `Check a webhook delivery's X-GrooveKit-Signature against its body and the
+webhook secret, the same way a receiver should, and show the signature
+that was expected.
+
+The signature covers the body byte for byte, so verify the raw request
+body rather than re-encoded JSON. --body takes the body itself, @file to
+read it from a file, or @- to read it from stdin.
+
+Exits with status 1 when the signature doesn't match.
+
+Examples:
+  groovekit webhooks verify --secret s3cret --signature sha256=5d41... --body @payload.json
+  pbpaste | groovekit webhooks verify --secret s3cret --signature sha256=5d41... --body @-`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		out := cmd.OutOrStdout()
+
+		secret, _ := cmd.Flags().GetString("secret")
+		signature, _ := cmd.Flags().GetString("signature")
+		bodyFlag, _ := cmd.Flags().GetString("body")
+		if secret == "" || signature == "" || !cmd.Flags().Changed("body") {
+			return usageErrorf("must specify --secret, --signature, and --body")
+		}
+
+		body, err := readBodyFlag(cmd.InOrStdin(), bodyFlag)
+		if err != nil {
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		result := webhookVerification{Signature: signature, Expected: webhook.Sign(secret, body)}
+		err = webhook.Verify(secret, body, signature)
+		result.Valid = err == nil
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		if jsonOutput {
+			err = cmdutil.OutputJSON(out, result)
+		} else if result.Valid {
+			output.SuccessMessage(out, fmt.Sprintf("Signature is valid for the body (%s)", countNoun(len(body), "byte", "bytes")))
+		} else {
+			output.ErrorMessage(out, fmt.Sprintf("Signature is invalid: %v", err))
+			fmt.Fprintf(out, "  Received: %s\n", signature)
+			fmt.Fprintf(out, "  Expected: %s\n", result.Expected)
+			if hint := signatureHint(secret, body, signature); hint != "" {
+				fmt.Fprintf(out, "\n%s\n", output.Yellow(hint))
+			}
+			err = nil
+		}
+		if err != nil || result.Valid {
+			return err
+		}
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return &exitCodeError{code: exitGeneric}
+	},
+}
+
+// webhooks listen
+var webhooksListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Run a local webhook receiver",
+	Long: `Run a local HTTP server that accepts webhook deliveries on any path and
+prints each one as it arrives: the event, whether its signature is valid,
+and the JSON body.
+
+Signatures are checked against --secret, or the webhook secret of the job
+given with --job. Deliveries with a missing or invalid signature are
+answered with 401, like a receiver should, and others with 200. Without a
+secret every delivery is accepted and shown as unverified.
+
+To receive deliveries from GrooveKit itself, expose the port with a
+tunnel. --tunnel runs a command through the shell once the server is
+listening, with GROOVEKIT_LISTEN_PORT and GROOVEKIT_LISTEN_URL set, and
+stops it on exit. Point the job's webhook at the tunnel's public URL and
+send one with 'groovekit jobs webhook test'.
+
+Examples:
+  groovekit webhooks listen --port 8080 --secret s3cret
+  groovekit webhooks listen --job abc12345
+  groovekit webhooks listen --job abc12345 --tunnel 'ngrok http $GROOVEKIT_LISTEN_PORT'
+  groovekit webhooks listen --json | jq .body`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		errOut := cmd.ErrOrStderr()
+
+		host, _ := cmd.Flags().GetString("host")
+		port, _ := cmd.Flags().GetInt("port")
+		secret, _ := cmd.Flags().GetString("secret")
+		jobID, _ := cmd.Flags().GetString("job")
+		tunnel, _ := cmd.Flags().GetString("tunnel")
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+
+		if port < 0 || port > 65535 {
+			return usageErrorf("invalid --port %d: must be between 0 and 65535", port)
+		}
+		if secret != "" && jobID != "" {
+			return usageErrorf("cannot specify both --secret and --job")
+		}
+
+		if jobID != "" {
+			client, err := cmdutil.AuthenticatedClient()
+			if err != nil {
+				return err
+			}
+			fullID, err := cmdutil.ResolveJobID(client, jobID)
+			if err != nil {
+				return err
+			}
+			job, err := client.GetJob(fullID)
+			if err != nil {
+				return fmt.Errorf("failed to get job: %w", err)
+			}
+			if job.WebhookSecret == "" {
+				return fmt.Errorf("job %s has no webhook secret; set one with 'groovekit jobs update %s --webhook-secret <secret>'", job.Name, cmdutil.ShortID(job.ID))
+			}
+			secret = job.WebhookSecret
+		}
+
+		listener, err := net.Listen("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+		if err != nil {
+			return fmt.Errorf("failed to listen: %w", err)
+		}
+		url := "http://" + listener.Addr().String()
+
+		receiver := &webhookReceiver{out: cmd.OutOrStdout(), secret: secret, jsonOutput: jsonOutput}
+		server := &http.Server{Handler: receiver, ReadHeaderTimeout: 10 * time.Second}
+		served := make(chan error, 1)
+		go func() { served <- server.Serve(listener) }()
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		verifying := "signatures are not checked"
+		if secret != "" {
+			verifying = "checking signatures"
+		}
+		output.InfoMessage(errOut, fmt.Sprintf("Listening for webhooks on %s (%s). Press Ctrl+C to stop.", url, verifying))
+
+		if tunnel != "" {
+			go runTunnel(ctx, cmd, tunnel, listener.Addr().(*net.TCPAddr).Port, url)
+		}
+
+		select {
+		case err := <-served:
+			return fmt.Errorf("webhook server stopped: %w", err)
+		case <-ctx.Done():
+		}
+
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdown)
+	},
+}
+
+// maxWebhookBody is the largest delivery body webhooks listen reads
+const maxWebhookBody = 1 << 20
+
+// Signature states of a received delivery
+const (
+	signatureValid      = "valid"
+	signatureInvalid    = "invalid"
+	signatureMissing    = "missing"
+	signatureUnverified = "unverified"
+)
+
+// receivedWebhook is a delivery received by webhooks listen, and its JSON
+// output
+type receivedWebhook struct {
+	ReceivedAt string `json:"received_at"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Event      string `json:"event,omitempty"`
+	Signature  string `json:"signature"`
+	// Body is the JSON body, or the body as a string when it isn't JSON
+	Body any `json:"body"`
+}
+
+// webhookReceiver answers and prints webhook deliveries, verifying them
+// against secret when it is set
+type webhookReceiver struct {
+	out        io.Writer
+	secret     string
+	jsonOutput bool
+
+	// mu keeps concurrent deliveries from interleaving their output
+	mu sync.Mutex
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	delivery := receivedWebhook{
+		ReceivedAt: time.Now().Format(time.RFC3339),
+		Method:     r.Method,
+		Path:       r.URL.RequestURI(),
+		Signature:  signatureUnverified,
+		Body:       string(body),
+	}
+	var payload struct {
+		Event string `json:"event"`
+	}
+	if json.Valid(body) {
+		delivery.Body = json.RawMessage(body)
+		_ = json.Unmarshal(body, &payload)
+		delivery.Event = payload.Event
+	}
+
+	status := http.StatusOK
+	if rcv.secret != "" {
+		switch err := webhook.Verify(rcv.secret, body, r.Header.Get(webhook.SignatureHeader)); {
+		case err == nil:
+			delivery.Signature = signatureValid
+		case errors.Is(err, webhook.ErrNoSignature):
+			delivery.Signature = signatureMissing
+			status = http.StatusUnauthorized
+		default:
+			delivery.Signature = signatureInvalid
+			status = http.StatusUnauthorized
+		}
+	}
+	w.WriteHeader(status)
+
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	if rcv.jsonOutput {
+		_ = json.NewEncoder(rcv.out).Encode(delivery)
+		return
+	}
+	printReceivedWebhook(rcv.out, delivery, body)
+}
+
+// printReceivedWebhook renders a delivery as a header line followed by the
+// indented body
+func printReceivedWebhook(w io.Writer, delivery receivedWebhook, body []byte) {
+	var signature string
+	switch delivery.Signature {
+	case signatureValid:
+		signature = output.Green("signature valid")
+	case signatureInvalid:
+		signature = output.Red("signature invalid")
+	case signatureMissing:
+		signature = output.Red("signature missing")
+	default:
+		signature = output.Yellow("unverified")
+	}
+	event := delivery.Event
+	if event == "" {
+		event = "-"
+	}
+	fmt.Fprintf(w, "%s %s %s %s %s\n", output.FormatTime(delivery.ReceivedAt), delivery.Method, delivery.Path, output.Bold(event), signature)
+
+	var indented bytes.Buffer
+	if json.Indent(&indented, body, "  ", "  ") == nil {
+		body = indented.Bytes()
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(w, "  %s\n", bytes.TrimRight(body, "\n"))
+	}
+}
+
+// runTunnel runs the --tunnel command through the shell until ctx is done,
+// with the listening port and URL in its environment
+func runTunnel(ctx context.Context, cmd *cobra.Command, tunnel string, port int, url string) {
+	errOut := cmd.ErrOrStderr()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	child := exec.CommandContext(ctx, shell, flag, tunnel)
+	child.Env = append(os.Environ(), "GROOVEKIT_LISTEN_PORT="+strconv.Itoa(port), "GROOVEKIT_LISTEN_URL="+url)
+	child.Stdout = errOut
+	child.Stderr = errOut
+
+	err := child.Run()
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(errOut, "%s %s\n", watchTimestamp(), output.Yellow(fmt.Sprintf("tunnel command failed: %v", err)))
+		return
+	}
+	fmt.Fprintf(errOut, "%s %s\n", watchTimestamp(), output.Yellow("tunnel command exited"))
+}
+
+// readBodyFlag reads a body given as text, @file, or @- for stdin
+func readBodyFlag(stdin io.Reader, value string) ([]byte, error) {
+	path, ok := strings.CutPrefix(value, "@")
+	if !ok {
+		return []byte(value), nil
+	}
+	if path == "-" {
+		body, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body from stdin: %w", err)
+		}
+		return body, nil
+	}
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	return body, nil
+}
+
+// signatureHint suggests why a signature didn't match when the body was
+// probably altered on its way to the receiver
+func signatureHint(secret string, body []byte, signature string) string {
+	if trimmed := bytes.TrimRight(body, "\r\n"); len(trimmed) < len(body) && webhook.Verify(secret, trimmed, signature) == nil {
+		return "The signature matches the body without its trailing newline. Verify the raw request body, not a copy saved by an editor."
+	}
+	if trimmed := strings.TrimSpace(secret); trimmed != secret && webhook.Verify(trimmed, body, signature) == nil {
+		return "The signature matches with surrounding whitespace removed from the secret."
+	}
+	return ""
+}
+
+func init() {
+	// Add flags to verify command
+	webhooksVerifyCmd.Flags().String("secret", "", "Webhook secret the job or monitor signs with (required)")
+	webhooksVerifyCmd.Flags().String("signature", "", "X-GrooveKit-Signature header value, e.g. sha256=5d41... (required)")
+	webhooksVerifyCmd.Flags().String("body", "", "Raw request body, @file to read a file, or @- for stdin (required)")
+	webhooksVerifyCmd.Flags().Bool("json", false, "Output as JSON")
+
+	// Add flags to listen command
+	webhooksListenCmd.Flags().IntP("port", "p", 8080, "Port to listen on (0 for any free port)")
+	webhooksListenCmd.Flags().String("host", "127.0.0.1", "Address to listen on; 0.0.0.0 accepts deliveries from other machines")
+	webhooksListenCmd.Flags().String("secret", "", "Webhook secret to check signatures against")
+	webhooksListenCmd.Flags().StringP("job", "j", "", "Job whose webhook secret to check signatures against")
+	webhooksListenCmd.Flags().String("tunnel", "", "Command that exposes the port publicly, e.g. 'ngrok http $GROOVEKIT_LISTEN_PORT'")
+	webhooksListenCmd.Flags().Bool("json", false, "Print each delivery as a line of JSON")
+
+	// Add subcommands
+	webhooksCmd.AddCommand(webhooksVerifyCmd)
+	webhooksCmd.AddCommand(webhooksListenCmd)
+
+	// Add webhooks command to root
+	rootCmd.AddCommand(webhooksCmd)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhooksVerifyCommand tests checking signatures against a body given
+// inline, from a file, and from stdin
+func TestWebhooksVerifyCommand(t *testing.T) {
+	body := `{"event":"down","job_id":"job-1"}`
+	signature := webhook.Sign("s3cret", []byte(body))
+
+	out := mustRun(t, "webhooks", "verify", "--secret", "s3cret", "--signature", signature, "--body", body)
+	assert.Contains(t, out, "Signature is valid for the body (33 bytes)")
+
+	path := filepath.Join(t.TempDir(), "payload.json")
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+	mustRun(t, "webhooks", "verify", "--secret", "s3cret", "--signature", signature, "--body", "@"+path)
+
+	_, _, err := runCommandWithInput(t, body, "webhooks", "verify", "--secret", "s3cret", "--signature", signature, "--body", "@-")
+	assert.NoError(t, err)
+
+	out, _, err = runCommand(t, "webhooks", "verify", "--secret", "wrong", "--signature", signature, "--body", body)
+	assert.Equal(t, exitGeneric, exitCode(err))
+	assert.Contains(t, out, "Signature is invalid: signature does not match")
+	assert.Contains(t, out, "Expected: "+webhook.Sign("wrong", []byte(body)))
+
+	out, _, err = runCommand(t, "webhooks", "verify", "--secret", "s3cret", "--signature", signature, "--body", "{}", "--json")
+	assert.Equal(t, exitGeneric, exitCode(err))
+	var result webhookVerification
+	require.NoError(t, json.Unmarshal([]byte(out), &result))
+	assert.False(t, result.Valid)
+	assert.Equal(t, webhook.Sign("s3cret", []byte("{}")), result.Expected)
+
+	_, _, err = runCommand(t, "webhooks", "verify", "--secret", "s3cret", "--body", body)
+	assert.Equal(t, exitUsage, exitCode(err))
+}
+
+// TestSignatureHint tests spotting bodies and secrets that were altered
+func TestSignatureHint(t *testing.T) {
+	body := []byte(`{"event":"down"}`)
+	signature := webhook.Sign("s3cret", body)
+
+	assert.Contains(t, signatureHint("s3cret", append(body, '\n'), signature), "trailing newline")
+	assert.Contains(t, signatureHint("s3cret\n", body, signature), "whitespace removed from the secret")
+	assert.Empty(t, signatureHint("other", body, signature))
+}
+
+// deliver posts a webhook body to a receiver, signed when signature isn't
+// empty, and returns the response status
+func deliver(t *testing.T, rcv *webhookReceiver, body, signature string) int {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/hooks/groovekit", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set(webhook.SignatureHeader, signature)
+	}
+	rec := httptest.NewRecorder()
+	rcv.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// TestWebhookReceiver tests answering and printing deliveries with and
+// without a secret to check them against
+func TestWebhookReceiver(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	body := `{"event":"down","job_id":"job-1"}`
+	var out bytes.Buffer
+
+	rcv := &webhookReceiver{out: &out, secret: "s3cret"}
+	assert.Equal(t, http.StatusOK, deliver(t, rcv, body, webhook.Sign("s3cret", []byte(body))))
+	assert.Contains(t, out.String(), "POST /hooks/groovekit down signature valid\n  {\n    \"event\": \"down\",")
+
+	out.Reset()
+	assert.Equal(t, http.StatusUnauthorized, deliver(t, rcv, body, webhook.Sign("other", []byte(body))))
+	assert.Contains(t, out.String(), "signature invalid")
+
+	out.Reset()
+	assert.Equal(t, http.StatusUnauthorized, deliver(t, rcv, body, ""))
+	assert.Contains(t, out.String(), "signature missing")
+
+	out.Reset()
+	rcv = &webhookReceiver{out: &out}
+	assert.Equal(t, http.StatusOK, deliver(t, rcv, "not json", ""))
+	assert.Contains(t, out.String(), "POST /hooks/groovekit - unverified\n  not json\n")
+}
+
+// TestWebhookReceiver_JSON tests printing deliveries as lines of JSON
+func TestWebhookReceiver_JSON(t *testing.T) {
+	var out bytes.Buffer
+	rcv := &webhookReceiver{out: &out, secret: "s3cret", jsonOutput: true}
+	body := `{"event":"recovered"}`
+	deliver(t, rcv, body, webhook.Sign("s3cret", []byte(body)))
+	deliver(t, rcv, "plain", "")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	var first, second map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.Equal(t, "recovered", first["event"])
+	assert.Equal(t, signatureValid, first["signature"])
+	assert.Equal(t, map[string]any{"event": "recovered"}, first["body"])
+	assert.Equal(t, signatureMissing, second["signature"])
+	assert.Equal(t, "plain", second["body"])
+}
+
+// TestWebhooksListenCommand_Flags tests the errors listen reports before
+// starting a server
+func TestWebhooksListenCommand_Flags(t *testing.T) {
+	srv := startAPI(t)
+	srv.Fake.Jobs = []api.Job{{ID: "abc12345-0000-4000-8000-000000000001", Name: "Backup"}}
+
+	_, _, err := runCommand(t, "webhooks", "listen", "--port", "70000")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, _, err = runCommand(t, "webhooks", "listen", "--secret", "s3cret", "--job", "abc12345")
+	assert.Equal(t, exitUsage, exitCode(err))
+
+	_, _, err = runCommand(t, "webhooks", "listen", "--job", "abc12345")
+	assert.ErrorContains(t, err, "job Backup has no webhook secret")
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/scookdev/groovekit-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// wizardField is one question in a create wizard. The answer is applied to
+// the flag of the same name, so the command goes on to validate and use it
+// exactly as if it had been given on the command line.
+type wizardField struct {
+	flag   string
+	prompt string
+	// required fields are asked again until answered
+	required bool
+	// options turns the question into a numbered selection list
+	options []string
+	// repeat asks again after each answer until an empty one, for
+	// repeatable flags such as --tag and --header
+	repeat bool
+	// def returns a default based on earlier answers, overriding the
+	// flag's own default
+	def func(cmd *cobra.Command) string
+	// skip leaves the field out based on earlier answers
+	skip     func(cmd *cobra.Command) bool
+	validate func(answer string) error
+}
+
+// tagsWizardField asks for tags, one per line
+var tagsWizardField = wizardField{flag: "tag", prompt: "Tag as key=value", repeat: true, validate: func(answer string) error {
+	_, err := parseTags([]string{answer})
+	return err
+}}
+
+// addInteractiveFlag registers --interactive on a create command
+func addInteractiveFlag(c *cobra.Command) {
+	c.Flags().BoolP("interactive", "i", false, "Prompt for each setting (the default when run in a terminal with no flags)")
+}
+
+// wantsWizard reports whether a create command should prompt for its
+// settings: when asked to with --interactive, or when run in a terminal
+// without any flags
+func wantsWizard(cmd *cobra.Command) bool {
+	if interactive, _ := cmd.Flags().GetBool("interactive"); interactive {
+		return true
+	}
+	if cmd.LocalFlags().NFlag() > 0 {
+		return false
+	}
+	return isTerminal(cmd.InOrStdin())
+}
+
+// runCreateWizard prompts for each field not already set by a flag, when
+// the command wants a wizard
+func runCreateWizard(cmd *cobra.Command, fields []wizardField) error {
+	if !wantsWizard(cmd) {
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+	in := bufio.NewReader(cmd.InOrStdin())
+	fmt.Fprintf(out, "%s (press Enter to accept the default in brackets)\n\n", output.Bold(capitalize(cmd.Short)))
+	for _, field := range fields {
+		if cmd.Flags().Changed(field.flag) || (field.skip != nil && field.skip(cmd)) {
+			continue
+		}
+		if err := field.ask(cmd, in); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(out)
+	return nil
+}
+
+// ask prompts until the field has a valid answer
+func (f wizardField) ask(cmd *cobra.Command, in *bufio.Reader) error {
+	out := cmd.OutOrStdout()
+	flag := cmd.Flags().Lookup(f.flag)
+
+	def := flag.DefValue
+	if f.def != nil {
+		def = f.def(cmd)
+	}
+	if def == "0" || def == "[]" {
+		def = ""
+	}
+
+	prompt := f.prompt
+	if len(f.options) > 0 {
+		fmt.Fprintf(out, "%s:\n", f.prompt)
+		for i, option := range f.options {
+			fmt.Fprintf(out, "  %d) %s\n", i+1, option)
+		}
+		prompt = fmt.Sprintf("Choose 1-%d", len(f.options))
+		if i := slices.Index(f.options, def); i >= 0 {
+			def = strconv.Itoa(i + 1)
+		}
+	}
+	if f.repeat {
+		prompt += " (Enter when done)"
+	}
+	if def != "" {
+		prompt += " [" + def + "]"
+	}
+
+	answered := false
+	for {
+		fmt.Fprintf(out, "%s: ", prompt)
+		line, err := in.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("input ended before %q was answered", f.prompt)
+			}
+			return err
+		}
+		answer := strings.TrimSpace(line)
+
+		if answer == "" {
+			switch {
+			case f.repeat && answered:
+				return nil
+			case def != "":
+				answer = def
+			case f.required:
+				fmt.Fprintln(out, output.Red("  A value is required"))
+				continue
+			default:
+				return nil
+			}
+		}
+
+		if len(f.options) > 0 {
+			if answer, err = f.choose(answer); err != nil {
+				fmt.Fprintln(out, output.Red("  "+err.Error()))
+				continue
+			}
+		}
+		// Leave the flag unchanged when its own default was accepted, so
+		// commands that check Changed still see it as unset
+		if answer == flag.DefValue && f.def == nil && !f.repeat {
+			return nil
+		}
+		if f.validate != nil {
+			if err := f.validate(answer); err != nil {
+				fmt.Fprintln(out, output.Red("  "+err.Error()))
+				continue
+			}
+		}
+		if err := cmd.Flags().Set(f.flag, answer); err != nil {
+			fmt.Fprintln(out, output.Red("  "+err.Error()))
+			continue
+		}
+		if !f.repeat {
+			return nil
+		}
+		answered = true
+		def = ""
+	}
+}
+
+// choose maps a selection list answer, given as a number or the option
+// itself, to the option
+func (f wizardField) choose(answer string) (string, error) {
+	if n, err := strconv.Atoi(answer); err == nil {
+		if n < 1 || n > len(f.options) {
+			return "", fmt.Errorf("choose a number from 1 to %d", len(f.options))
+		}
+		return f.options[n-1], nil
+	}
+	for _, option := range f.options {
+		if strings.EqualFold(option, answer) {
+			return option, nil
+		}
+	}
+	return "", fmt.Errorf("choose one of %s", strings.Join(f.options, ", "))
+}
+
+// validateMonitorURL accepts absolute http and https URLs
+func validateMonitorURL(answer string) error {
+	u, err := url.Parse(answer)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("enter a full URL, e.g. https://api.example.com/health")
+	}
+	return nil
+}
+
+// validateDomainName accepts a bare host name, without a scheme or path
+func validateDomainName(answer string) error {
+	if strings.ContainsAny(answer, "/: ") {
+		return fmt.Errorf("enter just the domain, e.g. example.com")
+	}
+	return nil
+}
+
+// defaultToFlag defaults a field to another flag's answer, e.g. a monitor's
+// name to its domain
+func defaultToFlag(name string) func(cmd *cobra.Command) string {
+	return func(cmd *cobra.Command) string {
+		value, _ := cmd.Flags().GetString(name)
+		return value
+	}
+}
+
+// defaultToHost defaults a field to the host of a URL flag's answer
+func defaultToHost(name string) func(cmd *cobra.Command) string {
+	return func(cmd *cobra.Command) string {
+		value, _ := cmd.Flags().GetString(name)
+		if u, err := url.Parse(value); err == nil {
+			return u.Host
+		}
+		return ""
+	}
+}
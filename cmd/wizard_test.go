@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWizardCommand returns a create command with the flags the wizard tests use
+func newWizardCommand(input string) (*cobra.Command, *bytes.Buffer) {
+	c := &cobra.Command{Use: "create", Short: "create a monitor"}
+	c.Flags().String("url", "", "URL")
+	c.Flags().String("name", "", "Name")
+	c.Flags().String("method", "GET", "HTTP method")
+	addDurationFlag(c, "interval", 60, time.Minute, "Check interval")
+	addTagFlag(c)
+	addInteractiveFlag(c)
+
+	var out bytes.Buffer
+	c.SetIn(strings.NewReader(input))
+	c.SetOut(&out)
+	return c, &out
+}
+
+var wizardTestFields = []wizardField{
+	{flag: "url", prompt: "URL to monitor", required: true, validate: validateMonitorURL},
+	{flag: "name", prompt: "Monitor name", required: true, def: defaultToHost("url")},
+	{flag: "method", prompt: "HTTP method", options: httpMethods},
+	{flag: "interval", prompt: "Check interval"},
+	tagsWizardField,
+}
+
+// TestRunCreateWizard tests answering, defaults, re-prompting, and selection lists
+func TestRunCreateWizard(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	c, out := newWizardCommand("\nexample.com\nhttps://api.example.com/health\n\nPOST\n90\nteam=payments\nenv=prod\n\n")
+	require.NoError(t, c.Flags().Set("interactive", "true"))
+
+	require.NoError(t, runCreateWizard(c, wizardTestFields))
+
+	url, _ := c.Flags().GetString("url")
+	name, _ := c.Flags().GetString("name")
+	method, _ := c.Flags().GetString("method")
+	tags, _ := getTags(c)
+	assert.Equal(t, "https://api.example.com/health", url)
+	assert.Equal(t, "api.example.com", name)
+	assert.Equal(t, "POST", method)
+	assert.Equal(t, 90, getDurationFlag(c, "interval"))
+	assert.Equal(t, []string{"team=payments", "env=prod"}, tags)
+
+	assert.Contains(t, out.String(), "A value is required")
+	assert.Contains(t, out.String(), "enter a full URL")
+	assert.Contains(t, out.String(), "Monitor name [api.example.com]: ")
+	assert.Contains(t, out.String(), "  2) POST\n")
+}
+
+// TestRunCreateWizardDefaults tests that accepted flag defaults leave flags unset
+func TestRunCreateWizardDefaults(t *testing.T) {
+	c, _ := newWizardCommand("\n\n\n")
+	require.NoError(t, c.Flags().Set("interactive", "true"))
+	require.NoError(t, c.Flags().Set("url", "https://example.com"))
+	require.NoError(t, c.Flags().Set("name", "Example"))
+
+	require.NoError(t, runCreateWizard(c, wizardTestFields))
+	assert.False(t, c.Flags().Changed("method"))
+	assert.False(t, c.Flags().Changed("interval"))
+	assert.False(t, c.Flags().Changed("tag"))
+}
+
+// TestRunCreateWizardEOF tests that running out of input stops the wizard
+func TestRunCreateWizardEOF(t *testing.T) {
+	c, _ := newWizardCommand("")
+	require.NoError(t, c.Flags().Set("interactive", "true"))
+	assert.ErrorContains(t, runCreateWizard(c, wizardTestFields), "input ended")
+}
+
+// TestWantsWizard tests that the wizard only runs when asked or in a terminal without flags
+func TestWantsWizard(t *testing.T) {
+	c, _ := newWizardCommand("")
+	assert.False(t, wantsWizard(c), "stdin is not a terminal")
+
+	require.NoError(t, c.Flags().Set("interactive", "true"))
+	assert.True(t, wantsWizard(c))
+}
+
+// TestWizardChoose tests picking an option by number or name
+func TestWizardChoose(t *testing.T) {
+	f := wizardField{options: dnsRecordTypes}
+
+	choice, err := f.choose("2")
+	require.NoError(t, err)
+	assert.Equal(t, "AAAA", choice)
+
+	choice, err = f.choose("mx")
+	require.NoError(t, err)
+	assert.Equal(t, "MX", choice)
+
+	_, err = f.choose("11")
+	assert.Error(t, err)
+	_, err = f.choose("SPF")
+	assert.Error(t, err)
+}
+
+// TestCreateCommandsInteractive tests that every create command has --interactive
+func TestCreateCommandsInteractive(t *testing.T) {
+	for _, c := range []*cobra.Command{apisCreateCmd, jobsCreateCmd, certsCreateCmd, domainsCreateCmd, dnsCreateCmd} {
+		flag := c.Flags().Lookup("interactive")
+		require.NotNil(t, flag, "%s should have --interactive", c.CommandPath())
+		assert.Equal(t, "i", flag.Shorthand)
+	}
+}
@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/scookdev/groovekit-cli/internal/config"
 )
@@ -17,17 +21,55 @@ type Client struct {
 	BaseURL    string
 	HTTPClient *http.Client
 	Token      string
+	// TokenHeader is the header the token is sent in; empty means
+	// "Authorization: Bearer <token>"
+	TokenHeader string
+	// Headers are added to every request
+	Headers map[string]string
+
+	// rateLimit is the allowance reported by the latest response; pace and
+	// paceNotify are set by PaceRequests
+	rateMu     sync.Mutex
+	rateLimit  *RateLimit
+	pace       bool
+	paceNotify func(wait time.Duration)
+	// sleep waits while pacing; tests replace it
+	sleep func(time.Duration)
 }
 
 // NewClient creates a new API client
 func NewClient(cfg *config.Config) *Client {
 	return &Client{
-		BaseURL:    cfg.APIBaseURL,
-		HTTPClient: &http.Client{},
-		Token:      cfg.AccessToken,
+		BaseURL:     cfg.APIBaseURL,
+		HTTPClient:  newHTTPClient(),
+		Token:       cfg.AccessToken,
+		TokenHeader: cfg.TokenHeader,
+		Headers:     cfg.RequestHeaders(),
+		sleep:       time.Sleep,
 	}
 }
 
+// Identity returns the API URL and token the client uses
+func (c *Client) Identity() (baseURL, token string) {
+	return c.BaseURL, c.Token
+}
+
+// setHeaders adds the configured extra headers and, when authenticated,
+// the access token
+func (c *Client) setHeaders(req *http.Request, withToken bool) {
+	for name, value := range c.Headers {
+		req.Header.Set(name, value)
+	}
+	if !withToken || c.Token == "" {
+		return
+	}
+	if c.TokenHeader == "" || strings.EqualFold(c.TokenHeader, "Authorization") {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+		return
+	}
+	req.Header.Set(c.TokenHeader, c.Token)
+}
+
 // Login authenticates and returns an access token
 func (c *Client) Login(email, password string) (string, error) {
 	payload := map[string]string{
@@ -46,6 +88,7 @@ func (c *Client) Login(email, password string) (string, error) {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	c.setHeaders(req, false)
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -55,7 +98,7 @@ func (c *Client) Login(email, password string) (string, error) {
 
 	if resp.StatusCode != http.StatusCreated {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("login failed: %s", string(bodyBytes))
+		return "", fmt.Errorf("login failed: %w", newError(resp.StatusCode, bodyBytes))
 	}
 
 	var result struct {
@@ -86,51 +129,26 @@ func (c *Client) doRequest(method, path string, body interface{}, result interfa
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	if c.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.Token)
-	}
+	c.setHeaders(req, true)
 
+	c.waitForRateLimit()
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
+	c.recordRateLimit(resp.Header)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		bodyStr := string(bodyBytes)
-
-		// Check if response looks like HTML (common for Rails error pages)
-		if len(bodyStr) > 0 && (bodyStr[0] == '<' || strings.Contains(bodyStr, "<!DOCTYPE")) {
-			// Don't dump HTML, provide a clean error message
-			return fmt.Errorf("API error (status %d): %s", resp.StatusCode, http.StatusText(resp.StatusCode))
-		}
-
-		// Try to parse as JSON error
-		var errResp struct {
-			Error   string `json:"error"`
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &errResp); err == nil {
-			if errResp.Error != "" {
-				return fmt.Errorf("API error (status %d): %s", resp.StatusCode, errResp.Error)
-			}
-			if errResp.Message != "" {
-				return fmt.Errorf("API error (status %d): %s", resp.StatusCode, errResp.Message)
-			}
-		}
-
-		// Fallback to raw body if it's short
-		if len(bodyStr) < 200 {
-			return fmt.Errorf("API error (status %d): %s", resp.StatusCode, bodyStr)
-		}
-
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+		apiErr := newError(resp.StatusCode, bodyBytes)
+		apiErr.RetryAfter = retryAfter(resp.Header.Get("Retry-After"), time.Now())
+		return apiErr
 	}
 
 	if result != nil {
 		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return err
+			return fmt.Errorf("failed to decode %s %s response: %w", method, path, err)
 		}
 	}
 
@@ -157,6 +175,86 @@ func (c *Client) Delete(path string) error {
 	return c.doRequest("DELETE", path, nil, nil)
 }
 
+// query encodes list options as a URL query string
+func (o *ListOptions) query() string {
+	if o == nil {
+		return ""
+	}
+	params := url.Values{}
+	if o.Status != "" {
+		params.Set("status", o.Status)
+	}
+	for _, tag := range o.Tags {
+		params.Add("tag", tag)
+	}
+	if o.Name != "" {
+		params.Set("name", o.Name)
+	}
+	if o.Project != "" {
+		params.Set("project_id", o.Project)
+	}
+	if o.Type != "" {
+		params.Set("type", o.Type)
+	}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		params.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if !o.Since.IsZero() {
+		params.Set("since", o.Since.UTC().Format(time.RFC3339))
+	}
+	if !o.Until.IsZero() {
+		params.Set("until", o.Until.UTC().Format(time.RFC3339))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
+
+// allPagesPerPage is the page size used when fetching every page
+const allPagesPerPage = 100
+
+// paginate calls fetch for each page starting from opts until the API
+// reports no more results. fetch returns whether more pages remain and how
+// many items the page held.
+func paginate(opts *ListOptions, fetch func(*ListOptions) (bool, int, error)) error {
+	page := ListOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	if page.Page < 1 {
+		page.Page = 1
+	}
+	if page.PerPage == 0 {
+		page.PerPage = allPagesPerPage
+	}
+
+	for {
+		hasMore, n, err := fetch(&page)
+		if err != nil {
+			return err
+		}
+		// An empty page means the API is done even if has_more says otherwise
+		if !hasMore || n == 0 {
+			return nil
+		}
+		page.Page++
+	}
+}
+
+// reachedSince reports whether a newest-first history page has gone back
+// past opts.Since, so later pages can only hold older entries
+func reachedSince(opts *ListOptions, oldest string) bool {
+	if opts == nil || opts.Since.IsZero() {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, oldest)
+	return err == nil && t.Before(opts.Since)
+}
+
 // Account API method
 
 // GetAccount returns account information with subscription and usage
@@ -168,17 +266,225 @@ func (c *Client) GetAccount() (*Account, error) {
 	return &account, nil
 }
 
+// GetNotificationPreferences returns the account's alert preferences
+func (c *Client) GetNotificationPreferences() (*NotificationPreferences, error) {
+	var result NotificationPreferencesResponse
+	if err := c.Get("/users/me/notification_preferences", &result); err != nil {
+		return nil, err
+	}
+	return &result.NotificationPreferences, nil
+}
+
+// UpdateNotificationPreferences changes the account's alert preferences
+func (c *Client) UpdateNotificationPreferences(req *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	payload := map[string]any{
+		"notification_preferences": req,
+	}
+	var result NotificationPreferencesResponse
+	if err := c.Put("/users/me/notification_preferences", payload, &result); err != nil {
+		return nil, err
+	}
+	return &result.NotificationPreferences, nil
+}
+
+// GetRetentionPolicy returns how long the account's check and ping
+// history is kept
+func (c *Client) GetRetentionPolicy() (*RetentionPolicy, error) {
+	var result RetentionPolicyResponse
+	if err := c.Get("/users/me/retention", &result); err != nil {
+		return nil, err
+	}
+	return &result.Retention, nil
+}
+
+// Region API methods
+
+// ListRegions returns the probe locations API monitors can be checked from
+func (c *Client) ListRegions() ([]Region, error) {
+	var result RegionsResponse
+	if err := c.Get("/regions", &result); err != nil {
+		return nil, err
+	}
+	return result.Regions, nil
+}
+
+// Project API methods
+
+// ListProjects returns every project in the account
+func (c *Client) ListProjects() ([]Project, error) {
+	var result ProjectsResponse
+	if err := c.Get("/projects", &result); err != nil {
+		return nil, err
+	}
+	return result.Projects, nil
+}
+
+// GetProject returns a project by ID
+func (c *Client) GetProject(id string) (*Project, error) {
+	var result ProjectResponse
+	if err := c.Get("/projects/"+id, &result); err != nil {
+		return nil, err
+	}
+	return &result.Project, nil
+}
+
+// CreateProject creates a project
+func (c *Client) CreateProject(req *CreateProjectRequest) (*Project, error) {
+	payload := map[string]any{
+		"project": req,
+	}
+	var result ProjectResponse
+	if err := c.Post("/projects", payload, &result); err != nil {
+		return nil, err
+	}
+	return &result.Project, nil
+}
+
+// DeleteProject deletes a project. Its resources are kept and no longer
+// belong to a project.
+func (c *Client) DeleteProject(id string) error {
+	return c.Delete("/projects/" + id)
+}
+
+// moveToProject moves the resource at path to a project through the move
+// endpoint, which keeps its check history, unlike deleting and recreating it
+func (c *Client) moveToProject(path, projectID string) error {
+	return c.Post(path+"/move", map[string]any{"project_id": projectID}, nil)
+}
+
+// MoveJob moves a job to a project
+func (c *Client) MoveJob(id, projectID string) error {
+	return c.moveToProject("/jobs/"+id, projectID)
+}
+
+// MoveApi moves an API monitor to a project
+func (c *Client) MoveApi(id, projectID string) error {
+	return c.moveToProject("/api_monitors/"+id, projectID)
+}
+
+// MoveCert moves an SSL monitor to a project
+func (c *Client) MoveCert(id, projectID string) error {
+	return c.moveToProject("/ssl_monitors/"+id, projectID)
+}
+
+// MoveDomain moves a domain monitor to a project
+func (c *Client) MoveDomain(id, projectID string) error {
+	return c.moveToProject("/domain_monitors/"+id, projectID)
+}
+
+// MoveDnsMonitor moves a DNS monitor to a project
+func (c *Client) MoveDnsMonitor(id, projectID string) error {
+	return c.moveToProject("/dns_monitors/"+id, projectID)
+}
+
+// snooze holds back the alerts of the resource at path until the given
+// time while its checks keep running, or ends the snooze when until is zero
+func (c *Client) snooze(path string, until time.Time) error {
+	if until.IsZero() {
+		return c.Delete(path + "/snooze")
+	}
+	return c.Post(path+"/snooze", map[string]any{"until": until.UTC().Format(time.RFC3339)}, nil)
+}
+
+// SnoozeJob snoozes a job's alerts until the given time, or ends the
+// snooze when until is zero
+func (c *Client) SnoozeJob(id string, until time.Time) error {
+	return c.snooze("/jobs/"+id, until)
+}
+
+// SnoozeApi snoozes an API monitor's alerts until the given time, or ends
+// the snooze when until is zero
+func (c *Client) SnoozeApi(id string, until time.Time) error {
+	return c.snooze("/api_monitors/"+id, until)
+}
+
+// SnoozeCert snoozes an SSL monitor's alerts until the given time, or ends
+// the snooze when until is zero
+func (c *Client) SnoozeCert(id string, until time.Time) error {
+	return c.snooze("/ssl_monitors/"+id, until)
+}
+
+// SnoozeDomain snoozes a domain monitor's alerts until the given time, or
+// ends the snooze when until is zero
+func (c *Client) SnoozeDomain(id string, until time.Time) error {
+	return c.snooze("/domain_monitors/"+id, until)
+}
+
+// SnoozeDnsMonitor snoozes a DNS monitor's alerts until the given time, or
+// ends the snooze when until is zero
+func (c *Client) SnoozeDnsMonitor(id string, until time.Time) error {
+	return c.snooze("/dns_monitors/"+id, until)
+}
+
+// Alert API methods
+
+// Alert channels accepted by ListOptions.Type
+const (
+	AlertSMS     = "sms"
+	AlertEmail   = "email"
+	AlertWebhook = "webhook"
+)
+
+// ListAlerts returns a page of alerts sent for the account, newest first
+// (opts may be nil)
+func (c *Client) ListAlerts(opts *ListOptions) (*AlertsResponse, error) {
+	var result AlertsResponse
+	if err := c.Get("/alerts"+opts.query(), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListAllAlerts pages through alerts until opts.Since is reached or max
+// alerts have been fetched (0 for no cap)
+func (c *Client) ListAllAlerts(opts *ListOptions, max int) ([]Alert, error) {
+	var all []Alert
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListAlerts(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all = append(all, result.Alerts...)
+		if (max > 0 && len(all) >= max) || (len(result.Alerts) > 0 && reachedSince(opts, result.Alerts[len(result.Alerts)-1].SentAt)) {
+			return false, len(result.Alerts), nil
+		}
+		return result.HasMore, len(result.Alerts), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
 // Jobs API methods
 
-// ListJobs returns all jobs for the authenticated user
-func (c *Client) ListJobs() (*JobsResponse, error) {
+// ListJobs returns all jobs for the authenticated user (opts may be nil)
+func (c *Client) ListJobs(opts *ListOptions) (*JobsResponse, error) {
 	var result JobsResponse
-	if err := c.Get("/jobs", &result); err != nil {
+	if err := c.Get("/jobs"+opts.query(), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// ListAllJobs returns every job matching opts, fetching all pages
+func (c *Client) ListAllJobs(opts *ListOptions) (*JobsResponse, error) {
+	all := &JobsResponse{}
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListJobs(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all.Jobs = append(all.Jobs, result.Jobs...)
+		return result.HasMore, len(result.Jobs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.TotalCount = len(all.Jobs)
+	return all, nil
+}
+
 // GetJob returns a single job by ID
 func (c *Client) GetJob(id string) (*Job, error) {
 	var result Job
@@ -217,15 +523,57 @@ func (c *Client) DeleteJob(id string) error {
 	return c.Delete("/jobs/" + id)
 }
 
-// ListJobPings returns recent pings for a job
-func (c *Client) ListJobPings(id string) ([]Ping, error) {
-	var result struct {
-		Pings []Ping `json:"pings"`
+// ListJobPings returns one page of pings for a job, newest first (opts may
+// be nil)
+func (c *Client) ListJobPings(id string, opts *ListOptions) (*PingsResponse, error) {
+	var result PingsResponse
+	if err := c.Get("/jobs/"+id+"/pings"+opts.query(), &result); err != nil {
+		return nil, err
 	}
-	if err := c.Get("/jobs/"+id+"/pings", &result); err != nil {
+	return &result, nil
+}
+
+// ListAllJobPings pages through a job's ping history matching opts. It
+// stops after max pings when max is positive, or once a page reaches back
+// past opts.Since.
+func (c *Client) ListAllJobPings(id string, opts *ListOptions, max int) ([]Ping, error) {
+	var all []Ping
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListJobPings(id, page)
+		if err != nil {
+			return false, 0, err
+		}
+		all = append(all, result.Pings...)
+		if (max > 0 && len(all) >= max) || (len(result.Pings) > 0 && reachedSince(opts, result.Pings[len(result.Pings)-1].CreatedAt)) {
+			return false, len(result.Pings), nil
+		}
+		return result.HasMore, len(result.Pings), nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return result.Pings, nil
+	return all, nil
+}
+
+// Ping types accepted by the ping endpoint
+const (
+	PingStart   = "start"
+	PingSuccess = "success"
+	PingFail    = "fail"
+)
+
+// SendPing records a heartbeat for the job owning token. The success ping
+// hits /pings/<token>; start and fail append the type. A non-zero duration
+// is reported in seconds.
+func (c *Client) SendPing(token, pingType string, duration time.Duration) error {
+	path := "/pings/" + url.PathEscape(token)
+	if pingType != "" && pingType != PingSuccess {
+		path += "/" + pingType
+	}
+	if duration > 0 {
+		path += "?duration=" + strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+	}
+	return c.Post(path, nil, nil)
 }
 
 // ListJobIncidents returns incident history for a job
@@ -239,17 +587,59 @@ func (c *Client) ListJobIncidents(id string) ([]Incident, error) {
 	return result.Incidents, nil
 }
 
+// TestJobWebhook asks the API to deliver a sample payload to the job's
+// webhook URL now, returning the delivery attempt
+func (c *Client) TestJobWebhook(id string) (*WebhookDelivery, error) {
+	var result struct {
+		Delivery WebhookDelivery `json:"delivery"`
+	}
+	if err := c.Post("/jobs/"+id+"/webhook/test", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result.Delivery, nil
+}
+
+// ListJobWebhookDeliveries returns a job's recent webhook delivery
+// attempts, newest first
+func (c *Client) ListJobWebhookDeliveries(id string) ([]WebhookDelivery, error) {
+	var result struct {
+		Deliveries []WebhookDelivery `json:"deliveries"`
+	}
+	if err := c.Get("/jobs/"+id+"/webhook/deliveries", &result); err != nil {
+		return nil, err
+	}
+	return result.Deliveries, nil
+}
+
 // API Monitors methods
 
-// ListApi returns all api monitors for the authenticated user
-func (c *Client) ListApis() (*ApisResponse, error) {
+// ListApi returns all api monitors for the authenticated user (opts may be nil)
+func (c *Client) ListApis(opts *ListOptions) (*ApisResponse, error) {
 	var result ApisResponse
-	if err := c.Get("/api_monitors", &result); err != nil {
+	if err := c.Get("/api_monitors"+opts.query(), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// ListAllApis returns every api monitor matching opts, fetching all pages
+func (c *Client) ListAllApis(opts *ListOptions) (*ApisResponse, error) {
+	all := &ApisResponse{}
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListApis(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all.APIMonitors = append(all.APIMonitors, result.APIMonitors...)
+		return result.HasMore, len(result.APIMonitors), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.TotalCount = len(all.APIMonitors)
+	return all, nil
+}
+
 // GetApi returns a single api monitor by ID
 func (c *Client) GetApi(id string) (*ApiMonitor, error) {
 	var result ApiMonitorResponse
@@ -288,15 +678,77 @@ func (c *Client) DeleteApi(id string) error {
 	return c.Delete("/api_monitors/" + id)
 }
 
-// ListApiChecks returns recent checks for an api monitor
-func (c *Client) ListApiChecks(id string) ([]Check, error) {
-	var result struct {
-		APIChecks []Check `json:"api_checks"`
+// ListApiChecks returns one page of checks for an api monitor, newest
+// first (opts may be nil)
+func (c *Client) ListApiChecks(id string, opts *ListOptions) (*ApiChecksResponse, error) {
+	var result ApiChecksResponse
+	if err := c.Get("/api_monitors/"+id+"/api_checks"+opts.query(), &result); err != nil {
+		return nil, err
 	}
-	if err := c.Get("/api_monitors/"+id+"/api_checks", &result); err != nil {
+	return &result, nil
+}
+
+// ListAllApiChecks pages through an api monitor's check history matching
+// opts. It stops after max checks when max is positive, or once a page
+// reaches back past opts.Since.
+func (c *Client) ListAllApiChecks(id string, opts *ListOptions, max int) ([]Check, error) {
+	var all []Check
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListApiChecks(id, page)
+		if err != nil {
+			return false, 0, err
+		}
+		all = append(all, result.APIChecks...)
+		if (max > 0 && len(all) >= max) || (len(result.APIChecks) > 0 && reachedSince(opts, result.APIChecks[len(result.APIChecks)-1].CreatedAt)) {
+			return false, len(result.APIChecks), nil
+		}
+		return result.HasMore, len(result.APIChecks), nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	return result.APIChecks, nil
+	return all, nil
+}
+
+// EachApiCheckPage pages through an api monitor's check history matching
+// opts, newest first, handing each page to fn as it arrives so long
+// histories can be streamed rather than held in memory. It stops once a
+// page reaches back past opts.Since.
+func (c *Client) EachApiCheckPage(id string, opts *ListOptions, fn func([]Check) error) error {
+	return paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListApiChecks(id, page)
+		if err != nil {
+			return false, 0, err
+		}
+		if err := fn(result.APIChecks); err != nil {
+			return false, 0, err
+		}
+		if len(result.APIChecks) > 0 && reachedSince(opts, result.APIChecks[len(result.APIChecks)-1].CreatedAt) {
+			return false, len(result.APIChecks), nil
+		}
+		return result.HasMore, len(result.APIChecks), nil
+	})
+}
+
+// PruneApiChecks deletes an api monitor's checks from before before and
+// returns how many were deleted
+func (c *Client) PruneApiChecks(id string, before time.Time) (int, error) {
+	params := url.Values{}
+	params.Set("before", before.UTC().Format(time.RFC3339))
+	var result PruneChecksResponse
+	if err := c.doRequest("DELETE", "/api_monitors/"+id+"/api_checks?"+params.Encode(), nil, &result); err != nil {
+		return 0, err
+	}
+	return result.Deleted, nil
+}
+
+// GetApiCheck returns a single check, including the stored response
+func (c *Client) GetApiCheck(id string) (*Check, error) {
+	var result CheckResponse
+	if err := c.Get("/api_checks/"+id, &result); err != nil {
+		return nil, err
+	}
+	return &result.APICheck, nil
 }
 
 // ListApiIncidents returns incident history for an api monitor
@@ -321,15 +773,33 @@ func (c *Client) GetCert(id string) (*SslMonitor, error) {
 	return &result.SslMonitor, nil
 }
 
-// ListCerts returns all ssl monitors for the authenticated user
-func (c *Client) ListCerts() (*SslMonitorsResponse, error) {
+// ListCerts returns all ssl monitors for the authenticated user (opts may be nil)
+func (c *Client) ListCerts(opts *ListOptions) (*SslMonitorsResponse, error) {
 	var result SslMonitorsResponse
-	if err := c.Get("/ssl_monitors", &result); err != nil {
+	if err := c.Get("/ssl_monitors"+opts.query(), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// ListAllCerts returns every ssl monitor matching opts, fetching all pages
+func (c *Client) ListAllCerts(opts *ListOptions) (*SslMonitorsResponse, error) {
+	all := &SslMonitorsResponse{}
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListCerts(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all.SslMonitors = append(all.SslMonitors, result.SslMonitors...)
+		return result.HasMore, len(result.SslMonitors), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.TotalCount = len(all.SslMonitors)
+	return all, nil
+}
+
 // CreateCert creates a new SSL monitor
 func (c *Client) CreateCert(req *CreateSslMonitorRequest) (*SslMonitor, error) {
 	payload := map[string]interface{}{
@@ -372,15 +842,33 @@ func (c *Client) ListCertIncidents(id string) ([]Incident, error) {
 
 // Domain Monitor API methods
 
-// ListDomains returns all domain monitors for the authenticated user
-func (c *Client) ListDomains() (*DomainMonitorsResponse, error) {
+// ListDomains returns all domain monitors for the authenticated user (opts may be nil)
+func (c *Client) ListDomains(opts *ListOptions) (*DomainMonitorsResponse, error) {
 	var result DomainMonitorsResponse
-	if err := c.Get("/domain_monitors", &result); err != nil {
+	if err := c.Get("/domain_monitors"+opts.query(), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// ListAllDomains returns every domain monitor matching opts, fetching all pages
+func (c *Client) ListAllDomains(opts *ListOptions) (*DomainMonitorsResponse, error) {
+	all := &DomainMonitorsResponse{}
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListDomains(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all.DomainMonitors = append(all.DomainMonitors, result.DomainMonitors...)
+		return result.HasMore, len(result.DomainMonitors), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.TotalCount = len(all.DomainMonitors)
+	return all, nil
+}
+
 // GetDomain returns a single domain monitor by ID
 func (c *Client) GetDomain(id string) (*DomainMonitor, error) {
 	var result DomainMonitorResponse
@@ -430,17 +918,47 @@ func (c *Client) ListDomainIncidents(id string) ([]Incident, error) {
 	return result.Incidents, nil
 }
 
+// ListDomainChanges returns every detected change to a domain's WHOIS
+// record, newest first
+func (c *Client) ListDomainChanges(id string) ([]DomainChange, error) {
+	var result struct {
+		Changes []DomainChange `json:"changes"`
+	}
+	if err := c.Get("/domain_monitors/"+id+"/changes", &result); err != nil {
+		return nil, err
+	}
+	return result.Changes, nil
+}
+
 // DNS Monitor API methods
 
-// ListDnsMonitors returns all DNS monitors for the authenticated user
-func (c *Client) ListDnsMonitors() (*DnsMonitorsResponse, error) {
+// ListDnsMonitors returns all DNS monitors for the authenticated user (opts may be nil)
+func (c *Client) ListDnsMonitors(opts *ListOptions) (*DnsMonitorsResponse, error) {
 	var result DnsMonitorsResponse
-	if err := c.Get("/dns_monitors", &result); err != nil {
+	if err := c.Get("/dns_monitors"+opts.query(), &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// ListAllDnsMonitors returns every DNS monitor matching opts, fetching all pages
+func (c *Client) ListAllDnsMonitors(opts *ListOptions) (*DnsMonitorsResponse, error) {
+	all := &DnsMonitorsResponse{}
+	err := paginate(opts, func(page *ListOptions) (bool, int, error) {
+		result, err := c.ListDnsMonitors(page)
+		if err != nil {
+			return false, 0, err
+		}
+		all.DnsMonitors = append(all.DnsMonitors, result.DnsMonitors...)
+		return result.HasMore, len(result.DnsMonitors), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.TotalCount = len(all.DnsMonitors)
+	return all, nil
+}
+
 // GetDnsMonitor returns a single DNS monitor by ID
 func (c *Client) GetDnsMonitor(id string) (*DnsMonitor, error) {
 	var result DnsMonitorResponse
@@ -489,3 +1007,36 @@ func (c *Client) ListDnsMonitorIncidents(id string) ([]Incident, error) {
 	}
 	return result.Incidents, nil
 }
+
+// ListDnsMonitorChanges returns every detected change to a DNS monitor's
+// record, newest first
+func (c *Client) ListDnsMonitorChanges(id string) ([]DnsChange, error) {
+	var result struct {
+		Changes []DnsChange `json:"changes"`
+	}
+	if err := c.Get("/dns_monitors/"+id+"/changes", &result); err != nil {
+		return nil, err
+	}
+	return result.Changes, nil
+}
+
+// GetIncident gets an incident with its checks and alerts
+func (c *Client) GetIncident(id string) (*IncidentDetail, error) {
+	var result IncidentDetailResponse
+	if err := c.Get("/incidents/"+id, &result); err != nil {
+		return nil, err
+	}
+	return &result.Incident, nil
+}
+
+// UpdateIncident acknowledges an incident and/or adds a note to it
+func (c *Client) UpdateIncident(id string, req *UpdateIncidentRequest) (*Incident, error) {
+	payload := map[string]any{
+		"incident": req,
+	}
+	var result IncidentResponse
+	if err := c.Put("/incidents/"+id, payload, &result); err != nil {
+		return nil, err
+	}
+	return &result.Incident, nil
+}
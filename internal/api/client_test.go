@@ -2,9 +2,11 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/scookdev/groovekit-cli/internal/config"
 	"github.com/stretchr/testify/assert"
@@ -124,3 +126,414 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, "test-token", client.Token)
 	assert.NotNil(t, client.HTTPClient)
 }
+
+// TestListJobs_Options tests that list options are sent as query parameters
+func TestListJobs_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/jobs", r.URL.Path)
+		assert.Equal(t, "paused", r.URL.Query().Get("status"))
+		assert.Equal(t, []string{"env=prod", "team"}, r.URL.Query()["tag"])
+		assert.Empty(t, r.URL.Query().Get("name"))
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jobs": []interface{}{}})
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	_, err := client.ListJobs(&ListOptions{Status: "paused", Tags: []string{"env=prod", "team"}})
+	require.NoError(t, err)
+}
+
+// TestListAllJobs_Paginates tests that every page is fetched until has_more is false
+func TestListAllJobs_Paginates(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		switch page {
+		case "1":
+			_ = json.NewEncoder(w).Encode(JobsResponse{Jobs: []Job{{ID: "a"}, {ID: "b"}}, HasMore: true, TotalCount: 3})
+		default:
+			_ = json.NewEncoder(w).Encode(JobsResponse{Jobs: []Job{{ID: "c"}}, HasMore: false, TotalCount: 3})
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	result, err := client.ListAllJobs(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.Len(t, result.Jobs, 3)
+	assert.Equal(t, 3, result.TotalCount)
+	assert.False(t, result.HasMore)
+}
+
+// TestListAllApiChecks_Paginates tests paging through check history with a
+// time range and a cap
+func TestListAllApiChecks_Paginates(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_monitors/mon-1/api_checks", r.URL.Path)
+		assert.Equal(t, "2026-03-01T00:00:00Z", r.URL.Query().Get("since"))
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		switch page {
+		case "1":
+			_ = json.NewEncoder(w).Encode(ApiChecksResponse{APIChecks: []Check{{ID: "a", CreatedAt: "2026-03-03T00:00:00Z"}, {ID: "b", CreatedAt: "2026-03-02T00:00:00Z"}}, HasMore: true})
+		case "2":
+			_ = json.NewEncoder(w).Encode(ApiChecksResponse{APIChecks: []Check{{ID: "c", CreatedAt: "2026-03-01T12:00:00Z"}, {ID: "d", CreatedAt: "2026-02-28T00:00:00Z"}}, HasMore: true})
+		default:
+			t.Errorf("unexpected page %s", page)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	// Stops once a page reaches back past Since, even though has_more is set
+	checks, err := client.ListAllApiChecks("mon-1", &ListOptions{Since: since}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.Len(t, checks, 4)
+
+	// Stops once max checks are fetched
+	pages = nil
+	checks, err = client.ListAllApiChecks("mon-1", &ListOptions{Since: since}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1"}, pages)
+	assert.Len(t, checks, 2)
+}
+
+// TestEachApiCheckPage tests handing each page to the callback and stopping
+// on its error
+func TestEachApiCheckPage(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_ = json.NewEncoder(w).Encode(ApiChecksResponse{APIChecks: []Check{{ID: "a", CreatedAt: "2026-03-03T00:00:00Z"}}, HasMore: true})
+		case "2":
+			_ = json.NewEncoder(w).Encode(ApiChecksResponse{APIChecks: []Check{{ID: "b", CreatedAt: "2026-02-28T00:00:00Z"}}, HasMore: true})
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	var ids []string
+	err := client.EachApiCheckPage("mon-1", &ListOptions{Since: since}, func(checks []Check) error {
+		for _, check := range checks {
+			ids = append(ids, check.ID)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids)
+
+	stop := errors.New("stop")
+	calls := 0
+	err = client.EachApiCheckPage("mon-1", &ListOptions{Since: since}, func([]Check) error {
+		calls++
+		return stop
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
+
+// TestListJobPings_Until tests that --until is sent as a query parameter
+func TestListJobPings_Until(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/jobs/job-1/pings", r.URL.Path)
+		assert.Equal(t, "2026-03-01T10:00:00Z", r.URL.Query().Get("until"))
+		assert.Empty(t, r.URL.Query().Get("since"))
+		_ = json.NewEncoder(w).Encode(PingsResponse{Pings: []Ping{{ID: "p1"}}})
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	until := time.Date(2026, 3, 1, 11, 0, 0, 0, time.FixedZone("CET", 3600))
+	result, err := client.ListJobPings("job-1", &ListOptions{Until: until})
+	require.NoError(t, err)
+	assert.Len(t, result.Pings, 1)
+}
+
+// TestListAllAlerts tests the alerts query and paging until --since
+func TestListAllAlerts(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/alerts", r.URL.Path)
+		assert.Equal(t, "sms", r.URL.Query().Get("type"))
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		resp := AlertsResponse{HasMore: true}
+		switch page {
+		case "1":
+			resp.Alerts = []Alert{{ID: "a1", SentAt: "2026-03-09T10:00:00Z"}}
+		case "2":
+			resp.Alerts = []Alert{{ID: "a2", SentAt: "2026-02-01T10:00:00Z"}}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	alerts, err := client.ListAllAlerts(&ListOptions{Type: AlertSMS, Since: since}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.Len(t, alerts, 2)
+}
+
+// TestClient_CustomHeaders tests the token header and extra headers from config
+func TestClient_CustomHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-token", r.Header.Get("X-GrooveKit-Token"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "client.access", r.Header.Get("CF-Access-Client-Id"))
+
+		_ = json.NewEncoder(w).Encode(Account{ID: "u1"})
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{
+		APIBaseURL:  server.URL,
+		AccessToken: "test-token",
+		TokenHeader: "X-GrooveKit-Token",
+		Headers:     map[string]string{"CF-Access-Client-Id": "client.access"},
+	})
+
+	_, err := client.GetAccount()
+	require.NoError(t, err)
+}
+
+// TestSendPing tests the ping endpoint path for each ping type
+func TestSendPing(t *testing.T) {
+	tests := []struct {
+		pingType string
+		duration time.Duration
+		path     string
+		query    string
+	}{
+		{PingSuccess, 0, "/pings/tok123", ""},
+		{PingStart, 0, "/pings/tok123/start", ""},
+		{PingFail, 90 * time.Second, "/pings/tok123/fail", "duration=90.000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pingType, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, tt.path, r.URL.Path)
+				assert.Equal(t, tt.query, r.URL.RawQuery)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := NewClient(&config.Config{APIBaseURL: server.URL})
+			require.NoError(t, client.SendPing("tok123", tt.pingType, tt.duration))
+		})
+	}
+}
+
+// TestGetApiCheck tests fetching a single check with its stored response
+func TestGetApiCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api_checks/check-1", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"api_check":{"id":"check-1","status_code":503,"response_body":"{}","response_headers":{"Retry-After":"30"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	check, err := client.GetApiCheck("check-1")
+	require.NoError(t, err)
+	assert.Equal(t, 503, check.StatusCode)
+	require.NotNil(t, check.ResponseBody)
+	assert.Equal(t, "{}", *check.ResponseBody)
+	assert.Equal(t, "30", check.ResponseHeaders["Retry-After"])
+}
+
+// TestJobWebhook tests sending a test webhook and listing deliveries
+func TestJobWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /jobs/job-1/webhook/test":
+			_, _ = w.Write([]byte(`{"delivery":{"id":"d1","event":"job.test","status_code":204,"response_time":91.5,"success":true}}`))
+		case "GET /jobs/job-1/webhook/deliveries":
+			_, _ = w.Write([]byte(`{"deliveries":[{"id":"d1","success":true},{"id":"d0","status_code":null,"success":false,"error_message":"timeout"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	delivery, err := client.TestJobWebhook("job-1")
+	require.NoError(t, err)
+	assert.True(t, delivery.Success)
+	require.NotNil(t, delivery.StatusCode)
+	assert.Equal(t, 204, *delivery.StatusCode)
+
+	deliveries, err := client.ListJobWebhookDeliveries("job-1")
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Nil(t, deliveries[1].StatusCode)
+	assert.Equal(t, "timeout", *deliveries[1].ErrorMessage)
+}
+
+// TestGetIncident tests fetching an incident with its checks and alerts
+func TestGetIncident(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/incidents/inc-1", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"incident":{"id":"inc-1","started_at":"2026-03-01T10:00:00Z","resource_type":"api","resource_name":"Checkout","checks":[{"success":false,"status_code":503,"error_message":"Service Unavailable","created_at":"2026-03-01T10:00:00Z"},{"success":true,"status_code":200,"created_at":"2026-03-01T10:10:00Z"}],"alerts":[{"id":"al-1","alert_type":"email","event":"down","delivered":true,"sent_at":"2026-03-01T10:01:00Z"}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	incident, err := client.GetIncident("inc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "inc-1", incident.ID)
+	assert.Equal(t, "Checkout", incident.ResourceName)
+	require.Len(t, incident.Checks, 2)
+	assert.Equal(t, 503, incident.Checks[0].StatusCode)
+	assert.True(t, incident.Checks[1].Success)
+	require.Len(t, incident.Alerts, 1)
+	assert.Equal(t, "email", incident.Alerts[0].AlertType)
+}
+
+// TestListDomainChanges tests listing a domain monitor's WHOIS changes
+func TestListDomainChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/domain_monitors/dom-1/changes", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"changes":[{"id":"chg-1","field":"nameservers","old_value":"ns1.example.com, ns2.example.com","new_value":"ns1.attacker.test","detected_at":"2026-03-01T10:00:00Z"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	changes, err := client.ListDomainChanges("dom-1")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "nameservers", changes[0].Field)
+	assert.Equal(t, "ns1.attacker.test", changes[0].NewValue)
+}
+
+// TestListRegions tests listing probe locations
+func TestListRegions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/regions", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"regions":[{"id":"us-east","name":"US East","location":"Virginia, US","default":true},{"id":"eu-west","name":"EU West","location":"Dublin, IE"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	regions, err := client.ListRegions()
+	require.NoError(t, err)
+	require.Len(t, regions, 2)
+	assert.Equal(t, "us-east", regions[0].ID)
+	assert.True(t, regions[0].Default)
+	assert.Equal(t, "Dublin, IE", regions[1].Location)
+}
+
+// TestGetRetentionPolicy tests reading how long history is kept
+func TestGetRetentionPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/users/me/retention", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"retention":{"check_days":90,"ping_days":30,"stored_checks":125000,"stored_pings":4200}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	retention, err := client.GetRetentionPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, 90, retention.CheckDays)
+	assert.Equal(t, 30, retention.PingDays)
+	assert.Equal(t, 125000, retention.StoredChecks)
+}
+
+// TestPruneApiChecks tests deleting an API monitor's old checks
+func TestPruneApiChecks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/api_monitors/api-1/api_checks", r.URL.Path)
+		assert.Equal(t, "2026-01-02T03:04:05Z", r.URL.Query().Get("before"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"deleted":1234}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	before := time.Date(2026, 1, 2, 4, 4, 5, 0, time.FixedZone("CET", 3600))
+	deleted, err := client.PruneApiChecks("api-1", before)
+	require.NoError(t, err)
+	assert.Equal(t, 1234, deleted)
+}
+
+// TestListDnsMonitorChanges tests listing a DNS monitor's record changes
+func TestListDnsMonitorChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/dns_monitors/dns-1/changes", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"changes":[{"id":"chg-1","old_values":["mail.example.com"],"new_values":["mx.example.net"],"detected_at":"2026-03-01T10:00:00Z"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	changes, err := client.ListDnsMonitorChanges("dns-1")
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, []string{"mail.example.com"}, changes[0].OldValues)
+	assert.Equal(t, []string{"mx.example.net"}, changes[0].NewValues)
+	assert.Equal(t, "2026-03-01T10:00:00Z", changes[0].DetectedAt)
+}
+
+// TestUpdateIncident tests acknowledging an incident with a note
+func TestUpdateIncident(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/incidents/inc-1", r.URL.Path)
+
+		var payload map[string]map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, true, payload["incident"]["acknowledged"])
+		assert.Equal(t, "deploying fix", payload["incident"]["note"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"incident":{"id":"inc-1","started_at":"2026-03-01T10:00:00Z","acknowledged_at":"2026-03-01T10:05:00Z","acknowledged_by":"me@example.com","notes":[{"body":"deploying fix","created_at":"2026-03-01T10:05:00Z"}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	acknowledged := true
+	incident, err := client.UpdateIncident("inc-1", &UpdateIncidentRequest{Acknowledged: &acknowledged, Note: "deploying fix"})
+	require.NoError(t, err)
+	require.NotNil(t, incident.AcknowledgedAt)
+	assert.Equal(t, "me@example.com", incident.AcknowledgedBy)
+	require.Len(t, incident.Notes, 1)
+	assert.Equal(t, "deploying fix", incident.Notes[0].Body)
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// DefaultConcurrency is how many requests commands that combine several
+// endpoints run at once. It keeps large accounts fast without bursting
+// through the rate limit.
+const DefaultConcurrency = 8
+
+// Each calls fetch for every index from 0 to n-1, running at most limit
+// calls at once, and returns their errors by index. Once ctx is done no
+// more calls are started, and those left out fail with the context's error.
+// fetch stores its results itself, typically into a slice by index.
+func Each(ctx context.Context, n, limit int, fetch func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	if n == 0 {
+		return errs
+	}
+	limit = min(max(limit, 1), n)
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(limit)
+	for range limit {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fetch(ctx, i)
+			}
+		}()
+	}
+	for i := range n {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return errs
+}
+
+// EachUntilError is Each for callers that need every fetch to succeed. The
+// first failure cancels the calls not yet started, and the failure with the
+// lowest index is returned, so the error reported doesn't depend on timing.
+func EachUntilError(ctx context.Context, n, limit int, fetch func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := Each(ctx, n, limit, func(ctx context.Context, i int) error {
+		err := fetch(ctx, i)
+		if err != nil {
+			cancel()
+		}
+		return err
+	})
+
+	// Calls skipped after a failure fail with context.Canceled; report the
+	// failure that caused it, unless the caller's own context was canceled
+	var canceled error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case errors.Is(err, context.Canceled) && canceled == nil:
+			canceled = err
+		case !errors.Is(err, context.Canceled):
+			return err
+		}
+	}
+	return canceled
+}
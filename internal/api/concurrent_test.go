@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEachBoundsParallelism tests that Each runs every fetch, never more
+// than the limit at once
+func TestEachBoundsParallelism(t *testing.T) {
+	var running, peak atomic.Int32
+	results := make([]int, 20)
+
+	errs := Each(context.Background(), len(results), 3, func(_ context.Context, i int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		results[i] = i * i
+		return nil
+	})
+
+	assert.Len(t, errs, 20)
+	for i, err := range errs {
+		assert.NoError(t, err)
+		assert.Equal(t, i*i, results[i])
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+// TestEachErrorsByIndex tests that failures are reported against their
+// index without stopping the other fetches
+func TestEachErrorsByIndex(t *testing.T) {
+	boom := errors.New("boom")
+	errs := Each(context.Background(), 4, 2, func(_ context.Context, i int) error {
+		if i == 2 {
+			return boom
+		}
+		return nil
+	})
+	assert.Equal(t, []error{nil, nil, boom, nil}, errs)
+
+	assert.Empty(t, Each(context.Background(), 0, 2, func(context.Context, int) error {
+		t.Fatal("fetch called with nothing to fetch")
+		return nil
+	}))
+}
+
+// TestEachCanceled tests that no fetches start once the context is done
+func TestEachCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls atomic.Int32
+	errs := Each(ctx, 3, 2, func(context.Context, int) error {
+		calls.Add(1)
+		return nil
+	})
+	assert.Zero(t, calls.Load())
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
+
+// TestEachUntilError tests that the first failure stops later fetches and
+// is the error returned
+func TestEachUntilError(t *testing.T) {
+	boom := errors.New("boom")
+	var calls atomic.Int32
+	err := EachUntilError(context.Background(), 50, 1, func(_ context.Context, i int) error {
+		calls.Add(1)
+		if i == 1 {
+			return boom
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, int32(2), calls.Load())
+
+	assert.NoError(t, EachUntilError(context.Background(), 5, 2, func(context.Context, int) error { return nil }))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = EachUntilError(ctx, 5, 2, func(context.Context, int) error { return nil })
+	assert.ErrorIs(t, err, context.Canceled)
+}
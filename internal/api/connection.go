@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Connection describes one round trip to the API, for diagnostics
+type Connection struct {
+	StatusCode int
+	Latency    time.Duration
+	// ServerTime is the response's Date header; zero when it was missing
+	ServerTime time.Time
+}
+
+// CheckConnection requests the account with the configured credentials and
+// reports how the API responded. Only network failures are errors, so
+// callers can tell an unreachable API from a rejected token.
+func (c *Client) CheckConnection(ctx context.Context) (*Connection, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.BaseURL+"/users/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(req, true)
+
+	start := time.Now()
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	c.recordRateLimit(resp.Header)
+
+	conn := &Connection{StatusCode: resp.StatusCode, Latency: time.Since(start)}
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		conn.ServerTime = date
+	}
+	return conn, nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckConnection tests reporting the status and server time
+func TestCheckConnection(t *testing.T) {
+	serverTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/me", r.URL.Path)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "test-token"})
+	conn, err := client.CheckConnection(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, conn.StatusCode)
+	assert.True(t, conn.ServerTime.Equal(serverTime))
+	assert.Positive(t, conn.Latency)
+}
+
+// TestCheckConnection_Rejected tests that a rejected token isn't an error
+func TestCheckConnection_Rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "expired"})
+	conn, err := client.CheckConnection(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, conn.StatusCode)
+}
+
+// TestCheckConnection_Unreachable tests that network failures are errors
+func TestCheckConnection_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+	_, err := client.CheckConnection(context.Background())
+
+	assert.Error(t, err)
+}
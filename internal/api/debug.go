@@ -0,0 +1,164 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// debugLog receives a line per request when debug logging is on
+var (
+	debugLog    io.Writer
+	debugBodies bool
+	debugMu     sync.Mutex
+)
+
+// maxDebugBody caps how much of each body is logged
+const maxDebugBody = 4096
+
+// redacted replaces secret values in logged bodies
+const redacted = "[redacted]"
+
+// secretKeys are substrings of JSON keys whose values are redacted from
+// logged bodies, matched case-insensitively. They cover login passwords,
+// access and ping tokens, webhook secrets, and monitor auth headers.
+var secretKeys = []string{"password", "token", "secret", "authorization", "api_key", "api-key", "apikey", "cookie"}
+
+// SetDebug logs the method, URL, status, and timing of every request made
+// by clients created afterwards to w. With bodies, request and response
+// bodies are logged too, with secrets redacted. A nil w turns logging off.
+func SetDebug(w io.Writer, bodies bool) {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	debugLog = w
+	debugBodies = bodies
+}
+
+// newHTTPClient returns the HTTP client for API requests, counting them and
+// logging them when debugging is on
+func newHTTPClient() *http.Client {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	transport := baseTransport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if debugLog != nil {
+		transport = &debugTransport{next: transport, w: debugLog, bodies: debugBodies}
+	}
+	return &http.Client{Transport: &countingTransport{next: transport}}
+}
+
+// debugTransport logs requests as they are made
+type debugTransport struct {
+	next   http.RoundTripper
+	w      io.Writer
+	bodies bool
+}
+
+// RoundTrip logs a request and its response or failure
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target := req.Method + " " + req.URL.String()
+	t.logf("%s", target)
+	if t.bodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			_ = body.Close()
+			t.logBody("request", data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		t.logf("%s failed after %s: %v", target, elapsed, err)
+		return nil, err
+	}
+
+	t.logf("%s -> %s (%s)", target, resp.Status, elapsed)
+	if t.bodies {
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		t.logBody("response", data)
+	}
+	return resp, nil
+}
+
+// logBody logs a redacted, truncated body
+func (t *debugTransport) logBody(kind string, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	body := redactBody(data)
+	if len(body) > maxDebugBody {
+		body = fmt.Sprintf("%s... (%d more bytes)", body[:maxDebugBody], len(body)-maxDebugBody)
+	}
+	t.logf("%s body: %s", kind, body)
+}
+
+// logf writes one debug line. Requests made concurrently share the writer,
+// so lines are written whole.
+func (t *debugTransport) logf(format string, args ...interface{}) {
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	fmt.Fprintf(t.w, "debug: "+format+"\n", args...)
+}
+
+// redactBody replaces secret values in a JSON body. Bodies that aren't JSON,
+// such as HTML error pages, are logged as they are.
+func redactBody(data []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return strings.TrimSpace(string(data))
+	}
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		return strings.TrimSpace(string(data))
+	}
+	return strings.TrimSpace(out.String())
+}
+
+// redactValue walks a decoded JSON value, redacting the values of secret
+// keys
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSecretKey(key) {
+				if value != nil && value != "" {
+					v[key] = redacted
+				}
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+	return v
+}
+
+// isSecretKey reports whether a JSON key names a secret
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
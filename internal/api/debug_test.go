@@ -0,0 +1,82 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDebugLogging tests that requests are logged with redacted bodies
+func TestDebugLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "mock-token-123"})
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	SetDebug(&log, true)
+	t.Cleanup(func() { SetDebug(nil, false) })
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL})
+	token, err := client.Login("test@example.com", "password123")
+	require.NoError(t, err)
+	assert.Equal(t, "mock-token-123", token, "the response body is still readable after logging")
+
+	assert.Contains(t, log.String(), "debug: POST "+server.URL+"/tokens\n")
+	assert.Contains(t, log.String(), "debug: POST "+server.URL+"/tokens -> 201 Created (")
+	assert.Contains(t, log.String(), `request body: {"email":"test@example.com","password":"[redacted]"}`)
+	assert.Contains(t, log.String(), `response body: {"access_token":"[redacted]"}`)
+	assert.NotContains(t, log.String(), "password123")
+	assert.NotContains(t, log.String(), "mock-token-123")
+}
+
+// TestDebugLoggingWithoutBodies tests that bodies are only logged when asked for
+func TestDebugLoggingWithoutBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jobs":[]}`))
+	}))
+	defer server.Close()
+
+	var log bytes.Buffer
+	SetDebug(&log, false)
+	t.Cleanup(func() { SetDebug(nil, false) })
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "t"})
+	_, err := client.ListJobs(nil)
+	require.NoError(t, err)
+	assert.Contains(t, log.String(), "-> 200 OK (")
+	assert.NotContains(t, log.String(), "body")
+}
+
+// TestRedactBody tests redacting secrets from nested JSON bodies
+func TestRedactBody(t *testing.T) {
+	body := redactBody([]byte(`{"api_monitor":{"headers":{"Authorization":"Bearer s3cret","X-Env":"prod"},"url":"https://x.io/?a=1&b=2"},"job":{"webhook_secret":"","ping_token":"abc"}}`))
+	assert.Equal(t, `{"api_monitor":{"headers":{"Authorization":"[redacted]","X-Env":"prod"},"url":"https://x.io/?a=1&b=2"},"job":{"ping_token":"[redacted]","webhook_secret":""}}`, body)
+
+	assert.Equal(t, "<html>Bad Gateway</html>", redactBody([]byte("<html>Bad Gateway</html>\n")))
+}
+
+// TestRequestCount tests every request sent is counted
+func TestRequestCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "token"})
+	before := RequestCount()
+	_, err := client.CheckConnection(context.Background())
+	require.NoError(t, err)
+	_, err = client.CheckConnection(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), RequestCount()-before)
+}
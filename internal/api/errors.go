@@ -0,0 +1,187 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kinds of API error, matched with errors.Is against the *Error a request
+// returns:
+//
+//	if errors.Is(err, api.ErrNotFound) { ... }
+var (
+	// ErrUnauthorized means the token is missing, invalid, or expired
+	// (401), or lacks access to the resource (403)
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound means the resource doesn't exist (404)
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited means too many requests were made (429); see
+	// Error.RetryAfter
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation means the API rejected the request's fields (400 or
+	// 422); see Error.Fields
+	ErrValidation = errors.New("validation failed")
+)
+
+// Error is returned when the API responds with a non-2xx status
+type Error struct {
+	StatusCode int
+	Message    string
+	// Fields maps each rejected field to the API's messages about it, for
+	// validation errors
+	Fields map[string][]string
+	// RetryAfter is how long the API asked to wait before retrying, from
+	// the Retry-After header of a rate limited response
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+	if details := e.fieldDetails(); details != "" {
+		msg += ": " + details
+	}
+	return msg
+}
+
+// Is matches the error against ErrUnauthorized, ErrNotFound,
+// ErrRateLimited, and ErrValidation
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.IsAuth()
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// fieldDetails formats validation messages as "name can't be blank;
+// interval must be greater than 0", in field order
+func (e *Error) fieldDetails() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var details []string
+	for _, name := range names {
+		for _, message := range e.Fields[name] {
+			if name == "base" {
+				details = append(details, message)
+			} else {
+				details = append(details, name+" "+message)
+			}
+		}
+	}
+	return strings.Join(details, "; ")
+}
+
+// IsAuth reports whether the request was rejected for missing or invalid credentials
+func (e *Error) IsAuth() bool {
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsQuota reports whether the request was rejected by a plan limit or rate limit
+func (e *Error) IsQuota() bool {
+	return e.StatusCode == http.StatusPaymentRequired || e.StatusCode == http.StatusTooManyRequests
+}
+
+// newError builds an Error from a response body, preferring the JSON error
+// message and never dumping HTML error pages
+func newError(statusCode int, body []byte) *Error {
+	bodyStr := string(body)
+	e := &Error{StatusCode: statusCode, Message: http.StatusText(statusCode)}
+
+	// Check if response looks like HTML (common for Rails error pages)
+	if len(bodyStr) > 0 && (bodyStr[0] == '<' || strings.Contains(bodyStr, "<!DOCTYPE")) {
+		return e
+	}
+
+	// Try to parse as JSON error
+	var errResp struct {
+		Error   string          `json:"error"`
+		Message string          `json:"message"`
+		Errors  json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &errResp); err == nil {
+		e.Fields = parseFieldErrors(errResp.Errors)
+		if errResp.Error != "" {
+			e.Message = errResp.Error
+			return e
+		}
+		if errResp.Message != "" {
+			e.Message = errResp.Message
+			return e
+		}
+		if e.Fields != nil {
+			return e
+		}
+	}
+
+	// Fallback to raw body if it's short
+	if len(bodyStr) < 200 {
+		e.Message = bodyStr
+	}
+	return e
+}
+
+// parseFieldErrors reads validation errors given as {"field": ["message"]},
+// {"field": "message"}, or a list of full messages, which are filed under
+// "base"
+func parseFieldErrors(raw json.RawMessage) map[string][]string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		if len(list) == 0 {
+			return nil
+		}
+		return map[string][]string{"base": list}
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil || len(fields) == 0 {
+		return nil
+	}
+	result := make(map[string][]string, len(fields))
+	for name, value := range fields {
+		var messages []string
+		if err := json.Unmarshal(value, &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(value, &message); err != nil {
+				continue
+			}
+			messages = []string{message}
+		}
+		result[name] = messages
+	}
+	return result
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP
+// date, returning zero when it is missing or invalid
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now).Round(time.Second)
+	}
+	return 0
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestErrorIs tests matching API errors against the error kinds
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusBadRequest, ErrValidation},
+	}
+	kinds := []error{ErrUnauthorized, ErrNotFound, ErrRateLimited, ErrValidation}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("failed to get job: %w", &Error{StatusCode: tt.status})
+		for _, kind := range kinds {
+			assert.Equal(t, kind == tt.kind, errors.Is(err, kind), "status %d is %v", tt.status, kind)
+		}
+	}
+	assert.False(t, errors.Is(&Error{StatusCode: 500}, ErrNotFound))
+}
+
+// TestNewErrorFields tests reading validation errors from the response body
+func TestNewErrorFields(t *testing.T) {
+	e := newError(422, []byte(`{"errors":{"name":["can't be blank"],"check_interval":["must be greater than 0"]}}`))
+	assert.Equal(t, []string{"can't be blank"}, e.Fields["name"])
+	assert.Equal(t, "API error (status 422): Unprocessable Entity: check_interval must be greater than 0; name can't be blank", e.Error())
+
+	e = newError(422, []byte(`{"error":"Validation failed","errors":["Name can't be blank"]}`))
+	assert.Equal(t, "API error (status 422): Validation failed: Name can't be blank", e.Error())
+
+	e = newError(400, []byte(`{"errors":{"url":"is invalid"}}`))
+	assert.Equal(t, []string{"is invalid"}, e.Fields["url"])
+
+	e = newError(404, []byte(`{"error":"Not found"}`))
+	assert.Nil(t, e.Fields)
+	assert.Equal(t, "API error (status 404): Not found", e.Error())
+}
+
+// TestRetryAfter tests parsing the Retry-After header
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, 30*time.Second, retryAfter("30", now))
+	assert.Equal(t, 2*time.Minute, retryAfter(now.Add(2*time.Minute).Format(http.TimeFormat), now))
+	assert.Zero(t, retryAfter("", now))
+	assert.Zero(t, retryAfter("soon", now))
+}
+
+// TestDoRequestRetryAfter tests that rate limited responses carry Retry-After
+func TestDoRequestRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "12")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"error":"Rate limit exceeded"}`))
+	}))
+	defer server.Close()
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "t"})
+
+	_, err := client.GetJob("abc")
+	var apiErr *Error
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.Equal(t, 12*time.Second, apiErr.RetryAfter)
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import "time"
+
+// JobsAPI manages cron job monitors and their pings
+type JobsAPI interface {
+	ListJobs(opts *ListOptions) (*JobsResponse, error)
+	ListAllJobs(opts *ListOptions) (*JobsResponse, error)
+	GetJob(id string) (*Job, error)
+	CreateJob(req *CreateJobRequest) (*Job, error)
+	UpdateJob(id string, req *UpdateJobRequest) (*Job, error)
+	DeleteJob(id string) error
+	MoveJob(id, projectID string) error
+	SnoozeJob(id string, until time.Time) error
+	ListJobPings(id string, opts *ListOptions) (*PingsResponse, error)
+	ListAllJobPings(id string, opts *ListOptions, max int) ([]Ping, error)
+	SendPing(token, pingType string, duration time.Duration) error
+	ListJobIncidents(id string) ([]Incident, error)
+	TestJobWebhook(id string) (*WebhookDelivery, error)
+	ListJobWebhookDeliveries(id string) ([]WebhookDelivery, error)
+}
+
+// MonitorsAPI manages API monitors and their check history
+type MonitorsAPI interface {
+	ListApis(opts *ListOptions) (*ApisResponse, error)
+	ListAllApis(opts *ListOptions) (*ApisResponse, error)
+	GetApi(id string) (*ApiMonitor, error)
+	CreateApi(req *CreateApiRequest) (*ApiMonitor, error)
+	UpdateApi(id string, req *UpdateApiRequest) (*ApiMonitor, error)
+	DeleteApi(id string) error
+	MoveApi(id, projectID string) error
+	SnoozeApi(id string, until time.Time) error
+	ListApiChecks(id string, opts *ListOptions) (*ApiChecksResponse, error)
+	ListAllApiChecks(id string, opts *ListOptions, max int) ([]Check, error)
+	EachApiCheckPage(id string, opts *ListOptions, fn func([]Check) error) error
+	GetApiCheck(id string) (*Check, error)
+	PruneApiChecks(id string, before time.Time) (int, error)
+	ListApiIncidents(id string) ([]Incident, error)
+}
+
+// CertsAPI manages SSL certificate monitors
+type CertsAPI interface {
+	ListCerts(opts *ListOptions) (*SslMonitorsResponse, error)
+	ListAllCerts(opts *ListOptions) (*SslMonitorsResponse, error)
+	GetCert(id string) (*SslMonitor, error)
+	CreateCert(req *CreateSslMonitorRequest) (*SslMonitor, error)
+	UpdateCert(id string, req *UpdateSslMonitorRequest) (*SslMonitor, error)
+	DeleteCert(id string) error
+	MoveCert(id, projectID string) error
+	SnoozeCert(id string, until time.Time) error
+	ListCertIncidents(id string) ([]Incident, error)
+}
+
+// DomainsAPI manages domain expiration monitors
+type DomainsAPI interface {
+	ListDomains(opts *ListOptions) (*DomainMonitorsResponse, error)
+	ListAllDomains(opts *ListOptions) (*DomainMonitorsResponse, error)
+	GetDomain(id string) (*DomainMonitor, error)
+	CreateDomain(req *CreateDomainMonitorRequest) (*DomainMonitor, error)
+	UpdateDomain(id string, req *UpdateDomainMonitorRequest) (*DomainMonitor, error)
+	DeleteDomain(id string) error
+	MoveDomain(id, projectID string) error
+	SnoozeDomain(id string, until time.Time) error
+	ListDomainIncidents(id string) ([]Incident, error)
+	ListDomainChanges(id string) ([]DomainChange, error)
+}
+
+// DNSAPI manages DNS record monitors
+type DNSAPI interface {
+	ListDnsMonitors(opts *ListOptions) (*DnsMonitorsResponse, error)
+	ListAllDnsMonitors(opts *ListOptions) (*DnsMonitorsResponse, error)
+	GetDnsMonitor(id string) (*DnsMonitor, error)
+	CreateDnsMonitor(req *CreateDnsMonitorRequest) (*DnsMonitor, error)
+	UpdateDnsMonitor(id string, req *UpdateDnsMonitorRequest) (*DnsMonitor, error)
+	DeleteDnsMonitor(id string) error
+	MoveDnsMonitor(id, projectID string) error
+	SnoozeDnsMonitor(id string, until time.Time) error
+	ListDnsMonitorIncidents(id string) ([]Incident, error)
+	ListDnsMonitorChanges(id string) ([]DnsChange, error)
+}
+
+// ProjectsAPI manages the projects resources are grouped into
+type ProjectsAPI interface {
+	ListProjects() ([]Project, error)
+	GetProject(id string) (*Project, error)
+	CreateProject(req *CreateProjectRequest) (*Project, error)
+	DeleteProject(id string) error
+}
+
+// IncidentsAPI reads and acknowledges incidents across resource types
+type IncidentsAPI interface {
+	GetIncident(id string) (*IncidentDetail, error)
+	UpdateIncident(id string, req *UpdateIncidentRequest) (*Incident, error)
+}
+
+// AccountAPI covers the account itself: usage, history retention, alert
+// preferences, sent alerts, and the probe regions available to it
+type AccountAPI interface {
+	GetAccount() (*Account, error)
+	GetRetentionPolicy() (*RetentionPolicy, error)
+	GetNotificationPreferences() (*NotificationPreferences, error)
+	UpdateNotificationPreferences(req *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error)
+	ListAlerts(opts *ListOptions) (*AlertsResponse, error)
+	ListAllAlerts(opts *ListOptions, max int) ([]Alert, error)
+	ListRegions() ([]Region, error)
+}
+
+// APIClient is everything commands need from an authenticated client, so
+// they can be tested against a fake such as testutil.FakeAPI. *Client
+// implements it; Login and CheckConnection stay on *Client since they are
+// used before there is an authenticated client.
+type APIClient interface {
+	JobsAPI
+	MonitorsAPI
+	CertsAPI
+	DomainsAPI
+	DNSAPI
+	ProjectsAPI
+	IncidentsAPI
+	AccountAPI
+
+	RateLimit() (RateLimit, bool)
+	PaceRequests(notify func(wait time.Duration))
+	// Identity returns the API URL and token the client uses, e.g. to key
+	// local caches by account
+	Identity() (baseURL, token string)
+}
+
+var _ APIClient = (*Client)(nil)
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit is the API's request allowance as of the latest response
+type RateLimit struct {
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	Reset     time.Time `json:"reset"`
+}
+
+// maxPaceWait bounds how long a paced request waits for the rate limit to
+// reset. Past this the request is sent anyway and the API decides.
+const maxPaceWait = 2 * time.Minute
+
+// parseRateLimit reads the X-RateLimit-Limit, -Remaining, and -Reset
+// headers, or their unprefixed RateLimit-* equivalents. Reset may be a Unix
+// time or a number of seconds from now.
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	get := func(name string) (int64, bool) {
+		value := header.Get("X-RateLimit-" + name)
+		if value == "" {
+			value = header.Get("RateLimit-" + name)
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		return n, err == nil
+	}
+
+	limit, ok := get("Limit")
+	if !ok {
+		return RateLimit{}, false
+	}
+	remaining, ok := get("Remaining")
+	if !ok {
+		return RateLimit{}, false
+	}
+	rl := RateLimit{Limit: int(limit), Remaining: int(remaining)}
+	if reset, ok := get("Reset"); ok {
+		// Seconds from now are small; Unix times are not
+		if reset > 1e9 {
+			rl.Reset = time.Unix(reset, 0)
+		} else {
+			rl.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rl, true
+}
+
+// RateLimit returns the rate limit reported by the latest response, and
+// false when the API hasn't reported one
+func (c *Client) RateLimit() (RateLimit, bool) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if c.rateLimit == nil {
+		return RateLimit{}, false
+	}
+	return *c.rateLimit, true
+}
+
+// PaceRequests makes later requests wait for the rate limit to reset once
+// it is nearly used up, so bulk operations slow down instead of failing
+// partway through with 429s. notify, when set, is called with the wait
+// before sleeping.
+func (c *Client) PaceRequests(notify func(wait time.Duration)) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.pace = true
+	c.paceNotify = notify
+}
+
+// recordRateLimit keeps the rate limit from a response
+func (c *Client) recordRateLimit(header http.Header) {
+	rl, ok := parseRateLimit(header, time.Now())
+	if !ok {
+		return
+	}
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.rateLimit = &rl
+}
+
+// paceWait returns how long a paced request should wait: until the reset
+// when fewer than a twentieth of the requests (and at least two) remain
+func (c *Client) paceWait(now time.Time) time.Duration {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	if !c.pace || c.rateLimit == nil || c.rateLimit.Reset.IsZero() {
+		return 0
+	}
+	if c.rateLimit.Remaining > max(c.rateLimit.Limit/20, 2) {
+		return 0
+	}
+	wait := c.rateLimit.Reset.Sub(now)
+	if wait <= 0 || wait > maxPaceWait {
+		return 0
+	}
+	return wait
+}
+
+// waitForRateLimit sleeps before a paced request when the rate limit is
+// nearly used up
+func (c *Client) waitForRateLimit() {
+	wait := c.paceWait(time.Now())
+	if wait == 0 {
+		return
+	}
+	c.rateMu.Lock()
+	notify := c.paceNotify
+	c.rateMu.Unlock()
+	if notify != nil {
+		notify(wait)
+	}
+	c.sleep(wait)
+
+	// The allowance has reset, so don't wait again on a stale count
+	c.rateMu.Lock()
+	c.rateLimit = nil
+	c.rateMu.Unlock()
+}
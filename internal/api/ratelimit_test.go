@@ -0,0 +1,97 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseRateLimit tests reading the rate limit headers
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	header.Set("X-RateLimit-Remaining", "42")
+	header.Set("X-RateLimit-Reset", "30")
+	rl, ok := parseRateLimit(header, now)
+	assert.True(t, ok)
+	assert.Equal(t, RateLimit{Limit: 100, Remaining: 42, Reset: now.Add(30 * time.Second)}, rl)
+
+	header = http.Header{}
+	header.Set("RateLimit-Limit", "100")
+	header.Set("RateLimit-Remaining", "0")
+	header.Set("RateLimit-Reset", "1773050400")
+	rl, ok = parseRateLimit(header, now)
+	assert.True(t, ok)
+	assert.Equal(t, 0, rl.Remaining)
+	assert.True(t, rl.Reset.Equal(time.Unix(1773050400, 0)))
+
+	header = http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	_, ok = parseRateLimit(header, now)
+	assert.False(t, ok)
+}
+
+// TestPaceWait tests when paced requests wait for the reset
+func TestPaceWait(t *testing.T) {
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		remaining int
+		reset     time.Duration
+		want      time.Duration
+	}{
+		{"plenty left", 50, 30 * time.Second, 0},
+		{"nearly used up", 5, 30 * time.Second, 30 * time.Second},
+		{"already reset", 1, -time.Second, 0},
+		{"reset too far off", 0, time.Hour, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{pace: true, rateLimit: &RateLimit{Limit: 100, Remaining: tt.remaining, Reset: now.Add(tt.reset)}}
+			assert.Equal(t, tt.want, c.paceWait(now))
+		})
+	}
+
+	c := &Client{rateLimit: &RateLimit{Limit: 100, Remaining: 0, Reset: now.Add(time.Second)}}
+	assert.Zero(t, c.paceWait(now), "requests only wait once pacing is on")
+}
+
+// TestClientPacesRequests tests that a paced client records the rate limit
+// and waits before sending once it is nearly used up
+func TestClientPacesRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", "1")
+		w.Header().Set("X-RateLimit-Reset", "20")
+		_, _ = w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer server.Close()
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "t"})
+
+	var slept, notified []time.Duration
+	client.sleep = func(d time.Duration) { slept = append(slept, d) }
+	client.PaceRequests(func(wait time.Duration) { notified = append(notified, wait) })
+
+	_, err := client.GetJob("abc")
+	assert.NoError(t, err)
+	rl, ok := client.RateLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 1, rl.Remaining)
+	assert.Empty(t, slept)
+
+	_, err = client.GetJob("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	if assert.Len(t, slept, 1) {
+		assert.InDelta(t, 20*time.Second, slept[0], float64(time.Second))
+		assert.Equal(t, slept, notified)
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// requestCount counts the requests sent by every client
+var requestCount atomic.Int64
+
+// RequestCount returns how many API requests have been sent, including
+// retries
+func RequestCount() int64 {
+	return requestCount.Load()
+}
+
+// countingTransport counts requests as they are sent
+type countingTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip counts a request and sends it
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestCount.Add(1)
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// TransportOptions configures how clients connect to the API, for
+// self-hosted and staging servers behind corporate TLS interception
+type TransportOptions struct {
+	// CABundle is a PEM file of certificates trusted in addition to the
+	// system roots
+	CABundle string
+	// InsecureSkipVerify turns off TLS certificate verification
+	InsecureSkipVerify bool
+}
+
+// baseTransport sends requests for clients created after
+// ConfigureTransport; nil uses a default transport
+var baseTransport http.RoundTripper
+
+// ConfigureTransport applies opts to clients created afterwards. Requests
+// always go through the proxy named by HTTPS_PROXY or HTTP_PROXY, unless
+// NO_PROXY excludes the API host.
+func ConfigureTransport(opts TransportOptions) error {
+	transport, err := newTransport(opts)
+	if err != nil {
+		return err
+	}
+	debugMu.Lock()
+	defer debugMu.Unlock()
+	baseTransport = transport
+	return nil
+}
+
+// newTransport builds a transport honoring the proxy environment variables
+// and opts
+func newTransport(opts TransportOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if opts.CABundle == "" && !opts.InsecureSkipVerify {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.CABundle != "" {
+		pem, err := os.ReadFile(opts.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in CA bundle %s", opts.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	tlsConfig.InsecureSkipVerify = opts.InsecureSkipVerify
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
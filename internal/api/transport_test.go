@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"encoding/pem"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkTLSServer requests a TLS test server through a new client
+func checkTLSServer(t *testing.T, server *httptest.Server) error {
+	t.Helper()
+	client := NewClient(&config.Config{APIBaseURL: server.URL, AccessToken: "token"})
+	_, err := client.CheckConnection(context.Background())
+	return err
+}
+
+// TestConfigureTransport_CABundle tests trusting a private CA
+func TestConfigureTransport_CABundle(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	// The rejected handshake is expected; keep it out of the test log
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+	t.Cleanup(func() { _ = ConfigureTransport(TransportOptions{}) })
+
+	require.NoError(t, ConfigureTransport(TransportOptions{}))
+	assert.Error(t, checkTLSServer(t, server), "self-signed certificate should be rejected")
+
+	bundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(bundle, certPEM, 0600))
+
+	require.NoError(t, ConfigureTransport(TransportOptions{CABundle: bundle}))
+	assert.NoError(t, checkTLSServer(t, server))
+}
+
+// TestConfigureTransport_InsecureSkipVerify tests turning off verification
+func TestConfigureTransport_InsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	t.Cleanup(func() { _ = ConfigureTransport(TransportOptions{}) })
+
+	require.NoError(t, ConfigureTransport(TransportOptions{InsecureSkipVerify: true}))
+	assert.NoError(t, checkTLSServer(t, server))
+}
+
+// TestConfigureTransport_InvalidBundle tests missing and empty CA bundles
+func TestConfigureTransport_InvalidBundle(t *testing.T) {
+	t.Cleanup(func() { _ = ConfigureTransport(TransportOptions{}) })
+	dir := t.TempDir()
+
+	assert.Error(t, ConfigureTransport(TransportOptions{CABundle: filepath.Join(dir, "missing.pem")}))
+
+	empty := filepath.Join(dir, "empty.pem")
+	require.NoError(t, os.WriteFile(empty, []byte("not a certificate"), 0600))
+	err := ConfigureTransport(TransportOptions{CABundle: empty})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no PEM certificates")
+}
+
+// TestNewTransport_Proxy tests requests go through the proxy environment
+// variables. http.ProxyFromEnvironment reads them once per process, so only
+// its use is checked here.
+func TestNewTransport_Proxy(t *testing.T) {
+	transport, err := newTransport(TransportOptions{})
+	require.NoError(t, err)
+	assert.NotNil(t, transport.Proxy)
+}
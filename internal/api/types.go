@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 // Job types
 //
 // Job represents a cron job monitor
@@ -8,6 +10,9 @@ type Job struct {
 	Name          string   `json:"name"`
 	Interval      int      `json:"interval"`
 	GracePeriod   int      `json:"grace_period"`
+	AlertAfter    int      `json:"alert_after"`
+	RealertEvery  int      `json:"realert_every"`
+	Schedule      string   `json:"schedule,omitempty"`
 	Status        string   `json:"status"`
 	PingToken     string   `json:"ping_token"`
 	WebhookURL    string   `json:"webhook_url"`
@@ -17,6 +22,10 @@ type Job struct {
 	LastRunAt     *string  `json:"last_run_at"`
 	LastAlertedAt *string  `json:"last_alerted_at"`
 	Down          bool     `json:"down"`
+	Tags          []string `json:"tags,omitempty"`
+	ProjectID     string   `json:"project_id,omitempty"`
+	SnoozedUntil  *string  `json:"snoozed_until,omitempty"`
+	ChannelIDs    []string `json:"notification_channel_ids,omitempty"`
 	CreatedAt     string   `json:"created_at"`
 	UpdatedAt     string   `json:"updated_at"`
 }
@@ -38,10 +47,15 @@ type CreateJobRequest struct {
 	Name          string   `json:"name"`
 	Interval      int      `json:"interval"`
 	GracePeriod   int      `json:"grace_period,omitempty"`
+	AlertAfter    int      `json:"alert_after,omitempty"`
+	RealertEvery  int      `json:"realert_every,omitempty"`
+	Schedule      string   `json:"schedule,omitempty"`
 	Status        string   `json:"status,omitempty"`
 	WebhookURL    string   `json:"webhook_url,omitempty"`
 	WebhookSecret string   `json:"webhook_secret,omitempty"`
 	AllowedIPs    []string `json:"allowed_ips,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	ProjectID     string   `json:"project_id,omitempty"`
 }
 
 // UpdateJobRequest represents the request body for updating a job
@@ -49,43 +63,60 @@ type UpdateJobRequest struct {
 	Name          *string   `json:"name,omitempty"`
 	Interval      *int      `json:"interval,omitempty"`
 	GracePeriod   *int      `json:"grace_period,omitempty"`
+	AlertAfter    *int      `json:"alert_after,omitempty"`
+	RealertEvery  *int      `json:"realert_every,omitempty"`
 	Status        *string   `json:"status,omitempty"`
 	WebhookURL    *string   `json:"webhook_url,omitempty"`
 	WebhookSecret *string   `json:"webhook_secret,omitempty"`
 	AllowedIPs    *[]string `json:"allowed_ips,omitempty"`
+	Tags          *[]string `json:"tags,omitempty"`
+	ChannelIDs    *[]string `json:"notification_channel_ids,omitempty"`
 }
 
 // API types
 
 // API represents an API endpoint monitor
 type ApiMonitor struct {
-	ID                    string      `json:"id"`
-	Name                  string      `json:"name"`
-	URL                   string      `json:"url"`
-	HTTPMethod            string      `json:"http_method"`
-	Headers               interface{} `json:"headers"`
-	ExpectedStatusCodes   []int       `json:"expected_status_codes"`
-	Timeout               int         `json:"timeout"`
-	Interval              int         `json:"interval"`
-	GracePeriod           int         `json:"grace_period"`
-	Status                string      `json:"status"`
-	APICheckToken         string      `json:"api_check_token"`
-	HasAuthHeaders        bool        `json:"has_auth_headers"`
-	ValidateResponsePaths []string    `json:"validate_response_paths"`
-	JSONSchema            *string     `json:"json_schema"`
-	RequestBody           *string     `json:"request_body"`
-	LastCheckAt           *string     `json:"last_check_at"`
-	ConsecutiveFailures   int         `json:"consecutive_failures"`
-	Down                  bool        `json:"down"`
-	UptimePercentage      *float64    `json:"uptime_percentage"`
-	AverageResponseTime   *float64    `json:"average_response_time"`
-	CreatedAt             string      `json:"created_at"`
-	UpdatedAt             string      `json:"updated_at"`
+	ID                    string          `json:"id"`
+	Name                  string          `json:"name"`
+	URL                   string          `json:"url"`
+	HTTPMethod            string          `json:"http_method"`
+	Headers               interface{}     `json:"headers"`
+	ExpectedStatusCodes   []int           `json:"expected_status_codes"`
+	Timeout               int             `json:"timeout"`
+	Interval              int             `json:"interval"`
+	GracePeriod           int             `json:"grace_period"`
+	AlertAfter            int             `json:"alert_after"`
+	RealertEvery          int             `json:"realert_every"`
+	Status                string          `json:"status"`
+	APICheckToken         string          `json:"api_check_token"`
+	HasAuthHeaders        bool            `json:"has_auth_headers"`
+	ValidateResponsePaths []string        `json:"validate_response_paths"`
+	JSONSchema            *string         `json:"json_schema"`
+	AssertContains        []string        `json:"assert_contains,omitempty"`
+	AssertRegex           []string        `json:"assert_regex,omitempty"`
+	AssertJSONPaths       []PathAssertion `json:"assert_jsonpath,omitempty"`
+	Regions               []string        `json:"regions,omitempty"`
+	MaxResponseTime       int             `json:"max_response_time,omitempty"`
+	RequestBody           *string         `json:"request_body"`
+	LastCheckAt           *string         `json:"last_check_at"`
+	ConsecutiveFailures   int             `json:"consecutive_failures"`
+	Down                  bool            `json:"down"`
+	UptimePercentage      *float64        `json:"uptime_percentage"`
+	AverageResponseTime   *float64        `json:"average_response_time"`
+	Tags                  []string        `json:"tags,omitempty"`
+	ProjectID             string          `json:"project_id,omitempty"`
+	SnoozedUntil          *string         `json:"snoozed_until,omitempty"`
+	ChannelIDs            []string        `json:"notification_channel_ids,omitempty"`
+	CreatedAt             string          `json:"created_at"`
+	UpdatedAt             string          `json:"updated_at"`
 }
 
 // MonitorsResponse represents the response from GET /api_monitors
 type ApisResponse struct {
 	APIMonitors []ApiMonitor `json:"api_monitors"`
+	HasMore     bool         `json:"has_more"`
+	TotalCount  int          `json:"total_count"`
 }
 
 // MonitorResponse represents the response from POST/PUT /api_monitors
@@ -95,26 +126,57 @@ type ApiMonitorResponse struct {
 
 // CreateApiRequest represents the request body for creating a monitor
 type CreateApiRequest struct {
-	Name                string `json:"name"`
-	URL                 string `json:"url"`
-	HTTPMethod          string `json:"http_method,omitempty"`
-	Interval            int    `json:"interval,omitempty"`
-	ExpectedStatusCodes []int  `json:"expected_status_codes,omitempty"`
-	Timeout             int    `json:"timeout,omitempty"`
-	GracePeriod         int    `json:"grace_period,omitempty"`
-	Status              string `json:"status,omitempty"`
+	Name                  string            `json:"name"`
+	URL                   string            `json:"url"`
+	HTTPMethod            string            `json:"http_method,omitempty"`
+	Headers               map[string]string `json:"headers,omitempty"`
+	RequestBody           string            `json:"request_body,omitempty"`
+	Interval              int               `json:"interval,omitempty"`
+	ExpectedStatusCodes   []int             `json:"expected_status_codes,omitempty"`
+	Timeout               int               `json:"timeout,omitempty"`
+	GracePeriod           int               `json:"grace_period,omitempty"`
+	AlertAfter            int               `json:"alert_after,omitempty"`
+	RealertEvery          int               `json:"realert_every,omitempty"`
+	Status                string            `json:"status,omitempty"`
+	ValidateResponsePaths []string          `json:"validate_response_paths,omitempty"`
+	JSONSchema            string            `json:"json_schema,omitempty"`
+	AssertContains        []string          `json:"assert_contains,omitempty"`
+	AssertRegex           []string          `json:"assert_regex,omitempty"`
+	AssertJSONPaths       []PathAssertion   `json:"assert_jsonpath,omitempty"`
+	Regions               []string          `json:"regions,omitempty"`
+	MaxResponseTime       int               `json:"max_response_time,omitempty"`
+	Tags                  []string          `json:"tags,omitempty"`
+	ProjectID             string            `json:"project_id,omitempty"`
 }
 
 // UpdateApiRequest represents the request body for updating a monitor
 type UpdateApiRequest struct {
-	Name                *string `json:"name,omitempty"`
-	URL                 *string `json:"url,omitempty"`
-	HTTPMethod          *string `json:"http_method,omitempty"`
-	Interval            *int    `json:"interval,omitempty"`
-	ExpectedStatusCodes *[]int  `json:"expected_status_codes,omitempty"`
-	Timeout             *int    `json:"timeout,omitempty"`
-	GracePeriod         *int    `json:"grace_period,omitempty"`
-	Status              *string `json:"status,omitempty"`
+	Name                *string            `json:"name,omitempty"`
+	URL                 *string            `json:"url,omitempty"`
+	HTTPMethod          *string            `json:"http_method,omitempty"`
+	Headers             *map[string]string `json:"headers,omitempty"`
+	Interval            *int               `json:"interval,omitempty"`
+	ExpectedStatusCodes *[]int             `json:"expected_status_codes,omitempty"`
+	Timeout             *int               `json:"timeout,omitempty"`
+	GracePeriod         *int               `json:"grace_period,omitempty"`
+	AlertAfter          *int               `json:"alert_after,omitempty"`
+	RealertEvery        *int               `json:"realert_every,omitempty"`
+	Status              *string            `json:"status,omitempty"`
+	JSONSchema          *string            `json:"json_schema,omitempty"`
+	AssertContains      *[]string          `json:"assert_contains,omitempty"`
+	AssertRegex         *[]string          `json:"assert_regex,omitempty"`
+	AssertJSONPaths     *[]PathAssertion   `json:"assert_jsonpath,omitempty"`
+	Regions             *[]string          `json:"regions,omitempty"`
+	MaxResponseTime     *int               `json:"max_response_time,omitempty"`
+	Tags                *[]string          `json:"tags,omitempty"`
+	ChannelIDs          *[]string          `json:"notification_channel_ids,omitempty"`
+}
+
+// PathAssertion is a JSON path in an API monitor's response body and the
+// value it must have
+type PathAssertion struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
 }
 
 // Check represents an API health check result
@@ -126,7 +188,25 @@ type Check struct {
 	Success         bool    `json:"success"`
 	ErrorMessage    *string `json:"error_message"`
 	ValidationError *string `json:"validation_error"`
-	CreatedAt       string  `json:"created_at"`
+	// Slow is set when the response took longer than the monitor's
+	// max_response_time
+	Slow bool `json:"slow,omitempty"`
+	// ResponseBody and ResponseHeaders are only returned for a single check
+	ResponseBody    *string           `json:"response_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	CreatedAt       string            `json:"created_at"`
+}
+
+// ApiChecksResponse represents a page of checks from
+// GET /api_monitors/:id/api_checks, newest first
+type ApiChecksResponse struct {
+	APIChecks []Check `json:"api_checks"`
+	HasMore   bool    `json:"has_more"`
+}
+
+// CheckResponse represents the response from GET /api_checks/:id
+type CheckResponse struct {
+	APICheck Check `json:"api_check"`
 }
 
 // Ping represents a job heartbeat ping
@@ -135,16 +215,176 @@ type Ping struct {
 	JobID     string  `json:"job_id"`
 	PingType  string  `json:"ping_type"`
 	Duration  *string `json:"duration"`
+	SourceIP  string  `json:"source_ip,omitempty"`
 	CreatedAt string  `json:"created_at"`
 }
 
+// PingsResponse represents a page of pings from GET /jobs/:id/pings,
+// newest first
+type PingsResponse struct {
+	Pings   []Ping `json:"pings"`
+	HasMore bool   `json:"has_more"`
+}
+
+// IncidentSlow is the type of an incident opened because an API monitor's
+// responses were slower than its max_response_time, rather than failing
+const IncidentSlow = "slow"
+
 // Incident represents a downtime incident
 type Incident struct {
+	ID           string  `json:"id,omitempty"`
 	StartedAt    string  `json:"started_at"`
 	EndedAt      *string `json:"ended_at"`
 	Duration     float64 `json:"duration"`
 	Type         string  `json:"type"`
 	ErrorMessage *string `json:"error_message,omitempty"`
+	// AcknowledgedAt is nil until someone acknowledges the incident
+	AcknowledgedAt *string        `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string         `json:"acknowledged_by,omitempty"`
+	Notes          []IncidentNote `json:"notes,omitempty"`
+}
+
+// IncidentNote is a note left on an incident, e.g. when acknowledging it
+type IncidentNote struct {
+	Body      string `json:"body"`
+	Author    string `json:"author,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// IncidentResponse wraps a single incident
+type IncidentResponse struct {
+	Incident Incident `json:"incident"`
+}
+
+// IncidentDetail is an incident with the resource it belongs to and
+// everything that happened during it, from GET /incidents/:id
+type IncidentDetail struct {
+	Incident
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	// Checks are the failed checks during the incident, oldest first,
+	// followed by the passing check that ended it
+	Checks []IncidentCheck `json:"checks"`
+	// Alerts are the alerts sent about the incident, oldest first
+	Alerts []Alert `json:"alerts"`
+}
+
+// IncidentCheck is one check made during an incident
+type IncidentCheck struct {
+	Success      bool    `json:"success"`
+	StatusCode   int     `json:"status_code,omitempty"`
+	ResponseTime float64 `json:"response_time,omitempty"`
+	Slow         bool    `json:"slow,omitempty"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// IncidentDetailResponse wraps an incident's details
+type IncidentDetailResponse struct {
+	Incident IncidentDetail `json:"incident"`
+}
+
+// UpdateIncidentRequest acknowledges an incident and/or adds a note to it
+type UpdateIncidentRequest struct {
+	Acknowledged *bool  `json:"acknowledged,omitempty"`
+	Note         string `json:"note,omitempty"`
+}
+
+// WebhookDelivery is one attempt to deliver a job's webhook
+type WebhookDelivery struct {
+	ID    string `json:"id"`
+	Event string `json:"event"`
+	URL   string `json:"url"`
+	// StatusCode is nil when no response was received
+	StatusCode   *int    `json:"status_code"`
+	ResponseTime float64 `json:"response_time"`
+	Success      bool    `json:"success"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// Region is a probe location API monitors can be checked from
+type Region struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Location string `json:"location,omitempty"`
+	// Default regions are used by monitors that don't choose any
+	Default bool `json:"default"`
+}
+
+// RegionsResponse represents the response from GET /regions
+type RegionsResponse struct {
+	Regions []Region `json:"regions"`
+}
+
+// Project groups related jobs and monitors, e.g. by service
+type Project struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	JobCount     int    `json:"job_count"`
+	MonitorCount int    `json:"monitor_count"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// ProjectsResponse represents the response from GET /projects
+type ProjectsResponse struct {
+	Projects []Project `json:"projects"`
+}
+
+// ProjectResponse wraps a single project
+type ProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+// CreateProjectRequest represents the request body for creating a project
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// Alert represents a notification sent about a resource
+type Alert struct {
+	ID string `json:"id"`
+	// AlertType is the channel the alert went out on: sms, email, or webhook
+	AlertType string `json:"alert_type"`
+	// Event is what triggered the alert, e.g. down or recovered
+	Event        string  `json:"event"`
+	Recipient    string  `json:"recipient"`
+	ResourceType string  `json:"resource_type"`
+	ResourceID   string  `json:"resource_id"`
+	ResourceName string  `json:"resource_name"`
+	Delivered    bool    `json:"delivered"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	SentAt       string  `json:"sent_at"`
+}
+
+// AlertsResponse represents a page of alerts from GET /alerts, newest first
+type AlertsResponse struct {
+	Alerts  []Alert `json:"alerts"`
+	HasMore bool    `json:"has_more"`
+}
+
+// ListOptions narrows a list request. The API applies the filters it
+// supports; callers should still filter results client-side.
+type ListOptions struct {
+	Status string
+	// Tags are key=value or key filters; resources must match all of them
+	Tags []string
+	Name string
+	// Project narrows to resources in one project, by full ID
+	Project string
+	// Type narrows to one kind, e.g. the channel alerts were sent over
+	Type string
+	// Page is the 1-based page to fetch; zero means the first page
+	Page int
+	// PerPage is the page size; zero uses the API default
+	PerPage int
+	// Since and Until restrict check and ping history to a time range; zero
+	// values leave that end open
+	Since time.Time
+	Until time.Time
 }
 
 // Account represents user account with subscription and usage
@@ -171,29 +411,110 @@ type AccountSubscription struct {
 	MinCheckInterval int     `json:"min_check_interval"`
 }
 
+// RetentionPolicy is how long the account's history is kept before the
+// API deletes it, and how much is stored now
+type RetentionPolicy struct {
+	// CheckDays and PingDays are how many days of API monitor checks and
+	// job pings are kept
+	CheckDays    int `json:"check_days"`
+	PingDays     int `json:"ping_days"`
+	StoredChecks int `json:"stored_checks"`
+	StoredPings  int `json:"stored_pings"`
+}
+
+// RetentionPolicyResponse wraps the retention policy
+type RetentionPolicyResponse struct {
+	Retention RetentionPolicy `json:"retention"`
+}
+
+// PruneChecksResponse represents the response from deleting an API
+// monitor's old checks
+type PruneChecksResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+// NotificationPreferences controls how and when the account is alerted
+type NotificationPreferences struct {
+	// QuietHoursStart and QuietHoursEnd are local "15:04" times between
+	// which SMS alerts are held; the window may cross midnight
+	QuietHoursEnabled bool   `json:"quiet_hours_enabled"`
+	QuietHoursStart   string `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd     string `json:"quiet_hours_end,omitempty"`
+	Timezone          string `json:"timezone,omitempty"`
+	SMSEnabled        bool   `json:"sms_enabled"`
+	// EscalationDelay is how many minutes an incident stays open before
+	// escalating from email to SMS
+	EscalationDelay int `json:"escalation_delay"`
+}
+
+// NotificationPreferencesResponse wraps notification preferences
+type NotificationPreferencesResponse struct {
+	NotificationPreferences NotificationPreferences `json:"notification_preferences"`
+}
+
+// UpdateNotificationPreferencesRequest represents the request body for
+// updating notification preferences
+type UpdateNotificationPreferencesRequest struct {
+	QuietHoursEnabled *bool   `json:"quiet_hours_enabled,omitempty"`
+	QuietHoursStart   *string `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd     *string `json:"quiet_hours_end,omitempty"`
+	Timezone          *string `json:"timezone,omitempty"`
+	SMSEnabled        *bool   `json:"sms_enabled,omitempty"`
+	EscalationDelay   *int    `json:"escalation_delay,omitempty"`
+}
+
 // SslMonitor types
 
 // SslMonitor represents ssl monitor details
 type SslMonitor struct {
-	ID                    string `json:"id"`
-	Name                  string `json:"name"`
-	Domain                string `json:"domain"`
-	Port                  int    `json:"port"`
-	Status                string `json:"status"`
-	Interval              int    `json:"check_interval"`
-	GracePeriod           int    `json:"grace_period"`
-	WarningThreshold      int    `json:"warning_threshold"`
-	UrgentThreshold       int    `json:"urgent_threshold"`
-	CriticalThreshold     int    `json:"critical_threshold"`
-	CertificateExpiresAt  string `json:"certificate_expires_at"`
-	CertificateIssuer     string `json:"certificate_issuer"`
-	CertificateSubject    string `json:"certificate_subject"`
-	DaysUntilExpiration   int    `json:"days_until_expiration"`
-	LastCheckAt           string `json:"last_check_at"`
-	LastSuccessfulCheckAt string `json:"last_successful_check_at"`
-	ConsecutiveFailures   int    `json:"consecutive_failures"`
-	CreatedAt             string `json:"created_at"`
-	UpdatedAt             string `json:"updated_at"`
+	ID                    string             `json:"id"`
+	Name                  string             `json:"name"`
+	Domain                string             `json:"domain"`
+	Port                  int                `json:"port"`
+	ResolveIP             string             `json:"resolve_ip,omitempty"`
+	SNI                   string             `json:"sni,omitempty"`
+	Status                string             `json:"status"`
+	Interval              int                `json:"check_interval"`
+	GracePeriod           int                `json:"grace_period"`
+	AlertAfter            int                `json:"alert_after"`
+	RealertEvery          int                `json:"realert_every"`
+	WarningThreshold      int                `json:"warning_threshold"`
+	UrgentThreshold       int                `json:"urgent_threshold"`
+	CriticalThreshold     int                `json:"critical_threshold"`
+	CertificateExpiresAt  string             `json:"certificate_expires_at"`
+	CertificateIssuer     string             `json:"certificate_issuer"`
+	CertificateSubject    string             `json:"certificate_subject"`
+	CertificateSANs       []string           `json:"certificate_sans,omitempty"`
+	KeyType               string             `json:"key_type,omitempty"`
+	KeySize               int                `json:"key_size,omitempty"`
+	Protocols             []string           `json:"protocols,omitempty"`
+	Grade                 string             `json:"grade,omitempty"`
+	CertificateChain      []ChainCertificate `json:"certificate_chain,omitempty"`
+	DaysUntilExpiration   int                `json:"days_until_expiration"`
+	LastCheckAt           string             `json:"last_check_at"`
+	LastSuccessfulCheckAt string             `json:"last_successful_check_at"`
+	ConsecutiveFailures   int                `json:"consecutive_failures"`
+	Tags                  []string           `json:"tags,omitempty"`
+	ProjectID             string             `json:"project_id,omitempty"`
+	SnoozedUntil          *string            `json:"snoozed_until,omitempty"`
+	ChannelIDs            []string           `json:"notification_channel_ids,omitempty"`
+	CreatedAt             string             `json:"created_at"`
+	UpdatedAt             string             `json:"updated_at"`
+}
+
+// ChainCertificate is one certificate in the chain an SSL monitor's domain
+// presents, leaf first. The chain, SANs, key, protocols, and grade are only
+// set on SslMonitor when the API provides them.
+type ChainCertificate struct {
+	Subject            string   `json:"subject"`
+	Issuer             string   `json:"issuer"`
+	SANs               []string `json:"sans,omitempty"`
+	NotBefore          string   `json:"not_before"`
+	NotAfter           string   `json:"not_after"`
+	KeyType            string   `json:"key_type,omitempty"`
+	KeySize            int      `json:"key_size,omitempty"`
+	SignatureAlgorithm string   `json:"signature_algorithm,omitempty"`
+	PEM                string   `json:"pem,omitempty"`
 }
 
 // SslMonitorsResponse represents the response from GET /ssl_monitors
@@ -210,28 +531,40 @@ type SslMonitorResponse struct {
 
 // CreateSslMonitorRequest represents the request body for creating an SSL monitor
 type CreateSslMonitorRequest struct {
-	Name              string `json:"name"`
-	Domain            string `json:"domain"`
-	Port              int    `json:"port,omitempty"`
-	Interval          int    `json:"check_interval,omitempty"`
-	GracePeriod       int    `json:"grace_period,omitempty"`
-	WarningThreshold  int    `json:"warning_threshold,omitempty"`
-	UrgentThreshold   int    `json:"urgent_threshold,omitempty"`
-	CriticalThreshold int    `json:"critical_threshold,omitempty"`
-	Status            string `json:"status,omitempty"`
+	Name              string   `json:"name"`
+	Domain            string   `json:"domain"`
+	Port              int      `json:"port,omitempty"`
+	ResolveIP         string   `json:"resolve_ip,omitempty"`
+	SNI               string   `json:"sni,omitempty"`
+	Interval          int      `json:"check_interval,omitempty"`
+	GracePeriod       int      `json:"grace_period,omitempty"`
+	AlertAfter        int      `json:"alert_after,omitempty"`
+	RealertEvery      int      `json:"realert_every,omitempty"`
+	WarningThreshold  int      `json:"warning_threshold,omitempty"`
+	UrgentThreshold   int      `json:"urgent_threshold,omitempty"`
+	CriticalThreshold int      `json:"critical_threshold,omitempty"`
+	Status            string   `json:"status,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	ProjectID         string   `json:"project_id,omitempty"`
 }
 
 // UpdateSslMonitorRequest represents the request body for updating an SSL monitor
 type UpdateSslMonitorRequest struct {
-	Name              *string `json:"name,omitempty"`
-	Domain            *string `json:"domain,omitempty"`
-	Port              *int    `json:"port,omitempty"`
-	Interval          *int    `json:"check_interval,omitempty"`
-	GracePeriod       *int    `json:"grace_period,omitempty"`
-	WarningThreshold  *int    `json:"warning_threshold,omitempty"`
-	UrgentThreshold   *int    `json:"urgent_threshold,omitempty"`
-	CriticalThreshold *int    `json:"critical_threshold,omitempty"`
-	Status            *string `json:"status,omitempty"`
+	Name              *string   `json:"name,omitempty"`
+	Domain            *string   `json:"domain,omitempty"`
+	Port              *int      `json:"port,omitempty"`
+	ResolveIP         *string   `json:"resolve_ip,omitempty"`
+	SNI               *string   `json:"sni,omitempty"`
+	Interval          *int      `json:"check_interval,omitempty"`
+	GracePeriod       *int      `json:"grace_period,omitempty"`
+	AlertAfter        *int      `json:"alert_after,omitempty"`
+	RealertEvery      *int      `json:"realert_every,omitempty"`
+	WarningThreshold  *int      `json:"warning_threshold,omitempty"`
+	UrgentThreshold   *int      `json:"urgent_threshold,omitempty"`
+	CriticalThreshold *int      `json:"critical_threshold,omitempty"`
+	Status            *string   `json:"status,omitempty"`
+	Tags              *[]string `json:"tags,omitempty"`
+	ChannelIDs        *[]string `json:"notification_channel_ids,omitempty"`
 }
 
 // type SslCheck struct {
@@ -260,24 +593,44 @@ type UpdateSslMonitorRequest struct {
 
 // DomainMonitor represents a domain expiration monitor
 type DomainMonitor struct {
-	ID                    string  `json:"id"`
-	Name                  string  `json:"name"`
-	Domain                string  `json:"domain"`
-	Status                string  `json:"status"`
-	Interval              int     `json:"check_interval"`
-	GracePeriod           int     `json:"grace_period"`
-	WarningThreshold      int     `json:"warning_threshold"`
-	UrgentThreshold       int     `json:"urgent_threshold"`
-	CriticalThreshold     int     `json:"critical_threshold"`
-	Registrar             string  `json:"registrar"`
-	RegistrarURL          *string `json:"registrar_url"`
-	ExpiresAt             string  `json:"expires_at"`
-	DaysUntilExpiration   int     `json:"days_until_expiration"`
-	LastCheckAt           string  `json:"last_check_at"`
-	LastSuccessfulCheckAt string  `json:"last_successful_check_at"`
-	ConsecutiveFailures   int     `json:"consecutive_failures"`
-	CreatedAt             string  `json:"created_at"`
-	UpdatedAt             string  `json:"updated_at"`
+	ID                    string   `json:"id"`
+	Name                  string   `json:"name"`
+	Domain                string   `json:"domain"`
+	Status                string   `json:"status"`
+	Interval              int      `json:"check_interval"`
+	GracePeriod           int      `json:"grace_period"`
+	AlertAfter            int      `json:"alert_after"`
+	RealertEvery          int      `json:"realert_every"`
+	WarningThreshold      int      `json:"warning_threshold"`
+	UrgentThreshold       int      `json:"urgent_threshold"`
+	CriticalThreshold     int      `json:"critical_threshold"`
+	Registrar             string   `json:"registrar"`
+	RegistrarURL          *string  `json:"registrar_url"`
+	TransferLocked        *bool    `json:"transfer_locked"`
+	DomainStatuses        []string `json:"domain_statuses,omitempty"`
+	Nameservers           []string `json:"nameservers,omitempty"`
+	DNSSEC                string   `json:"dnssec,omitempty"`
+	ExpiresAt             string   `json:"expires_at"`
+	DaysUntilExpiration   int      `json:"days_until_expiration"`
+	LastCheckAt           string   `json:"last_check_at"`
+	LastSuccessfulCheckAt string   `json:"last_successful_check_at"`
+	ConsecutiveFailures   int      `json:"consecutive_failures"`
+	Tags                  []string `json:"tags,omitempty"`
+	ProjectID             string   `json:"project_id,omitempty"`
+	SnoozedUntil          *string  `json:"snoozed_until,omitempty"`
+	ChannelIDs            []string `json:"notification_channel_ids,omitempty"`
+	CreatedAt             string   `json:"created_at"`
+	UpdatedAt             string   `json:"updated_at"`
+}
+
+// DomainChange is a change to a domain's registration detected by its
+// WHOIS checks, e.g. new nameservers or a transfer lock being removed
+type DomainChange struct {
+	ID         string `json:"id"`
+	Field      string `json:"field"`
+	OldValue   string `json:"old_value"`
+	NewValue   string `json:"new_value"`
+	DetectedAt string `json:"detected_at"`
 }
 
 // DomainMonitorsResponse represents the response from GET /domain_monitors
@@ -294,26 +647,34 @@ type DomainMonitorResponse struct {
 
 // CreateDomainMonitorRequest represents the request body for creating a domain monitor
 type CreateDomainMonitorRequest struct {
-	Name              string `json:"name"`
-	Domain            string `json:"domain"`
-	Interval          int    `json:"check_interval,omitempty"`
-	GracePeriod       int    `json:"grace_period,omitempty"`
-	WarningThreshold  int    `json:"warning_threshold,omitempty"`
-	UrgentThreshold   int    `json:"urgent_threshold,omitempty"`
-	CriticalThreshold int    `json:"critical_threshold,omitempty"`
-	Status            string `json:"status,omitempty"`
+	Name              string   `json:"name"`
+	Domain            string   `json:"domain"`
+	Interval          int      `json:"check_interval,omitempty"`
+	GracePeriod       int      `json:"grace_period,omitempty"`
+	AlertAfter        int      `json:"alert_after,omitempty"`
+	RealertEvery      int      `json:"realert_every,omitempty"`
+	WarningThreshold  int      `json:"warning_threshold,omitempty"`
+	UrgentThreshold   int      `json:"urgent_threshold,omitempty"`
+	CriticalThreshold int      `json:"critical_threshold,omitempty"`
+	Status            string   `json:"status,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	ProjectID         string   `json:"project_id,omitempty"`
 }
 
 // UpdateDomainMonitorRequest represents the request body for updating a domain monitor
 type UpdateDomainMonitorRequest struct {
-	Name              *string `json:"name,omitempty"`
-	Domain            *string `json:"domain,omitempty"`
-	Interval          *int    `json:"check_interval,omitempty"`
-	GracePeriod       *int    `json:"grace_period,omitempty"`
-	WarningThreshold  *int    `json:"warning_threshold,omitempty"`
-	UrgentThreshold   *int    `json:"urgent_threshold,omitempty"`
-	CriticalThreshold *int    `json:"critical_threshold,omitempty"`
-	Status            *string `json:"status,omitempty"`
+	Name              *string   `json:"name,omitempty"`
+	Domain            *string   `json:"domain,omitempty"`
+	Interval          *int      `json:"check_interval,omitempty"`
+	GracePeriod       *int      `json:"grace_period,omitempty"`
+	AlertAfter        *int      `json:"alert_after,omitempty"`
+	RealertEvery      *int      `json:"realert_every,omitempty"`
+	WarningThreshold  *int      `json:"warning_threshold,omitempty"`
+	UrgentThreshold   *int      `json:"urgent_threshold,omitempty"`
+	CriticalThreshold *int      `json:"critical_threshold,omitempty"`
+	Status            *string   `json:"status,omitempty"`
+	Tags              *[]string `json:"tags,omitempty"`
+	ChannelIDs        *[]string `json:"notification_channel_ids,omitempty"`
 }
 
 // DNS Monitor types
@@ -325,19 +686,35 @@ type DnsMonitor struct {
 	Domain                string   `json:"domain"`
 	RecordType            string   `json:"record_type"`
 	ExpectedValues        []string `json:"expected_values"`
+	MatchMode             string   `json:"match_mode,omitempty"`
+	Nameserver            string   `json:"nameserver,omitempty"`
 	Status                string   `json:"status"`
 	Interval              int      `json:"check_interval"`
 	GracePeriod           int      `json:"grace_period"`
+	AlertAfter            int      `json:"alert_after"`
+	RealertEvery          int      `json:"realert_every"`
 	CurrentValues         []string `json:"current_values"`
 	LastChanged           *string  `json:"last_changed"`
 	HasMismatch           bool     `json:"has_mismatch"`
 	LastCheckAt           string   `json:"last_check_at"`
 	LastSuccessfulCheckAt string   `json:"last_successful_check_at"`
 	ConsecutiveFailures   int      `json:"consecutive_failures"`
+	Tags                  []string `json:"tags,omitempty"`
+	ProjectID             string   `json:"project_id,omitempty"`
+	SnoozedUntil          *string  `json:"snoozed_until,omitempty"`
+	ChannelIDs            []string `json:"notification_channel_ids,omitempty"`
 	CreatedAt             string   `json:"created_at"`
 	UpdatedAt             string   `json:"updated_at"`
 }
 
+// DnsChange is a change to a DNS monitor's record detected by its checks
+type DnsChange struct {
+	ID         string   `json:"id"`
+	OldValues  []string `json:"old_values"`
+	NewValues  []string `json:"new_values"`
+	DetectedAt string   `json:"detected_at"`
+}
+
 // DnsMonitorsResponse represents the response from GET /dns_monitors
 type DnsMonitorsResponse struct {
 	DnsMonitors []DnsMonitor `json:"dns_monitors"`
@@ -356,9 +733,15 @@ type CreateDnsMonitorRequest struct {
 	Domain         string   `json:"domain"`
 	RecordType     string   `json:"record_type"`
 	ExpectedValues []string `json:"expected_values"`
+	MatchMode      string   `json:"match_mode,omitempty"`
+	Nameserver     string   `json:"nameserver,omitempty"`
 	Interval       int      `json:"check_interval,omitempty"`
 	GracePeriod    int      `json:"grace_period,omitempty"`
+	AlertAfter     int      `json:"alert_after,omitempty"`
+	RealertEvery   int      `json:"realert_every,omitempty"`
 	Status         string   `json:"status,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	ProjectID      string   `json:"project_id,omitempty"`
 }
 
 // UpdateDnsMonitorRequest represents the request body for updating a DNS monitor
@@ -367,7 +750,13 @@ type UpdateDnsMonitorRequest struct {
 	Domain         *string   `json:"domain,omitempty"`
 	RecordType     *string   `json:"record_type,omitempty"`
 	ExpectedValues *[]string `json:"expected_values,omitempty"`
+	MatchMode      *string   `json:"match_mode,omitempty"`
+	Nameserver     *string   `json:"nameserver,omitempty"`
 	Interval       *int      `json:"check_interval,omitempty"`
 	GracePeriod    *int      `json:"grace_period,omitempty"`
+	AlertAfter     *int      `json:"alert_after,omitempty"`
+	RealertEvery   *int      `json:"realert_every,omitempty"`
 	Status         *string   `json:"status,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+	ChannelIDs     *[]string `json:"notification_channel_ids,omitempty"`
 }
@@ -0,0 +1,515 @@
+// Package apitest serves a fake GrooveKit API over HTTP, so tests can run
+// real commands and the real api.Client end to end
+package apitest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/testutil"
+)
+
+// Server is a fake GrooveKit API listening on a local port. It serves every
+// route api.Client uses from Fake, so seeding Fake (or setting
+// Fake.Errors) controls what requests see.
+type Server struct {
+	*httptest.Server
+
+	// Fake holds the account's resources and history
+	Fake *testutil.FakeAPI
+	// Token is the access token requests must send; Password is the one
+	// POST /tokens accepts for Fake.Account.Email
+	Token    string
+	Password string
+}
+
+// NewServer starts a fake API backed by a new testutil.FakeAPI and closes
+// it when the test ends
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		Fake:     testutil.NewFakeAPI(),
+		Token:    "test-token",
+		Password: "password",
+	}
+	s.Server = httptest.NewServer(s.routes())
+	t.Cleanup(s.Close)
+	return s
+}
+
+// handler serves one route, returning the status and body to send
+type handler func(r *http.Request) (int, any, error)
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+	handle := func(pattern string, h handler) {
+		mux.HandleFunc(pattern, s.serve(h, true))
+	}
+	f := s.Fake
+
+	mux.HandleFunc("POST /tokens", s.serve(s.login, false))
+
+	// Account
+	handle("GET /users/me", func(*http.Request) (int, any, error) {
+		account, err := f.GetAccount()
+		return http.StatusOK, account, err
+	})
+	handle("GET /users/me/retention", func(*http.Request) (int, any, error) {
+		retention, err := f.GetRetentionPolicy()
+		return ok(api.RetentionPolicyResponse{Retention: deref(retention)}, err)
+	})
+	handle("GET /users/me/notification_preferences", func(*http.Request) (int, any, error) {
+		prefs, err := f.GetNotificationPreferences()
+		return ok(api.NotificationPreferencesResponse{NotificationPreferences: deref(prefs)}, err)
+	})
+	handle("PUT /users/me/notification_preferences", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateNotificationPreferencesRequest](r, "notification_preferences")
+		if err != nil {
+			return 0, nil, err
+		}
+		prefs, err := f.UpdateNotificationPreferences(req)
+		return ok(api.NotificationPreferencesResponse{NotificationPreferences: deref(prefs)}, err)
+	})
+	handle("GET /regions", func(*http.Request) (int, any, error) {
+		regions, err := f.ListRegions()
+		return ok(api.RegionsResponse{Regions: regions}, err)
+	})
+	handle("GET /alerts", func(r *http.Request) (int, any, error) {
+		result, err := f.ListAlerts(listOptions(r))
+		return http.StatusOK, result, err
+	})
+
+	// Projects
+	handle("GET /projects", func(*http.Request) (int, any, error) {
+		projects, err := f.ListProjects()
+		return ok(api.ProjectsResponse{Projects: projects}, err)
+	})
+	handle("GET /projects/{id}", func(r *http.Request) (int, any, error) {
+		project, err := f.GetProject(r.PathValue("id"))
+		return ok(api.ProjectResponse{Project: deref(project)}, err)
+	})
+	handle("POST /projects", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateProjectRequest](r, "project")
+		if err != nil {
+			return 0, nil, err
+		}
+		project, err := f.CreateProject(req)
+		return created(api.ProjectResponse{Project: deref(project)}, err)
+	})
+	handle("DELETE /projects/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteProject(r.PathValue("id")))
+	})
+
+	// Jobs
+	handle("GET /jobs", func(r *http.Request) (int, any, error) {
+		result, err := f.ListJobs(listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /jobs/{id}", func(r *http.Request) (int, any, error) {
+		job, err := f.GetJob(r.PathValue("id"))
+		return http.StatusOK, job, err
+	})
+	handle("POST /jobs", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateJobRequest](r, "job")
+		if err != nil {
+			return 0, nil, err
+		}
+		job, err := f.CreateJob(req)
+		return created(api.JobResponse{Job: deref(job)}, err)
+	})
+	handle("PUT /jobs/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateJobRequest](r, "job")
+		if err != nil {
+			return 0, nil, err
+		}
+		job, err := f.UpdateJob(r.PathValue("id"), req)
+		return ok(api.JobResponse{Job: deref(job)}, err)
+	})
+	handle("DELETE /jobs/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteJob(r.PathValue("id")))
+	})
+	handle("POST /jobs/{id}/move", move(f.MoveJob))
+	handle("POST /jobs/{id}/snooze", snooze(f.SnoozeJob))
+	handle("DELETE /jobs/{id}/snooze", unsnooze(f.SnoozeJob))
+	handle("GET /jobs/{id}/pings", func(r *http.Request) (int, any, error) {
+		result, err := f.ListJobPings(r.PathValue("id"), listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /jobs/{id}/incidents", incidents(f.ListJobIncidents))
+	handle("POST /jobs/{id}/webhook/test", func(r *http.Request) (int, any, error) {
+		delivery, err := f.TestJobWebhook(r.PathValue("id"))
+		return ok(map[string]any{"delivery": delivery}, err)
+	})
+	handle("GET /jobs/{id}/webhook/deliveries", func(r *http.Request) (int, any, error) {
+		deliveries, err := f.ListJobWebhookDeliveries(r.PathValue("id"))
+		return ok(map[string]any{"deliveries": deliveries}, err)
+	})
+
+	// Pings authenticate with the job's ping token rather than an access
+	// token
+	ping := func(r *http.Request) (int, any, error) {
+		var duration time.Duration
+		if value := r.URL.Query().Get("duration"); value != "" {
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return 0, nil, badRequest("duration must be a number of seconds")
+			}
+			duration = time.Duration(seconds * float64(time.Second))
+		}
+		return ok(map[string]string{"status": "ok"}, f.SendPing(r.PathValue("token"), r.PathValue("type"), duration))
+	}
+	mux.HandleFunc("POST /pings/{token}", s.serve(ping, false))
+	mux.HandleFunc("POST /pings/{token}/{type}", s.serve(ping, false))
+
+	// API monitors
+	handle("GET /api_monitors", func(r *http.Request) (int, any, error) {
+		result, err := f.ListApis(listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /api_monitors/{id}", func(r *http.Request) (int, any, error) {
+		monitor, err := f.GetApi(r.PathValue("id"))
+		return ok(api.ApiMonitorResponse{APIMonitor: deref(monitor)}, err)
+	})
+	handle("POST /api_monitors", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateApiRequest](r, "api_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		monitor, err := f.CreateApi(req)
+		return created(api.ApiMonitorResponse{APIMonitor: deref(monitor)}, err)
+	})
+	handle("PUT /api_monitors/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateApiRequest](r, "api_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		monitor, err := f.UpdateApi(r.PathValue("id"), req)
+		return ok(api.ApiMonitorResponse{APIMonitor: deref(monitor)}, err)
+	})
+	handle("DELETE /api_monitors/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteApi(r.PathValue("id")))
+	})
+	handle("POST /api_monitors/{id}/move", move(f.MoveApi))
+	handle("POST /api_monitors/{id}/snooze", snooze(f.SnoozeApi))
+	handle("DELETE /api_monitors/{id}/snooze", unsnooze(f.SnoozeApi))
+	handle("GET /api_monitors/{id}/api_checks", func(r *http.Request) (int, any, error) {
+		result, err := f.ListApiChecks(r.PathValue("id"), listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("DELETE /api_monitors/{id}/api_checks", func(r *http.Request) (int, any, error) {
+		before, err := time.Parse(time.RFC3339, r.URL.Query().Get("before"))
+		if err != nil {
+			return 0, nil, badRequest("before must be an RFC 3339 time")
+		}
+		deleted, err := f.PruneApiChecks(r.PathValue("id"), before)
+		return ok(api.PruneChecksResponse{Deleted: deleted}, err)
+	})
+	handle("GET /api_checks/{id}", func(r *http.Request) (int, any, error) {
+		check, err := f.GetApiCheck(r.PathValue("id"))
+		return ok(api.CheckResponse{APICheck: deref(check)}, err)
+	})
+	handle("GET /api_monitors/{id}/incidents", incidents(f.ListApiIncidents))
+
+	// SSL monitors
+	handle("GET /ssl_monitors", func(r *http.Request) (int, any, error) {
+		result, err := f.ListCerts(listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /ssl_monitors/{id}", func(r *http.Request) (int, any, error) {
+		cert, err := f.GetCert(r.PathValue("id"))
+		return ok(api.SslMonitorResponse{SslMonitor: deref(cert)}, err)
+	})
+	handle("POST /ssl_monitors", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateSslMonitorRequest](r, "ssl_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		cert, err := f.CreateCert(req)
+		return created(api.SslMonitorResponse{SslMonitor: deref(cert)}, err)
+	})
+	handle("PUT /ssl_monitors/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateSslMonitorRequest](r, "ssl_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		cert, err := f.UpdateCert(r.PathValue("id"), req)
+		return ok(api.SslMonitorResponse{SslMonitor: deref(cert)}, err)
+	})
+	handle("DELETE /ssl_monitors/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteCert(r.PathValue("id")))
+	})
+	handle("POST /ssl_monitors/{id}/move", move(f.MoveCert))
+	handle("POST /ssl_monitors/{id}/snooze", snooze(f.SnoozeCert))
+	handle("DELETE /ssl_monitors/{id}/snooze", unsnooze(f.SnoozeCert))
+	handle("GET /ssl_monitors/{id}/incidents", incidents(f.ListCertIncidents))
+
+	// Domain monitors
+	handle("GET /domain_monitors", func(r *http.Request) (int, any, error) {
+		result, err := f.ListDomains(listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /domain_monitors/{id}", func(r *http.Request) (int, any, error) {
+		domain, err := f.GetDomain(r.PathValue("id"))
+		return ok(api.DomainMonitorResponse{DomainMonitor: deref(domain)}, err)
+	})
+	handle("POST /domain_monitors", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateDomainMonitorRequest](r, "domain_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		domain, err := f.CreateDomain(req)
+		return created(api.DomainMonitorResponse{DomainMonitor: deref(domain)}, err)
+	})
+	handle("PUT /domain_monitors/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateDomainMonitorRequest](r, "domain_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		domain, err := f.UpdateDomain(r.PathValue("id"), req)
+		return ok(api.DomainMonitorResponse{DomainMonitor: deref(domain)}, err)
+	})
+	handle("DELETE /domain_monitors/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteDomain(r.PathValue("id")))
+	})
+	handle("POST /domain_monitors/{id}/move", move(f.MoveDomain))
+	handle("POST /domain_monitors/{id}/snooze", snooze(f.SnoozeDomain))
+	handle("DELETE /domain_monitors/{id}/snooze", unsnooze(f.SnoozeDomain))
+	handle("GET /domain_monitors/{id}/incidents", incidents(f.ListDomainIncidents))
+	handle("GET /domain_monitors/{id}/changes", func(r *http.Request) (int, any, error) {
+		changes, err := f.ListDomainChanges(r.PathValue("id"))
+		return ok(map[string]any{"changes": changes}, err)
+	})
+
+	// DNS monitors
+	handle("GET /dns_monitors", func(r *http.Request) (int, any, error) {
+		result, err := f.ListDnsMonitors(listOptions(r))
+		return http.StatusOK, result, err
+	})
+	handle("GET /dns_monitors/{id}", func(r *http.Request) (int, any, error) {
+		monitor, err := f.GetDnsMonitor(r.PathValue("id"))
+		return ok(api.DnsMonitorResponse{DnsMonitor: deref(monitor)}, err)
+	})
+	handle("POST /dns_monitors", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.CreateDnsMonitorRequest](r, "dns_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		monitor, err := f.CreateDnsMonitor(req)
+		return created(api.DnsMonitorResponse{DnsMonitor: deref(monitor)}, err)
+	})
+	handle("PUT /dns_monitors/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateDnsMonitorRequest](r, "dns_monitor")
+		if err != nil {
+			return 0, nil, err
+		}
+		monitor, err := f.UpdateDnsMonitor(r.PathValue("id"), req)
+		return ok(api.DnsMonitorResponse{DnsMonitor: deref(monitor)}, err)
+	})
+	handle("DELETE /dns_monitors/{id}", func(r *http.Request) (int, any, error) {
+		return noContent(f.DeleteDnsMonitor(r.PathValue("id")))
+	})
+	handle("POST /dns_monitors/{id}/move", move(f.MoveDnsMonitor))
+	handle("POST /dns_monitors/{id}/snooze", snooze(f.SnoozeDnsMonitor))
+	handle("DELETE /dns_monitors/{id}/snooze", unsnooze(f.SnoozeDnsMonitor))
+	handle("GET /dns_monitors/{id}/incidents", incidents(f.ListDnsMonitorIncidents))
+	handle("GET /dns_monitors/{id}/changes", func(r *http.Request) (int, any, error) {
+		changes, err := f.ListDnsMonitorChanges(r.PathValue("id"))
+		return ok(map[string]any{"changes": changes}, err)
+	})
+
+	// Incidents
+	handle("GET /incidents/{id}", func(r *http.Request) (int, any, error) {
+		incident, err := f.GetIncident(r.PathValue("id"))
+		return ok(api.IncidentDetailResponse{Incident: deref(incident)}, err)
+	})
+	handle("PUT /incidents/{id}", func(r *http.Request) (int, any, error) {
+		req, err := decode[api.UpdateIncidentRequest](r, "incident")
+		if err != nil {
+			return 0, nil, err
+		}
+		incident, err := f.UpdateIncident(r.PathValue("id"), req)
+		return ok(api.IncidentResponse{Incident: deref(incident)}, err)
+	})
+
+	mux.HandleFunc("/", s.serve(func(r *http.Request) (int, any, error) {
+		return 0, nil, &api.Error{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path)}
+	}, false))
+	return mux
+}
+
+// serve adapts h to an http.HandlerFunc, checking the access token when
+// authenticated is set and rendering errors the way the API does
+func (s *Server) serve(h handler, authenticated bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if limit, ok := s.Fake.RateLimit(); ok {
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit.Limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(limit.Remaining))
+			if !limit.Reset.IsZero() {
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(limit.Reset.Unix(), 10))
+			}
+		}
+
+		if authenticated && r.Header.Get("Authorization") != "Bearer "+s.Token {
+			writeError(w, &api.Error{StatusCode: http.StatusUnauthorized, Message: "Invalid or expired token"})
+			return
+		}
+
+		status, body, err := h(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(status)
+		if body != nil {
+			_ = json.NewEncoder(w).Encode(body)
+		}
+	}
+}
+
+// login hands out Token for Fake.Account.Email and Password
+func (s *Server) login(r *http.Request) (int, any, error) {
+	var creds struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
+		return 0, nil, badRequest("invalid JSON body")
+	}
+	account, err := s.Fake.GetAccount()
+	if err != nil {
+		return 0, nil, err
+	}
+	if !strings.EqualFold(creds.Email, account.Email) || creds.Password != s.Password {
+		return 0, nil, &api.Error{StatusCode: http.StatusUnauthorized, Message: "Invalid email or password"}
+	}
+	return http.StatusCreated, map[string]string{"access_token": s.Token}, nil
+}
+
+// move serves a resource's move endpoint
+func move(fn func(id, projectID string) error) handler {
+	return func(r *http.Request) (int, any, error) {
+		var body struct {
+			ProjectID string `json:"project_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return 0, nil, badRequest("invalid JSON body")
+		}
+		return noContent(fn(r.PathValue("id"), body.ProjectID))
+	}
+}
+
+// snooze serves a resource's snooze endpoint
+func snooze(fn func(id string, until time.Time) error) handler {
+	return func(r *http.Request) (int, any, error) {
+		var body struct {
+			Until time.Time `json:"until"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return 0, nil, badRequest("invalid JSON body")
+		}
+		if body.Until.IsZero() {
+			return 0, nil, badRequest("until is required")
+		}
+		return noContent(fn(r.PathValue("id"), body.Until))
+	}
+}
+
+// unsnooze serves the endpoint ending a resource's snooze
+func unsnooze(fn func(id string, until time.Time) error) handler {
+	return func(r *http.Request) (int, any, error) {
+		return noContent(fn(r.PathValue("id"), time.Time{}))
+	}
+}
+
+// incidents serves a resource's incident history
+func incidents(fn func(id string) ([]api.Incident, error)) handler {
+	return func(r *http.Request) (int, any, error) {
+		list, err := fn(r.PathValue("id"))
+		return ok(map[string]any{"incidents": list}, err)
+	}
+}
+
+// writeError renders err as the API's JSON error body. Errors that aren't
+// *api.Error, such as those set in Fake.Errors with errors.New, are 500s.
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		apiErr = &api.Error{StatusCode: http.StatusInternalServerError, Message: err.Error()}
+	}
+	if apiErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(apiErr.RetryAfter.Seconds())))
+	}
+	body := map[string]any{"error": apiErr.Message}
+	if apiErr.Fields != nil {
+		body["errors"] = apiErr.Fields
+	}
+	w.WriteHeader(apiErr.StatusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// decode reads a request body that wraps T under key, as in
+// {"job": {...}}
+func decode[T any](r *http.Request, key string) (*T, error) {
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, badRequest("invalid JSON body")
+	}
+	var req T
+	if err := json.Unmarshal(body[key], &req); err != nil {
+		return nil, badRequest(fmt.Sprintf("param is missing or the value is empty: %s", key))
+	}
+	return &req, nil
+}
+
+// listOptions reads the filters and paging api.ListOptions sends as query
+// parameters
+func listOptions(r *http.Request) *api.ListOptions {
+	q := r.URL.Query()
+	opts := &api.ListOptions{
+		Status:  q.Get("status"),
+		Tags:    q["tag"],
+		Name:    q.Get("name"),
+		Project: q.Get("project_id"),
+		Type:    q.Get("type"),
+	}
+	opts.Page, _ = strconv.Atoi(q.Get("page"))
+	opts.PerPage, _ = strconv.Atoi(q.Get("per_page"))
+	opts.Since, _ = time.Parse(time.RFC3339, q.Get("since"))
+	opts.Until, _ = time.Parse(time.RFC3339, q.Get("until"))
+	return opts
+}
+
+func ok(body any, err error) (int, any, error) {
+	return http.StatusOK, body, err
+}
+
+func created(body any, err error) (int, any, error) {
+	return http.StatusCreated, body, err
+}
+
+func noContent(err error) (int, any, error) {
+	return http.StatusNoContent, nil, err
+}
+
+func badRequest(message string) error {
+	return &api.Error{StatusCode: http.StatusBadRequest, Message: message}
+}
+
+// deref returns the value v points to, or T's zero value when the fake
+// returned an error instead
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
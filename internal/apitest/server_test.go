@@ -0,0 +1,127 @@
+package apitest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient returns a real API client for the server
+func newClient(s *Server, token string) *api.Client {
+	return api.NewClient(&config.Config{APIBaseURL: s.URL, AccessToken: token})
+}
+
+// TestServer_JobsCRUD tests creating, listing, updating, and deleting a
+// job through the real client
+func TestServer_JobsCRUD(t *testing.T) {
+	s := NewServer(t)
+	client := newClient(s, s.Token)
+
+	job, err := client.CreateJob(&api.CreateJobRequest{Name: "Backup", Interval: 3600})
+	require.NoError(t, err)
+	assert.Equal(t, "Backup", job.Name)
+	assert.Equal(t, "active", job.Status)
+
+	list, err := client.ListAllJobs(nil)
+	require.NoError(t, err)
+	require.Len(t, list.Jobs, 1)
+
+	got, err := client.GetJob(job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, got.ID)
+
+	status := "paused"
+	updated, err := client.UpdateJob(job.ID, &api.UpdateJobRequest{Status: &status})
+	require.NoError(t, err)
+	assert.Equal(t, "paused", updated.Status)
+	assert.Equal(t, "Backup", updated.Name)
+
+	require.NoError(t, client.DeleteJob(job.ID))
+	_, err = client.GetJob(job.ID)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
+
+// TestServer_Pagination tests that the real client pages through a long
+// listing
+func TestServer_Pagination(t *testing.T) {
+	s := NewServer(t)
+	for range 150 {
+		s.Fake.Jobs = append(s.Fake.Jobs, api.Job{ID: s.Fake.NewID(), Name: "job"})
+	}
+
+	list, err := newClient(s, s.Token).ListAllJobs(nil)
+	require.NoError(t, err)
+	assert.Len(t, list.Jobs, 150)
+}
+
+// TestServer_Errors tests authentication, validation, and injected errors
+func TestServer_Errors(t *testing.T) {
+	s := NewServer(t)
+
+	_, err := newClient(s, "wrong").ListJobs(nil)
+	assert.ErrorIs(t, err, api.ErrUnauthorized)
+
+	client := newClient(s, s.Token)
+	_, err = client.CreateJob(&api.CreateJobRequest{Interval: 60})
+	var apiErr *api.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 422, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Fields, "name")
+
+	s.Fake.Errors["ListApis"] = errors.New("database unavailable")
+	_, err = client.ListApis(nil)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 500, apiErr.StatusCode)
+	assert.Equal(t, "database unavailable", apiErr.Message)
+}
+
+// TestServer_Login tests exchanging the account's credentials for Token
+func TestServer_Login(t *testing.T) {
+	s := NewServer(t)
+	client := newClient(s, "")
+
+	token, err := client.Login(s.Fake.Account.Email, s.Password)
+	require.NoError(t, err)
+	assert.Equal(t, s.Token, token)
+
+	_, err = client.Login(s.Fake.Account.Email, "nope")
+	assert.ErrorIs(t, err, api.ErrUnauthorized)
+}
+
+// TestServer_Pings tests sending pings and reading them back as history
+func TestServer_Pings(t *testing.T) {
+	s := NewServer(t)
+	s.Fake.Jobs = []api.Job{{ID: "job-1", Name: "Backup", PingToken: "tok"}}
+	client := newClient(s, s.Token)
+
+	require.NoError(t, client.SendPing("tok", api.PingStart, 0))
+	require.NoError(t, client.SendPing("tok", api.PingSuccess, 1500*time.Millisecond))
+	assert.Error(t, client.SendPing("missing", "", 0))
+
+	require.Len(t, s.Fake.SentPings, 2)
+	assert.Equal(t, api.PingStart, s.Fake.SentPings[0].PingType)
+	assert.Equal(t, 1500*time.Millisecond, s.Fake.SentPings[1].Duration)
+
+	pings, err := client.ListAllJobPings("job-1", nil, 0)
+	require.NoError(t, err)
+	assert.Len(t, pings, 2)
+}
+
+// TestServer_RateLimit tests that the fake's rate limit reaches the client
+// as headers
+func TestServer_RateLimit(t *testing.T) {
+	s := NewServer(t)
+	s.Fake.Limit = &api.RateLimit{Limit: 100, Remaining: 42}
+	client := newClient(s, s.Token)
+
+	_, err := client.ListProjects()
+	require.NoError(t, err)
+	limit, ok := client.RateLimit()
+	require.True(t, ok)
+	assert.Equal(t, 42, limit.Remaining)
+}
@@ -0,0 +1,117 @@
+// Package cache keeps short-lived copies of API responses on disk, so
+// commands run in quick succession (shell completion, ID resolution) don't
+// each fetch the same listings
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Store is a directory of cached values that expire after a TTL
+type Store struct {
+	dir string
+	ttl time.Duration
+	// scope separates entries for different accounts and API servers
+	scope string
+	now   func() time.Time
+}
+
+// entry is a cached value as written to disk
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// New returns a store in dir whose entries expire after ttl. Entries are
+// scoped by the given parts, e.g. the API URL and access token, so one
+// account never sees another's cache. The parts are hashed, never written.
+func New(dir string, ttl time.Duration, scope ...string) *Store {
+	h := sha256.New()
+	for _, part := range scope {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return &Store{dir: dir, ttl: ttl, scope: hex.EncodeToString(h.Sum(nil))[:16], now: time.Now}
+}
+
+// path returns the file holding key
+func (s *Store) path(key string) string {
+	return filepath.Join(s.dir, s.scope+"-"+key+".json")
+}
+
+// Get decodes the cached value for key into v, reporting false when there
+// is none or it has expired
+func (s *Store) Get(key string, v any) bool {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return false
+	}
+	if age := s.now().Sub(e.StoredAt); age < 0 || age > s.ttl {
+		return false
+	}
+	return json.Unmarshal(e.Data, v) == nil
+}
+
+// Put caches v under key. The file is written with owner-only permissions
+// and renamed into place, so a concurrent Get never sees half of it.
+func (s *Store) Put(key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(entry{StoredAt: s.now(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+// Clear removes every cached value in dir, for all scopes, and reports how
+// many there were
+func Clear(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, e.Name())); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStore tests caching a value until it expires
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+	s := New(dir, time.Minute, "https://api.groovekit.io", "token")
+	s.now = func() time.Time { return now }
+
+	var got []string
+	assert.False(t, s.Get("jobs", &got))
+
+	require.NoError(t, s.Put("jobs", []string{"a", "b"}))
+	assert.True(t, s.Get("jobs", &got))
+	assert.Equal(t, []string{"a", "b"}, got)
+
+	info, err := os.Stat(s.path("jobs"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	now = now.Add(2 * time.Minute)
+	assert.False(t, s.Get("jobs", &got), "expired entries are ignored")
+}
+
+// TestStoreScopes tests that accounts don't share cached values
+func TestStoreScopes(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, New(dir, time.Minute, "https://api.groovekit.io", "alice").Put("jobs", []string{"a"}))
+
+	var got []string
+	assert.False(t, New(dir, time.Minute, "https://api.groovekit.io", "bob").Get("jobs", &got))
+	assert.True(t, New(dir, time.Minute, "https://api.groovekit.io", "alice").Get("jobs", &got))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, e := range entries {
+		assert.NotContains(t, e.Name(), "alice", "scopes are hashed")
+	}
+}
+
+// TestClear tests removing every cached value
+func TestClear(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	removed, err := Clear(dir)
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+
+	require.NoError(t, New(dir, time.Minute, "a").Put("jobs", 1))
+	require.NoError(t, New(dir, time.Minute, "b").Put("apis", 2))
+	removed, err = Clear(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	var got int
+	assert.False(t, New(dir, time.Minute, "a").Get("jobs", &got))
+}
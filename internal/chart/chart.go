@@ -0,0 +1,240 @@
+// Package chart draws small text charts for the terminal
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Point is one sample in a time series
+type Point struct {
+	Time   time.Time
+	Value  float64
+	Failed bool
+}
+
+// Options control how a line chart is drawn
+type Options struct {
+	// Width and Height are the plot size in characters, excluding axes
+	Width  int
+	Height int
+	// ASCII draws with "*" instead of braille dots, for terminals and fonts
+	// without braille support
+	ASCII bool
+	// From and To fix the time axis; when zero, it spans the points
+	From time.Time
+	To   time.Time
+	// Unit is appended to the axis labels, e.g. "ms"
+	Unit string
+	// Mark styles the failure markers, e.g. to color them; nil leaves them plain
+	Mark func(string) string
+}
+
+// FailureMarker marks the columns of failed points below the x axis
+const FailureMarker = "✗"
+
+// braille dot bits, indexed by [x][y] within a 2x4 cell
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// Line renders points as a line chart of value over time, with the y axis
+// starting at zero and a row of failure markers under the x axis. Points
+// are plotted in their own time zone.
+func Line(points []Point, opts Options) string {
+	if opts.Width < 2 {
+		opts.Width = 2
+	}
+	if opts.Height < 2 {
+		opts.Height = 2
+	}
+	if opts.Mark == nil {
+		opts.Mark = func(s string) string { return s }
+	}
+	if len(points) == 0 {
+		return ""
+	}
+
+	points = append([]Point(nil), points...)
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Time.Before(points[j].Time) })
+
+	dotsX, dotsY := 2, 4
+	if opts.ASCII {
+		dotsX, dotsY = 1, 1
+	}
+	cols, rows := opts.Width*dotsX, opts.Height*dotsY
+
+	start, end := points[0].Time, points[len(points)-1].Time
+	if !opts.From.IsZero() {
+		start = opts.From
+	}
+	if !opts.To.IsZero() {
+		end = opts.To
+	}
+	column := func(t time.Time) int {
+		span := end.Sub(start)
+		if span <= 0 {
+			return 0
+		}
+		return int(float64(t.Sub(start)) / float64(span) * float64(cols-1))
+	}
+
+	// Bucket points into dot columns, keeping the worst value per column
+	peak := make([]float64, cols)
+	seen := make([]bool, cols)
+	failed := make([]bool, opts.Width)
+	top := 0.0
+	for _, p := range points {
+		if p.Time.Before(start) || p.Time.After(end) {
+			continue
+		}
+		x := column(p.Time)
+		if !seen[x] || p.Value > peak[x] {
+			peak[x] = p.Value
+		}
+		seen[x] = true
+		if p.Failed {
+			failed[x/dotsX] = true
+		}
+		top = math.Max(top, p.Value)
+	}
+	if top == 0 {
+		top = 1
+	}
+
+	row := func(v float64) int {
+		return rows - 1 - int(math.Round(v/top*float64(rows-1)))
+	}
+
+	grid := make([][]bool, cols)
+	for x := range grid {
+		grid[x] = make([]bool, rows)
+	}
+	prev := -1
+	for x := 0; x < cols; x++ {
+		if !seen[x] {
+			continue
+		}
+		y := row(peak[x])
+		grid[x][y] = true
+		// Join to the previous sample with a vertical run so the line reads
+		// as continuous
+		if prev >= 0 {
+			for yy := min(y, prev); yy <= max(y, prev); yy++ {
+				grid[x][yy] = true
+			}
+		}
+		prev = y
+	}
+
+	labels := []string{formatValue(top, opts.Unit), formatValue(top/2, opts.Unit), formatValue(0, opts.Unit)}
+	labelWidth := 0
+	for _, label := range labels {
+		labelWidth = max(labelWidth, len(label))
+	}
+	pad := strings.Repeat(" ", labelWidth)
+
+	var b strings.Builder
+	for cy := 0; cy < opts.Height; cy++ {
+		label := pad
+		switch cy {
+		case 0:
+			label = labels[0]
+		case opts.Height - 1:
+			label = labels[2]
+		case opts.Height / 2:
+			label = labels[1]
+		}
+		axis := "│"
+		if label != pad {
+			axis = "┤"
+		}
+		var line strings.Builder
+		for cx := 0; cx < opts.Width; cx++ {
+			line.WriteString(cell(grid, cx, cy, dotsX, dotsY, opts.ASCII))
+		}
+		fmt.Fprintf(&b, "%*s %s%s\n", labelWidth, label, axis, strings.TrimRight(line.String(), " "))
+	}
+
+	fmt.Fprintf(&b, "%s └%s\n", pad, strings.Repeat("─", opts.Width))
+
+	var markers strings.Builder
+	anyFailed := false
+	for _, f := range failed {
+		if f {
+			anyFailed = true
+			markers.WriteString(opts.Mark(FailureMarker))
+		} else {
+			markers.WriteString(" ")
+		}
+	}
+	if anyFailed {
+		fmt.Fprintf(&b, "%s  %s\n", pad, strings.TrimRight(markers.String(), " "))
+	}
+
+	first, last := formatTime(start, end), formatTime(end, start)
+	gap := opts.Width - len(first) - len(last)
+	if gap < 1 || first == last {
+		fmt.Fprintf(&b, "%s  %s\n", pad, first)
+	} else {
+		fmt.Fprintf(&b, "%s  %s%s%s\n", pad, first, strings.Repeat(" ", gap), last)
+	}
+	return b.String()
+}
+
+// cell renders one character of the plot
+func cell(grid [][]bool, cx, cy, dotsX, dotsY int, ascii bool) string {
+	if ascii {
+		if grid[cx][cy] {
+			return "*"
+		}
+		return " "
+	}
+	r := rune(0x2800)
+	for dx := 0; dx < dotsX; dx++ {
+		for dy := 0; dy < dotsY; dy++ {
+			if grid[cx*dotsX+dx][cy*dotsY+dy] {
+				r |= brailleDots[dx][dy]
+			}
+		}
+	}
+	if r == 0x2800 {
+		return " "
+	}
+	return string(r)
+}
+
+// formatValue renders an axis label
+func formatValue(v float64, unit string) string {
+	if v >= 10 || v == 0 {
+		return fmt.Sprintf("%.0f%s", v, unit)
+	}
+	return fmt.Sprintf("%.1f%s", v, unit)
+}
+
+// formatTime renders an x axis label, including the date when the chart
+// spans more than a day
+func formatTime(t, other time.Time) string {
+	span := t.Sub(other)
+	if span < 0 {
+		span = -span
+	}
+	if span > 24*time.Hour {
+		return t.Format("Jan 02 15:04")
+	}
+	return t.Format("15:04")
+}
+
+// Bar renders fraction (0 to 1) as a bar of width characters, painting the
+// filled part with paint, e.g. "[████░░]". paint may be nil.
+func Bar(fraction float64, width int, paint func(string) string) string {
+	if paint == nil {
+		paint = func(s string) string { return s }
+	}
+	filled := int(math.Max(0, math.Min(1, fraction)) * float64(width))
+	return "[" + paint(strings.Repeat("█", filled)) + strings.Repeat("░", width-filled) + "]"
+}
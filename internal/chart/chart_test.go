@@ -0,0 +1,76 @@
+package chart
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var t0 = time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC)
+
+// TestLine_ASCII tests plotting values, axis labels, and failure markers
+func TestLine_ASCII(t *testing.T) {
+	points := []Point{
+		{Time: t0, Value: 100},
+		{Time: t0.Add(30 * time.Minute), Value: 0, Failed: true},
+		{Time: t0.Add(60 * time.Minute), Value: 200},
+	}
+
+	got := Line(points, Options{Width: 5, Height: 3, ASCII: true, Unit: "ms"})
+
+	want := strings.Join([]string{
+		"200ms ┤    *",
+		"100ms ┤* * *",
+		"  0ms ┤  * *",
+		"      └─────",
+		"         ✗",
+		"       10:00",
+		"",
+	}, "\n")
+	assert.Equal(t, want, got)
+}
+
+// TestLine_Braille tests that braille cells combine dots
+func TestLine_Braille(t *testing.T) {
+	points := []Point{
+		{Time: t0, Value: 0},
+		{Time: t0.Add(time.Hour), Value: 10},
+	}
+
+	got := Line(points, Options{Width: 2, Height: 2})
+	lines := strings.Split(got, "\n")
+	require.GreaterOrEqual(t, len(lines), 2)
+	assert.Equal(t, " 10 ┤ ⢸", lines[0])
+	assert.Equal(t, "  0 ┤⡀⢸", lines[1])
+}
+
+// TestLine_TimeRange tests that From and To fix the time axis and drop
+// points outside it
+func TestLine_TimeRange(t *testing.T) {
+	points := []Point{
+		{Time: t0.Add(-time.Hour), Value: 500},
+		{Time: t0.Add(2 * time.Hour), Value: 50},
+	}
+
+	got := Line(points, Options{Width: 20, Height: 2, ASCII: true, From: t0, To: t0.Add(4 * time.Hour)})
+
+	assert.Contains(t, got, "50 ┤         *")
+	assert.Contains(t, got, "10:00          14:00")
+	assert.NotContains(t, got, "500")
+}
+
+// TestLine_Empty tests that nothing is drawn without points
+func TestLine_Empty(t *testing.T) {
+	assert.Equal(t, "", Line(nil, Options{Width: 10, Height: 3}))
+}
+
+// TestBar tests rendering and clamping bars
+func TestBar(t *testing.T) {
+	assert.Equal(t, "[██░░]", Bar(0.5, 4, nil))
+	assert.Equal(t, "[████]", Bar(1.5, 4, nil))
+	assert.Equal(t, "[░░░░]", Bar(-1, 4, nil))
+	assert.Equal(t, "[<█>░░░]", Bar(0.25, 4, func(s string) string { return "<" + s + ">" }))
+}
@@ -0,0 +1,54 @@
+package cmdutil
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/config"
+)
+
+// ErrNotLoggedIn is returned when a command needs credentials and none are
+// configured
+var ErrNotLoggedIn = errors.New("not logged in. Run 'groovekit auth login' first")
+
+// ClientFactory creates the API client commands use from the loaded config
+type ClientFactory func(cfg *config.Config) api.APIClient
+
+var (
+	clientMu  sync.Mutex
+	newClient ClientFactory = func(cfg *config.Config) api.APIClient {
+		return api.NewClient(cfg)
+	}
+)
+
+// SetClientFactory replaces the factory AuthenticatedClient uses and returns
+// the previous one, so tests can hand commands a fake such as
+// testutil.FakeAPI and restore the real client afterwards
+func SetClientFactory(f ClientFactory) ClientFactory {
+	clientMu.Lock()
+	defer clientMu.Unlock()
+
+	previous := newClient
+	newClient = f
+	return previous
+}
+
+// AuthenticatedClient loads the config and returns a client for the
+// logged-in user, or ErrNotLoggedIn when there are no credentials
+func AuthenticatedClient() (api.APIClient, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if !cfg.IsAuthenticated() {
+		return nil, ErrNotLoggedIn
+	}
+
+	clientMu.Lock()
+	factory := newClient
+	clientMu.Unlock()
+	return factory(cfg), nil
+}
@@ -0,0 +1,41 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/config"
+	"github.com/scookdev/groovekit-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useConfigDir points the config at an empty directory for the rest of the
+// test
+func useConfigDir(t *testing.T) {
+	t.Helper()
+	previous := config.Dir()
+	config.SetDir(t.TempDir())
+	t.Cleanup(func() { config.SetDir(previous) })
+}
+
+// TestAuthenticatedClient tests that the client comes from the injected
+// factory, and only once credentials are configured
+func TestAuthenticatedClient(t *testing.T) {
+	useConfigDir(t)
+	fake := testutil.NewFakeAPI()
+	previous := SetClientFactory(func(cfg *config.Config) api.APIClient {
+		assert.Equal(t, "test-token", cfg.AccessToken)
+		return fake
+	})
+	t.Cleanup(func() { SetClientFactory(previous) })
+
+	t.Setenv("GROOVEKIT_TOKEN", "")
+	_, err := AuthenticatedClient()
+	assert.ErrorIs(t, err, ErrNotLoggedIn)
+
+	t.Setenv("GROOVEKIT_TOKEN", "test-token")
+	client, err := AuthenticatedClient()
+	require.NoError(t, err)
+	assert.Same(t, fake, client)
+}
@@ -0,0 +1,71 @@
+// Package cmdutil provides the helpers shared by the CLI's commands: the
+// authenticated API client, short ID resolution, and formatting and output
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Truncate shortens s to maxLen characters, ending in "..." when cut. It
+// cuts between runes so multi-byte names stay valid UTF-8, and a maxLen too
+// small for the ellipsis just keeps the first maxLen characters.
+func Truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	if maxLen <= 3 {
+		return string(runes[:max(maxLen, 0)])
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
+
+// ValueWriter is a writer that renders values itself instead of having
+// them written as JSON, such as one applying an output template
+type ValueWriter interface {
+	io.Writer
+	WriteValue(v interface{}) error
+}
+
+// OutputJSON writes v to w as indented JSON, or hands it to w when w is a
+// ValueWriter
+func OutputJSON(w io.Writer, v interface{}) error {
+	if vw, ok := w.(ValueWriter); ok {
+		return vw.WriteValue(v)
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Fprintln(w, string(data))
+	return nil
+}
+
+// FormatIncidentDuration formats an incident's length in seconds as 30s,
+// 15m, 2.5h, or 1.5d
+func FormatIncidentDuration(seconds float64) string {
+	if seconds < 60 {
+		return fmt.Sprintf("%.0fs", seconds)
+	}
+	minutes := seconds / 60
+	if minutes < 60 {
+		return fmt.Sprintf("%.0fm", minutes)
+	}
+	hours := minutes / 60
+	if hours < 24 {
+		return fmt.Sprintf("%.1fh", hours)
+	}
+	days := hours / 24
+	return fmt.Sprintf("%.1fd", days)
+}
+
+// ShortID truncates an ID to its first 8 characters (like Docker), which
+// is enough for commands to resolve it
+func ShortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
@@ -0,0 +1,90 @@
+package cmdutil
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTruncate tests shortening strings to a maximum length
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxLen   int
+		expected string
+	}{
+		{"short string", "hello", 10, "hello"},
+		{"exact length", "hello", 5, "hello"},
+		{"long string", "this is a very long string", 10, "this is..."},
+		{"multi-byte", "café crème brûlée", 10, "café cr..."},
+		{"multi-byte exact length", "日本語", 3, "日本語"},
+		{"multi-byte cut", "日本語のジョブ", 5, "日本..."},
+		{"too short for ellipsis", "hello", 2, "he"},
+		{"ellipsis length", "hello", 3, "hel"},
+		{"zero", "hello", 0, ""},
+		{"negative", "hello", -1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Truncate(tt.input, tt.maxLen))
+		})
+	}
+}
+
+// TestOutputJSON tests writing indented JSON and reporting values that
+// can't be encoded
+func TestOutputJSON(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, OutputJSON(&out, map[string]string{"name": "backup"}))
+	assert.Equal(t, "{\n  \"name\": \"backup\"\n}\n", out.String())
+
+	assert.ErrorContains(t, OutputJSON(&out, math.Inf(1)), "failed to marshal JSON")
+
+	var values valueRecorder
+	require.NoError(t, OutputJSON(&values, map[string]string{"name": "backup"}))
+	assert.Equal(t, []interface{}{map[string]string{"name": "backup"}}, values.values)
+	assert.Empty(t, values.String())
+}
+
+// valueRecorder is a ValueWriter that keeps the values it's given
+type valueRecorder struct {
+	bytes.Buffer
+	values []interface{}
+}
+
+func (r *valueRecorder) WriteValue(v interface{}) error {
+	r.values = append(r.values, v)
+	return nil
+}
+
+// TestFormatIncidentDuration tests picking the unit for an incident's length
+func TestFormatIncidentDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		seconds  float64
+		expected string
+	}{
+		{"seconds", 30.0, "30s"},
+		{"minutes", 120.0, "2m"},
+		{"hours", 7200.0, "2.0h"},
+		{"days", 172800.0, "2.0d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatIncidentDuration(tt.seconds))
+		})
+	}
+}
+
+// TestShortID tests shortening IDs for display
+func TestShortID(t *testing.T) {
+	assert.Equal(t, "abc12345", ShortID("abc12345-0000-4000-8000-000000000001"))
+	assert.Equal(t, "inc-1", ShortID("inc-1"))
+	assert.Equal(t, "", ShortID(""))
+}
@@ -0,0 +1,114 @@
+package cmdutil
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+)
+
+// Kinds of resources whose short IDs can be resolved. Each is the plural
+// noun used in "no X match" errors.
+const (
+	KindJobs        = "jobs"
+	KindAPIMonitors = "API monitors"
+	KindCerts       = "certs"
+	KindDomains     = "domain monitors"
+	KindDNSMonitors = "DNS monitors"
+	KindProjects    = "projects"
+	KindIncidents   = "incidents"
+)
+
+// Resolver resolves short or partial IDs of one kind of resource to full
+// IDs
+type Resolver interface {
+	ID(query string) (string, error)
+}
+
+// ResolverFactory creates a resolver that lists resources with client
+type ResolverFactory func(client api.APIClient) Resolver
+
+// resolverKey identifies a cached resolver
+type resolverKey struct {
+	client api.APIClient
+	kind   string
+}
+
+var (
+	resolversMu sync.Mutex
+	factories   = map[string]ResolverFactory{}
+	resolvers   = map[resolverKey]Resolver{}
+)
+
+// RegisterResolver sets the factory for a kind of resource's resolvers
+func RegisterResolver(kind string, f ResolverFactory) {
+	resolversMu.Lock()
+	defer resolversMu.Unlock()
+
+	factories[kind] = f
+}
+
+// ResolverFor returns the resolver for a kind of resource, or nil when none
+// is registered. Resolvers are cached per client, which lives for one
+// command invocation, so a command that resolves several IDs lists each
+// kind of resource only once.
+func ResolverFor(client api.APIClient, kind string) Resolver {
+	resolversMu.Lock()
+	defer resolversMu.Unlock()
+
+	key := resolverKey{client: client, kind: kind}
+	if r, ok := resolvers[key]; ok {
+		return r
+	}
+	f, ok := factories[kind]
+	if !ok {
+		return nil
+	}
+	r := f(client)
+	resolvers[key] = r
+	return r
+}
+
+// ResolveID resolves a short ID of a kind of resource to a full ID
+func ResolveID(client api.APIClient, kind, shortID string) (string, error) {
+	r := ResolverFor(client, kind)
+	if r == nil {
+		return "", fmt.Errorf("cannot resolve IDs of %s", kind)
+	}
+	return r.ID(shortID)
+}
+
+// ResolveJobID resolves a short job ID to a full ID
+func ResolveJobID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindJobs, shortID)
+}
+
+// ResolveAPIMonitorID resolves a short API monitor ID to a full ID
+func ResolveAPIMonitorID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindAPIMonitors, shortID)
+}
+
+// ResolveCertID resolves a short cert ID to a full ID
+func ResolveCertID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindCerts, shortID)
+}
+
+// ResolveDomainID resolves a short domain monitor ID to a full ID
+func ResolveDomainID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindDomains, shortID)
+}
+
+// ResolveDNSMonitorID resolves a short DNS monitor ID to a full ID
+func ResolveDNSMonitorID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindDNSMonitors, shortID)
+}
+
+// ResolveProjectID resolves a short project ID to a full ID
+func ResolveProjectID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindProjects, shortID)
+}
+
+// ResolveIncidentID resolves a short incident ID to a full ID
+func ResolveIncidentID(client api.APIClient, shortID string) (string, error) {
+	return ResolveID(client, KindIncidents, shortID)
+}
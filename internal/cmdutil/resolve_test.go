@@ -0,0 +1,67 @@
+package cmdutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/scookdev/groovekit-cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// created
+type prefixResolver struct {
+	prefix string
+}
+
+func (r prefixResolver) ID(query string) (string, error) {
+	if query == "" {
+		return "", errors.New("empty ID")
+	}
+	return r.prefix + query, nil
+}
+
+// TestResolveID tests resolving IDs with registered resolvers, which are
+// created once per client
+func TestResolveID(t *testing.T) {
+	created := 0
+	RegisterResolver("widgets", func(api.APIClient) Resolver {
+		created++
+		return prefixResolver{prefix: "widget-"}
+	})
+
+	client := testutil.NewFakeAPI()
+	id, err := ResolveID(client, "widgets", "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "widget-abc", id)
+
+	_, err = ResolveID(client, "widgets", "")
+	assert.EqualError(t, err, "empty ID")
+	assert.Equal(t, 1, created)
+
+	_, err = ResolveID(testutil.NewFakeAPI(), "widgets", "abc")
+	require.NoError(t, err)
+	assert.Equal(t, 2, created)
+
+	assert.Nil(t, ResolverFor(client, "gadgets"))
+	_, err = ResolveID(client, "gadgets", "abc")
+	assert.EqualError(t, err, "cannot resolve IDs of gadgets")
+}
+
+// TestResolveJobID tests that the named helpers resolve their kind of
+// resource
+func TestResolveJobID(t *testing.T) {
+	RegisterResolver(KindJobs, func(api.APIClient) Resolver {
+		return prefixResolver{prefix: "job-"}
+	})
+	t.Cleanup(func() {
+		resolversMu.Lock()
+		defer resolversMu.Unlock()
+		delete(factories, KindJobs)
+	})
+
+	id, err := ResolveJobID(testutil.NewFakeAPI(), "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "job-abc", id)
+}
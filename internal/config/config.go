@@ -3,58 +3,262 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/keyring"
 )
 
+// TokenStorageKeyring marks an access token that lives in the OS keyring
+const TokenStorageKeyring = "keyring"
+
+// DefaultProfile is the profile stored in the top-level config fields
+const DefaultProfile = "default"
+
+// DefaultAPIBaseURL is the hosted GrooveKit API
+const DefaultAPIBaseURL = "https://api.groovekit.io"
+
+// Release channels checked for new versions of the CLI
+const (
+	UpdateChannelStable = "stable"
+	UpdateChannelNone   = "none"
+)
+
+// UpdateCheck is the result of the last check for a new version
+type UpdateCheck struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Latest    string    `json:"latest,omitempty"`
+}
+
 // Config stores the CLI configuration including API credentials
 type Config struct {
 	APIBaseURL  string `json:"api_base_url"`
 	AccessToken string `json:"access_token"`
 	Email       string `json:"email"`
+	// TokenStorage records where the access token is kept ("keyring" or
+	// empty for plaintext in this file)
+	TokenStorage string `json:"token_storage,omitempty"`
+	// InsecureStorage opts out of the OS keyring and keeps the token in
+	// this file
+	InsecureStorage bool `json:"insecure_storage,omitempty"`
+	// TokenHeader is the header the access token is sent in. Empty or
+	// "Authorization" sends "Bearer <token>"; any other header gets the
+	// raw token.
+	TokenHeader string `json:"token_header,omitempty"`
+	// Headers are sent with every request, e.g. Cloudflare Access service
+	// tokens for deployments behind a zero-trust gateway. Values may
+	// reference environment variables as $VAR or ${VAR}.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Profiles holds named configurations. The top-level fields are the
+	// default profile.
+	Profiles map[string]*Config `json:"profiles,omitempty"`
+	// UpdateChannel is the release channel checked for new versions; empty
+	// or "none" turns the check off. Shared by all profiles.
+	UpdateChannel string       `json:"update_channel,omitempty"`
+	UpdateCheck   *UpdateCheck `json:"update_check,omitempty"`
+	// Output is the default output format, "table" or "json". Shared by
+	// all profiles.
+	Output string `json:"output,omitempty"`
+	// Color is when to color output: "auto", "always", or "never". Shared
+	// by all profiles.
+	Color string `json:"color,omitempty"`
+	// Timezone is the zone times are displayed in: "local", "UTC", or an
+	// IANA name such as "Europe/London". Shared by all profiles.
+	Timezone string `json:"timezone,omitempty"`
+	// CurrentProfile is the profile used when neither --profile nor
+	// GROOVEKIT_PROFILE selects one
+	CurrentProfile string `json:"current_profile,omitempty"`
+
+	// profile is the name of the loaded profile and root the whole config
+	// file it came from, so Save can write it back in place
+	profile string
+	root    *Config
+	// fileAPIURL is the API URL in the config file when an override from
+	// SetAPIURL or GROOVEKIT_API_URL is in effect, so Save keeps it
+	fileAPIURL       string
+	apiURLOverridden bool
+	// keyringToken is the token last read from or written to the keyring,
+	// so Save only rewrites the keychain item when the token changes
+	keyringToken string
+}
+
+// activeProfile is the profile selected with SetProfile
+var activeProfile string
+
+// SetProfile selects the profile Load returns, overriding GROOVEKIT_PROFILE
+func SetProfile(name string) {
+	activeProfile = name
+}
+
+// apiURLOverride is the API URL set with SetAPIURL
+var apiURLOverride string
+
+// SetAPIURL points the loaded profile at url, overriding GROOVEKIT_API_URL
+// and the config file
+func SetAPIURL(url string) {
+	apiURLOverride = url
+}
+
+// profileName returns the selected profile, from SetProfile, the
+// GROOVEKIT_PROFILE environment variable, or the config file's current
+// profile
+func profileName(root *Config) string {
+	name := activeProfile
+	if name == "" {
+		name = os.Getenv("GROOVEKIT_PROFILE")
+	}
+	if name == "" {
+		name = root.CurrentProfile
+	}
+	if name == "" {
+		return DefaultProfile
+	}
+	return name
+}
+
+// ProfileName returns the name of the profile Load would return
+func ProfileName() string {
+	root, err := loadRoot()
+	if err != nil {
+		root = &Config{}
+	}
+	return profileName(root)
 }
 
-var configDir = filepath.Join(os.Getenv("HOME"), ".groovekit")
+var configDir = defaultConfigDir()
 var configFile = filepath.Join(configDir, "config.json")
 
-// Load reads the config from ~/.groovekit/config.json
+// defaultConfigDir returns %AppData%\groovekit on Windows and ~/.groovekit
+// everywhere else
+func defaultConfigDir() string {
+	if runtime.GOOS == "windows" {
+		if dir, err := os.UserConfigDir(); err == nil {
+			return filepath.Join(dir, "groovekit")
+		}
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".groovekit")
+	}
+	return ".groovekit"
+}
+
+// Path returns the location of the config file
+func Path() string {
+	return configFile
+}
+
+// Dir returns the directory holding the config file and other CLI state
+func Dir() string {
+	return configDir
+}
+
+// SetDir moves the config file and other CLI state to dir, e.g. so tests
+// don't read or write the real ~/.groovekit
+func SetDir(dir string) {
+	configDir = dir
+	configFile = filepath.Join(dir, "config.json")
+}
+
+// Load reads the active profile from ~/.groovekit/config.json. A profile
+// that doesn't exist yet loads empty so `auth login` can create it.
 func Load() (*Config, error) {
-	data, err := os.ReadFile(configFile)
+	root, err := loadRoot()
 	if err != nil {
-		if os.IsNotExist(err) {
-			// Config doesn't exist yet, return default with env var support
-			cfg := &Config{
-				APIBaseURL: getAPIBaseURL(),
-			}
-			// Check for CI/CD token even if config file doesn't exist
-			if envToken := os.Getenv("GROOVEKIT_TOKEN"); envToken != "" {
-				cfg.AccessToken = envToken
-			}
-			return cfg, nil
-		}
 		return nil, err
 	}
 
-	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
-		return nil, err
+	name := profileName(root)
+	cfg := root
+	if name != DefaultProfile {
+		cfg = &Config{}
+		if p, ok := root.Profiles[name]; ok {
+			*cfg = *p
+		}
+		cfg.Profiles = nil
 	}
+	cfg.profile = name
+	cfg.root = root
 
-	// Environment variables take precedence
-	if envURL := os.Getenv("GROOVEKIT_API_URL"); envURL != "" {
+	// SetAPIURL and then environment variables take precedence
+	if apiURLOverride != "" || os.Getenv("GROOVEKIT_API_URL") != "" {
+		cfg.fileAPIURL, cfg.apiURLOverridden = cfg.APIBaseURL, true
+	}
+	if apiURLOverride != "" {
+		cfg.APIBaseURL = apiURLOverride
+	} else if envURL := os.Getenv("GROOVEKIT_API_URL"); envURL != "" {
 		cfg.APIBaseURL = envURL
 	} else if cfg.APIBaseURL == "" {
 		// Set default API URL if not present
 		cfg.APIBaseURL = getAPIBaseURL()
 	}
 
-	// Check for CI/CD token environment variable
+	// Check for CI/CD token environment variable; only fall back to the
+	// keyring when it isn't set so CI never triggers keychain prompts
 	if envToken := os.Getenv("GROOVEKIT_TOKEN"); envToken != "" {
 		cfg.AccessToken = envToken
+	} else if cfg.TokenStorage == TokenStorageKeyring {
+		token, err := keyring.Get(name)
+		if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+			return nil, fmt.Errorf("failed to read token from keyring: %w", err)
+		}
+		cfg.AccessToken, cfg.keyringToken = token, token
+	}
+
+	return cfg, nil
+}
+
+// loadRoot reads the whole config file, returning an empty config when it
+// doesn't exist yet
+func loadRoot() (*Config, error) {
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Config{}, nil
+		}
+		return nil, err
+	}
+
+	var root Config
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, err
 	}
+	return &root, nil
+}
+
+// ProfileSettings returns the stored fields of the named profile within the
+// whole config file, adding the profile if it doesn't exist
+func (c *Config) ProfileSettings(name string) *Config {
+	if name == DefaultProfile {
+		return c
+	}
+	if c.Profiles == nil {
+		c.Profiles = map[string]*Config{}
+	}
+	if c.Profiles[name] == nil {
+		c.Profiles[name] = &Config{}
+	}
+	return c.Profiles[name]
+}
+
+// Profile returns the name of the loaded profile
+func (c *Config) Profile() string {
+	if c.profile == "" {
+		return DefaultProfile
+	}
+	return c.profile
+}
 
-	return &cfg, nil
+// RequestHeaders returns the extra headers with environment variables expanded
+func (c *Config) RequestHeaders() map[string]string {
+	headers := make(map[string]string, len(c.Headers))
+	for name, value := range c.Headers {
+		headers[name] = os.ExpandEnv(value)
+	}
+	return headers
 }
 
 // getAPIBaseURL returns the API base URL from env var or default
@@ -62,17 +266,61 @@ func getAPIBaseURL() string {
 	if envURL := os.Getenv("GROOVEKIT_API_URL"); envURL != "" {
 		return envURL
 	}
-	return "https://api.groovekit.io"
+	return DefaultAPIBaseURL
 }
 
-// Save writes the config to ~/.groovekit/config.json
+// Save writes the profile to ~/.groovekit/config.json. Unless
+// InsecureStorage is set, the access token is moved into the OS keyring,
+// which is left alone when it already holds the token; when no keyring is
+// available it falls back to plaintext in the file.
 func (c *Config) Save() error {
 	// Create config directory if it doesn't exist
 	if err := os.MkdirAll(configDir, 0700); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	data, err := json.MarshalIndent(c, "", "  ")
+	inKeyring := c.TokenStorage == TokenStorageKeyring && c.keyringToken == c.AccessToken
+	c.TokenStorage = ""
+	if c.AccessToken != "" && !c.InsecureStorage {
+		if inKeyring {
+			c.TokenStorage = TokenStorageKeyring
+		} else if err := keyring.Set(c.Profile(), c.AccessToken); err == nil {
+			c.TokenStorage = TokenStorageKeyring
+			c.keyringToken = c.AccessToken
+		}
+	}
+
+	stored := *c
+	stored.profile, stored.root = "", nil
+	// An overridden API URL only applies to this run
+	if c.apiURLOverridden {
+		stored.APIBaseURL = c.fileAPIURL
+	}
+	stored.fileAPIURL, stored.apiURLOverridden = "", false
+	if stored.TokenStorage == TokenStorageKeyring {
+		stored.AccessToken = ""
+	}
+
+	// Named profiles are written back into the file they were loaded from
+	file := &stored
+	if c.Profile() != DefaultProfile {
+		file = c.root
+		if file == nil {
+			file = &Config{}
+		}
+		if file.Profiles == nil {
+			file.Profiles = map[string]*Config{}
+		}
+		stored.Profiles = nil
+		file.Profiles[c.Profile()] = &stored
+	}
+
+	return writeFile(file)
+}
+
+// writeFile writes the whole config file with owner-only permissions
+func writeFile(file *Config) error {
+	data, err := json.MarshalIndent(file, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -84,12 +332,96 @@ func (c *Config) Save() error {
 	return nil
 }
 
-// Clear removes the config file
+// Clear removes the active profile's credentials, including any token in
+// the keyring. The config file is removed once no profiles or shared
+// settings remain.
 func Clear() error {
-	return os.Remove(configFile)
+	root, err := loadRoot()
+	if err != nil {
+		return err
+	}
+
+	name := profileName(root)
+	_ = keyring.Delete(name)
+
+	if name != DefaultProfile {
+		delete(root.Profiles, name)
+	} else {
+		root.AccessToken, root.Email, root.TokenStorage = "", "", ""
+		if len(root.Profiles) == 0 && !root.hasSettings() {
+			return os.Remove(configFile)
+		}
+	}
+	return writeFile(root)
+}
+
+// UpdateChannel returns the release channel checked for new versions, or
+// "" when the check is off
+func UpdateChannel() string {
+	root, err := loadRoot()
+	if err != nil || root.UpdateChannel == UpdateChannelNone {
+		return ""
+	}
+	return root.UpdateChannel
+}
+
+// SetUpdateChannel sets the release channel checked for new versions,
+// forgetting the last check when it is turned off
+func (c *Config) SetUpdateChannel(channel string) {
+	c.UpdateChannel = channel
+	if channel == "" || channel == UpdateChannelNone {
+		c.UpdateCheck = nil
+	}
+}
+
+// LastUpdateCheck returns the result of the last version check, or nil if
+// there hasn't been one
+func LastUpdateCheck() *UpdateCheck {
+	root, err := loadRoot()
+	if err != nil {
+		return nil
+	}
+	return root.UpdateCheck
+}
+
+// RecordUpdateCheck stores the result of a version check
+func RecordUpdateCheck(check UpdateCheck) error {
+	return UpdateSettings(func(root *Config) {
+		root.UpdateCheck = &check
+	})
+}
+
+// LoadSettings reads the whole config file, with every profile and the
+// settings they share, without applying environment variables
+func LoadSettings() (*Config, error) {
+	return loadRoot()
+}
+
+// UpdateSettings changes the config file in place; change is given the
+// whole file as returned by LoadSettings
+func UpdateSettings(change func(root *Config)) error {
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	root, err := loadRoot()
+	if err != nil {
+		return err
+	}
+	change(root)
+	return writeFile(root)
+}
+
+// hasSettings reports whether any settings shared by all profiles are set
+func (c *Config) hasSettings() bool {
+	return c.UpdateChannel != "" || c.Output != "" || c.Color != "" || c.Timezone != "" || c.CurrentProfile != ""
 }
 
 // IsAuthenticated checks if user is logged in
 func (c *Config) IsAuthenticated() bool {
 	return c.AccessToken != ""
 }
+
+// TokenInKeyring reports whether the access token is stored in the OS keyring
+func (c *Config) TokenInKeyring() bool {
+	return c.TokenStorage == TokenStorageKeyring
+}
@@ -2,7 +2,13 @@ package config
 
 import (
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/keyring"
 )
 
 func TestLoad_WithTokenEnvVar(t *testing.T) {
@@ -109,3 +115,377 @@ func TestIsAuthenticated(t *testing.T) {
 		})
 	}
 }
+
+// useTempConfig points the config file at a temp dir and mocks the keyring
+func useTempConfig(t *testing.T) {
+	t.Helper()
+	keyring.MockInit()
+	origDir := configDir
+	SetDir(t.TempDir())
+	t.Cleanup(func() { SetDir(origDir) })
+}
+
+func TestSave_StoresTokenInKeyring(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &Config{APIBaseURL: "https://api.example.com", AccessToken: "secret-token", Email: "me@example.com"}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	if !cfg.TokenInKeyring() {
+		t.Fatalf("Expected token to be stored in keyring")
+	}
+
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	if strings.Contains(string(data), "secret-token") {
+		t.Errorf("Expected token not to be written to the config file, got %s", data)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if loaded.AccessToken != "secret-token" {
+		t.Errorf("Expected token to be read back from keyring, got %q", loaded.AccessToken)
+	}
+}
+
+func TestSave_SkipsUnchangedKeyringToken(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &Config{AccessToken: "secret-token"}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	// Change the keychain item behind the CLI's back; saving settings with
+	// the token it loaded must not write the item again
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if err := keyring.Set("default", "other-token"); err != nil {
+		t.Fatalf("keyring.Set() failed: %v", err)
+	}
+	loaded.Output = "json"
+	if err := loaded.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if got, _ := keyring.Get("default"); got != "other-token" {
+		t.Errorf("Expected unchanged token not to be rewritten, got %q", got)
+	}
+	if !loaded.TokenInKeyring() {
+		t.Errorf("Expected token to stay in keyring")
+	}
+
+	loaded.AccessToken = "new-token"
+	if err := loaded.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if got, _ := keyring.Get("default"); got != "new-token" {
+		t.Errorf("Expected changed token to be written, got %q", got)
+	}
+}
+
+func TestSave_InsecureStorage(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &Config{AccessToken: "plain-token", InsecureStorage: true}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	if cfg.TokenInKeyring() {
+		t.Errorf("Expected token to stay out of the keyring")
+	}
+	if _, err := keyring.Get("default"); err == nil {
+		t.Errorf("Expected no keyring entry with --insecure-storage")
+	}
+
+	data, err := os.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("Failed to read config file: %v", err)
+	}
+	if !strings.Contains(string(data), "plain-token") {
+		t.Errorf("Expected token in config file, got %s", data)
+	}
+}
+
+func TestClear_RemovesKeyringToken(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &Config{AccessToken: "secret-token"}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() failed: %v", err)
+	}
+	if _, err := keyring.Get("default"); err == nil {
+		t.Errorf("Expected keyring entry to be removed")
+	}
+}
+
+// TestClear_KeepsSharedSettings tests logging out keeps settings shared
+// by all profiles
+func TestClear_KeepsSharedSettings(t *testing.T) {
+	useTempConfig(t)
+
+	cfg := &Config{AccessToken: "secret-token", InsecureStorage: true}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if err := UpdateSettings(func(root *Config) { root.Timezone = "UTC" }); err != nil {
+		t.Fatalf("UpdateSettings() failed: %v", err)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() failed: %v", err)
+	}
+
+	settings, err := LoadSettings()
+	if err != nil {
+		t.Fatalf("LoadSettings() failed: %v", err)
+	}
+	if settings.Timezone != "UTC" {
+		t.Errorf("Expected timezone to be kept, got %q", settings.Timezone)
+	}
+	if settings.AccessToken != "" {
+		t.Errorf("Expected token to be cleared, got %q", settings.AccessToken)
+	}
+}
+
+// TestDefaultConfigDir tests the config directory is resolved from the user's home
+func TestDefaultConfigDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("AppData", filepath.Join(home, "AppData"))
+
+	want := filepath.Join(home, ".groovekit")
+	if runtime.GOOS == "windows" {
+		want = filepath.Join(home, "AppData", "groovekit")
+	}
+
+	if got := defaultConfigDir(); got != want {
+		t.Errorf("defaultConfigDir() = %q, want %q", got, want)
+	}
+}
+
+func TestProfiles_SaveAndLoad(t *testing.T) {
+	useTempConfig(t)
+	t.Setenv("GROOVEKIT_TOKEN", "")
+	t.Setenv("GROOVEKIT_API_URL", "")
+
+	// Default profile
+	cfg := &Config{APIBaseURL: "https://api.example.com", AccessToken: "default-token"}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	// Named profile written alongside it
+	SetProfile("staging")
+	t.Cleanup(func() { SetProfile("") })
+
+	staging, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if staging.IsAuthenticated() {
+		t.Fatalf("Expected new profile to start logged out")
+	}
+	staging.APIBaseURL = "https://groovekit.internal"
+	staging.TokenHeader = "X-GrooveKit-Token"
+	staging.AccessToken = "staging-token"
+	if err := staging.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	staging, err = Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if staging.APIBaseURL != "https://groovekit.internal" || staging.AccessToken != "staging-token" {
+		t.Errorf("Unexpected staging profile: %+v", staging)
+	}
+	if staging.TokenHeader != "X-GrooveKit-Token" {
+		t.Errorf("Expected token header to round trip, got %q", staging.TokenHeader)
+	}
+
+	// The default profile is untouched
+	SetProfile("")
+	def, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if def.AccessToken != "default-token" || def.APIBaseURL != "https://api.example.com" {
+		t.Errorf("Unexpected default profile: %+v", def)
+	}
+	if _, ok := def.Profiles["staging"]; !ok {
+		t.Errorf("Expected staging profile in config file")
+	}
+}
+
+func TestProfiles_ClearOnlyActive(t *testing.T) {
+	useTempConfig(t)
+	t.Setenv("GROOVEKIT_TOKEN", "")
+	t.Setenv("GROOVEKIT_PROFILE", "prod")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	cfg.AccessToken = "prod-token"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() failed: %v", err)
+	}
+
+	root, err := loadRoot()
+	if err != nil {
+		t.Fatalf("loadRoot() failed: %v", err)
+	}
+	if _, ok := root.Profiles["prod"]; ok {
+		t.Errorf("Expected prod profile to be removed")
+	}
+}
+
+func TestRequestHeaders_ExpandsEnv(t *testing.T) {
+	t.Setenv("CF_ACCESS_CLIENT_SECRET", "shh")
+
+	cfg := &Config{Headers: map[string]string{
+		"CF-Access-Client-Id":     "client.access",
+		"CF-Access-Client-Secret": "${CF_ACCESS_CLIENT_SECRET}",
+	}}
+	headers := cfg.RequestHeaders()
+
+	if headers["CF-Access-Client-Secret"] != "shh" {
+		t.Errorf("Expected secret from environment, got %q", headers["CF-Access-Client-Secret"])
+	}
+	if headers["CF-Access-Client-Id"] != "client.access" {
+		t.Errorf("Expected literal header value, got %q", headers["CF-Access-Client-Id"])
+	}
+}
+
+func TestUpdateChannel_SharedByProfiles(t *testing.T) {
+	useTempConfig(t)
+	t.Setenv("GROOVEKIT_TOKEN", "")
+
+	if got := UpdateChannel(); got != "" {
+		t.Fatalf("Expected update checks to be off by default, got %q", got)
+	}
+
+	cfg := &Config{AccessToken: "default-token", InsecureStorage: true}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+	if err := UpdateSettings(func(root *Config) { root.SetUpdateChannel(UpdateChannelStable) }); err != nil {
+		t.Fatalf("UpdateSettings() failed: %v", err)
+	}
+	checkedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := RecordUpdateCheck(UpdateCheck{CheckedAt: checkedAt, Latest: "v1.8.0"}); err != nil {
+		t.Fatalf("RecordUpdateCheck() failed: %v", err)
+	}
+
+	// Saving a named profile keeps the shared settings
+	SetProfile("staging")
+	t.Cleanup(func() { SetProfile("") })
+	staging, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	staging.AccessToken = "staging-token"
+	if err := staging.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	if got := UpdateChannel(); got != UpdateChannelStable {
+		t.Errorf("Expected update channel %q, got %q", UpdateChannelStable, got)
+	}
+	last := LastUpdateCheck()
+	if last == nil || last.Latest != "v1.8.0" || !last.CheckedAt.Equal(checkedAt) {
+		t.Errorf("Unexpected update check: %+v", last)
+	}
+
+	SetProfile("")
+	def, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if def.AccessToken != "default-token" {
+		t.Errorf("Expected default profile to be untouched, got %+v", def)
+	}
+
+	// Turning the check off forgets the last result
+	if err := UpdateSettings(func(root *Config) { root.SetUpdateChannel(UpdateChannelNone) }); err != nil {
+		t.Fatalf("UpdateSettings() failed: %v", err)
+	}
+	if got := UpdateChannel(); got != "" {
+		t.Errorf("Expected update checks to be off, got %q", got)
+	}
+	if LastUpdateCheck() != nil {
+		t.Errorf("Expected the last update check to be cleared")
+	}
+}
+
+func TestSetAPIURL_OverridesEnv(t *testing.T) {
+	useTempConfig(t)
+	t.Setenv("GROOVEKIT_API_URL", "http://env.example.com")
+
+	SetAPIURL("https://flag.example.com")
+	t.Cleanup(func() { SetAPIURL("") })
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.APIBaseURL != "https://flag.example.com" {
+		t.Errorf("Expected APIBaseURL from SetAPIURL, got %q", cfg.APIBaseURL)
+	}
+}
+
+func TestSave_KeepsStoredAPIURL(t *testing.T) {
+	useTempConfig(t)
+	t.Setenv("GROOVEKIT_API_URL", "")
+	t.Cleanup(func() { SetAPIURL("") })
+
+	cfg := &Config{APIBaseURL: "https://api.example.com", AccessToken: "secret-token", InsecureStorage: true}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	// Saving with an override in effect, e.g. after logging in, keeps the
+	// stored URL
+	for _, override := range []func(){
+		func() { SetAPIURL("https://flag.example.com") },
+		func() { t.Setenv("GROOVEKIT_API_URL", "http://env.example.com") },
+	} {
+		override()
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		cfg.AccessToken = "new-token"
+		if err := cfg.Save(); err != nil {
+			t.Fatalf("Save() failed: %v", err)
+		}
+		SetAPIURL("")
+		t.Setenv("GROOVEKIT_API_URL", "")
+
+		settings, err := LoadSettings()
+		if err != nil {
+			t.Fatalf("LoadSettings() failed: %v", err)
+		}
+		if settings.APIBaseURL != "https://api.example.com" {
+			t.Errorf("Expected the stored API URL to be kept, got %q", settings.APIBaseURL)
+		}
+		if settings.AccessToken != "new-token" {
+			t.Errorf("Expected the token to be saved, got %q", settings.AccessToken)
+		}
+	}
+}
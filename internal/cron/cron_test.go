@@ -0,0 +1,87 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Monday 2026-03-09 10:30 UTC
+var monday = time.Date(2026, 3, 9, 10, 30, 0, 0, time.UTC)
+
+// TestNext tests finding the next run for common expressions
+func TestNext(t *testing.T) {
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 9, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 9, 10, 45, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2026, 3, 10, 10, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * mon-fri", time.Date(2026, 3, 9, 13, 0, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 */3 *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1 jan *", time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either matches (the 13th or a Friday)
+		{"0 0 13 * fri", time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2026, 3, 9, 10, 45, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, s.Next(monday), tt.expr)
+	}
+}
+
+// TestNext_Never tests expressions that can't run
+func TestNext_Never(t *testing.T) {
+	s, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, s.Next(monday).IsZero())
+	assert.Zero(t, s.Interval(monday))
+}
+
+// TestInterval tests the longest gap between runs
+func TestInterval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want time.Duration
+	}{
+		{"*/5 * * * *", 5 * time.Minute},
+		{"0 * * * *", time.Hour},
+		{"@daily", 24 * time.Hour},
+		{"0 3 * * 1", 7 * 24 * time.Hour},
+		// Weekdays only: Friday to Monday is the longest gap
+		{"0 9 * * 1-5", 72 * time.Hour},
+		// Business hours: Friday 17:50 to Monday 09:00
+		{"*/10 9-17 * * 1-5", 63*time.Hour + 10*time.Minute},
+		{"0 0 1 * *", 31 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, s.Interval(monday), tt.expr)
+	}
+}
+
+// TestParse_Errors tests rejecting malformed expressions
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"* * * *":       `invalid cron expression "* * * *": expected 5 fields, got 4`,
+		"60 * * * *":    `invalid cron expression "60 * * * *": invalid value "60" in minute field (allowed 0-59)`,
+		"* * * * mon-x": `invalid cron expression "* * * * mon-x": invalid value "x" in day of week field (allowed 0-7)`,
+		"*/0 * * * *":   `invalid cron expression "*/0 * * * *": invalid step "0" in minute field`,
+		"5-1 * * * *":   `invalid cron expression "5-1 * * * *": invalid range "5-1" in minute field`,
+		"@reboot":       `invalid cron expression "@reboot": unsupported shorthand`,
+	}
+	for expr, want := range tests {
+		_, err := Parse(expr)
+		assert.EqualError(t, err, want)
+	}
+}
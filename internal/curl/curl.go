@@ -0,0 +1,244 @@
+// Package curl parses curl command lines into the HTTP request they would
+// send, so a request copied from a terminal or a browser's "Copy as cURL"
+// can be turned into a monitor
+package curl
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Header is a request header, in the order it was given
+type Header struct {
+	Name  string
+	Value string
+}
+
+// Request is the HTTP request a curl command sends
+type Request struct {
+	Method  string
+	URL     string
+	Headers []Header
+	Body    string
+	// Timeout is --max-time, or zero when it isn't given
+	Timeout time.Duration
+}
+
+// Header returns the value of the named header, matched case-insensitively,
+// or "" when it isn't set
+func (r *Request) Header(name string) string {
+	for _, h := range r.Headers {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+// Supported options, by whether they take a value. Those Parse doesn't
+// handle, such as -s or -o, don't change the request and are ignored.
+var (
+	flagOptions = strings.Fields(`-G --get -I --head
+		-s --silent -S --show-error -L --location -k --insecure -v --verbose
+		-i --include -f --fail --fail-with-body -g --globoff -N --no-buffer
+		-# --progress-bar -4 --ipv4 -6 --ipv6 --compressed --http1.1 --http2`)
+	valueOptions = strings.Fields(`-X --request -H --header
+		-d --data --data-ascii --data-binary --data-raw --json -u --user
+		-A --user-agent -e --referer -b --cookie -m --max-time --url
+		-o --output -w --write-out --connect-timeout --retry --retry-delay
+		--retry-max-time`)
+)
+
+// takesValue reports whether option takes a value, and ok is false when it
+// isn't supported
+func takesValue(option string) (value, ok bool) {
+	if slices.Contains(valueOptions, option) {
+		return true, true
+	}
+	return false, slices.Contains(flagOptions, option)
+}
+
+// Parse parses a curl command line, as pasted from a shell, into the
+// request it sends
+func Parse(command string) (*Request, error) {
+	args, err := Split(command)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 || (args[0] != "curl" && !strings.HasSuffix(args[0], "/curl")) {
+		return nil, errors.New("not a curl command: it must start with curl")
+	}
+
+	req := &Request{}
+	var method string
+	var data []string
+	var get, head bool
+
+	// apply handles an option and its argument, if it takes one
+	apply := func(option, value string) error {
+		switch option {
+		case "-X", "--request":
+			method = strings.ToUpper(value)
+		case "-H", "--header":
+			name, v, ok := strings.Cut(value, ":")
+			if !ok || strings.TrimSpace(name) == "" {
+				return fmt.Errorf("invalid header %q: use \"Name: value\"", value)
+			}
+			req.Headers = append(req.Headers, Header{Name: strings.TrimSpace(name), Value: strings.TrimSpace(v)})
+		case "-d", "--data", "--data-ascii", "--data-binary":
+			if strings.HasPrefix(value, "@") {
+				return fmt.Errorf("%s %s reads the body from a file, which isn't supported; paste the body instead", option, value)
+			}
+			data = append(data, value)
+		case "--data-raw":
+			data = append(data, value)
+		case "--json":
+			data = append(data, value)
+			if req.Header("Content-Type") == "" {
+				req.Headers = append(req.Headers, Header{Name: "Content-Type", Value: "application/json"})
+			}
+			if req.Header("Accept") == "" {
+				req.Headers = append(req.Headers, Header{Name: "Accept", Value: "application/json"})
+			}
+		case "-u", "--user":
+			if !strings.Contains(value, ":") {
+				return fmt.Errorf("%s %s has no password, which curl would prompt for; use user:password", option, value)
+			}
+			req.Headers = append(req.Headers, Header{Name: "Authorization", Value: "Basic " + base64.StdEncoding.EncodeToString([]byte(value))})
+		case "-A", "--user-agent":
+			req.Headers = append(req.Headers, Header{Name: "User-Agent", Value: value})
+		case "-e", "--referer":
+			req.Headers = append(req.Headers, Header{Name: "Referer", Value: value})
+		case "-b", "--cookie":
+			if !strings.Contains(value, "=") {
+				return fmt.Errorf("%s %s reads cookies from a file, which isn't supported; pass them as name=value", option, value)
+			}
+			req.Headers = append(req.Headers, Header{Name: "Cookie", Value: value})
+		case "-m", "--max-time":
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil || seconds <= 0 {
+				return fmt.Errorf("invalid %s %q: must be a number of seconds", option, value)
+			}
+			req.Timeout = time.Duration(seconds * float64(time.Second))
+		case "--url":
+			return setURL(req, value)
+		case "-G", "--get":
+			get = true
+		case "-I", "--head":
+			head = true
+		}
+		return nil
+	}
+
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			for _, rest := range args[i+1:] {
+				if err := setURL(req, rest); err != nil {
+					return nil, err
+				}
+			}
+			i = len(args)
+		case strings.HasPrefix(arg, "--"):
+			hasValue, ok := takesValue(arg)
+			if !ok {
+				return nil, fmt.Errorf("unsupported curl option %s", arg)
+			}
+			value := ""
+			if hasValue {
+				if i+1 >= len(args) {
+					return nil, fmt.Errorf("curl option %s needs a value", arg)
+				}
+				i++
+				value = args[i]
+			}
+			if err := apply(arg, value); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			// Short options can be combined, as in -sSL, and the last one
+			// can take its value attached, as in -XPOST
+			for j := 1; j < len(arg); j++ {
+				option := "-" + arg[j:j+1]
+				hasValue, ok := takesValue(option)
+				if !ok {
+					return nil, fmt.Errorf("unsupported curl option %s", option)
+				}
+				value := ""
+				if hasValue {
+					switch {
+					case j+1 < len(arg):
+						value = arg[j+1:]
+					case i+1 < len(args):
+						i++
+						value = args[i]
+					default:
+						return nil, fmt.Errorf("curl option %s needs a value", option)
+					}
+					j = len(arg)
+				}
+				if err := apply(option, value); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if err := setURL(req, arg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if req.URL == "" {
+		return nil, errors.New("the curl command has no URL")
+	}
+
+	body := strings.Join(data, "&")
+	switch {
+	case get && body != "":
+		separator := "?"
+		if strings.Contains(req.URL, "?") {
+			separator = "&"
+		}
+		req.URL += separator + body
+	default:
+		req.Body = body
+	}
+
+	switch {
+	case method != "":
+		req.Method = method
+	case head:
+		req.Method = "HEAD"
+	case req.Body != "":
+		req.Method = "POST"
+	default:
+		req.Method = "GET"
+	}
+	return req, nil
+}
+
+// setURL sets the request's URL, defaulting the scheme to http like curl
+func setURL(req *Request, value string) error {
+	if req.URL != "" {
+		return fmt.Errorf("more than one URL: %s and %s", req.URL, value)
+	}
+	if !strings.Contains(value, "://") {
+		value = "http://" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", value)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported URL scheme %q: must be http or https", u.Scheme)
+	}
+	req.URL = value
+	return nil
+}
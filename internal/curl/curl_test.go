@@ -0,0 +1,125 @@
+package curl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplit tests breaking command lines into words like a shell
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected []string
+	}{
+		{"plain words", "curl  -s\thttps://example.com", []string{"curl", "-s", "https://example.com"}},
+		{"single quotes", `curl -H 'Auth: a "b" \c'`, []string{"curl", "-H", `Auth: a "b" \c`}},
+		{"double quotes", `curl -d "{\"a\":\"\$x\"} \n"`, []string{"curl", "-d", `{"a":"$x"} \n`}},
+		{"line continuations", "curl \\\n  -X POST \\\r\n  https://example.com", []string{"curl", "-X", "POST", "https://example.com"}},
+		{"escaped space", `curl https://example.com/a\ b`, []string{"curl", "https://example.com/a b"}},
+		{"joined quotes", `curl -H'X: '"y"`, []string{"curl", "-HX: y"}},
+		{"empty quotes", `curl -d ''`, []string{"curl", "-d", ""}},
+		{"ansi quotes", `curl --data-raw $'{"a":"it\'s\\né\x41"}'`, []string{"curl", "--data-raw", "{\"a\":\"it's\\néA\"}"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, err := Split(tt.command)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, words)
+		})
+	}
+
+	for _, command := range []string{`curl 'open`, `curl "open`, `curl $'open`} {
+		_, err := Split(command)
+		assert.ErrorContains(t, err, "unterminated", command)
+	}
+}
+
+// TestParse tests reading the request a curl command sends
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected Request
+	}{
+		{
+			name:     "GET with a header",
+			command:  `curl -H "Auth: x" https://api.example.com/health`,
+			expected: Request{Method: "GET", URL: "https://api.example.com/health", Headers: []Header{{"Auth", "x"}}},
+		},
+		{
+			name:    "POST from data",
+			command: `curl -sSL https://api.example.com/orders -H 'Content-Type: application/json' -d '{"dry_run":true}' --max-time 2.5`,
+			expected: Request{
+				Method:  "POST",
+				URL:     "https://api.example.com/orders",
+				Headers: []Header{{"Content-Type", "application/json"}},
+				Body:    `{"dry_run":true}`,
+				Timeout: 2500 * time.Millisecond,
+			},
+		},
+		{
+			name:     "explicit method attached",
+			command:  `curl -XPUT --url example.com/items/1 --data-raw @literal`,
+			expected: Request{Method: "PUT", URL: "http://example.com/items/1", Body: "@literal"},
+		},
+		{
+			name:    "json and credentials",
+			command: `curl --json '{"a":1}' -u user:pass -A probe/1.0 https://example.com`,
+			expected: Request{
+				Method: "POST",
+				URL:    "https://example.com",
+				Headers: []Header{
+					{"Content-Type", "application/json"},
+					{"Accept", "application/json"},
+					{"Authorization", "Basic dXNlcjpwYXNz"},
+					{"User-Agent", "probe/1.0"},
+				},
+				Body: `{"a":1}`,
+			},
+		},
+		{
+			name:     "get with data",
+			command:  `curl -G -d q=up -d limit=5 'https://example.com/search?x=1'`,
+			expected: Request{Method: "GET", URL: "https://example.com/search?x=1&q=up&limit=5"},
+		},
+		{
+			name:     "head",
+			command:  `/usr/bin/curl -I https://example.com -o /dev/null -w '%{http_code}'`,
+			expected: Request{Method: "HEAD", URL: "https://example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := Parse(tt.command)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *req)
+		})
+	}
+}
+
+// TestParse_Errors tests commands Parse can't turn into a request
+func TestParse_Errors(t *testing.T) {
+	tests := map[string]string{
+		"wget https://example.com": "not a curl command",
+		"curl -s":                  "no URL",
+		"curl https://a.example.com https://b.example.com": "more than one URL",
+		"curl --cacert ca.pem https://example.com":         "unsupported curl option --cacert",
+		"curl -sz https://example.com":                     "unsupported curl option -z",
+		"curl -d @body.json https://example.com":           "isn't supported",
+		"curl -H nocolon https://example.com":              "invalid header",
+		"curl -u alice https://example.com":                "no password",
+		"curl ftp://example.com":                           "unsupported URL scheme",
+		"curl https://example.com -X":                      "needs a value",
+	}
+
+	for command, expected := range tests {
+		_, err := Parse(command)
+		assert.ErrorContains(t, err, expected, command)
+	}
+}
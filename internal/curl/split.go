@@ -0,0 +1,154 @@
+package curl
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Split breaks a command line into words the way a POSIX shell does:
+// single quotes, double quotes, backslash escapes, and backslash-newline
+// line continuations, plus bash's $'...' quoting, which browsers use in
+// "Copy as cURL" for bodies with special characters. Variables and other
+// expansions are left as written.
+func Split(command string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case c == '\\':
+			if i+1 < len(command) && command[i+1] == '\n' {
+				i++
+				continue
+			}
+			if i+2 < len(command) && command[i+1] == '\r' && command[i+2] == '\n' {
+				i += 2
+				continue
+			}
+			inWord = true
+			if i+1 < len(command) {
+				i++
+				word.WriteByte(command[i])
+			}
+		case c == '\'':
+			inWord = true
+			end := strings.IndexByte(command[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated single quote")
+			}
+			word.WriteString(command[i+1 : i+1+end])
+			i += end + 1
+		case c == '"':
+			inWord = true
+			n, err := readDoubleQuoted(command[i+1:], &word)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+		case c == '$' && i+1 < len(command) && command[i+1] == '\'':
+			inWord = true
+			n, err := readANSIQuoted(command[i+2:], &word)
+			if err != nil {
+				return nil, err
+			}
+			i += n + 1
+		default:
+			inWord = true
+			word.WriteByte(c)
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// readDoubleQuoted writes the contents of a double-quoted string to word,
+// where s starts just after the opening quote, and returns the length of
+// s up to and including the closing quote
+func readDoubleQuoted(s string, word *strings.Builder) (int, error) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return i + 1, nil
+		case '\\':
+			if i+1 < len(s) {
+				switch next := s[i+1]; next {
+				case '"', '\\', '$', '`':
+					word.WriteByte(next)
+					i++
+					continue
+				case '\n':
+					i++
+					continue
+				}
+			}
+			word.WriteByte(c)
+		default:
+			word.WriteByte(c)
+		}
+	}
+	return 0, errors.New("unterminated double quote")
+}
+
+// readANSIQuoted writes the contents of a $'...' string to word, decoding
+// its backslash escapes, where s starts just after the opening quote, and
+// returns the length of s up to and including the closing quote
+func readANSIQuoted(s string, word *strings.Builder) (int, error) {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\'' {
+			return i + 1, nil
+		}
+		if c != '\\' || i+1 >= len(s) {
+			word.WriteByte(c)
+			continue
+		}
+		i++
+		switch esc := s[i]; esc {
+		case 'n':
+			word.WriteByte('\n')
+		case 't':
+			word.WriteByte('\t')
+		case 'r':
+			word.WriteByte('\r')
+		case '\\', '\'', '"', '?':
+			word.WriteByte(esc)
+		case 'x', 'u', 'U':
+			digits := map[byte]int{'x': 2, 'u': 4, 'U': 8}[esc]
+			end := i + 1
+			for end < len(s) && end < i+1+digits && isHex(s[end]) {
+				end++
+			}
+			if end == i+1 {
+				word.WriteByte('\\')
+				word.WriteByte(esc)
+				continue
+			}
+			n, _ := strconv.ParseUint(s[i+1:end], 16, 32)
+			if esc == 'x' {
+				word.WriteByte(byte(n))
+			} else {
+				word.WriteString(string(rune(n)))
+			}
+			i = end - 1
+		default:
+			word.WriteByte('\\')
+			word.WriteByte(esc)
+		}
+	}
+	return 0, errors.New("unterminated $' quote")
+}
+
+func isHex(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
@@ -0,0 +1,118 @@
+// Package diff compares decoded JSON values field by field
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Change operations
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// Change is a single difference between two values
+type Change struct {
+	Path string      `json:"path"`
+	Op   string      `json:"op"`
+	Old  interface{} `json:"old"`
+	New  interface{} `json:"new"`
+}
+
+// Compare returns the differences between two decoded JSON values
+// (maps, slices, and scalars as produced by encoding/json), ordered by path.
+// Objects are compared key by key and arrays index by index; anything else
+// is compared as a whole.
+func Compare(a, b interface{}) []Change {
+	var changes []Change
+	compare("", a, b, &changes)
+	return changes
+}
+
+func compare(path string, a, b interface{}, changes *[]Change) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		if bv, ok := b.(map[string]interface{}); ok {
+			compareObjects(path, av, bv, changes)
+			return
+		}
+	case []interface{}:
+		if bv, ok := b.([]interface{}); ok {
+			compareArrays(path, av, bv, changes)
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, Change{Path: path, Op: Changed, Old: a, New: b})
+	}
+}
+
+func compareObjects(path string, a, b map[string]interface{}, changes *[]Change) {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		child := key
+		if path != "" {
+			child = path + "." + key
+		}
+		av, inA := a[key]
+		bv, inB := b[key]
+		switch {
+		case !inB:
+			*changes = append(*changes, Change{Path: child, Op: Removed, Old: av})
+		case !inA:
+			*changes = append(*changes, Change{Path: child, Op: Added, New: bv})
+		default:
+			compare(child, av, bv, changes)
+		}
+	}
+}
+
+func compareArrays(path string, a, b []interface{}, changes *[]Change) {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		child := path + "[" + strconv.Itoa(i) + "]"
+		switch {
+		case i >= len(b):
+			*changes = append(*changes, Change{Path: child, Op: Removed, Old: a[i]})
+		case i >= len(a):
+			*changes = append(*changes, Change{Path: child, Op: Added, New: b[i]})
+		default:
+			compare(child, a[i], b[i], changes)
+		}
+	}
+}
+
+// String renders a change on one line, e.g. `~ status: "ok" -> "down"`
+func (c Change) String() string {
+	switch c.Op {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", c.Path, Format(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", c.Path, Format(c.Old))
+	}
+	return fmt.Sprintf("~ %s: %s -> %s", c.Path, Format(c.Old), Format(c.New))
+}
+
+// Format renders a value as compact JSON
+func Format(v interface{}) string {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(encoded)
+}
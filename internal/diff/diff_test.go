@@ -0,0 +1,50 @@
+package diff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decode(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &v))
+	return v
+}
+
+// TestCompare tests changes across nested objects and arrays
+func TestCompare(t *testing.T) {
+	a := decode(t, `{"status":"ok","items":[1,2],"db":{"up":true},"gone":1}`)
+	b := decode(t, `{"status":"down","items":[1],"db":{"up":true,"err":"timeout"}}`)
+
+	changes := Compare(a, b)
+	require.Len(t, changes, 4)
+	assert.Equal(t, Change{Path: "db.err", Op: Added, New: "timeout"}, changes[0])
+	assert.Equal(t, Change{Path: "gone", Op: Removed, Old: 1.0}, changes[1])
+	assert.Equal(t, Change{Path: "items[1]", Op: Removed, Old: 2.0}, changes[2])
+	assert.Equal(t, Change{Path: "status", Op: Changed, Old: "ok", New: "down"}, changes[3])
+}
+
+// TestCompare_Equal tests that identical values have no changes
+func TestCompare_Equal(t *testing.T) {
+	v := decode(t, `{"a":[{"b":null}],"c":"d"}`)
+	assert.Empty(t, Compare(v, decode(t, `{"a":[{"b":null}],"c":"d"}`)))
+}
+
+// TestCompare_TypeChange tests values whose type changes are replaced whole
+func TestCompare_TypeChange(t *testing.T) {
+	changes := Compare(decode(t, `{"a":{"b":1}}`), decode(t, `{"a":[1]}`))
+	require.Len(t, changes, 1)
+	assert.Equal(t, "a", changes[0].Path)
+	assert.Equal(t, Changed, changes[0].Op)
+}
+
+// TestChangeString tests the one-line rendering of changes
+func TestChangeString(t *testing.T) {
+	assert.Equal(t, `+ a.b: "x"`, Change{Path: "a.b", Op: Added, New: "x"}.String())
+	assert.Equal(t, `- a[0]: 1`, Change{Path: "a[0]", Op: Removed, Old: 1.0}.String())
+	assert.Equal(t, `~ ok: true -> false`, Change{Path: "ok", Op: Changed, Old: true, New: false}.String())
+}
@@ -0,0 +1,108 @@
+// Package har reads HTTP Archive (HAR) files, the request logs browsers
+// export from their developer tools
+package har
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Header is a request or response header
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PostData is a request's body
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+// Request is a captured request
+type Request struct {
+	Method   string    `json:"method"`
+	URL      string    `json:"url"`
+	Headers  []Header  `json:"headers"`
+	PostData *PostData `json:"postData,omitempty"`
+}
+
+// Content describes a response body
+type Content struct {
+	MimeType string `json:"mimeType"`
+}
+
+// Response is the response to a captured request. Status is 0 when the
+// request failed or was blocked.
+type Response struct {
+	Status  int     `json:"status"`
+	Content Content `json:"content"`
+}
+
+// Entry is one request and its response
+type Entry struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+	// ResourceType is what the browser fetched the request for, e.g. xhr,
+	// fetch, document, or image; Chrome and Edge record it
+	ResourceType string `json:"_resourceType,omitempty"`
+}
+
+// Log is the contents of a HAR file
+type Log struct {
+	Entries []Entry `json:"entries"`
+}
+
+// Parse reads a HAR document
+func Parse(data []byte) (*Log, error) {
+	var doc struct {
+		Log *Log `json:"log"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid HAR file: %w", err)
+	}
+	if doc.Log == nil {
+		return nil, errors.New("invalid HAR file: no log")
+	}
+	return doc.Log, nil
+}
+
+// assetResourceTypes are resource types browsers fetch to render a page
+var assetResourceTypes = []string{"image", "media", "font", "stylesheet", "script", "manifest", "texttrack", "websocket", "ping", "preflight"}
+
+// IsAsset reports whether the entry fetched part of a page, such as an
+// image, stylesheet, or script, rather than a document or API call
+func (e Entry) IsAsset() bool {
+	if e.ResourceType != "" {
+		return slices.Contains(assetResourceTypes, e.ResourceType)
+	}
+	mime := strings.ToLower(e.Response.Content.MimeType)
+	for _, prefix := range []string{"image/", "font/", "audio/", "video/", "text/css", "application/wasm"} {
+		if strings.HasPrefix(mime, prefix) {
+			return true
+		}
+	}
+	return strings.Contains(mime, "javascript")
+}
+
+// managedHeaders are set by the HTTP client itself, so they aren't worth
+// replaying
+var managedHeaders = []string{"host", "content-length", "connection", "accept-encoding", "upgrade-insecure-requests", "priority", "te", "keep-alive", "transfer-encoding"}
+
+// ReplayHeaders returns the request headers worth sending again, leaving
+// out HTTP/2 pseudo-headers and those the browser or HTTP client manages,
+// such as Host, Content-Length, and Sec-Fetch-*
+func (r Request) ReplayHeaders() []Header {
+	var headers []Header
+	for _, h := range r.Headers {
+		name := strings.ToLower(h.Name)
+		if strings.HasPrefix(name, ":") || strings.HasPrefix(name, "sec-") || slices.Contains(managedHeaders, name) {
+			continue
+		}
+		headers = append(headers, h)
+	}
+	return headers
+}
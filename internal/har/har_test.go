@@ -0,0 +1,67 @@
+package har
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse tests reading entries from a HAR document
+func TestParse(t *testing.T) {
+	log, err := Parse([]byte(`{"log":{"version":"1.2","entries":[{
+		"_resourceType":"fetch",
+		"request":{"method":"POST","url":"https://api.example.com/orders","headers":[{"name":"Content-Type","value":"application/json"}],
+			"postData":{"mimeType":"application/json","text":"{\"dry_run\":true}"}},
+		"response":{"status":201,"content":{"mimeType":"application/json"}}
+	}]}}`))
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+
+	entry := log.Entries[0]
+	assert.Equal(t, "POST", entry.Request.Method)
+	assert.Equal(t, "https://api.example.com/orders", entry.Request.URL)
+	assert.Equal(t, `{"dry_run":true}`, entry.Request.PostData.Text)
+	assert.Equal(t, 201, entry.Response.Status)
+	assert.Equal(t, "fetch", entry.ResourceType)
+
+	_, err = Parse([]byte(`{"entries":[]}`))
+	assert.ErrorContains(t, err, "no log")
+	_, err = Parse([]byte(`not json`))
+	assert.ErrorContains(t, err, "invalid HAR file")
+}
+
+// TestEntryIsAsset tests telling page assets from API calls
+func TestEntryIsAsset(t *testing.T) {
+	tests := []struct {
+		name     string
+		entry    Entry
+		expected bool
+	}{
+		{"fetch", Entry{ResourceType: "fetch"}, false},
+		{"document", Entry{ResourceType: "document"}, false},
+		{"script", Entry{ResourceType: "script"}, true},
+		{"image by MIME type", Entry{Response: Response{Content: Content{MimeType: "image/png"}}}, true},
+		{"script by MIME type", Entry{Response: Response{Content: Content{MimeType: "application/javascript; charset=utf-8"}}}, true},
+		{"JSON by MIME type", Entry{Response: Response{Content: Content{MimeType: "application/json"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.entry.IsAsset())
+		})
+	}
+}
+
+// TestRequestReplayHeaders tests dropping headers the client manages
+func TestRequestReplayHeaders(t *testing.T) {
+	req := Request{Headers: []Header{
+		{":authority", "api.example.com"},
+		{"Host", "api.example.com"},
+		{"Authorization", "Bearer abc"},
+		{"sec-fetch-mode", "cors"},
+		{"Content-Length", "16"},
+		{"Accept", "application/json"},
+	}}
+	assert.Equal(t, []Header{{"Authorization", "Bearer abc"}, {"Accept", "application/json"}}, req.ReplayHeaders())
+}
@@ -0,0 +1,201 @@
+// Package hcl writes Terraform configuration in HCL's native syntax
+package hcl
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Block is a configuration block such as resource "type" "name" { ... }
+type Block struct {
+	Type   string
+	Labels []string
+	// Comment is written above the block, one # line per line
+	Comment    string
+	Attributes []Attribute
+	Blocks     []Block
+}
+
+// Attribute is a name = value line in a block
+type Attribute struct {
+	Name  string
+	Value any
+}
+
+// Expr is written as is rather than quoted, e.g. a reference such as
+// groovekit_job.backup
+type Expr string
+
+// Write writes the blocks, separated by blank lines, with attribute values
+// aligned as terraform fmt does
+func Write(w io.Writer, blocks []Block) error {
+	var b strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeBlock(&b, block, "")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeBlock(b *strings.Builder, block Block, indent string) {
+	if block.Comment != "" {
+		for _, line := range strings.Split(block.Comment, "\n") {
+			b.WriteString(strings.TrimRight(indent+"# "+line, " ") + "\n")
+		}
+	}
+	b.WriteString(indent + block.Type)
+	for _, label := range block.Labels {
+		b.WriteString(" " + Quote(label))
+	}
+	b.WriteString(" {\n")
+
+	width := 0
+	for _, attr := range block.Attributes {
+		width = max(width, len(attr.Name))
+	}
+	for _, attr := range block.Attributes {
+		fmt.Fprintf(b, "%s  %-*s = %s\n", indent, width, attr.Name, Value(attr.Value))
+	}
+	for i, nested := range block.Blocks {
+		if i > 0 || len(block.Attributes) > 0 {
+			b.WriteString("\n")
+		}
+		writeBlock(b, nested, indent+"  ")
+	}
+	b.WriteString(indent + "}\n")
+}
+
+// Value formats a value as an HCL expression on one line. Strings, bools,
+// numbers, Expr, slices of these, and maps with string keys are supported;
+// map keys are sorted, and quoted unless they're plain identifiers.
+func Value(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case Expr:
+		return string(v)
+	case string:
+		return Quote(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return Value(items)
+	case []int:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return Value(items)
+	case []any:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = Value(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]string:
+		items := make(map[string]any, len(v))
+		for key, item := range v {
+			items[key] = item
+		}
+		return Value(items)
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, len(keys))
+		for i, key := range keys {
+			items[i] = objectKey(key) + " = " + Value(v[key])
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	}
+	return Quote(fmt.Sprint(v))
+}
+
+// objectKey leaves keys that are plain identifiers bare and quotes others,
+// such as header names with hyphens
+func objectKey(key string) string {
+	if key == "" {
+		return Quote(key)
+	}
+	for i, r := range key {
+		if r == '_' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return Quote(key)
+	}
+	return key
+}
+
+// Quote returns s as an HCL string literal, escaping template sequences so
+// ${ and %{ are kept literally
+func Quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		case unicode.IsControl(r):
+			fmt.Fprintf(&b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// Identifier turns a name into a valid block label for resource names,
+// e.g. "Nightly Backup!" becomes nightly_backup, falling back to fallback
+// when nothing is left and prefixing it to names that start with a digit
+func Identifier(name, fallback string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+		} else if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	id := strings.TrimSuffix(b.String(), "_")
+	switch {
+	case id == "":
+		return fallback
+	case id[0] >= '0' && id[0] <= '9':
+		return fallback + "_" + id
+	}
+	return id
+}
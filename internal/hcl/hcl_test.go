@@ -0,0 +1,77 @@
+package hcl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWrite tests writing blocks with aligned attributes and nested blocks
+func TestWrite(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, Write(&b, []Block{
+		{Type: "terraform", Blocks: []Block{
+			{Type: "required_providers", Attributes: []Attribute{
+				{"groovekit", map[string]any{"source": "scookdev/groovekit"}},
+			}},
+		}},
+		{Type: "resource", Labels: []string{"groovekit_job", "backup"}, Comment: "Backup (1a2b3c4d)", Attributes: []Attribute{
+			{"name", "Backup"},
+			{"interval", 1440},
+			{"allowed_ips", []string{"10.0.0.0/24"}},
+		}},
+		{Type: "import", Attributes: []Attribute{
+			{"to", Expr("groovekit_job.backup")},
+			{"id", "1a2b3c4d"},
+		}},
+	}))
+
+	assert.Equal(t, `terraform {
+  required_providers {
+    groovekit = { source = "scookdev/groovekit" }
+  }
+}
+
+# Backup (1a2b3c4d)
+resource "groovekit_job" "backup" {
+  name        = "Backup"
+  interval    = 1440
+  allowed_ips = ["10.0.0.0/24"]
+}
+
+import {
+  to = groovekit_job.backup
+  id = "1a2b3c4d"
+}
+`, b.String())
+}
+
+// TestValue tests formatting values as expressions
+func TestValue(t *testing.T) {
+	assert.Equal(t, "null", Value(nil))
+	assert.Equal(t, "true", Value(true))
+	assert.Equal(t, "2.5", Value(2.5))
+	assert.Equal(t, "200", Value(float64(200)))
+	assert.Equal(t, "[200, 201]", Value([]int{200, 201}))
+	assert.Equal(t, "[]", Value([]any{}))
+	assert.Equal(t, `{ "Content-Type" = "1", b_2 = ["x"] }`, Value(map[string]any{"b_2": []any{"x"}, "Content-Type": "1"}))
+	assert.Equal(t, "{}", Value(map[string]string{}))
+}
+
+// TestQuote tests escaping strings, including template sequences
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `"plain"`, Quote("plain"))
+	assert.Equal(t, `"say \"hi\"\n\\"`, Quote("say \"hi\"\n\\"))
+	assert.Equal(t, `"$${HOME} and %%{if} but $5 and 100%"`, Quote("${HOME} and %{if} but $5 and 100%"))
+	assert.Equal(t, `"\u0007"`, Quote("\a"))
+}
+
+// TestIdentifier tests turning names into resource labels
+func TestIdentifier(t *testing.T) {
+	assert.Equal(t, "nightly_backup", Identifier("Nightly Backup!", "job"))
+	assert.Equal(t, "api_example_com_health", Identifier("api.example.com/health", "api"))
+	assert.Equal(t, "job_2fa_reset", Identifier("2FA reset", "job"))
+	assert.Equal(t, "job", Identifier("✓✓", "job"))
+}
@@ -0,0 +1,506 @@
+// Package jsonschema validates JSON documents against a JSON Schema. It
+// covers the draft-07 keywords monitors use in practice: types, properties,
+// arrays, enums, numeric and string bounds, patterns, combinators, and local
+// $ref pointers. Unknown keywords such as format are ignored.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	root     interface{}
+	patterns map[string]*regexp.Regexp
+}
+
+// Error is one way a document fails a schema
+type Error struct {
+	// Path locates the failing value, e.g. "$.data.items[0].id"
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// Error formats the failure as "path: message"
+func (e Error) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Compile parses a schema and checks that its patterns and references are
+// usable
+func Compile(data []byte) (*Schema, error) {
+	var root interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	switch root.(type) {
+	case map[string]interface{}, bool:
+	default:
+		return nil, fmt.Errorf("invalid JSON schema: must be an object or boolean")
+	}
+
+	s := &Schema{root: root, patterns: map[string]*regexp.Regexp{}}
+	if err := s.check(root); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %w", err)
+	}
+	return s, nil
+}
+
+// check walks the schema, compiling patterns and resolving references up
+// front so that mistakes surface when the schema is loaded
+func (s *Schema) check(node interface{}) error {
+	n, ok := node.(map[string]interface{})
+	if !ok {
+		// Boolean schemas need no checking
+		return nil
+	}
+
+	if value, ok := n["pattern"]; ok {
+		pattern, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("pattern must be a string")
+		}
+		if err := s.compilePattern(pattern); err != nil {
+			return err
+		}
+	}
+	if value, ok := n["$ref"]; ok {
+		ref, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("$ref must be a string")
+		}
+		if _, err := s.resolve(ref); err != nil {
+			return err
+		}
+	}
+
+	var subschemas []interface{}
+	for _, key := range []string{"properties", "patternProperties", "definitions", "$defs"} {
+		children, _ := n[key].(map[string]interface{})
+		for name, child := range children {
+			if key == "patternProperties" {
+				if err := s.compilePattern(name); err != nil {
+					return err
+				}
+			}
+			subschemas = append(subschemas, child)
+		}
+	}
+	for _, key := range []string{"additionalItems", "additionalProperties", "contains", "not", "if", "then", "else", "propertyNames"} {
+		if child, ok := n[key]; ok {
+			subschemas = append(subschemas, child)
+		}
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		children, _ := n[key].([]interface{})
+		subschemas = append(subschemas, children...)
+	}
+	switch items := n["items"].(type) {
+	case []interface{}:
+		subschemas = append(subschemas, items...)
+	case nil:
+	default:
+		subschemas = append(subschemas, items)
+	}
+
+	for _, child := range subschemas {
+		if err := s.check(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compilePattern compiles a regular expression used by the schema
+func (s *Schema) compilePattern(pattern string) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	s.patterns[pattern] = re
+	return nil
+}
+
+// resolve follows a local reference such as "#/definitions/item"
+func (s *Schema) resolve(ref string) (interface{}, error) {
+	if ref != "#" && !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported $ref %q: only local references (#/...) are supported", ref)
+	}
+	node := s.root
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+		if part == "" {
+			continue
+		}
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+		switch n := node.(type) {
+		case map[string]interface{}:
+			next, ok := n[part]
+			if !ok {
+				return nil, fmt.Errorf("unresolved $ref %q", ref)
+			}
+			node = next
+		case []interface{}:
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("unresolved $ref %q", ref)
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("unresolved $ref %q", ref)
+		}
+	}
+	return node, nil
+}
+
+// Validate checks a decoded JSON document (as produced by encoding/json)
+// against the schema and returns every failure, ordered by path
+func (s *Schema) Validate(doc interface{}) []Error {
+	var errs []Error
+	s.validate(s.root, doc, "$", &errs, 0)
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs
+}
+
+// maxDepth stops runaway recursion through self-referencing schemas
+const maxDepth = 64
+
+// validate checks value against one schema node, appending failures
+func (s *Schema) validate(node, value interface{}, path string, errs *[]Error, depth int) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, Error{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+	if depth > maxDepth {
+		fail("schema nests too deeply")
+		return
+	}
+
+	switch n := node.(type) {
+	case bool:
+		if !n {
+			fail("no value is allowed here")
+		}
+		return
+	case map[string]interface{}:
+		if ref, ok := n["$ref"].(string); ok {
+			target, _ := s.resolve(ref)
+			// In draft-07, $ref replaces the rest of the schema
+			s.validate(target, value, path, errs, depth+1)
+			return
+		}
+		s.validateObject(n, value, path, errs, depth, fail)
+	}
+}
+
+// validateObject applies the keywords of an object schema
+func (s *Schema) validateObject(n map[string]interface{}, value interface{}, path string, errs *[]Error, depth int, fail func(string, ...interface{})) {
+	if t, ok := n["type"]; ok && !matchesType(t, value) {
+		fail("expected %s, got %s", describeType(t), typeOf(value))
+		return
+	}
+	if enum, ok := n["enum"].([]interface{}); ok {
+		found := false
+		for _, allowed := range enum {
+			if equal(allowed, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fail("must be one of %s", encode(enum))
+		}
+	}
+	if c, ok := n["const"]; ok && !equal(c, value) {
+		fail("must equal %s", encode(c))
+	}
+
+	switch v := value.(type) {
+	case float64:
+		s.validateNumber(n, v, fail)
+	case string:
+		s.validateString(n, v, fail)
+	case []interface{}:
+		s.validateArray(n, v, path, errs, depth, fail)
+	case map[string]interface{}:
+		s.validateProperties(n, v, path, errs, depth, fail)
+	}
+
+	if all, ok := n["allOf"].([]interface{}); ok {
+		for _, sub := range all {
+			s.validate(sub, value, path, errs, depth+1)
+		}
+	}
+	if anyOf, ok := n["anyOf"].([]interface{}); ok {
+		if s.countMatches(anyOf, value, path, depth) == 0 {
+			fail("must match at least one schema in anyOf")
+		}
+	}
+	if oneOf, ok := n["oneOf"].([]interface{}); ok {
+		if matches := s.countMatches(oneOf, value, path, depth); matches != 1 {
+			fail("must match exactly one schema in oneOf, matched %d", matches)
+		}
+	}
+	if not, ok := n["not"]; ok && s.matches(not, value, path, depth) {
+		fail("must not match the schema in not")
+	}
+	if ifSchema, ok := n["if"]; ok {
+		if s.matches(ifSchema, value, path, depth) {
+			if then, ok := n["then"]; ok {
+				s.validate(then, value, path, errs, depth+1)
+			}
+		} else if elseSchema, ok := n["else"]; ok {
+			s.validate(elseSchema, value, path, errs, depth+1)
+		}
+	}
+}
+
+// validateNumber applies the numeric bounds
+func (s *Schema) validateNumber(n map[string]interface{}, v float64, fail func(string, ...interface{})) {
+	if min, ok := n["minimum"].(float64); ok && v < min {
+		fail("must be at least %s", formatNumber(min))
+	}
+	if max, ok := n["maximum"].(float64); ok && v > max {
+		fail("must be at most %s", formatNumber(max))
+	}
+	if min, ok := n["exclusiveMinimum"].(float64); ok && v <= min {
+		fail("must be greater than %s", formatNumber(min))
+	}
+	if max, ok := n["exclusiveMaximum"].(float64); ok && v >= max {
+		fail("must be less than %s", formatNumber(max))
+	}
+	if m, ok := n["multipleOf"].(float64); ok && m > 0 {
+		if q := v / m; math.Abs(q-math.Round(q)) > 1e-9 {
+			fail("must be a multiple of %s", formatNumber(m))
+		}
+	}
+}
+
+// validateString applies the length bounds and pattern
+func (s *Schema) validateString(n map[string]interface{}, v string, fail func(string, ...interface{})) {
+	length := utf8.RuneCountInString(v)
+	if min, ok := n["minLength"].(float64); ok && float64(length) < min {
+		fail("must be at least %s characters", formatNumber(min))
+	}
+	if max, ok := n["maxLength"].(float64); ok && float64(length) > max {
+		fail("must be at most %s characters", formatNumber(max))
+	}
+	if pattern, ok := n["pattern"].(string); ok && !s.patterns[pattern].MatchString(v) {
+		fail("must match pattern %q", pattern)
+	}
+}
+
+// validateArray applies the array keywords and validates each item
+func (s *Schema) validateArray(n map[string]interface{}, v []interface{}, path string, errs *[]Error, depth int, fail func(string, ...interface{})) {
+	if min, ok := n["minItems"].(float64); ok && float64(len(v)) < min {
+		fail("must have at least %s items", formatNumber(min))
+	}
+	if max, ok := n["maxItems"].(float64); ok && float64(len(v)) > max {
+		fail("must have at most %s items", formatNumber(max))
+	}
+	if unique, _ := n["uniqueItems"].(bool); unique {
+		for i := range v {
+			for j := i + 1; j < len(v); j++ {
+				if equal(v[i], v[j]) {
+					fail("items %d and %d are equal, but items must be unique", i, j)
+				}
+			}
+		}
+	}
+
+	switch items := n["items"].(type) {
+	case []interface{}:
+		// Tuple form: one schema per position, then additionalItems
+		for i, item := range v {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if i < len(items) {
+				s.validate(items[i], item, itemPath, errs, depth+1)
+			} else if extra, ok := n["additionalItems"]; ok {
+				s.validate(extra, item, itemPath, errs, depth+1)
+			}
+		}
+	case nil:
+	default:
+		for i, item := range v {
+			s.validate(items, item, fmt.Sprintf("%s[%d]", path, i), errs, depth+1)
+		}
+	}
+
+	if contains, ok := n["contains"]; ok {
+		found := false
+		for _, item := range v {
+			if s.matches(contains, item, path, depth) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fail("must contain an item matching the contains schema")
+		}
+	}
+}
+
+// validateProperties applies the object keywords and validates each
+// property
+func (s *Schema) validateProperties(n map[string]interface{}, v map[string]interface{}, path string, errs *[]Error, depth int, fail func(string, ...interface{})) {
+	if required, ok := n["required"].([]interface{}); ok {
+		for _, name := range required {
+			if key, ok := name.(string); ok {
+				if _, present := v[key]; !present {
+					fail("missing required property %q", key)
+				}
+			}
+		}
+	}
+	if min, ok := n["minProperties"].(float64); ok && float64(len(v)) < min {
+		fail("must have at least %s properties", formatNumber(min))
+	}
+	if max, ok := n["maxProperties"].(float64); ok && float64(len(v)) > max {
+		fail("must have at most %s properties", formatNumber(max))
+	}
+
+	properties, _ := n["properties"].(map[string]interface{})
+	patternProperties, _ := n["patternProperties"].(map[string]interface{})
+	additional, hasAdditional := n["additionalProperties"]
+
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	names, hasNames := n["propertyNames"]
+	for _, key := range keys {
+		propPath := propertyPath(path, key)
+		if hasNames && !s.matches(names, key, propPath, depth) {
+			*errs = append(*errs, Error{Path: propPath, Message: "property name is not allowed"})
+		}
+		matched := false
+		if sub, ok := properties[key]; ok {
+			matched = true
+			s.validate(sub, v[key], propPath, errs, depth+1)
+		}
+		for pattern, sub := range patternProperties {
+			if s.patterns[pattern].MatchString(key) {
+				matched = true
+				s.validate(sub, v[key], propPath, errs, depth+1)
+			}
+		}
+		if !matched && hasAdditional {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				*errs = append(*errs, Error{Path: propPath, Message: "property is not allowed"})
+				continue
+			}
+			s.validate(additional, v[key], propPath, errs, depth+1)
+		}
+	}
+}
+
+// matches reports whether value passes a subschema
+func (s *Schema) matches(node, value interface{}, path string, depth int) bool {
+	var errs []Error
+	s.validate(node, value, path, &errs, depth+1)
+	return len(errs) == 0
+}
+
+// countMatches counts the subschemas value passes
+func (s *Schema) countMatches(nodes []interface{}, value interface{}, path string, depth int) int {
+	count := 0
+	for _, node := range nodes {
+		if s.matches(node, value, path, depth) {
+			count++
+		}
+	}
+	return count
+}
+
+// propertyPath appends an object key to a path, bracketing keys that
+// aren't simple identifiers
+func propertyPath(path, key string) string {
+	if key != "" && strings.IndexFunc(key, func(r rune) bool {
+		return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) < 0 {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// matchesType checks a "type" keyword, which may be a name or a list
+func matchesType(t, value interface{}) bool {
+	switch names := t.(type) {
+	case string:
+		return isType(names, value)
+	case []interface{}:
+		for _, name := range names {
+			if s, ok := name.(string); ok && isType(s, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isType checks a value against one JSON Schema type name
+func isType(name string, value interface{}) bool {
+	switch name {
+	case "integer":
+		n, ok := value.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	}
+	return typeOf(value) == name
+}
+
+// typeOf names the JSON type of a decoded value
+func typeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	}
+	return "object"
+}
+
+// describeType renders a "type" keyword for messages, e.g. "string or null"
+func describeType(t interface{}) string {
+	names, ok := t.([]interface{})
+	if !ok {
+		return fmt.Sprint(t)
+	}
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprint(name)
+	}
+	return strings.Join(parts, " or ")
+}
+
+// equal compares decoded JSON values
+func equal(a, b interface{}) bool {
+	return encode(a) == encode(b)
+}
+
+// encode renders a value as JSON; map keys are sorted, so equal values
+// encode alike
+func encode(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// formatNumber renders a schema bound without a trailing .0
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
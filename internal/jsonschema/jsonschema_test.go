@@ -0,0 +1,124 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderSchema = `{
+  "type": "object",
+  "required": ["id", "status", "items"],
+  "properties": {
+    "id": {"type": "integer", "minimum": 1},
+    "status": {"enum": ["pending", "paid", "shipped"]},
+    "email": {"type": "string", "pattern": "^[^@]+@[^@]+$"},
+    "note": {"type": ["string", "null"], "maxLength": 10},
+    "items": {
+      "type": "array",
+      "minItems": 1,
+      "items": {"$ref": "#/definitions/item"}
+    },
+    "pattern": {"type": "boolean"}
+  },
+  "additionalProperties": false,
+  "definitions": {
+    "item": {
+      "type": "object",
+      "required": ["sku"],
+      "properties": {
+        "sku": {"type": "string", "minLength": 3},
+        "qty": {"type": "number", "exclusiveMinimum": 0}
+      }
+    }
+  }
+}`
+
+func decode(t *testing.T, data string) interface{} {
+	t.Helper()
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &doc))
+	return doc
+}
+
+// TestValidate_Passes tests a document that matches the schema
+func TestValidate_Passes(t *testing.T) {
+	schema, err := Compile([]byte(orderSchema))
+	require.NoError(t, err)
+
+	doc := decode(t, `{"id": 7, "status": "paid", "email": "a@b.io", "note": null, "pattern": true, "items": [{"sku": "ABC", "qty": 2}]}`)
+	assert.Empty(t, schema.Validate(doc))
+}
+
+// TestValidate_Failures tests reporting each failure with its path
+func TestValidate_Failures(t *testing.T) {
+	schema, err := Compile([]byte(orderSchema))
+	require.NoError(t, err)
+
+	doc := decode(t, `{"id": 1.5, "status": "lost", "email": "nope", "note": "far too long", "items": [{"qty": 0}, {"sku": "X"}], "extra-field": 1}`)
+	assert.Equal(t, []Error{
+		{Path: "$.email", Message: `must match pattern "^[^@]+@[^@]+$"`},
+		{Path: "$.id", Message: "expected integer, got number"},
+		{Path: "$.items[0]", Message: `missing required property "sku"`},
+		{Path: "$.items[0].qty", Message: "must be greater than 0"},
+		{Path: "$.items[1].sku", Message: "must be at least 3 characters"},
+		{Path: "$.note", Message: "must be at most 10 characters"},
+		{Path: "$.status", Message: `must be one of ["pending","paid","shipped"]`},
+		{Path: `$["extra-field"]`, Message: "property is not allowed"},
+	}, schema.Validate(doc))
+}
+
+// TestValidate_Combinators tests anyOf, oneOf, not, if/then, and const
+func TestValidate_Combinators(t *testing.T) {
+	schema, err := Compile([]byte(`{
+  "properties": {
+    "a": {"anyOf": [{"type": "string"}, {"type": "number"}]},
+    "b": {"oneOf": [{"type": "number"}, {"type": "integer"}]},
+    "c": {"not": {"type": "null"}},
+    "d": {"if": {"const": "card"}, "then": {"const": "card"}, "else": {"enum": ["cash"]}},
+    "e": {"const": {"ok": true}}
+  }
+}`))
+	require.NoError(t, err)
+
+	assert.Empty(t, schema.Validate(decode(t, `{"a": "x", "b": 1.5, "c": 1, "d": "card", "e": {"ok": true}}`)))
+
+	errs := schema.Validate(decode(t, `{"a": true, "b": 2, "c": null, "d": "cheque", "e": {"ok": false}}`))
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	assert.Equal(t, []string{
+		"$.a: must match at least one schema in anyOf",
+		"$.b: must match exactly one schema in oneOf, matched 2",
+		"$.c: must not match the schema in not",
+		`$.d: must be one of ["cash"]`,
+		`$.e: must equal {"ok":true}`,
+	}, messages)
+}
+
+// TestValidate_BooleanSchemas tests true and false schemas
+func TestValidate_BooleanSchemas(t *testing.T) {
+	schema, err := Compile([]byte(`{"properties": {"any": true, "never": false}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []Error{{Path: "$.never", Message: "no value is allowed here"}},
+		schema.Validate(decode(t, `{"any": [1], "never": 1}`)))
+}
+
+// TestCompile_Errors tests rejecting unusable schemas
+func TestCompile_Errors(t *testing.T) {
+	tests := map[string]string{
+		`not json`:                          "invalid JSON schema: invalid character 'o' in literal null (expecting 'u')",
+		`[1]`:                               "invalid JSON schema: must be an object or boolean",
+		`{"pattern": "("}`:                  "invalid JSON schema: invalid pattern \"(\": error parsing regexp: missing closing ): `(`",
+		`{"$ref": "#/definitions/missing"}`: `invalid JSON schema: unresolved $ref "#/definitions/missing"`,
+		`{"$ref": "https://example.com/s"}`: `invalid JSON schema: unsupported $ref "https://example.com/s": only local references (#/...) are supported`,
+		`{"items": {"pattern": "[a-"}}`:     "invalid JSON schema: invalid pattern \"[a-\": error parsing regexp: missing closing ]: `[a-`",
+	}
+	for schema, want := range tests {
+		_, err := Compile([]byte(schema))
+		assert.EqualError(t, err, want, schema)
+	}
+}
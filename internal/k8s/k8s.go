@@ -0,0 +1,148 @@
+// Package k8s reads Kubernetes manifests for the hosts their Ingress
+// resources expose outside the cluster
+package k8s
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Host is an external host an Ingress routes traffic for
+type Host struct {
+	Host string
+	// Path is the first path routed for the host, or / when the rule has
+	// none or it's a regular expression
+	Path string
+	// TLS is set when the Ingress terminates TLS for the host
+	TLS       bool
+	Namespace string
+	Ingress   string
+	// App is the app.kubernetes.io/name or app label, if either is set
+	App string
+}
+
+// object holds the fields read from any manifest; only Ingresses use spec,
+// and only lists use items
+type object struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name      string            `yaml:"name"`
+		Namespace string            `yaml:"namespace"`
+		Labels    map[string]string `yaml:"labels"`
+	} `yaml:"metadata"`
+	Spec struct {
+		TLS []struct {
+			Hosts []string `yaml:"hosts"`
+		} `yaml:"tls"`
+		Rules []struct {
+			Host string `yaml:"host"`
+			HTTP struct {
+				Paths []struct {
+					Path string `yaml:"path"`
+				} `yaml:"paths"`
+			} `yaml:"http"`
+		} `yaml:"rules"`
+	} `yaml:"spec"`
+	Items []object `yaml:"items"`
+}
+
+// Parse reads the Ingress hosts from one or more YAML documents, such as a
+// manifest file or the output of kubectl get ingress -o yaml. Other kinds
+// of resource are ignored, and lists are read item by item. Rules without
+// a host, or with a wildcard host, are skipped and counted in wildcards.
+func Parse(data []byte) (hosts []Host, wildcards int, err error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var obj object
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid manifest (document %d): %w", i, err)
+		}
+		h, w := obj.hosts()
+		hosts = append(hosts, h...)
+		wildcards += w
+	}
+	return hosts, wildcards, nil
+}
+
+// hosts returns the hosts an Ingress, or the Ingresses in a list, route
+func (o object) hosts() ([]Host, int) {
+	if strings.HasSuffix(o.Kind, "List") {
+		var hosts []Host
+		wildcards := 0
+		for _, item := range o.Items {
+			h, w := item.hosts()
+			hosts = append(hosts, h...)
+			wildcards += w
+		}
+		return hosts, wildcards
+	}
+	if o.Kind != "Ingress" {
+		return nil, 0
+	}
+
+	namespace := o.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	app := o.Metadata.Labels["app.kubernetes.io/name"]
+	if app == "" {
+		app = o.Metadata.Labels["app"]
+	}
+
+	var hosts []Host
+	wildcards := 0
+	for _, rule := range o.Spec.Rules {
+		if rule.Host == "" || strings.Contains(rule.Host, "*") {
+			wildcards++
+			continue
+		}
+		path := "/"
+		if len(rule.HTTP.Paths) > 0 && isPlainPath(rule.HTTP.Paths[0].Path) {
+			path = rule.HTTP.Paths[0].Path
+		}
+		hosts = append(hosts, Host{
+			Host:      strings.ToLower(rule.Host),
+			Path:      path,
+			TLS:       o.terminatesTLS(rule.Host),
+			Namespace: namespace,
+			Ingress:   o.Metadata.Name,
+			App:       app,
+		})
+	}
+	return hosts, wildcards
+}
+
+// terminatesTLS reports whether one of the Ingress's TLS entries covers
+// the host, directly or with a wildcard
+func (o object) terminatesTLS(host string) bool {
+	host = strings.ToLower(host)
+	for _, tls := range o.Spec.TLS {
+		for _, h := range tls.Hosts {
+			h = strings.ToLower(h)
+			if h == host {
+				return true
+			}
+			if suffix, ok := strings.CutPrefix(h, "*."); ok {
+				if prefix, ok := strings.CutSuffix(host, "."+suffix); ok && !strings.Contains(prefix, ".") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isPlainPath reports whether an Ingress path is a literal path rather than
+// a regular expression some controllers accept
+func isPlainPath(path string) bool {
+	return strings.HasPrefix(path, "/") && !strings.ContainsAny(path, "()[]*+?$^|\\")
+}
@@ -0,0 +1,78 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse tests reading hosts from Ingresses in a multi-document manifest
+func TestParse(t *testing.T) {
+	hosts, wildcards, err := Parse([]byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: shop
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: shop
+  namespace: store
+  labels:
+    app.kubernetes.io/name: shop
+spec:
+  tls:
+    - hosts: ["*.example.com"]
+  rules:
+    - host: Shop.example.com
+      http:
+        paths:
+          - path: /api
+    - host: "*.example.com"
+    - host: admin.shop.example.com
+      http:
+        paths:
+          - path: /admin(/|$)(.*)
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: docs
+  labels:
+    app: docs
+spec:
+  rules:
+    - host: docs.example.com
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, wildcards)
+	assert.Equal(t, []Host{
+		{Host: "shop.example.com", Path: "/api", TLS: true, Namespace: "store", Ingress: "shop", App: "shop"},
+		{Host: "admin.shop.example.com", Path: "/", TLS: false, Namespace: "store", Ingress: "shop", App: "shop"},
+		{Host: "docs.example.com", Path: "/", TLS: false, Namespace: "default", Ingress: "docs", App: "docs"},
+	}, hosts)
+}
+
+// TestParseList tests reading Ingresses from a list, as kubectl prints them
+func TestParseList(t *testing.T) {
+	hosts, _, err := Parse([]byte(`
+apiVersion: v1
+kind: List
+items:
+  - kind: Ingress
+    metadata: {name: shop, namespace: store}
+    spec:
+      tls: [{hosts: [shop.example.com]}]
+      rules: [{host: shop.example.com}]
+`))
+	require.NoError(t, err)
+	require.Len(t, hosts, 1)
+	assert.Equal(t, "shop.example.com", hosts[0].Host)
+	assert.True(t, hosts[0].TLS)
+	assert.Empty(t, hosts[0].App)
+
+	_, _, err = Parse([]byte("kind: Ingress\n---\nkind: [\n"))
+	assert.ErrorContains(t, err, "invalid manifest (document 2)")
+}
@@ -0,0 +1,82 @@
+// Package keyring stores secrets in the operating system credential store
+// (macOS Keychain, Secret Service on Linux, Credential Manager on Windows).
+package keyring
+
+import (
+	"errors"
+	"sync"
+)
+
+// Service is the name secrets are stored under in the credential store
+const Service = "groovekit-cli"
+
+var (
+	// ErrNotFound is returned when no secret is stored for an account
+	ErrNotFound = errors.New("secret not found in keyring")
+	// ErrUnsupported is returned when no credential store is available
+	ErrUnsupported = errors.New("no OS keyring available on this system")
+)
+
+// provider is implemented by each platform-specific credential store
+type provider interface {
+	Get(service, account string) (string, error)
+	Set(service, account, secret string) error
+	Delete(service, account string) error
+}
+
+// store is the active provider, selected at build time per platform
+var store provider = platformProvider{}
+
+// Get returns the secret stored for account
+func Get(account string) (string, error) {
+	return store.Get(Service, account)
+}
+
+// Set stores secret for account, replacing any existing value
+func Set(account, secret string) error {
+	return store.Set(Service, account, secret)
+}
+
+// Delete removes the secret stored for account
+func Delete(account string) error {
+	return store.Delete(Service, account)
+}
+
+// MockInit replaces the OS credential store with an in-memory one.
+// It is intended for tests that must not touch the real keyring.
+func MockInit() {
+	store = &memoryProvider{secrets: make(map[string]string)}
+}
+
+// memoryProvider is an in-memory provider used by MockInit
+type memoryProvider struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+func (m *memoryProvider) Get(service, account string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	secret, ok := m.secrets[service+"/"+account]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+func (m *memoryProvider) Set(service, account, secret string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secrets[service+"/"+account] = secret
+	return nil
+}
+
+func (m *memoryProvider) Delete(service, account string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.secrets[service+"/"+account]; !ok {
+		return ErrNotFound
+	}
+	delete(m.secrets, service+"/"+account)
+	return nil
+}
@@ -0,0 +1,81 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// platformProvider stores secrets in the macOS Keychain via the security tool
+type platformProvider struct{}
+
+// notFoundExitCode is returned by security when no matching item exists
+const notFoundExitCode = 44
+
+// maxCommandLen is the longest line security -i reads
+const maxCommandLen = 4096
+
+func (platformProvider) Get(service, account string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return "", keychainError(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (platformProvider) Set(service, account, secret string) error {
+	// security -i reads commands from stdin, so the secret never appears in
+	// argv where other processes can see it. -X takes the password as hex,
+	// as documented in security(1), so it needs no quoting; -U updates the
+	// item in place if it already exists.
+	command := addCommand(service, account, secret)
+	if len(command) > maxCommandLen {
+		return errors.New("secret is too long for the macOS Keychain")
+	}
+	c := exec.Command("security", "-i")
+	c.Stdin = strings.NewReader(command)
+	// security -i reports a failed command on stderr rather than in its exit
+	// status
+	var stderr strings.Builder
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		return keychainError(err)
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// addCommand returns the security -i command that stores secret
+func addCommand(service, account, secret string) string {
+	return fmt.Sprintf("add-generic-password -U -s %s -a %s -X %s\n",
+		quoteArg(service), quoteArg(account), hex.EncodeToString([]byte(secret)))
+}
+
+// quoteArg single-quotes s for the security -i command line
+func quoteArg(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+func (platformProvider) Delete(service, account string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", account).Run()
+	return keychainError(err)
+}
+
+// keychainError maps security tool failures to package errors
+func keychainError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == notFoundExitCode {
+		return ErrNotFound
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return ErrUnsupported
+	}
+	return err
+}
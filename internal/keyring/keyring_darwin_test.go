@@ -0,0 +1,51 @@
+package keyring
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+)
+
+func TestAddCommand(t *testing.T) {
+	got := addCommand("groovekit-cli", "o'brien", "tok")
+	want := "add-generic-password -U -s 'groovekit-cli' -a 'o'\"'\"'brien' -X 746f6b\n"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+// TestPlatformProvider_RoundTrip stores, updates, and removes a secret in
+// the real macOS Keychain, which the CI macOS job runs
+func TestPlatformProvider_RoundTrip(t *testing.T) {
+	if _, err := exec.LookPath("security"); err != nil {
+		t.Skip("security tool not available")
+	}
+
+	p := platformProvider{}
+	service, account := Service+"-test", "round trip's account"
+	_ = p.Delete(service, account)
+	t.Cleanup(func() { _ = p.Delete(service, account) })
+
+	if _, err := p.Get(service, account); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound before Set, got %v", err)
+	}
+
+	// Quotes, spaces, and backslashes must survive the trip through
+	// security's stdin
+	for _, secret := range []string{"token-1", `gk_"it's" a \token`} {
+		if err := p.Set(service, account, secret); err != nil {
+			t.Fatalf("Set() failed: %v", err)
+		}
+		got, err := p.Get(service, account)
+		if err != nil {
+			t.Fatalf("Get() failed: %v", err)
+		}
+		if got != secret {
+			t.Errorf("Expected secret %q, got %q", secret, got)
+		}
+	}
+
+	if err := p.Delete(service, account); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package keyring
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// platformProvider stores secrets via the Secret Service API (GNOME Keyring,
+// KWallet) using the secret-tool utility from libsecret
+type platformProvider struct{}
+
+func (platformProvider) Get(service, account string) (string, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(out) == 0 {
+			return "", ErrNotFound
+		}
+		return "", secretToolError(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (platformProvider) Set(service, account, secret string) error {
+	// The secret is passed on stdin so it never appears in the process list
+	c := exec.Command("secret-tool", "store", "--label=GrooveKit CLI", "service", service, "account", account)
+	c.Stdin = strings.NewReader(secret)
+	return secretToolError(c.Run())
+}
+
+func (platformProvider) Delete(service, account string) error {
+	return secretToolError(exec.Command("secret-tool", "clear", "service", service, "account", account).Run())
+}
+
+// secretToolError maps secret-tool failures to package errors
+func secretToolError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, exec.ErrNotFound) {
+		return ErrUnsupported
+	}
+	return err
+}
@@ -0,0 +1,18 @@
+//go:build !darwin && !linux && !windows
+
+package keyring
+
+// platformProvider reports that no credential store is available
+type platformProvider struct{}
+
+func (platformProvider) Get(_, _ string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (platformProvider) Set(_, _, _ string) error {
+	return ErrUnsupported
+}
+
+func (platformProvider) Delete(_, _ string) error {
+	return ErrUnsupported
+}
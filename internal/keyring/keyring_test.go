@@ -0,0 +1,36 @@
+package keyring
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMockInit_RoundTrip(t *testing.T) {
+	MockInit()
+
+	if _, err := Get("default"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound before Set, got %v", err)
+	}
+
+	if err := Set("default", "token-1"); err != nil {
+		t.Fatalf("Set() failed: %v", err)
+	}
+	if err := Set("default", "token-2"); err != nil {
+		t.Fatalf("Set() overwrite failed: %v", err)
+	}
+
+	got, err := Get("default")
+	if err != nil {
+		t.Fatalf("Get() failed: %v", err)
+	}
+	if got != "token-2" {
+		t.Errorf("Expected overwritten secret %q, got %q", "token-2", got)
+	}
+
+	if err := Delete("default"); err != nil {
+		t.Fatalf("Delete() failed: %v", err)
+	}
+	if err := Delete("default"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound deleting twice, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+// Package openapi reads the parts of OpenAPI 3 and Swagger 2 documents
+// needed to set up monitors: the server URL and the GET endpoints
+package openapi
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is an OpenAPI 3 or Swagger 2 document
+type Document struct {
+	OpenAPI string `yaml:"openapi"`
+	Swagger string `yaml:"swagger"`
+	Info    struct {
+		Title string `yaml:"title"`
+	} `yaml:"info"`
+
+	// OpenAPI 3
+	Servers []Server `yaml:"servers"`
+
+	// Swagger 2
+	Host     string   `yaml:"host"`
+	BasePath string   `yaml:"basePath"`
+	Schemes  []string `yaml:"schemes"`
+
+	Paths map[string]PathItem `yaml:"paths"`
+}
+
+// Server is an OpenAPI 3 server entry
+type Server struct {
+	URL       string `yaml:"url"`
+	Variables map[string]struct {
+		Default string `yaml:"default"`
+	} `yaml:"variables"`
+}
+
+// PathItem holds the operations for one path. Only GET is read.
+type PathItem struct {
+	Get        *Operation  `yaml:"get"`
+	Parameters []Parameter `yaml:"parameters"`
+}
+
+// Operation is a single API operation
+type Operation struct {
+	Summary     string                 `yaml:"summary"`
+	OperationID string                 `yaml:"operationId"`
+	Parameters  []Parameter            `yaml:"parameters"`
+	Responses   map[string]interface{} `yaml:"responses"`
+}
+
+// Parameter is an operation or path parameter
+type Parameter struct {
+	Name     string `yaml:"name"`
+	In       string `yaml:"in"`
+	Required bool   `yaml:"required"`
+}
+
+// Endpoint is a GET operation
+type Endpoint struct {
+	Path        string
+	Summary     string
+	OperationID string
+	// StatusCodes are the documented 2xx response codes, ascending
+	StatusCodes []int
+	// NeedsInput is set when the path has parameters or a query parameter is
+	// required, so the endpoint can't be requested as-is
+	NeedsInput bool
+}
+
+// Parse decodes an OpenAPI 3 or Swagger 2 document from YAML or JSON
+func Parse(data []byte) (*Document, error) {
+	var doc Document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	if doc.OpenAPI == "" && doc.Swagger == "" {
+		return nil, fmt.Errorf("not an OpenAPI document: missing openapi or swagger version")
+	}
+	return &doc, nil
+}
+
+// BaseURL returns the API's base URL from the first server (OpenAPI 3) or
+// from schemes, host, and basePath (Swagger 2), with server variables set
+// to their defaults. It is empty when the document doesn't say, and may be
+// relative, e.g. "/v1".
+func (d *Document) BaseURL() string {
+	if len(d.Servers) > 0 {
+		url := d.Servers[0].URL
+		for name, v := range d.Servers[0].Variables {
+			url = strings.ReplaceAll(url, "{"+name+"}", v.Default)
+		}
+		return strings.TrimSuffix(url, "/")
+	}
+	if d.Host == "" {
+		return strings.TrimSuffix(d.BasePath, "/")
+	}
+	scheme := "https"
+	if len(d.Schemes) > 0 && !slices.Contains(d.Schemes, "https") {
+		scheme = d.Schemes[0]
+	}
+	return scheme + "://" + d.Host + strings.TrimSuffix(d.BasePath, "/")
+}
+
+// GetEndpoints returns the document's GET operations, sorted by path
+func (d *Document) GetEndpoints() []Endpoint {
+	var endpoints []Endpoint
+	for path, item := range d.Paths {
+		if item.Get == nil {
+			continue
+		}
+		endpoint := Endpoint{
+			Path:        path,
+			Summary:     item.Get.Summary,
+			OperationID: item.Get.OperationID,
+			StatusCodes: successCodes(item.Get.Responses),
+			NeedsInput:  strings.Contains(path, "{"),
+		}
+		for _, p := range append(append([]Parameter(nil), item.Parameters...), item.Get.Parameters...) {
+			if p.Required && (p.In == "path" || p.In == "query") {
+				endpoint.NeedsInput = true
+			}
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Path < endpoints[j].Path })
+	return endpoints
+}
+
+// successCodes returns the explicit 2xx codes among response keys, skipping
+// ranges like "2XX" and "default"
+func successCodes(responses map[string]interface{}) []int {
+	var codes []int
+	for key := range responses {
+		code, err := strconv.Atoi(key)
+		if err == nil && code >= 200 && code < 300 {
+			codes = append(codes, code)
+		}
+	}
+	sort.Ints(codes)
+	return codes
+}
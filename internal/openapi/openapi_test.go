@@ -0,0 +1,95 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petstore = `
+openapi: 3.0.3
+info:
+  title: Petstore
+servers:
+  - url: https://{region}.example.com/v1/
+    variables:
+      region:
+        default: eu
+paths:
+  /health:
+    get:
+      summary: Health check
+      responses:
+        200:
+          description: OK
+        "503":
+          description: Unavailable
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+      responses:
+        "200": {description: OK}
+        "204": {description: Empty}
+        2XX: {description: Other}
+        default: {description: Error}
+    post:
+      responses:
+        "201": {description: Created}
+  /pets/{petId}:
+    get:
+      responses:
+        "200": {description: OK}
+  /search:
+    parameters:
+      - name: q
+        in: query
+        required: true
+    get:
+      responses:
+        "200": {description: OK}
+  /upload:
+    post:
+      responses:
+        "201": {description: Created}
+`
+
+// TestParse_OpenAPI3 tests reading servers and GET endpoints
+func TestParse_OpenAPI3(t *testing.T) {
+	doc, err := Parse([]byte(petstore))
+	require.NoError(t, err)
+	assert.Equal(t, "Petstore", doc.Info.Title)
+	assert.Equal(t, "https://eu.example.com/v1", doc.BaseURL())
+
+	endpoints := doc.GetEndpoints()
+	assert.Equal(t, []Endpoint{
+		{Path: "/health", Summary: "Health check", StatusCodes: []int{200}},
+		{Path: "/pets", OperationID: "listPets", StatusCodes: []int{200, 204}},
+		{Path: "/pets/{petId}", StatusCodes: []int{200}, NeedsInput: true},
+		{Path: "/search", StatusCodes: []int{200}, NeedsInput: true},
+	}, endpoints)
+}
+
+// TestParse_Swagger2 tests building the base URL from host and basePath
+func TestParse_Swagger2(t *testing.T) {
+	doc, err := Parse([]byte(`{"swagger": "2.0", "host": "api.example.com", "basePath": "/v2", "schemes": ["http"], "paths": {"/ping": {"get": {"responses": {"200": {}}}}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "http://api.example.com/v2", doc.BaseURL())
+	require.Len(t, doc.GetEndpoints(), 1)
+
+	doc, err = Parse([]byte(`{"swagger": "2.0", "host": "api.example.com", "schemes": ["http", "https"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.example.com", doc.BaseURL())
+}
+
+// TestParse_Errors tests rejecting documents that aren't OpenAPI
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte("name: not a spec\n"))
+	assert.EqualError(t, err, "not an OpenAPI document: missing openapi or swagger version")
+
+	_, err = Parse([]byte("openapi: [\n"))
+	assert.ErrorContains(t, err, "failed to parse OpenAPI document")
+}
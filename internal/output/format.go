@@ -3,10 +3,9 @@ package output
 
 import (
 	"fmt"
-	"os"
+	"io"
 
 	"github.com/fatih/color"
-	"github.com/jedib0t/go-pretty/v6/table"
 )
 
 // Colors
@@ -20,62 +19,83 @@ var (
 	Error   = color.New(color.FgRed, color.Bold).SprintFunc()
 )
 
-// Table is a wrapper around go-pretty table
-type Table struct {
-	writer table.Writer
-}
-
-// NewTable creates a nicely formatted table
-func NewTable(headers []string) *Table {
-	t := table.NewWriter()
-	t.SetOutputMirror(os.Stdout)
-	t.SetStyle(table.StyleLight)
+// When to color output
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
 
-	// Convert headers to table.Row
-	headerRow := make(table.Row, len(headers))
-	for i, h := range headers {
-		headerRow[i] = h
+// ResolveColor decides when to color output from --no-color, then the
+// NO_COLOR, CLICOLOR_FORCE, and CLICOLOR environment variables, then the
+// color setting
+func ResolveColor(noColor bool, setting string, getenv func(string) string) string {
+	switch {
+	case noColor, getenv("NO_COLOR") != "":
+		return ColorNever
+	case getenv("CLICOLOR_FORCE") != "" && getenv("CLICOLOR_FORCE") != "0":
+		return ColorAlways
+	case getenv("CLICOLOR") == "0":
+		return ColorNever
+	case setting != "":
+		return setting
 	}
-	t.AppendHeader(headerRow)
+	return ColorAuto
+}
 
-	return &Table{
-		writer: t,
+// SetColor colors output always or never, or only when writing to a
+// terminal for ColorAuto
+func SetColor(mode string) {
+	switch mode {
+	case ColorAlways:
+		color.NoColor = false
+	case ColorNever:
+		color.NoColor = true
 	}
 }
 
-// Render is a no-op for compatibility (go-pretty renders on Flush)
-func (t *Table) Render() {
-	// No-op: go-pretty handles rendering automatically
+// quiet suppresses success and info messages, totals, and table
+// decoration, leaving only essential data
+var quiet bool
+
+// SetQuiet turns quiet output on or off
+func SetQuiet(q bool) {
+	quiet = q
 }
 
-// Append adds a row to the table
-func (t *Table) Append(row []string) {
-	// Convert string slice to table.Row
-	tableRow := make(table.Row, len(row))
-	for i, cell := range row {
-		tableRow[i] = cell
-	}
-	t.writer.AppendRow(tableRow)
+// Quiet reports whether quiet output is on
+func Quiet() bool {
+	return quiet
 }
 
-// Flush writes all buffered data to output
-func (t *Table) Flush() {
-	t.writer.Render()
+// SuccessMessage prints a green success message to w
+func SuccessMessage(w io.Writer, msg string) {
+	if quiet {
+		return
+	}
+	fmt.Fprintln(w, Green("✓ "+msg))
 }
 
-// SuccessMessage prints a green success message
-func SuccessMessage(msg string) {
-	color.Green("✓ " + msg)
+// ErrorMessage prints a red error message to w
+func ErrorMessage(w io.Writer, msg string) {
+	fmt.Fprintln(w, Red("✗ "+msg))
 }
 
-// ErrorMessage prints a red error message
-func ErrorMessage(msg string) {
-	color.Red("✗ " + msg)
+// InfoMessage prints a cyan info message to w
+func InfoMessage(w io.Writer, msg string) {
+	if quiet {
+		return
+	}
+	fmt.Fprintln(w, Cyan(msg))
 }
 
-// InfoMessage prints a cyan info message
-func InfoMessage(msg string) {
-	color.Cyan(msg)
+// TotalMessage prints a bold summary line such as a list's total, after a
+// blank line
+func TotalMessage(w io.Writer, msg string) {
+	if quiet {
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", Bold(msg))
 }
 
 // FormatDuration converts minutes to human-readable format
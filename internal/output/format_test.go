@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResolveColor tests the precedence of color flags, environment, and setting
+func TestResolveColor(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(name string) string { return vars[name] }
+	}
+	tests := []struct {
+		name    string
+		noColor bool
+		setting string
+		vars    map[string]string
+		want    string
+	}{
+		{"default", false, "", nil, ColorAuto},
+		{"setting", false, ColorAlways, nil, ColorAlways},
+		{"flag wins", true, ColorAlways, map[string]string{"CLICOLOR_FORCE": "1"}, ColorNever},
+		{"NO_COLOR", false, ColorAlways, map[string]string{"NO_COLOR": "1"}, ColorNever},
+		{"CLICOLOR_FORCE", false, ColorNever, map[string]string{"CLICOLOR_FORCE": "1"}, ColorAlways},
+		{"CLICOLOR_FORCE=0 is unset", false, "", map[string]string{"CLICOLOR_FORCE": "0"}, ColorAuto},
+		{"CLICOLOR=0", false, ColorAlways, map[string]string{"CLICOLOR": "0"}, ColorNever},
+		{"CLICOLOR=1 leaves setting", false, ColorNever, map[string]string{"CLICOLOR": "1"}, ColorNever},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveColor(tt.noColor, tt.setting, env(tt.vars)))
+		})
+	}
+}
+
+// TestSetColor tests forcing color on and off
+func TestSetColor(t *testing.T) {
+	orig := color.NoColor
+	t.Cleanup(func() { color.NoColor = orig })
+
+	SetColor(ColorNever)
+	assert.Equal(t, "ok", Green("ok"))
+
+	SetColor(ColorAlways)
+	assert.Contains(t, Green("ok"), "\x1b[")
+
+	// Auto leaves the detected value alone
+	SetColor(ColorAuto)
+	assert.False(t, color.NoColor)
+}
+
+// TestQuietMessages tests quiet mode drops messages and totals but not errors
+func TestQuietMessages(t *testing.T) {
+	SetQuiet(true)
+	t.Cleanup(func() { SetQuiet(false) })
+
+	var buf bytes.Buffer
+	SuccessMessage(&buf, "Job created")
+	InfoMessage(&buf, "No jobs found")
+	TotalMessage(&buf, "Total: 2 job(s)")
+	assert.Empty(t, buf.String())
+
+	ErrorMessage(&buf, "failed")
+	assert.Contains(t, buf.String(), "failed")
+}
@@ -0,0 +1,328 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"golang.org/x/term"
+)
+
+// Table is a wrapper around go-pretty table that buffers rows so they can be
+// sorted and trimmed to selected columns before rendering
+type Table struct {
+	w       io.Writer
+	headers []string
+	keys    []string
+	hidden  map[string]bool
+	rows    []tableRow
+	opts    TableOptions
+}
+
+// tableRow is a rendered row plus optional raw values used for sorting
+type tableRow struct {
+	cells  []string
+	values []interface{}
+}
+
+// TableOptions controls row order and which columns are shown
+type TableOptions struct {
+	// Sort is a column key to order rows by; prefix with "-" for descending
+	Sort string
+	// Columns lists column keys to show, in order. Empty shows the defaults.
+	Columns []string
+	// Wide shows every column, including hidden ones, at full width
+	Wide bool
+	// Width is the widest the table may be, e.g. the terminal's width; the
+	// widest columns are shortened to fit. Zero leaves it unlimited.
+	Width int
+}
+
+// minColumnWidth is the narrowest a column is shortened to when fitting a
+// table to its width, unless its header or contents are narrower
+const minColumnWidth = 8
+
+// NewTable creates a nicely formatted table that renders to w
+func NewTable(w io.Writer, headers []string) *Table {
+	keys := make([]string, len(headers))
+	for i, h := range headers {
+		keys[i] = ColumnKey(h)
+	}
+	return &Table{
+		w:       w,
+		headers: headers,
+		keys:    keys,
+		hidden:  map[string]bool{},
+	}
+}
+
+// TerminalWidth returns the width of the terminal w writes to, or 0 when
+// it isn't a terminal, so output piped to a file or another program is
+// never shortened. COLUMNS is used when the terminal's size is unknown.
+func TerminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0
+	}
+	if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+		return width
+	}
+	width, _ := strconv.Atoi(os.Getenv("COLUMNS"))
+	return max(width, 0)
+}
+
+// ColumnKey returns the --sort/--columns key for a header ("DAYS LEFT" -> "days-left")
+func ColumnKey(header string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(header)), " ", "-")
+}
+
+// Hide keeps columns out of the default view; they can still be sorted on,
+// selected with --columns, and shown with --wide
+func (t *Table) Hide(keys ...string) {
+	for _, key := range keys {
+		t.hidden[key] = true
+	}
+}
+
+// SetOptions validates and applies sort and column options
+func (t *Table) SetOptions(opts TableOptions) error {
+	if opts.Sort != "" {
+		if t.index(strings.TrimPrefix(opts.Sort, "-")) < 0 {
+			return fmt.Errorf("unknown sort column %q (available: %s)", strings.TrimPrefix(opts.Sort, "-"), strings.Join(t.keys, ", "))
+		}
+	}
+	for _, key := range opts.Columns {
+		if t.index(key) < 0 {
+			return fmt.Errorf("unknown column %q (available: %s)", key, strings.Join(t.keys, ", "))
+		}
+	}
+	t.opts = opts
+	return nil
+}
+
+// Render is a no-op for compatibility (go-pretty renders on Flush)
+func (t *Table) Render() {
+	// No-op: go-pretty handles rendering automatically
+}
+
+// Append adds a row to the table. Rows are sorted on the displayed text,
+// treating leading numbers ("45 days", "99.5%") numerically.
+func (t *Table) Append(row []string) {
+	t.rows = append(t.rows, tableRow{cells: row})
+}
+
+// AppendWithValues adds a row along with raw per-column values to sort on
+// (e.g. minutes for an interval shown as "1 hour"). A nil value falls back
+// to the displayed text.
+func (t *Table) AppendWithValues(row []string, values []interface{}) {
+	t.rows = append(t.rows, tableRow{cells: row, values: values})
+}
+
+// Flush writes all buffered data to output. In quiet mode only the first
+// visible column is written, one plain value per line, e.g. just the IDs.
+func (t *Table) Flush() {
+	if t.opts.Sort != "" {
+		t.sortRows()
+	}
+	columns := t.visibleColumns()
+
+	if quiet {
+		for _, row := range t.rows {
+			if len(columns) > 0 && columns[0] < len(row.cells) {
+				fmt.Fprintln(t.w, text.StripEscape(row.cells[columns[0]]))
+			}
+		}
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetOutputMirror(t.w)
+	tw.SetStyle(table.StyleLight)
+	if !t.opts.Wide && t.opts.Width > 0 {
+		tw.SetColumnConfigs(t.fitColumns(columns))
+	}
+
+	headerRow := make(table.Row, len(columns))
+	for i, col := range columns {
+		headerRow[i] = t.headers[col]
+	}
+	tw.AppendHeader(headerRow)
+
+	for _, row := range t.rows {
+		tableRow := make(table.Row, len(columns))
+		for i, col := range columns {
+			if col < len(row.cells) {
+				tableRow[i] = row.cells[col]
+			}
+		}
+		tw.AppendRow(tableRow)
+	}
+
+	tw.Render()
+}
+
+// fitColumns shortens the widest of the given columns until the table fits
+// in opts.Width, returning configs that cut cells to size with an ellipsis
+func (t *Table) fitColumns(columns []int) []table.ColumnConfig {
+	// Each column is padded by a space either side and followed by a
+	// border, and the table starts with one
+	available := t.opts.Width - 1 - 3*len(columns)
+
+	widths := make([]int, len(columns))
+	minWidths := make([]int, len(columns))
+	total := 0
+	for i, col := range columns {
+		widths[i] = text.StringWidthWithoutEscSequences(t.headers[col])
+		for _, row := range t.rows {
+			if col < len(row.cells) {
+				widths[i] = max(widths[i], text.StringWidthWithoutEscSequences(row.cells[col]))
+			}
+		}
+		minWidths[i] = min(widths[i], max(minColumnWidth, text.StringWidthWithoutEscSequences(t.headers[col])))
+		total += widths[i]
+	}
+
+	for total > available {
+		widest := -1
+		for i := range widths {
+			if widths[i] > minWidths[i] && (widest < 0 || widths[i] > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		widths[widest]--
+		total--
+	}
+
+	configs := make([]table.ColumnConfig, len(columns))
+	for i := range columns {
+		configs[i] = table.ColumnConfig{
+			Number:           i + 1,
+			WidthMax:         widths[i],
+			WidthMaxEnforcer: func(cell string, width int) string { return text.Snip(cell, width, "…") },
+		}
+	}
+	return configs
+}
+
+// index returns the position of a column key, or -1
+func (t *Table) index(key string) int {
+	for i, k := range t.keys {
+		if k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// visibleColumns returns the column indexes to render, in order
+func (t *Table) visibleColumns() []int {
+	var columns []int
+	if len(t.opts.Columns) > 0 {
+		for _, key := range t.opts.Columns {
+			columns = append(columns, t.index(key))
+		}
+		return columns
+	}
+	for i, key := range t.keys {
+		if t.opts.Wide || !t.hidden[key] {
+			columns = append(columns, i)
+		}
+	}
+	return columns
+}
+
+// sortRows orders rows by the sort column, keeping empty values last
+func (t *Table) sortRows() {
+	desc := strings.HasPrefix(t.opts.Sort, "-")
+	col := t.index(strings.TrimPrefix(t.opts.Sort, "-"))
+
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		a, b := t.rows[i].sortValue(col), t.rows[j].sortValue(col)
+		if a == nil || b == nil {
+			return a != nil
+		}
+		cmp := compareValues(a, b)
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+}
+
+// sortValue returns the value a row sorts on for a column: a float64, a
+// lowercase string, or nil when the cell is empty
+func (r tableRow) sortValue(col int) interface{} {
+	if col < len(r.values) && r.values[col] != nil {
+		switch v := r.values[col].(type) {
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		case float64:
+			return v
+		case *float64:
+			if v == nil {
+				return nil
+			}
+			return *v
+		case string:
+			return strings.ToLower(v)
+		}
+	}
+	if col >= len(r.cells) {
+		return nil
+	}
+
+	cell := strings.TrimSpace(text.StripEscape(r.cells[col]))
+	if cell == "" || cell == "-" {
+		return nil
+	}
+	if n, ok := leadingNumber(cell); ok {
+		return n
+	}
+	return strings.ToLower(cell)
+}
+
+// leadingNumber parses the number at the start of s ("45 days" -> 45, "99.5%" -> 99.5)
+func leadingNumber(s string) (float64, bool) {
+	end := 0
+	for end < len(s) && (s[end] == '-' && end == 0 || s[end] == '.' || s[end] >= '0' && s[end] <= '9') {
+		end++
+	}
+	// Only treat it as a number when followed by a unit, not more
+	// characters of an identifier ("3fa8c2")
+	if end < len(s) && s[end] != ' ' && s[end] != '%' {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(s[:end], 64)
+	return n, err == nil
+}
+
+// compareValues orders numbers before strings, then numerically or lexically
+func compareValues(a, b interface{}) int {
+	af, aNum := a.(float64)
+	bf, bNum := b.(float64)
+	switch {
+	case aNum && bNum:
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		}
+		return 0
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a.(string), b.(string))
+}
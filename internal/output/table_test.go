@@ -0,0 +1,165 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// renderedColumn renders the table and returns the first cell of each body row
+func renderedColumn(t *testing.T, tbl *Table, buf *bytes.Buffer) []string {
+	t.Helper()
+	tbl.Flush()
+
+	var cells []string
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	// Skip top border, header, separator, and bottom border
+	for _, line := range lines[3 : len(lines)-1] {
+		fields := strings.Split(line, "│")
+		cells = append(cells, strings.TrimSpace(fields[1]))
+	}
+	return cells
+}
+
+// TestColumnKey tests header to key conversion
+func TestColumnKey(t *testing.T) {
+	assert.Equal(t, "days-left", ColumnKey("DAYS LEFT"))
+	assert.Equal(t, "name", ColumnKey("Name"))
+}
+
+// TestTableSortNumeric tests numeric sorting of display text and raw values
+func TestTableSortNumeric(t *testing.T) {
+	// Force colors so sorting has to look past ANSI escapes
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	var buf bytes.Buffer
+	tbl := NewTable(&buf, []string{"NAME", "DAYS LEFT", "INTERVAL"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Sort: "days-left"}))
+	tbl.Append([]string{"b", Green("120"), "1 hour"})
+	tbl.Append([]string{"a", Red("9"), "30 minutes"})
+	tbl.Append([]string{"c", "-", "1 day"})
+	tbl.Append([]string{"d", Yellow("30"), "5 minutes"})
+
+	assert.Equal(t, []string{"a", "d", "b", "c"}, renderedColumn(t, tbl, &buf))
+
+	buf.Reset()
+	tbl = NewTable(&buf, []string{"NAME", "INTERVAL"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Sort: "-interval"}))
+	tbl.AppendWithValues([]string{"hourly", "1 hour"}, []interface{}{nil, 60})
+	tbl.AppendWithValues([]string{"daily", "1 day"}, []interface{}{nil, 1440})
+	tbl.AppendWithValues([]string{"fast", "5 minutes"}, []interface{}{nil, 5})
+
+	assert.Equal(t, []string{"daily", "hourly", "fast"}, renderedColumn(t, tbl, &buf))
+}
+
+// TestTableColumns tests column selection and hidden columns
+func TestTableColumns(t *testing.T) {
+	var buf bytes.Buffer
+	tbl := NewTable(&buf, []string{"ID", "NAME", "UPTIME"})
+	tbl.Hide("uptime")
+	tbl.Append([]string{"1", "api", "99.9%"})
+	tbl.Flush()
+	assert.NotContains(t, buf.String(), "UPTIME")
+
+	buf.Reset()
+	tbl = NewTable(&buf, []string{"ID", "NAME", "UPTIME"})
+	tbl.Hide("uptime")
+	require.NoError(t, tbl.SetOptions(TableOptions{Columns: []string{"uptime", "name"}}))
+	tbl.Append([]string{"1", "api", "99.9%"})
+	tbl.Flush()
+	assert.Contains(t, buf.String(), "UPTIME")
+	assert.NotContains(t, buf.String(), "ID")
+	assert.Less(t, strings.Index(buf.String(), "UPTIME"), strings.Index(buf.String(), "NAME"))
+}
+
+// TestTableFitWidth tests shortening the widest columns to fit a width,
+// and --wide showing every column in full
+func TestTableFitWidth(t *testing.T) {
+	url := "https://api.example.com/v1/orders/health?verbose=true&region=eu-west"
+	fill := func(tbl *Table) {
+		tbl.Hide("token")
+		tbl.Append([]string{"aaaa1111", "Orders", url, "tok_123"})
+		tbl.Append([]string{"bbbb2222", "Search", "https://api.example.com/search", "tok_456"})
+	}
+
+	var buf bytes.Buffer
+	tbl := NewTable(&buf, []string{"ID", "NAME", "URL", "TOKEN"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Width: 50}))
+	fill(tbl)
+	tbl.Flush()
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		assert.LessOrEqual(t, text.StringWidthWithoutEscSequences(line), 50, line)
+	}
+	assert.Contains(t, buf.String(), "aaaa1111")
+	assert.Contains(t, buf.String(), "Orders")
+	assert.Contains(t, buf.String(), "│ https://api.example.com/v… │")
+	assert.NotContains(t, buf.String(), "TOKEN")
+
+	buf.Reset()
+	tbl = NewTable(&buf, []string{"ID", "NAME", "URL", "TOKEN"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Width: 50, Wide: true}))
+	fill(tbl)
+	tbl.Flush()
+	assert.Contains(t, buf.String(), url)
+	assert.Contains(t, buf.String(), "TOKEN")
+	assert.Contains(t, buf.String(), "tok_123")
+
+	// Tables that already fit are left alone
+	buf.Reset()
+	tbl = NewTable(&buf, []string{"ID", "NAME"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Width: 50}))
+	tbl.Append([]string{"aaaa1111", "Orders"})
+	tbl.Flush()
+	assert.Contains(t, buf.String(), "│ aaaa1111 │ Orders │")
+}
+
+// TestTerminalWidth tests output that isn't a terminal is never fitted
+func TestTerminalWidth(t *testing.T) {
+	t.Setenv("COLUMNS", "80")
+	assert.Zero(t, TerminalWidth(&bytes.Buffer{}))
+
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	require.NoError(t, err)
+	defer f.Close()
+	assert.Zero(t, TerminalWidth(f))
+}
+
+// TestTableQuiet tests quiet mode writes the first column's plain values
+func TestTableQuiet(t *testing.T) {
+	SetQuiet(true)
+	t.Cleanup(func() { SetQuiet(false) })
+	orig := color.NoColor
+	color.NoColor = false
+	t.Cleanup(func() { color.NoColor = orig })
+
+	var buf bytes.Buffer
+	tbl := NewTable(&buf, []string{"ID", "NAME"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Sort: "name"}))
+	tbl.Append([]string{Cyan("bbbb2222"), "web"})
+	tbl.Append([]string{Cyan("aaaa1111"), "api"})
+	tbl.Flush()
+	assert.Equal(t, "aaaa1111\nbbbb2222\n", buf.String())
+
+	buf.Reset()
+	tbl = NewTable(&buf, []string{"ID", "NAME"})
+	require.NoError(t, tbl.SetOptions(TableOptions{Columns: []string{"name"}}))
+	tbl.Append([]string{"aaaa1111", "api"})
+	tbl.Flush()
+	assert.Equal(t, "api\n", buf.String())
+}
+
+// TestTableOptionsValidation tests unknown sort and column keys are rejected
+func TestTableOptionsValidation(t *testing.T) {
+	tbl := NewTable(&bytes.Buffer{}, []string{"ID", "NAME"})
+	assert.Error(t, tbl.SetOptions(TableOptions{Sort: "-uptime"}))
+	assert.Error(t, tbl.SetOptions(TableOptions{Columns: []string{"id", "url"}}))
+	assert.NoError(t, tbl.SetOptions(TableOptions{Sort: "-name", Columns: []string{"name"}}))
+}
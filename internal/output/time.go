@@ -0,0 +1,57 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Time zone names work on systems without a zone database, e.g. Windows
+	_ "time/tzdata"
+)
+
+// TimezoneLocal is the time zone setting for the system's own zone
+const TimezoneLocal = "local"
+
+// TimeLayout is how FormatTime shows times, with the zone's abbreviation
+// so they can't be mistaken for another zone's
+const TimeLayout = "2006-01-02 15:04:05 MST"
+
+// timezone is the zone times are displayed in
+var timezone = time.Local
+
+// LoadTimezone returns the zone for a time zone setting: "local" or "" for
+// the system's zone, "UTC", or an IANA name such as Europe/London
+func LoadTimezone(name string) (*time.Location, error) {
+	switch {
+	case name == "" || strings.EqualFold(name, TimezoneLocal):
+		return time.Local, nil
+	case strings.EqualFold(name, "UTC"):
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: use local, UTC, or a name such as Europe/London", name)
+	}
+	return loc, nil
+}
+
+// SetTimezone sets the zone times are displayed in
+func SetTimezone(loc *time.Location) {
+	timezone = loc
+}
+
+// InTimezone returns t in the display time zone
+func InTimezone(t time.Time) time.Time {
+	return t.In(timezone)
+}
+
+// FormatTime shows an API timestamp in the display time zone, e.g.
+// "2026-05-01 10:00:00 BST". Values that aren't RFC 3339 timestamps, such
+// as "" or "-", are returned unchanged.
+func FormatTime(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return timestamp
+	}
+	return InTimezone(t).Format(TimeLayout)
+}
@@ -0,0 +1,50 @@
+package output
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadTimezone tests resolving time zone settings
+func TestLoadTimezone(t *testing.T) {
+	for _, name := range []string{"", "local", "Local"} {
+		loc, err := LoadTimezone(name)
+		require.NoError(t, err)
+		assert.Equal(t, time.Local, loc, name)
+	}
+
+	loc, err := LoadTimezone("utc")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	loc, err = LoadTimezone("Europe/London")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/London", loc.String())
+
+	_, err = LoadTimezone("Mars/Olympus_Mons")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"Mars/Olympus_Mons"`)
+}
+
+// TestFormatTime tests showing API timestamps in the display time zone
+func TestFormatTime(t *testing.T) {
+	t.Cleanup(func() { SetTimezone(time.Local) })
+
+	SetTimezone(time.UTC)
+	assert.Equal(t, "2026-07-01 09:30:00 UTC", FormatTime("2026-07-01T09:30:00Z"))
+
+	london, err := LoadTimezone("Europe/London")
+	require.NoError(t, err)
+	SetTimezone(london)
+	assert.Equal(t, "2026-07-01 10:30:00 BST", FormatTime("2026-07-01T09:30:00Z"))
+	assert.Equal(t, "2026-01-01 09:30:00 GMT", FormatTime("2026-01-01T09:30:00Z"))
+	assert.Equal(t, "2026-07-01 10:30:00 BST", FormatTime("2026-07-01T11:30:00+02:00"))
+
+	// Anything else is shown as is
+	assert.Equal(t, "", FormatTime(""))
+	assert.Equal(t, "-", FormatTime("-"))
+	assert.Equal(t, "2026-07-01", FormatTime("2026-07-01"))
+}
@@ -0,0 +1,294 @@
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DNSOptions describes a DNS query
+type DNSOptions struct {
+	Domain     string
+	RecordType string
+	// Nameserver is queried directly when set ("1.1.1.1" or "1.1.1.1:53");
+	// otherwise the system resolver is used
+	Nameserver string
+	Timeout    time.Duration
+}
+
+// Record match states
+const (
+	RecordMatched    = "matched"
+	RecordMissing    = "missing"
+	RecordUnexpected = "unexpected"
+)
+
+// Match modes, controlling how live answers are compared with expected values
+const (
+	// MatchExact requires the live answers to be exactly the expected values
+	MatchExact = "exact"
+	// MatchSubset requires every expected value but allows extra live answers
+	MatchSubset = "subset"
+	// MatchRegex treats expected values as patterns every live answer must
+	// match, each matching at least one answer
+	MatchRegex = "regex"
+)
+
+// MatchModes are the accepted match modes, the default first
+var MatchModes = []string{MatchExact, MatchSubset, MatchRegex}
+
+// RecordExtra marks a live value that isn't expected but is allowed, in
+// subset mode
+const RecordExtra = "extra"
+
+// RecordMatch compares one value between the expected and live answers
+type RecordMatch struct {
+	Value  string `json:"value"`
+	Status string `json:"status"`
+}
+
+// LookupDNS resolves a record and returns its values normalized the way
+// DNS monitors store them: hostnames without the trailing dot, MX records
+// as just the mail host, TXT records as their joined strings, and SRV
+// records as "priority weight port target". PTR lookups take an IP address
+// or its in-addr.arpa/ip6.arpa name. SOA and CAA records can't be resolved
+// locally.
+func LookupDNS(opts DNSOptions) ([]string, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resolver := net.DefaultResolver
+	if opts.Nameserver != "" {
+		addr := nameserverAddr(opts.Nameserver)
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		}
+	}
+
+	var values []string
+	switch strings.ToUpper(opts.RecordType) {
+	case "A", "AAAA":
+		network := "ip4"
+		if strings.EqualFold(opts.RecordType, "AAAA") {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			values = append(values, ip.String())
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, cname)
+	case "MX":
+		records, err := resolver.LookupMX(ctx, opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range records {
+			values = append(values, mx.Host)
+		}
+	case "NS":
+		records, err := resolver.LookupNS(ctx, opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range records {
+			values = append(values, ns.Host)
+		}
+	case "TXT":
+		records, err := resolver.LookupTXT(ctx, opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, records...)
+	case "SRV":
+		_, records, err := resolver.LookupSRV(ctx, "", "", opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, srv := range records {
+			values = append(values, fmt.Sprintf("%d %d %d %s", srv.Priority, srv.Weight, srv.Port, strings.TrimSuffix(srv.Target, ".")))
+		}
+	case "PTR":
+		addr, err := ptrAddr(opts.Domain)
+		if err != nil {
+			return nil, err
+		}
+		names, err := resolver.LookupAddr(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, names...)
+	case "SOA", "CAA":
+		return nil, fmt.Errorf("%s records can't be resolved locally", strings.ToUpper(opts.RecordType))
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", opts.RecordType)
+	}
+
+	for i, value := range values {
+		values[i] = strings.TrimSuffix(value, ".")
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+// nameserverAddr adds the default DNS port to a nameserver without one
+func nameserverAddr(nameserver string) string {
+	if _, _, err := net.SplitHostPort(nameserver); err == nil {
+		return nameserver
+	}
+	return net.JoinHostPort(strings.Trim(nameserver, "[]"), "53")
+}
+
+// ptrAddr returns the IP address a PTR lookup is for, given the address
+// itself or its reverse name, e.g. 4.3.2.1.in-addr.arpa for 1.2.3.4
+func ptrAddr(domain string) (string, error) {
+	name := strings.ToLower(strings.TrimSuffix(domain, "."))
+	if ip := net.ParseIP(name); ip != nil {
+		return ip.String(), nil
+	}
+
+	var labels []string
+	var sep string
+	switch {
+	case strings.HasSuffix(name, ".in-addr.arpa"):
+		labels = strings.Split(strings.TrimSuffix(name, ".in-addr.arpa"), ".")
+		sep = "."
+	case strings.HasSuffix(name, ".ip6.arpa"):
+		labels = strings.Split(strings.TrimSuffix(name, ".ip6.arpa"), ".")
+	}
+	slices.Reverse(labels)
+
+	var addr strings.Builder
+	for i, label := range labels {
+		if sep == "" && i > 0 && i%4 == 0 {
+			addr.WriteString(":")
+		} else if i > 0 {
+			addr.WriteString(sep)
+		}
+		addr.WriteString(label)
+	}
+	if ip := net.ParseIP(addr.String()); ip != nil && len(labels) > 0 {
+		return ip.String(), nil
+	}
+	return "", fmt.Errorf("PTR lookups need an IP address or its reverse name, got %q", domain)
+}
+
+// CompareRecords matches live values against expected ones, ignoring case
+// and trailing dots. Expected values come first, in order, followed by
+// unexpected live values.
+func CompareRecords(expected, actual []string) []RecordMatch {
+	normalize := func(v string) string {
+		return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(v), "."))
+	}
+
+	live := map[string]bool{}
+	for _, value := range actual {
+		live[normalize(value)] = true
+	}
+
+	var matches []RecordMatch
+	wanted := map[string]bool{}
+	for _, value := range expected {
+		wanted[normalize(value)] = true
+		status := RecordMissing
+		if live[normalize(value)] {
+			status = RecordMatched
+		}
+		matches = append(matches, RecordMatch{Value: value, Status: status})
+	}
+	for _, value := range actual {
+		if !wanted[normalize(value)] {
+			matches = append(matches, RecordMatch{Value: value, Status: RecordUnexpected})
+		}
+	}
+	return matches
+}
+
+// CompareRecordsMode matches live values against expected ones using a
+// match mode. Exact mode is CompareRecords; subset mode reports extra live
+// values as RecordExtra rather than RecordUnexpected; regex mode treats
+// each expected value as a case-insensitive pattern for whole values.
+func CompareRecordsMode(expected, actual []string, mode string) ([]RecordMatch, error) {
+	switch mode {
+	case "", MatchExact:
+		return CompareRecords(expected, actual), nil
+	case MatchSubset:
+		matches := CompareRecords(expected, actual)
+		for i := range matches {
+			if matches[i].Status == RecordUnexpected {
+				matches[i].Status = RecordExtra
+			}
+		}
+		return matches, nil
+	case MatchRegex:
+		patterns, err := CompileRecordPatterns(expected)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(actual))
+		for i, value := range actual {
+			values[i] = strings.TrimSuffix(strings.TrimSpace(value), ".")
+		}
+
+		var matches []RecordMatch
+		for i, re := range patterns {
+			status := RecordMissing
+			if slices.ContainsFunc(values, re.MatchString) {
+				status = RecordMatched
+			}
+			matches = append(matches, RecordMatch{Value: expected[i], Status: status})
+		}
+		for i, value := range values {
+			if !slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool { return re.MatchString(value) }) {
+				matches = append(matches, RecordMatch{Value: actual[i], Status: RecordUnexpected})
+			}
+		}
+		return matches, nil
+	}
+	return nil, fmt.Errorf("unknown match mode %q", mode)
+}
+
+// CompileRecordPatterns compiles regex-mode expected values, each anchored
+// to match a whole value and ignoring case
+func CompileRecordPatterns(expected []string) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, len(expected))
+	for i, value := range expected {
+		re, err := regexp.Compile("(?i)^(?:" + value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", value, err)
+		}
+		patterns[i] = re
+	}
+	return patterns, nil
+}
+
+// RecordsMatch reports whether a comparison passed: nothing expected is
+// missing and nothing unexpected was found
+func RecordsMatch(matches []RecordMatch) bool {
+	for _, m := range matches {
+		if m.Status == RecordMissing || m.Status == RecordUnexpected {
+			return false
+		}
+	}
+	return true
+}
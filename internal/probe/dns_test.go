@@ -0,0 +1,104 @@
+package probe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLookupDNS_Localhost tests resolving through the system resolver
+func TestLookupDNS_Localhost(t *testing.T) {
+	values, err := LookupDNS(DNSOptions{Domain: "localhost", RecordType: "a"})
+	require.NoError(t, err)
+	assert.Contains(t, values, "127.0.0.1")
+}
+
+// TestLookupDNS_UnsupportedType tests rejecting record types monitors don't support
+func TestLookupDNS_UnsupportedType(t *testing.T) {
+	_, err := LookupDNS(DNSOptions{Domain: "example.com", RecordType: "HINFO"})
+	assert.ErrorContains(t, err, "unsupported record type")
+
+	_, err = LookupDNS(DNSOptions{Domain: "example.com", RecordType: "caa"})
+	assert.ErrorContains(t, err, "CAA records can't be resolved locally")
+}
+
+// TestPtrAddr tests accepting an IP address or its reverse name for PTR lookups
+func TestPtrAddr(t *testing.T) {
+	for domain, want := range map[string]string{
+		"192.0.2.10":               "192.0.2.10",
+		"10.2.0.192.in-addr.arpa":  "192.0.2.10",
+		"10.2.0.192.IN-ADDR.ARPA.": "192.0.2.10",
+		"2001:db8::1":              "2001:db8::1",
+		"1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa": "2001:db8::1",
+	} {
+		addr, err := ptrAddr(domain)
+		require.NoError(t, err, domain)
+		assert.Equal(t, want, addr, domain)
+	}
+
+	for _, domain := range []string{"example.com", "1.2.in-addr.arpa", "in-addr.arpa"} {
+		_, err := ptrAddr(domain)
+		assert.Error(t, err, domain)
+	}
+}
+
+// TestNameserverAddr tests defaulting the DNS port
+func TestNameserverAddr(t *testing.T) {
+	assert.Equal(t, "1.1.1.1:53", nameserverAddr("1.1.1.1"))
+	assert.Equal(t, "1.1.1.1:5353", nameserverAddr("1.1.1.1:5353"))
+	assert.Equal(t, "[2606:4700::1111]:53", nameserverAddr("2606:4700::1111"))
+	assert.Equal(t, "[2606:4700::1111]:53", nameserverAddr("[2606:4700::1111]"))
+	assert.Equal(t, "ns1.example.com:53", nameserverAddr("ns1.example.com"))
+}
+
+// TestCompareRecords tests matching live answers against expected values
+func TestCompareRecords(t *testing.T) {
+	matches := CompareRecords(
+		[]string{"Mail.example.com.", "mail2.example.com"},
+		[]string{"mail.example.com", "mail3.example.com"},
+	)
+
+	assert.Equal(t, []RecordMatch{
+		{Value: "Mail.example.com.", Status: RecordMatched},
+		{Value: "mail2.example.com", Status: RecordMissing},
+		{Value: "mail3.example.com", Status: RecordUnexpected},
+	}, matches)
+
+	assert.Empty(t, CompareRecords(nil, nil))
+}
+
+// TestCompareRecordsMode tests each match mode
+func TestCompareRecordsMode(t *testing.T) {
+	actual := []string{"192.0.2.10", "192.0.2.11."}
+
+	matches, err := CompareRecordsMode([]string{"192.0.2.10"}, actual, MatchExact)
+	require.NoError(t, err)
+	assert.False(t, RecordsMatch(matches))
+
+	matches, err = CompareRecordsMode([]string{"192.0.2.10"}, actual, MatchSubset)
+	require.NoError(t, err)
+	assert.Equal(t, []RecordMatch{
+		{Value: "192.0.2.10", Status: RecordMatched},
+		{Value: "192.0.2.11.", Status: RecordExtra},
+	}, matches)
+	assert.True(t, RecordsMatch(matches))
+
+	matches, err = CompareRecordsMode([]string{`192\.0\.2\.\d+`}, actual, MatchRegex)
+	require.NoError(t, err)
+	assert.True(t, RecordsMatch(matches))
+
+	matches, err = CompareRecordsMode([]string{`192\.0\.2\.1\d`, "v=spf1 .*"}, []string{"192.0.2.10", "192.0.2.5"}, MatchRegex)
+	require.NoError(t, err)
+	assert.Equal(t, []RecordMatch{
+		{Value: `192\.0\.2\.1\d`, Status: RecordMatched},
+		{Value: "v=spf1 .*", Status: RecordMissing},
+		{Value: "192.0.2.5", Status: RecordUnexpected},
+	}, matches)
+
+	_, err = CompareRecordsMode([]string{"("}, actual, MatchRegex)
+	assert.ErrorContains(t, err, `invalid pattern "("`)
+
+	_, err = CompareRecordsMode(nil, actual, "fuzzy")
+	assert.ErrorContains(t, err, "unknown match mode")
+}
@@ -0,0 +1,314 @@
+// Package probe runs monitor checks locally, from the machine the CLI runs on
+package probe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxBodySize caps how much of a response body is read for validation
+const maxBodySize = 10 << 20
+
+// HTTPCheck describes an HTTP request and what a passing response looks like
+type HTTPCheck struct {
+	URL     string
+	Method  string
+	Headers map[string]string
+	Body    string
+	Timeout time.Duration
+	// ExpectedStatusCodes defaults to any 2xx status when empty
+	ExpectedStatusCodes []int
+	// MaxResponseTime fails a response slower than it, when set
+	MaxResponseTime time.Duration
+	// ValidatePaths are JSON paths ("data.status", "$.items[0].id") that
+	// must be present and non-null in the response body
+	ValidatePaths []string
+	// Contains are strings the response body must include
+	Contains []string
+	// Regexes are regular expressions the response body must match
+	Regexes []string
+	// PathValues are JSON paths whose values must equal the given values
+	PathValues []PathValue
+}
+
+// PathValue is a JSON path and the value it must have, compared as a string
+// for JSON strings and as encoded JSON ("true", "200", "null") otherwise
+type PathValue struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// Step is one part of a check and whether it passed
+type Step struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// HTTPResult is the outcome of running an HTTPCheck
+type HTTPResult struct {
+	URL            string  `json:"url"`
+	Method         string  `json:"method"`
+	StatusCode     int     `json:"status_code,omitempty"`
+	ResponseTimeMs float64 `json:"response_time_ms"`
+	Steps          []Step  `json:"steps"`
+	Passed         bool    `json:"passed"`
+}
+
+// RunHTTP performs the check with client, which may be nil to use a default
+// client. Failures are reported as steps rather than errors; an error means
+// the check could not be attempted at all.
+func RunHTTP(client *http.Client, check HTTPCheck) (*HTTPResult, error) {
+	if client == nil {
+		client = &http.Client{}
+	}
+	method := strings.ToUpper(check.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if check.Body != "" {
+		body = strings.NewReader(check.Body)
+	}
+	req, err := http.NewRequest(method, check.URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	for name, value := range check.Headers {
+		req.Header.Set(name, value)
+	}
+	if check.Body != "" && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	timed := *client
+	if check.Timeout > 0 {
+		timed.Timeout = check.Timeout
+	}
+
+	result := &HTTPResult{URL: check.URL, Method: method}
+	start := time.Now()
+	resp, err := timed.Do(req)
+	result.ResponseTimeMs = float64(time.Since(start).Microseconds()) / 1000
+	if err != nil {
+		result.add(Step{Name: "Request", Detail: err.Error()})
+		return result, nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	result.ResponseTimeMs = float64(time.Since(start).Microseconds()) / 1000
+	if err != nil {
+		result.add(Step{Name: "Request", Detail: fmt.Sprintf("reading response: %v", err)})
+		return result, nil
+	}
+	result.StatusCode = resp.StatusCode
+
+	detail := fmt.Sprintf("%s in %.0fms", resp.Proto, result.ResponseTimeMs)
+	if check.Timeout > 0 {
+		detail += fmt.Sprintf(" (timeout %s)", check.Timeout)
+	}
+	result.add(Step{Name: "Request", Passed: true, Detail: detail})
+	result.add(statusStep(resp.StatusCode, check.ExpectedStatusCodes))
+	if check.MaxResponseTime > 0 {
+		result.add(responseTimeStep(result.ResponseTimeMs, check.MaxResponseTime))
+	}
+
+	for _, want := range check.Contains {
+		result.add(containsStep(respBody, want))
+	}
+	for _, pattern := range check.Regexes {
+		result.add(regexStep(respBody, pattern))
+	}
+
+	if len(check.ValidatePaths) > 0 || len(check.PathValues) > 0 {
+		var doc interface{}
+		if err := json.Unmarshal(respBody, &doc); err != nil {
+			result.add(Step{Name: "JSON body", Detail: fmt.Sprintf("response is not valid JSON: %v", err)})
+			return result, nil
+		}
+		for _, path := range check.ValidatePaths {
+			result.add(pathStep(doc, path))
+		}
+		for _, pv := range check.PathValues {
+			result.add(pathValueStep(doc, pv))
+		}
+	}
+
+	return result, nil
+}
+
+// add records a step; the result passes only while every step passes
+func (r *HTTPResult) add(step Step) {
+	if len(r.Steps) == 0 {
+		r.Passed = true
+	}
+	r.Steps = append(r.Steps, step)
+	r.Passed = r.Passed && step.Passed
+}
+
+// statusStep checks a status code against the expected codes
+func statusStep(code int, expected []int) Step {
+	step := Step{Name: "Status code"}
+	if len(expected) == 0 {
+		step.Passed = code >= 200 && code < 300
+		step.Detail = fmt.Sprintf("%d (expected 2xx)", code)
+		return step
+	}
+
+	codes := make([]string, len(expected))
+	for i, want := range expected {
+		codes[i] = strconv.Itoa(want)
+		if code == want {
+			step.Passed = true
+		}
+	}
+	step.Detail = fmt.Sprintf("%d (expected %s)", code, strings.Join(codes, ", "))
+	return step
+}
+
+// responseTimeStep checks a response time against the slowest allowed
+func responseTimeStep(ms float64, limit time.Duration) Step {
+	return Step{
+		Name:   "Response time",
+		Passed: ms <= float64(limit.Milliseconds()),
+		Detail: fmt.Sprintf("%.0fms (limit %s)", ms, limit),
+	}
+}
+
+// pathStep checks that a JSON path is present and non-null
+func pathStep(doc interface{}, path string) Step {
+	step := Step{Name: "JSON path " + path}
+	value, err := lookupPath(doc, path)
+	switch {
+	case err != nil:
+		step.Detail = err.Error()
+	case value == nil:
+		step.Detail = "is null"
+	default:
+		step.Passed = true
+		step.Detail = summarize(value)
+	}
+	return step
+}
+
+// containsStep checks that the body includes a string
+func containsStep(body []byte, want string) Step {
+	step := Step{Name: fmt.Sprintf("Body contains %q", want)}
+	if strings.Contains(string(body), want) {
+		step.Passed = true
+		step.Detail = "found"
+	} else {
+		step.Detail = "not found"
+	}
+	return step
+}
+
+// regexStep checks that the body matches a regular expression
+func regexStep(body []byte, pattern string) Step {
+	step := Step{Name: fmt.Sprintf("Body matches /%s/", pattern)}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		step.Detail = fmt.Sprintf("invalid regex: %v", err)
+		return step
+	}
+	if match := re.Find(body); match != nil {
+		step.Passed = true
+		step.Detail = summarize(string(match))
+	} else {
+		step.Detail = "no match"
+	}
+	return step
+}
+
+// pathValueStep checks that a JSON path has the expected value
+func pathValueStep(doc interface{}, pv PathValue) Step {
+	step := Step{Name: fmt.Sprintf("JSON path %s = %s", pv.Path, pv.Value)}
+	value, err := lookupPath(doc, pv.Path)
+	if err != nil {
+		step.Detail = err.Error()
+		return step
+	}
+	actual, ok := value.(string)
+	if !ok {
+		encoded, _ := json.Marshal(value)
+		actual = string(encoded)
+	}
+	step.Passed = actual == pv.Value
+	step.Detail = summarize(value)
+	return step
+}
+
+// lookupPath walks a decoded JSON document. Paths use dots for object keys
+// and either [n] or .n for array indexes, with an optional leading "$".
+func lookupPath(doc interface{}, path string) (interface{}, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	path = strings.ReplaceAll(strings.ReplaceAll(path, "[", "."), "]", "")
+
+	current := doc
+	if path == "" {
+		return current, nil
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[key]
+			if !ok {
+				return nil, fmt.Errorf("missing key %q", key)
+			}
+			current = value
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an array index", key)
+			}
+			if i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("index %d out of range (length %d)", i, len(node))
+			}
+			current = node[i]
+		default:
+			return nil, fmt.Errorf("cannot look up %q in a %s", key, jsonType(current))
+		}
+	}
+	return current, nil
+}
+
+// summarize renders a JSON value briefly for step details
+func summarize(value interface{}) string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return fmt.Sprintf("object with %d keys", len(v))
+	case []interface{}:
+		return fmt.Sprintf("array of %d", len(v))
+	}
+	encoded, _ := json.Marshal(value)
+	s := string(encoded)
+	if len(s) > 60 {
+		s = s[:57] + "..."
+	}
+	return s
+}
+
+// jsonType names the JSON type of a decoded value
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	}
+	return "object"
+}
@@ -0,0 +1,163 @@
+package probe
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunHTTP_Pass tests a check where every step passes
+func TestRunHTTP_Pass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"ping":true}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"status":"ok","items":[{"id":1}]}}`))
+	}))
+	defer server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{
+		URL:                 server.URL,
+		Method:              "post",
+		Headers:             map[string]string{"X-Api-Key": "secret"},
+		Body:                `{"ping":true}`,
+		Timeout:             5 * time.Second,
+		ExpectedStatusCodes: []int{200, 201},
+		ValidatePaths:       []string{"data.status", "$.data.items[0].id", "data.items.0"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.Passed)
+	assert.Equal(t, "POST", result.Method)
+	assert.Equal(t, 201, result.StatusCode)
+	require.Len(t, result.Steps, 5)
+	assert.Equal(t, `"ok"`, result.Steps[2].Detail)
+}
+
+// TestRunHTTP_Failures tests that failing steps are reported, not returned as errors
+func TestRunHTTP_Failures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"data":{"status":null}}`))
+	}))
+	defer server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{
+		URL:           server.URL,
+		ValidatePaths: []string{"data.status", "data.missing"},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, result.Passed)
+	require.Len(t, result.Steps, 4)
+	assert.True(t, result.Steps[0].Passed)
+	assert.False(t, result.Steps[1].Passed)
+	assert.Equal(t, "503 (expected 2xx)", result.Steps[1].Detail)
+	assert.Equal(t, "is null", result.Steps[2].Detail)
+	assert.Contains(t, result.Steps[3].Detail, `missing key "missing"`)
+}
+
+// TestRunHTTP_Assertions tests body content assertions
+func TestRunHTTP_Assertions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"ok","version":"1.4.2","healthy":true,"count":3}`))
+	}))
+	defer server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{
+		URL:        server.URL,
+		Contains:   []string{`"status":"ok"`, "degraded"},
+		Regexes:    []string{`"version":"1\.\d+`, "("},
+		PathValues: []PathValue{{Path: "status", Value: "ok"}, {Path: "healthy", Value: "true"}, {Path: "count", Value: "4"}},
+	})
+	require.NoError(t, err)
+
+	assert.False(t, result.Passed)
+	require.Len(t, result.Steps, 9)
+	assert.True(t, result.Steps[2].Passed)
+	assert.Equal(t, "not found", result.Steps[3].Detail)
+	assert.True(t, result.Steps[4].Passed)
+	assert.Contains(t, result.Steps[5].Detail, "invalid regex")
+	assert.Equal(t, "JSON path status = ok", result.Steps[6].Name)
+	assert.True(t, result.Steps[6].Passed)
+	assert.True(t, result.Steps[7].Passed)
+	assert.False(t, result.Steps[8].Passed)
+	assert.Equal(t, "3", result.Steps[8].Detail)
+}
+
+// TestRunHTTP_MaxResponseTime tests failing a slow response
+func TestRunHTTP_MaxResponseTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(30 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{URL: server.URL, MaxResponseTime: 10 * time.Millisecond})
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	require.Len(t, result.Steps, 3)
+	assert.Equal(t, "Response time", result.Steps[2].Name)
+	assert.False(t, result.Steps[2].Passed)
+	assert.Contains(t, result.Steps[2].Detail, "(limit 10ms)")
+
+	result, err = RunHTTP(nil, HTTPCheck{URL: server.URL, MaxResponseTime: 5 * time.Second})
+	require.NoError(t, err)
+	assert.True(t, result.Passed)
+}
+
+// TestRunHTTP_Unreachable tests a request that never gets a response
+func TestRunHTTP_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{URL: url})
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, "Request", result.Steps[0].Name)
+}
+
+// TestRunHTTP_InvalidJSON tests path validation against a non-JSON body
+func TestRunHTTP_InvalidJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	result, err := RunHTTP(nil, HTTPCheck{URL: server.URL, ValidatePaths: []string{"status"}})
+	require.NoError(t, err)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "JSON body", result.Steps[len(result.Steps)-1].Name)
+}
+
+// TestLookupPath tests JSON path lookups
+func TestLookupPath(t *testing.T) {
+	doc := map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"id": 1.0}},
+		"name":  "x",
+	}
+
+	value, err := lookupPath(doc, "items[0].id")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, value)
+
+	_, err = lookupPath(doc, "items[3]")
+	assert.ErrorContains(t, err, "out of range")
+
+	_, err = lookupPath(doc, "name.first")
+	assert.ErrorContains(t, err, "in a string")
+
+	value, err = lookupPath(doc, "$")
+	require.NoError(t, err)
+	assert.Equal(t, doc, value)
+}
@@ -0,0 +1,144 @@
+package probe
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"time"
+)
+
+// TLSOptions describes a TLS endpoint to inspect
+type TLSOptions struct {
+	Host string
+	Port int
+	// ServerName is sent for SNI and verified against; defaults to Host
+	ServerName string
+	Timeout    time.Duration
+	// Roots verifies the chain; nil uses the system roots
+	Roots *x509.CertPool
+}
+
+// CertInfo describes one certificate in a chain
+type CertInfo struct {
+	Subject            string    `json:"subject"`
+	Issuer             string    `json:"issuer"`
+	SANs               []string  `json:"sans,omitempty"`
+	NotBefore          time.Time `json:"not_before"`
+	NotAfter           time.Time `json:"not_after"`
+	DaysLeft           int       `json:"days_left"`
+	SignatureAlgorithm string    `json:"signature_algorithm"`
+	PublicKey          string    `json:"public_key"`
+	SerialNumber       string    `json:"serial_number"`
+	IsCA               bool      `json:"is_ca"`
+}
+
+// TLSResult is the outcome of inspecting a TLS endpoint
+type TLSResult struct {
+	Host        string     `json:"host"`
+	Port        int        `json:"port"`
+	TLSVersion  string     `json:"tls_version"`
+	CipherSuite string     `json:"cipher_suite"`
+	Verified    bool       `json:"verified"`
+	VerifyError string     `json:"verify_error,omitempty"`
+	Chain       []CertInfo `json:"chain"`
+}
+
+// InspectTLS connects to a TLS endpoint and describes the certificate chain
+// it presents. The chain is returned even when it does not verify, with
+// the reason in VerifyError.
+func InspectTLS(opts TLSOptions) (*TLSResult, error) {
+	serverName := opts.ServerName
+	if serverName == "" {
+		serverName = opts.Host
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	addr := net.JoinHostPort(opts.Host, strconv.Itoa(opts.Port))
+	// Verification is done below so that invalid chains can still be shown
+	conn, err := tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("TLS connection to %s failed: %w", addr, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	state := conn.ConnectionState()
+	result := &TLSResult{
+		Host:        opts.Host,
+		Port:        opts.Port,
+		TLSVersion:  tls.VersionName(state.Version),
+		CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+	}
+
+	now := time.Now()
+	for _, cert := range state.PeerCertificates {
+		result.Chain = append(result.Chain, describeCert(cert, now))
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		result.VerifyError = "no certificates presented"
+		return result, nil
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err = state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         opts.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	if err != nil {
+		result.VerifyError = err.Error()
+	} else {
+		result.Verified = true
+	}
+	return result, nil
+}
+
+// describeCert summarises a certificate
+func describeCert(cert *x509.Certificate, now time.Time) CertInfo {
+	sans := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	return CertInfo{
+		Subject:            cert.Subject.String(),
+		Issuer:             cert.Issuer.String(),
+		SANs:               sans,
+		NotBefore:          cert.NotBefore,
+		NotAfter:           cert.NotAfter,
+		DaysLeft:           int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24)),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		PublicKey:          describeKey(cert.PublicKey),
+		SerialNumber:       fmt.Sprintf("%X", cert.SerialNumber),
+		IsCA:               cert.IsCA,
+	}
+}
+
+// describeKey names a public key's algorithm and size
+func describeKey(key interface{}) string {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", k.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA " + k.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	}
+	return "unknown"
+}
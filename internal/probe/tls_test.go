@@ -0,0 +1,67 @@
+package probe
+
+import (
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tlsServer(t *testing.T) (*httptest.Server, string, int) {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return server, host, port
+}
+
+// TestInspectTLS_Verified tests inspecting a chain that verifies against the given roots
+func TestInspectTLS_Verified(t *testing.T) {
+	server, host, port := tlsServer(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	result, err := InspectTLS(TLSOptions{Host: host, Port: port, ServerName: "example.com", Roots: roots})
+	require.NoError(t, err)
+
+	assert.True(t, result.Verified, result.VerifyError)
+	assert.NotEmpty(t, result.TLSVersion)
+	require.NotEmpty(t, result.Chain)
+
+	leaf := result.Chain[0]
+	assert.Contains(t, leaf.SANs, "example.com")
+	assert.Contains(t, leaf.SANs, "127.0.0.1")
+	assert.Greater(t, leaf.DaysLeft, 0)
+	assert.NotEmpty(t, leaf.SignatureAlgorithm)
+	assert.NotEqual(t, "unknown", leaf.PublicKey)
+}
+
+// TestInspectTLS_Unverified tests that an untrusted chain is still described
+func TestInspectTLS_Unverified(t *testing.T) {
+	_, host, port := tlsServer(t)
+
+	result, err := InspectTLS(TLSOptions{Host: host, Port: port, ServerName: "example.com", Roots: x509.NewCertPool()})
+	require.NoError(t, err)
+
+	assert.False(t, result.Verified)
+	assert.NotEmpty(t, result.VerifyError)
+	assert.NotEmpty(t, result.Chain)
+}
+
+// TestInspectTLS_ConnectionError tests a port that refuses connections
+func TestInspectTLS_ConnectionError(t *testing.T) {
+	server, host, port := tlsServer(t)
+	server.Close()
+
+	_, err := InspectTLS(TLSOptions{Host: host, Port: port})
+	assert.Error(t, err)
+}
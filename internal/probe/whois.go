@@ -0,0 +1,239 @@
+package probe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ianaWhois knows which WHOIS server is authoritative for each TLD
+const ianaWhois = "whois.iana.org"
+
+// maxWhoisSize caps how much of a WHOIS response is read
+const maxWhoisSize = 1 << 20
+
+// WhoisOptions describes a WHOIS query
+type WhoisOptions struct {
+	Domain string
+	// Server is queried instead of looking up the TLD's server at IANA
+	// ("whois.verisign-grs.com" or "whois.verisign-grs.com:43")
+	Server  string
+	Timeout time.Duration
+}
+
+// WhoisResult is the parsed answer to a WHOIS query
+type WhoisResult struct {
+	Domain      string     `json:"domain"`
+	Server      string     `json:"server"`
+	Registrar   string     `json:"registrar,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Nameservers []string   `json:"nameservers"`
+	Statuses    []string   `json:"statuses"`
+	// Locked is true when the registrar blocks transfers
+	Locked bool   `json:"locked"`
+	Raw    string `json:"-"`
+}
+
+// LookupWhois queries the WHOIS server for a domain's TLD, following the
+// referral to the registrar's server when the registry gives one, since
+// thin registries only hold part of the record
+func LookupWhois(opts WhoisOptions) (*WhoisResult, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	domain := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(opts.Domain)), ".")
+
+	server := opts.Server
+	if server == "" {
+		tld := domain[strings.LastIndex(domain, ".")+1:]
+		answer, err := queryWhois(ianaWhois, tld, timeout)
+		if err != nil {
+			return nil, err
+		}
+		server = whoisField(answer, "refer", "whois")
+		if server == "" {
+			return nil, fmt.Errorf("no WHOIS server known for .%s", tld)
+		}
+	}
+
+	answer, err := queryWhois(server, domain, timeout)
+	if err != nil {
+		return nil, err
+	}
+	result := ParseWhois(answer)
+	result.Domain = domain
+	result.Server = server
+
+	// Fill in from the registrar's server, keeping the registry's answer
+	// when the referral fails
+	referral := whoisField(answer, "registrar whois server")
+	if referral != "" && !strings.EqualFold(referral, server) {
+		if answer, err := queryWhois(referral, domain, timeout); err == nil {
+			merged := ParseWhois(answer)
+			merged.Domain = domain
+			merged.Server = referral
+			if merged.Registrar == "" {
+				merged.Registrar = result.Registrar
+			}
+			if merged.ExpiresAt == nil {
+				merged.ExpiresAt = result.ExpiresAt
+			}
+			if len(merged.Nameservers) == 0 {
+				merged.Nameservers = result.Nameservers
+			}
+			if len(merged.Statuses) == 0 {
+				merged.Statuses = result.Statuses
+				merged.Locked = result.Locked
+			}
+			result = merged
+		}
+	}
+	return result, nil
+}
+
+// queryWhois sends a query to a WHOIS server and returns its answer
+func queryWhois(server, query string, timeout time.Duration) (string, error) {
+	addr := server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		addr = net.JoinHostPort(server, "43")
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return "", fmt.Errorf("WHOIS query to %s failed: %w", server, err)
+	}
+	defer func() { _ = conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	if _, err := fmt.Fprintf(conn, "%s\r\n", query); err != nil {
+		return "", fmt.Errorf("WHOIS query to %s failed: %w", server, err)
+	}
+	answer, err := io.ReadAll(io.LimitReader(conn, maxWhoisSize))
+	if err != nil {
+		return "", fmt.Errorf("WHOIS query to %s failed: %w", server, err)
+	}
+	return string(answer), nil
+}
+
+// whoisExpiryFields are the labels registries use for the expiration date
+var whoisExpiryFields = []string{
+	"registry expiry date",
+	"registrar registration expiration date",
+	"expiration date",
+	"expiry date",
+	"expires",
+	"paid-till",
+}
+
+// whoisDateLayouts are the date formats seen in WHOIS answers
+var whoisDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006.01.02",
+	"02-Jan-2006",
+	"2006/01/02",
+}
+
+// ParseWhois extracts the registrar, expiration, nameservers, and statuses
+// from a WHOIS answer. Labels are matched case-insensitively since every
+// registry formats its answers a little differently.
+func ParseWhois(answer string) *WhoisResult {
+	result := &WhoisResult{Raw: answer, Nameservers: []string{}, Statuses: []string{}}
+
+	result.Registrar = whoisField(answer, "registrar", "sponsoring registrar", "registrar name")
+	for _, field := range whoisExpiryFields {
+		if expires := parseWhoisDate(whoisField(answer, field)); expires != nil {
+			result.ExpiresAt = expires
+			break
+		}
+	}
+
+	seenNS := map[string]bool{}
+	seenStatus := map[string]bool{}
+	for _, line := range whoisLines(answer) {
+		switch line.key {
+		case "name server", "nserver", "nameserver":
+			ns := strings.ToLower(strings.TrimSuffix(strings.Fields(line.value)[0], "."))
+			if !seenNS[ns] {
+				seenNS[ns] = true
+				result.Nameservers = append(result.Nameservers, ns)
+			}
+		case "domain status", "status":
+			// Statuses often carry an ICANN URL after the code
+			status := strings.Fields(line.value)[0]
+			if !seenStatus[status] {
+				seenStatus[status] = true
+				result.Statuses = append(result.Statuses, status)
+			}
+			if strings.Contains(strings.ToLower(status), "transferprohibited") {
+				result.Locked = true
+			}
+		}
+	}
+	sort.Strings(result.Nameservers)
+	return result
+}
+
+// whoisLine is one "Key: value" line of a WHOIS answer
+type whoisLine struct {
+	key   string
+	value string
+}
+
+// whoisLines splits a WHOIS answer into key/value lines, skipping comments
+// and lines without a value
+func whoisLines(answer string) []whoisLine {
+	var lines []whoisLine
+	scanner := bufio.NewScanner(strings.NewReader(answer))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "%") || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ">>>") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			continue
+		}
+		lines = append(lines, whoisLine{key: strings.ToLower(strings.TrimSpace(key)), value: value})
+	}
+	return lines
+}
+
+// whoisField returns the first value for any of the given labels
+func whoisField(answer string, keys ...string) string {
+	lines := whoisLines(answer)
+	for _, key := range keys {
+		for _, line := range lines {
+			if line.key == key {
+				return line.value
+			}
+		}
+	}
+	return ""
+}
+
+// parseWhoisDate parses a WHOIS date, returning nil when it isn't one
+func parseWhoisDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range whoisDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			t = t.UTC()
+			return &t
+		}
+	}
+	// Some registries append a time zone name or other text
+	if fields := strings.Fields(value); len(fields) > 1 {
+		return parseWhoisDate(fields[0])
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package probe
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const registryAnswer = `   Domain Name: EXAMPLE.COM
+   Registry Domain ID: 2336799_DOMAIN_COM-VRSN
+   Registrar WHOIS Server: whois.example-registrar.com
+   Registrar: Example Registrar, Inc.
+   Registry Expiry Date: 2027-08-13T04:00:00Z
+   Domain Status: clientDeleteProhibited https://icann.org/epp#clientDeleteProhibited
+   Domain Status: clientTransferProhibited https://icann.org/epp#clientTransferProhibited
+   Name Server: B.IANA-SERVERS.NET
+   Name Server: A.IANA-SERVERS.NET
+>>> Last update of whois database: 2026-10-15T10:00:00Z <<<
+`
+
+// TestParseWhois tests extracting fields from a registry answer
+func TestParseWhois(t *testing.T) {
+	result := ParseWhois(registryAnswer)
+
+	assert.Equal(t, "Example Registrar, Inc.", result.Registrar)
+	require.NotNil(t, result.ExpiresAt)
+	assert.Equal(t, time.Date(2027, 8, 13, 4, 0, 0, 0, time.UTC), *result.ExpiresAt)
+	assert.Equal(t, []string{"a.iana-servers.net", "b.iana-servers.net"}, result.Nameservers)
+	assert.Equal(t, []string{"clientDeleteProhibited", "clientTransferProhibited"}, result.Statuses)
+	assert.True(t, result.Locked)
+}
+
+// TestParseWhois_OtherFormats tests labels and dates used by other registries
+func TestParseWhois_OtherFormats(t *testing.T) {
+	result := ParseWhois(`% Comment line
+domain:       EXAMPLE.RU
+nserver:      ns1.example.ru.
+nserver:      ns2.example.ru.
+state:        REGISTERED, DELEGATED, VERIFIED
+registrar:    RU-CENTER-RU
+paid-till:    2027-03-01T21:00:00Z
+`)
+
+	assert.Equal(t, "RU-CENTER-RU", result.Registrar)
+	require.NotNil(t, result.ExpiresAt)
+	assert.Equal(t, "2027-03-01", result.ExpiresAt.Format("2006-01-02"))
+	assert.Equal(t, []string{"ns1.example.ru", "ns2.example.ru"}, result.Nameservers)
+	assert.False(t, result.Locked)
+
+	assert.Equal(t, "2027-01-02", parseWhoisDate("02-Jan-2027").Format("2006-01-02"))
+	assert.Equal(t, "2027-01-02", parseWhoisDate("2027-01-02 00:00:00 CLST").Format("2006-01-02"))
+	assert.Nil(t, parseWhoisDate("never"))
+}
+
+// TestLookupWhois tests querying a server directly and following the registrar referral
+func TestLookupWhois(t *testing.T) {
+	registrar := whoisServer(t, "Registrar: Example Registrar, Inc.\nRegistrar Registration Expiration Date: 2027-08-13T04:00:00Z\nDomain Status: clientTransferProhibited\n")
+	registry := whoisServer(t, strings.Replace(registryAnswer, "whois.example-registrar.com", registrar, 1))
+
+	result, err := LookupWhois(WhoisOptions{Domain: "Example.com.", Server: registry, Timeout: 2 * time.Second})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", result.Domain)
+	assert.Equal(t, registrar, result.Server)
+	assert.Equal(t, "Example Registrar, Inc.", result.Registrar)
+	assert.Equal(t, []string{"clientTransferProhibited"}, result.Statuses)
+	// Nameservers only came from the registry
+	assert.Equal(t, []string{"a.iana-servers.net", "b.iana-servers.net"}, result.Nameservers)
+}
+
+// whoisServer serves a fixed WHOIS answer and returns its address
+func whoisServer(t *testing.T, answer string) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = bufio.NewReader(conn).ReadString('\n')
+			_, _ = conn.Write([]byte(answer))
+			conn.Close()
+		}
+	}()
+	return ln.Addr().String()
+}
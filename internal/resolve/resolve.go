@@ -0,0 +1,239 @@
+// Package resolve turns the short IDs users type into full resource IDs
+package resolve
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fullIDLength is the length at which an ID is assumed to be complete
+const fullIDLength = 32
+
+// maxSuggestions caps the "did you mean" list
+const maxSuggestions = 3
+
+// Resource is anything that can be looked up by ID prefix
+type Resource interface {
+	ID() string
+	Name() string
+}
+
+// NotFoundError reports that no resource has the given ID prefix
+type NotFoundError struct {
+	Noun        string
+	Query       string
+	Suggestions []string
+}
+
+func (e *NotFoundError) Error() string {
+	msg := fmt.Sprintf("no %s found with ID prefix '%s'", e.Noun, e.Query)
+	if len(e.Suggestions) > 0 {
+		msg += "; did you mean " + joinOr(e.Suggestions) + "?"
+	}
+	return msg
+}
+
+// AmbiguousError reports that several resources share the given ID prefix
+type AmbiguousError struct {
+	Plural  string
+	Query   string
+	Matches []string
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("ambiguous ID prefix '%s' matches multiple %s: %s", e.Query, e.Plural, strings.Join(e.Matches, ", "))
+}
+
+// Resolver resolves IDs for one resource type. The listing is fetched on
+// first use and reused, so resolving several IDs costs a single request.
+type Resolver[R Resource] struct {
+	noun   string
+	plural string
+	list   func() ([]R, error)
+	// cached returns a possibly stale listing to try first, see WithCache
+	cached func() ([]R, bool)
+
+	once  sync.Once
+	items []R
+	err   error
+}
+
+// New returns a resolver that lists resources with list when needed
+func New[R Resource](noun, plural string, list func() ([]R, error)) *Resolver[R] {
+	return &Resolver[R]{noun: noun, plural: plural, list: list}
+}
+
+// WithCache makes Resource try a possibly stale cached listing before the
+// live one. The live listing is only fetched when the cached one is missing
+// or has no unique match, e.g. for a resource created since it was cached.
+// Items always returns the live listing.
+func (r *Resolver[R]) WithCache(cached func() ([]R, bool)) *Resolver[R] {
+	r.cached = cached
+	return r
+}
+
+// ID resolves a full ID or unique ID prefix to a full ID. IDs that look
+// complete are returned without listing anything.
+func (r *Resolver[R]) ID(query string) (string, error) {
+	if len(query) >= fullIDLength {
+		return query, nil
+	}
+	item, err := r.Resource(query)
+	if err != nil {
+		return "", err
+	}
+	return item.ID(), nil
+}
+
+// Resource resolves a full ID or unique ID prefix to its resource
+func (r *Resolver[R]) Resource(query string) (R, error) {
+	if r.cached != nil {
+		if items, ok := r.cached(); ok {
+			if item, err := Match(items, query, r.noun, r.plural); err == nil {
+				return item, nil
+			}
+		}
+	}
+
+	items, err := r.Items()
+	if err != nil {
+		var zero R
+		return zero, err
+	}
+	return Match(items, query, r.noun, r.plural)
+}
+
+// Items returns the cached listing, fetching it on first use
+func (r *Resolver[R]) Items() ([]R, error) {
+	r.once.Do(func() {
+		r.items, r.err = r.list()
+		if r.err != nil {
+			r.err = fmt.Errorf("failed to list %s: %w", r.plural, r.err)
+		}
+	})
+	return r.items, r.err
+}
+
+// Match finds the one item whose ID starts with query. An exact ID match
+// wins over longer IDs sharing the prefix.
+func Match[R Resource](items []R, query, noun, plural string) (R, error) {
+	var matches []R
+	for _, item := range items {
+		if item.ID() == query {
+			return item, nil
+		}
+		if strings.HasPrefix(item.ID(), query) {
+			matches = append(matches, item)
+		}
+	}
+
+	var zero R
+	switch len(matches) {
+	case 0:
+		return zero, &NotFoundError{Noun: noun, Query: query, Suggestions: describeAll(Suggest(items, query))}
+	case 1:
+		return matches[0], nil
+	}
+	return zero, &AmbiguousError{Plural: plural, Query: query, Matches: describeAll(matches)}
+}
+
+// Suggest returns the items that most resemble query: names containing it,
+// then IDs or names within a small edit distance, for typos
+func Suggest[R Resource](items []R, query string) []R {
+	type scored struct {
+		item  R
+		score int
+	}
+	q := strings.ToLower(query)
+	var candidates []scored
+	for _, item := range items {
+		score := -1
+		name := strings.ToLower(item.Name())
+		if q != "" && strings.Contains(name, q) {
+			score = 0
+		} else {
+			prefix := item.ID()
+			if len(prefix) > len(q) {
+				prefix = prefix[:len(q)]
+			}
+			if d := distance(q, strings.ToLower(prefix)); d <= maxTypos(q) {
+				score = d
+			}
+			if d := distance(q, name); d <= maxTypos(q) && (score < 0 || d < score) {
+				score = d
+			}
+		}
+		if score >= 0 {
+			candidates = append(candidates, scored{item, score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score < candidates[j].score })
+	if len(candidates) > maxSuggestions {
+		candidates = candidates[:maxSuggestions]
+	}
+	suggestions := make([]R, len(candidates))
+	for i, c := range candidates {
+		suggestions[i] = c.item
+	}
+	return suggestions
+}
+
+// maxTypos is how many edits a query may be from a suggestion
+func maxTypos(query string) int {
+	if len(query) < 4 {
+		return 1
+	}
+	return 2
+}
+
+// distance is the Levenshtein edit distance between a and b
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+// describe names a resource by short ID and name, e.g. "1a2b3c4d (Backup)"
+func describe(item Resource) string {
+	id := item.ID()
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	if item.Name() == "" {
+		return id
+	}
+	return fmt.Sprintf("%s (%s)", id, item.Name())
+}
+
+func describeAll[R Resource](items []R) []string {
+	described := make([]string, len(items))
+	for i, item := range items {
+		described[i] = describe(item)
+	}
+	return described
+}
+
+// joinOr joins values as "a, b or c"
+func joinOr(values []string) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
@@ -0,0 +1,123 @@
+package resolve
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct{ id, name string }
+
+func (i item) ID() string   { return i.id }
+func (i item) Name() string { return i.name }
+
+var items = []item{
+	{"1a2b3c4d-0000", "Nightly Backup"},
+	{"1a2b9999-0000", "Hourly Sync"},
+	{"7f00aaaa-0000", "Prod API"},
+}
+
+// TestMatch tests resolving ID prefixes
+func TestMatch(t *testing.T) {
+	got, err := Match(items, "7f", "job", "jobs")
+	require.NoError(t, err)
+	assert.Equal(t, "7f00aaaa-0000", got.ID())
+
+	got, err = Match(items, "1a2b3c4d-0000", "job", "jobs")
+	require.NoError(t, err)
+	assert.Equal(t, "Nightly Backup", got.Name())
+
+	_, err = Match(items, "1a2b", "job", "jobs")
+	var ambiguous *AmbiguousError
+	require.True(t, errors.As(err, &ambiguous))
+	assert.Equal(t, "ambiguous ID prefix '1a2b' matches multiple jobs: 1a2b3c4d (Nightly Backup), 1a2b9999 (Hourly Sync)", err.Error())
+}
+
+// TestMatch_Suggestions tests "did you mean" suggestions when nothing matches
+func TestMatch_Suggestions(t *testing.T) {
+	// A typo in the prefix
+	_, err := Match(items, "7f01", "job", "jobs")
+	var notFound *NotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "no job found with ID prefix '7f01'; did you mean 7f00aaaa (Prod API)?", err.Error())
+
+	// A name instead of an ID
+	_, err = Match(items, "backup", "job", "jobs")
+	assert.EqualError(t, err, "no job found with ID prefix 'backup'; did you mean 1a2b3c4d (Nightly Backup)?")
+
+	// Several close IDs
+	_, err = Match(items, "1a2c", "job", "jobs")
+	assert.EqualError(t, err, "no job found with ID prefix '1a2c'; did you mean 1a2b3c4d (Nightly Backup) or 1a2b9999 (Hourly Sync)?")
+
+	// Nothing close
+	_, err = Match(items, "zzzzzz", "job", "jobs")
+	assert.EqualError(t, err, "no job found with ID prefix 'zzzzzz'")
+}
+
+// TestResolver_CachesListing tests that the listing is fetched once
+func TestResolver_CachesListing(t *testing.T) {
+	calls := 0
+	r := New("job", "jobs", func() ([]item, error) {
+		calls++
+		return items, nil
+	})
+
+	id, err := r.ID("7f")
+	require.NoError(t, err)
+	assert.Equal(t, "7f00aaaa-0000", id)
+	_, err = r.ID("1a2b3")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+
+	// Full IDs are used as-is without listing
+	full := "0123456789abcdef0123456789abcdef"
+	id, err = New("job", "jobs", func() ([]item, error) {
+		t.Fatal("listed for a full ID")
+		return nil, nil
+	}).ID(full)
+	require.NoError(t, err)
+	assert.Equal(t, full, id)
+}
+
+// TestResolver_ListError tests that listing failures are wrapped
+func TestResolver_ListError(t *testing.T) {
+	r := New("job", "jobs", func() ([]item, error) {
+		return nil, errors.New("boom")
+	})
+	_, err := r.ID("7f")
+	assert.EqualError(t, err, "failed to list jobs: boom")
+}
+
+// TestDistance tests the edit distance used for suggestions
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, distance("abc", "abc"))
+	assert.Equal(t, 1, distance("abc", "abd"))
+	assert.Equal(t, 1, distance("abc", "ab"))
+	assert.Equal(t, 3, distance("", "abc"))
+	assert.Equal(t, 3, distance("kitten", "sitting"))
+}
+
+// TestResolver_WithCache tests that a cached listing is tried first and the
+// live one fetched when it has no unique match
+func TestResolver_WithCache(t *testing.T) {
+	calls := 0
+	r := New("job", "jobs", func() ([]item, error) {
+		calls++
+		return items, nil
+	}).WithCache(func() ([]item, bool) {
+		return items[:1], true
+	})
+
+	id, err := r.ID("1a2b3")
+	require.NoError(t, err)
+	assert.Equal(t, "1a2b3c4d-0000", id)
+	assert.Zero(t, calls)
+
+	// Not in the cached listing, e.g. created since it was cached
+	id, err = r.ID("7f")
+	require.NoError(t, err)
+	assert.Equal(t, "7f00aaaa-0000", id)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,120 @@
+// Package slack builds Block Kit messages and posts them to Slack incoming
+// webhooks
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxSectionText is the longest text Slack accepts in a section block
+const maxSectionText = 3000
+
+// maxBlocks is the most blocks Slack accepts in one message
+const maxBlocks = 50
+
+// Message is a Block Kit message. Text is the fallback shown in
+// notifications and by clients that can't render blocks.
+type Message struct {
+	Text   string  `json:"text"`
+	Blocks []Block `json:"blocks"`
+}
+
+// Block is a Block Kit layout block
+type Block struct {
+	Type     string  `json:"type"`
+	Text     *Text   `json:"text,omitempty"`
+	Elements []*Text `json:"elements,omitempty"`
+}
+
+// Text is a Block Kit text object, either plain_text or mrkdwn
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Header returns a header block
+func Header(text string) Block {
+	return Block{Type: "header", Text: &Text{Type: "plain_text", Text: text}}
+}
+
+// Section returns a section block of mrkdwn text
+func Section(mrkdwn string) Block {
+	return Block{Type: "section", Text: &Text{Type: "mrkdwn", Text: mrkdwn}}
+}
+
+// Context returns a context block of small mrkdwn text
+func Context(mrkdwn string) Block {
+	return Block{Type: "context", Elements: []*Text{{Type: "mrkdwn", Text: mrkdwn}}}
+}
+
+// Divider returns a divider block
+func Divider() Block {
+	return Block{Type: "divider"}
+}
+
+// List returns section blocks for a bold title followed by one line per
+// item, split across sections so none exceeds Slack's length limit
+func List(title string, lines []string) []Block {
+	var blocks []Block
+	text := "*" + title + "*"
+	for _, line := range lines {
+		if len(text)+1+len(line) > maxSectionText {
+			blocks = append(blocks, Section(text))
+			text = ""
+		}
+		if text != "" {
+			text += "\n"
+		}
+		text += line
+	}
+	return append(blocks, Section(text))
+}
+
+// Escape escapes the characters mrkdwn treats as control characters
+func Escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// Trim drops blocks beyond Slack's per-message limit, ending the message
+// with a note saying how many were left out
+func (m *Message) Trim() {
+	if len(m.Blocks) <= maxBlocks {
+		return
+	}
+	dropped := len(m.Blocks) - (maxBlocks - 1)
+	m.Blocks = append(m.Blocks[:maxBlocks-1], Context(fmt.Sprintf("…%d more sections not shown", dropped)))
+}
+
+// Post sends a message to a Slack incoming webhook URL
+func Post(ctx context.Context, client *http.Client, url string, msg Message) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		reason, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if msg := strings.TrimSpace(string(reason)); msg != "" {
+			return fmt.Errorf("slack webhook failed: %s: %s", resp.Status, msg)
+		}
+		return fmt.Errorf("slack webhook failed: %s", resp.Status)
+	}
+	return nil
+}
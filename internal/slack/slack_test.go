@@ -0,0 +1,84 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlocks tests the JSON Slack expects for each block type
+func TestBlocks(t *testing.T) {
+	msg := Message{Text: "fallback", Blocks: []Block{Header("Status"), Section("*bold*"), Context("small"), Divider()}}
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"fallback","blocks":[
+		{"type":"header","text":{"type":"plain_text","text":"Status"}},
+		{"type":"section","text":{"type":"mrkdwn","text":"*bold*"}},
+		{"type":"context","elements":[{"type":"mrkdwn","text":"small"}]},
+		{"type":"divider"}
+	]}`, string(data))
+}
+
+// TestList tests splitting long lists across sections
+func TestList(t *testing.T) {
+	blocks := List("Down", []string{"a", "b"})
+	require.Len(t, blocks, 1)
+	assert.Equal(t, "*Down*\na\nb", blocks[0].Text.Text)
+
+	line := strings.Repeat("x", 1000)
+	blocks = List("Down", []string{line, line, line, line})
+	require.Len(t, blocks, 2)
+	for _, block := range blocks {
+		assert.LessOrEqual(t, len(block.Text.Text), maxSectionText)
+	}
+}
+
+// TestEscape tests escaping mrkdwn control characters
+func TestEscape(t *testing.T) {
+	assert.Equal(t, "a &amp; b &lt;c&gt;", Escape("a & b <c>"))
+}
+
+// TestTrim tests capping the number of blocks in a message
+func TestTrim(t *testing.T) {
+	msg := Message{}
+	for i := 0; i < 60; i++ {
+		msg.Blocks = append(msg.Blocks, Divider())
+	}
+	msg.Trim()
+	require.Len(t, msg.Blocks, maxBlocks)
+	assert.Equal(t, "…11 more sections not shown", msg.Blocks[maxBlocks-1].Elements[0].Text)
+}
+
+// TestPost tests sending a message to an incoming webhook
+func TestPost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"text":"hi","blocks":[{"type":"section","text":{"type":"mrkdwn","text":"hi"}}]}`, string(body))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	err := Post(context.Background(), server.Client(), server.URL, Message{Text: "hi", Blocks: []Block{Section("hi")}})
+	assert.NoError(t, err)
+}
+
+// TestPost_Error tests reporting Slack's reason for rejecting a message
+func TestPost_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid_blocks"))
+	}))
+	defer server.Close()
+
+	err := Post(context.Background(), server.Client(), server.URL, Message{Text: "hi"})
+	assert.EqualError(t, err, "slack webhook failed: 400 Bad Request: invalid_blocks")
+}
@@ -0,0 +1,1523 @@
+// Package testutil provides test doubles for code built on the api package
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+)
+
+// FakeAPI is an in-memory api.APIClient for testing commands without a
+// server. Seed it through its exported fields; creates, updates, deletes,
+// moves, and snoozes change them the way the API would. Every call is
+// recorded in Calls, and a method can be made to fail by setting
+// Errors[method].
+//
+// History (pings, checks, incidents, alerts, changes, and webhook
+// deliveries) is kept newest first, as the API returns it.
+type FakeAPI struct {
+	mu sync.Mutex
+
+	Account     api.Account
+	Retention   api.RetentionPolicy
+	Preferences api.NotificationPreferences
+	Regions     []api.Region
+	Alerts      []api.Alert
+
+	Jobs        []api.Job
+	Apis        []api.ApiMonitor
+	Certs       []api.SslMonitor
+	Domains     []api.DomainMonitor
+	DnsMonitors []api.DnsMonitor
+	Projects    []api.Project
+
+	// Pings are by job ID and Checks by API monitor ID
+	Pings  map[string][]api.Ping
+	Checks map[string][]api.Check
+	// Incidents are by resource ID
+	Incidents         map[string][]api.Incident
+	DomainChanges     map[string][]api.DomainChange
+	DnsChanges        map[string][]api.DnsChange
+	WebhookDeliveries map[string][]api.WebhookDelivery
+
+	// SentPings are the pings sent with SendPing, oldest first
+	SentPings []SentPing
+
+	// Limit is returned by RateLimit when set; Paced records whether
+	// PaceRequests was called
+	Limit *api.RateLimit
+	Paced bool
+
+	// Errors makes a method fail with the given error, by method name,
+	// e.g. Errors["GetJob"]
+	Errors map[string]error
+	// Calls lists the methods called, in order
+	Calls []string
+
+	// Now stamps created resources, pings, and incident updates
+	Now func() time.Time
+
+	nextID int
+}
+
+// SentPing is a ping sent through FakeAPI.SendPing
+type SentPing struct {
+	Token    string
+	PingType string
+	Duration time.Duration
+}
+
+var _ api.APIClient = (*FakeAPI)(nil)
+
+// NewFakeAPI returns an empty FakeAPI for a test account
+func NewFakeAPI() *FakeAPI {
+	return &FakeAPI{
+		Account:   api.Account{ID: "user-1", Email: "test@example.com", FullName: "Test User"},
+		Retention: api.RetentionPolicy{CheckDays: 90, PingDays: 90},
+		Preferences: api.NotificationPreferences{
+			SMSEnabled:      true,
+			EscalationDelay: 15,
+		},
+		Pings:             map[string][]api.Ping{},
+		Checks:            map[string][]api.Check{},
+		Incidents:         map[string][]api.Incident{},
+		DomainChanges:     map[string][]api.DomainChange{},
+		DnsChanges:        map[string][]api.DnsChange{},
+		WebhookDeliveries: map[string][]api.WebhookDelivery{},
+		Errors:            map[string]error{},
+		Now:               time.Now,
+	}
+}
+
+// NewID returns a new UUID-like ID, unique within the fake, whose first
+// eight characters are enough to resolve it
+func (f *FakeAPI) NewID() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.newID()
+}
+
+func (f *FakeAPI) newID() string {
+	f.nextID++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", f.nextID, f.nextID)
+}
+
+// begin locks the fake and records a call, returning the error set for the
+// method. Callers must unlock f.mu.
+func (f *FakeAPI) begin(method string) error {
+	f.mu.Lock()
+	f.Calls = append(f.Calls, method)
+	return f.Errors[method]
+}
+
+func (f *FakeAPI) now() string {
+	return f.Now().UTC().Format(time.RFC3339)
+}
+
+// snoozedUntil is the snoozed_until the API stores for a snooze ending at
+// until, rejecting times that have passed
+func (f *FakeAPI) snoozedUntil(until time.Time) (*string, error) {
+	if until.IsZero() {
+		return nil, nil
+	}
+	if !until.After(f.Now()) {
+		return nil, invalid("until", "must be in the future")
+	}
+	value := until.UTC().Format(time.RFC3339)
+	return &value, nil
+}
+
+// notFound is the error the API returns for a missing resource
+func notFound(kind, id string) error {
+	return &api.Error{StatusCode: http.StatusNotFound, Message: fmt.Sprintf("%s %s not found", kind, id)}
+}
+
+// invalid is the error the API returns when it rejects a field
+func invalid(field, message string) error {
+	return &api.Error{StatusCode: http.StatusUnprocessableEntity, Message: "Validation failed", Fields: map[string][]string{field: {message}}}
+}
+
+// indexOf returns the index of the item with id, or -1
+func indexOf[T any](items []T, id string, idOf func(T) string) int {
+	return slices.IndexFunc(items, func(item T) bool { return idOf(item) == id })
+}
+
+// convert copies the JSON fields of from into a new T, the way a create
+// request becomes a resource
+func convert[T any](from any) T {
+	var to T
+	data, _ := json.Marshal(from)
+	_ = json.Unmarshal(data, &to)
+	return to
+}
+
+// patch applies the fields set in an update request to dst. Update
+// requests omit fields that aren't changing, so only those present in its
+// JSON are copied.
+func patch(dst, req any) {
+	var fields map[string]json.RawMessage
+	current, _ := json.Marshal(dst)
+	_ = json.Unmarshal(current, &fields)
+
+	var changes map[string]json.RawMessage
+	data, _ := json.Marshal(req)
+	_ = json.Unmarshal(data, &changes)
+	for name, value := range changes {
+		fields[name] = value
+	}
+
+	merged, _ := json.Marshal(fields)
+	_ = json.Unmarshal(merged, dst)
+}
+
+// matches reports whether a resource passes the status, project, name, and
+// tag filters in opts
+func matches(item any, opts *api.ListOptions) bool {
+	if opts == nil {
+		return true
+	}
+	var fields struct {
+		Name      string   `json:"name"`
+		Status    string   `json:"status"`
+		ProjectID string   `json:"project_id"`
+		Tags      []string `json:"tags"`
+	}
+	data, _ := json.Marshal(item)
+	_ = json.Unmarshal(data, &fields)
+
+	if opts.Status != "" && fields.Status != opts.Status {
+		return false
+	}
+	if opts.Project != "" && fields.ProjectID != opts.Project {
+		return false
+	}
+	if opts.Name != "" && !strings.Contains(strings.ToLower(fields.Name), strings.ToLower(opts.Name)) {
+		return false
+	}
+	for _, filter := range opts.Tags {
+		if !slices.ContainsFunc(fields.Tags, func(tag string) bool {
+			return tag == filter || (!strings.Contains(filter, "=") && strings.HasPrefix(tag, filter+"="))
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterList returns the items matching opts
+func filterList[T any](items []T, opts *api.ListOptions) []T {
+	var kept []T
+	for _, item := range items {
+		if matches(item, opts) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// inRange filters newest-first history to opts' Since and Until
+func inRange[T any](items []T, opts *api.ListOptions, createdAt func(T) string) []T {
+	var kept []T
+	for _, item := range items {
+		if opts != nil && (!opts.Since.IsZero() || !opts.Until.IsZero()) {
+			t, err := time.Parse(time.RFC3339, createdAt(item))
+			if err == nil && ((!opts.Since.IsZero() && t.Before(opts.Since)) || (!opts.Until.IsZero() && t.After(opts.Until))) {
+				continue
+			}
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
+
+// page returns the page of items opts asks for and whether there are more
+func page[T any](items []T, opts *api.ListOptions) ([]T, bool) {
+	if opts == nil || opts.PerPage <= 0 {
+		return items, false
+	}
+	start := (max(opts.Page, 1) - 1) * opts.PerPage
+	if start >= len(items) {
+		return []T{}, false
+	}
+	end := min(start+opts.PerPage, len(items))
+	return items[start:end], end < len(items)
+}
+
+// upTo returns at most n items; n <= 0 means all of them
+func upTo[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
+
+// Jobs
+
+func jobID(job api.Job) string { return job.ID }
+
+// ListJobs returns a page of jobs matching opts
+func (f *FakeAPI) ListJobs(opts *api.ListOptions) (*api.JobsResponse, error) {
+	err := f.begin("ListJobs")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	jobs := filterList(f.Jobs, opts)
+	items, more := page(jobs, opts)
+	return &api.JobsResponse{Jobs: items, HasMore: more, TotalCount: len(jobs)}, nil
+}
+
+// ListAllJobs returns every job matching opts
+func (f *FakeAPI) ListAllJobs(opts *api.ListOptions) (*api.JobsResponse, error) {
+	err := f.begin("ListAllJobs")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	jobs := filterList(f.Jobs, opts)
+	return &api.JobsResponse{Jobs: jobs, TotalCount: len(jobs)}, nil
+}
+
+// GetJob returns a job by ID
+func (f *FakeAPI) GetJob(id string) (*api.Job, error) {
+	err := f.begin("GetJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return nil, notFound("job", id)
+	}
+	job := f.Jobs[i]
+	return &job, nil
+}
+
+// CreateJob adds an active job with a new ID and ping token
+func (f *FakeAPI) CreateJob(req *api.CreateJobRequest) (*api.Job, error) {
+	err := f.begin("CreateJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, invalid("name", "can't be blank")
+	}
+	job := convert[api.Job](req)
+	job.ID = f.newID()
+	job.PingToken = "tok-" + job.ID[:8]
+	if job.Status == "" {
+		job.Status = "active"
+	}
+	job.CreatedAt, job.UpdatedAt = f.now(), f.now()
+	f.Jobs = append(f.Jobs, job)
+	return &job, nil
+}
+
+// UpdateJob applies the fields set in req to a job
+func (f *FakeAPI) UpdateJob(id string, req *api.UpdateJobRequest) (*api.Job, error) {
+	err := f.begin("UpdateJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return nil, notFound("job", id)
+	}
+	patch(&f.Jobs[i], req)
+	f.Jobs[i].UpdatedAt = f.now()
+	job := f.Jobs[i]
+	return &job, nil
+}
+
+// DeleteJob removes a job
+func (f *FakeAPI) DeleteJob(id string) error {
+	err := f.begin("DeleteJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return notFound("job", id)
+	}
+	f.Jobs = slices.Delete(f.Jobs, i, i+1)
+	return nil
+}
+
+// MoveJob moves a job into a project, or out of any with ""
+func (f *FakeAPI) MoveJob(id, projectID string) error {
+	err := f.begin("MoveJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return notFound("job", id)
+	}
+	if err := f.checkProject(projectID); err != nil {
+		return err
+	}
+	f.Jobs[i].ProjectID = projectID
+	return nil
+}
+
+// SnoozeJob snoozes a job's alerts until the given time, or ends the
+// snooze when until is zero
+func (f *FakeAPI) SnoozeJob(id string, until time.Time) error {
+	err := f.begin("SnoozeJob")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return notFound("job", id)
+	}
+	snoozedUntil, err := f.snoozedUntil(until)
+	if err != nil {
+		return err
+	}
+	f.Jobs[i].SnoozedUntil = snoozedUntil
+	return nil
+}
+
+func pingCreatedAt(ping api.Ping) string { return ping.CreatedAt }
+
+// ListJobPings returns a page of a job's pings in opts' time range
+func (f *FakeAPI) ListJobPings(id string, opts *api.ListOptions) (*api.PingsResponse, error) {
+	err := f.begin("ListJobPings")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Jobs, id, jobID) < 0 {
+		return nil, notFound("job", id)
+	}
+	items, more := page(inRange(f.Pings[id], opts, pingCreatedAt), opts)
+	return &api.PingsResponse{Pings: items, HasMore: more}, nil
+}
+
+// ListAllJobPings returns a job's pings in opts' time range, at most max
+// when it is positive
+func (f *FakeAPI) ListAllJobPings(id string, opts *api.ListOptions, max int) ([]api.Ping, error) {
+	err := f.begin("ListAllJobPings")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Jobs, id, jobID) < 0 {
+		return nil, notFound("job", id)
+	}
+	return upTo(inRange(f.Pings[id], opts, pingCreatedAt), max), nil
+}
+
+// SendPing records a ping and adds it to the history of the job with the
+// token
+func (f *FakeAPI) SendPing(token, pingType string, duration time.Duration) error {
+	err := f.begin("SendPing")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := slices.IndexFunc(f.Jobs, func(job api.Job) bool { return job.PingToken == token })
+	if i < 0 {
+		return notFound("ping token", token)
+	}
+	f.SentPings = append(f.SentPings, SentPing{Token: token, PingType: pingType, Duration: duration})
+
+	ping := api.Ping{ID: f.newID(), JobID: f.Jobs[i].ID, PingType: pingType, CreatedAt: f.now()}
+	if duration > 0 {
+		seconds := fmt.Sprintf("%g", duration.Seconds())
+		ping.Duration = &seconds
+	}
+	f.Pings[ping.JobID] = append([]api.Ping{ping}, f.Pings[ping.JobID]...)
+	f.Jobs[i].LastPingAt = &ping.CreatedAt
+	return nil
+}
+
+// ListJobIncidents returns a job's incidents
+func (f *FakeAPI) ListJobIncidents(id string) ([]api.Incident, error) {
+	err := f.begin("ListJobIncidents")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Jobs, id, jobID) < 0 {
+		return nil, notFound("job", id)
+	}
+	return f.Incidents[id], nil
+}
+
+// TestJobWebhook records a successful test delivery to a job's webhook
+func (f *FakeAPI) TestJobWebhook(id string) (*api.WebhookDelivery, error) {
+	err := f.begin("TestJobWebhook")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Jobs, id, jobID)
+	if i < 0 {
+		return nil, notFound("job", id)
+	}
+	if f.Jobs[i].WebhookURL == "" {
+		return nil, invalid("webhook_url", "must be set to test the webhook")
+	}
+	status := http.StatusOK
+	delivery := api.WebhookDelivery{ID: f.newID(), Event: "job.test", URL: f.Jobs[i].WebhookURL, StatusCode: &status, Success: true, CreatedAt: f.now()}
+	f.WebhookDeliveries[id] = append([]api.WebhookDelivery{delivery}, f.WebhookDeliveries[id]...)
+	return &delivery, nil
+}
+
+// ListJobWebhookDeliveries returns a job's webhook delivery attempts
+func (f *FakeAPI) ListJobWebhookDeliveries(id string) ([]api.WebhookDelivery, error) {
+	err := f.begin("ListJobWebhookDeliveries")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Jobs, id, jobID) < 0 {
+		return nil, notFound("job", id)
+	}
+	return f.WebhookDeliveries[id], nil
+}
+
+// API monitors
+
+func apiID(monitor api.ApiMonitor) string { return monitor.ID }
+
+// ListApis returns a page of API monitors matching opts
+func (f *FakeAPI) ListApis(opts *api.ListOptions) (*api.ApisResponse, error) {
+	err := f.begin("ListApis")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	monitors := filterList(f.Apis, opts)
+	items, more := page(monitors, opts)
+	return &api.ApisResponse{APIMonitors: items, HasMore: more, TotalCount: len(monitors)}, nil
+}
+
+// ListAllApis returns every API monitor matching opts
+func (f *FakeAPI) ListAllApis(opts *api.ListOptions) (*api.ApisResponse, error) {
+	err := f.begin("ListAllApis")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	monitors := filterList(f.Apis, opts)
+	return &api.ApisResponse{APIMonitors: monitors, TotalCount: len(monitors)}, nil
+}
+
+// GetApi returns an API monitor by ID
+func (f *FakeAPI) GetApi(id string) (*api.ApiMonitor, error) {
+	err := f.begin("GetApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Apis, id, apiID)
+	if i < 0 {
+		return nil, notFound("API monitor", id)
+	}
+	monitor := f.Apis[i]
+	return &monitor, nil
+}
+
+// CreateApi adds an active API monitor with a new ID
+func (f *FakeAPI) CreateApi(req *api.CreateApiRequest) (*api.ApiMonitor, error) {
+	err := f.begin("CreateApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, invalid("name", "can't be blank")
+	}
+	if req.URL == "" {
+		return nil, invalid("url", "can't be blank")
+	}
+	monitor := convert[api.ApiMonitor](req)
+	monitor.ID = f.newID()
+	if monitor.HTTPMethod == "" {
+		monitor.HTTPMethod = http.MethodGet
+	}
+	if monitor.Status == "" {
+		monitor.Status = "active"
+	}
+	monitor.CreatedAt, monitor.UpdatedAt = f.now(), f.now()
+	f.Apis = append(f.Apis, monitor)
+	return &monitor, nil
+}
+
+// UpdateApi applies the fields set in req to an API monitor
+func (f *FakeAPI) UpdateApi(id string, req *api.UpdateApiRequest) (*api.ApiMonitor, error) {
+	err := f.begin("UpdateApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Apis, id, apiID)
+	if i < 0 {
+		return nil, notFound("API monitor", id)
+	}
+	patch(&f.Apis[i], req)
+	f.Apis[i].UpdatedAt = f.now()
+	monitor := f.Apis[i]
+	return &monitor, nil
+}
+
+// DeleteApi removes an API monitor
+func (f *FakeAPI) DeleteApi(id string) error {
+	err := f.begin("DeleteApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Apis, id, apiID)
+	if i < 0 {
+		return notFound("API monitor", id)
+	}
+	f.Apis = slices.Delete(f.Apis, i, i+1)
+	return nil
+}
+
+// MoveApi moves an API monitor into a project, or out of any with ""
+func (f *FakeAPI) MoveApi(id, projectID string) error {
+	err := f.begin("MoveApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Apis, id, apiID)
+	if i < 0 {
+		return notFound("API monitor", id)
+	}
+	if err := f.checkProject(projectID); err != nil {
+		return err
+	}
+	f.Apis[i].ProjectID = projectID
+	return nil
+}
+
+// SnoozeApi snoozes an API monitor's alerts until the given time, or ends the
+// snooze when until is zero
+func (f *FakeAPI) SnoozeApi(id string, until time.Time) error {
+	err := f.begin("SnoozeApi")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Apis, id, apiID)
+	if i < 0 {
+		return notFound("API monitor", id)
+	}
+	snoozedUntil, err := f.snoozedUntil(until)
+	if err != nil {
+		return err
+	}
+	f.Apis[i].SnoozedUntil = snoozedUntil
+	return nil
+}
+
+func checkCreatedAt(check api.Check) string { return check.CreatedAt }
+
+// ListApiChecks returns a page of an API monitor's checks in opts' time
+// range
+func (f *FakeAPI) ListApiChecks(id string, opts *api.ListOptions) (*api.ApiChecksResponse, error) {
+	err := f.begin("ListApiChecks")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Apis, id, apiID) < 0 {
+		return nil, notFound("API monitor", id)
+	}
+	items, more := page(inRange(f.Checks[id], opts, checkCreatedAt), opts)
+	return &api.ApiChecksResponse{APIChecks: items, HasMore: more}, nil
+}
+
+// ListAllApiChecks returns an API monitor's checks in opts' time range, at
+// most max when it is positive
+func (f *FakeAPI) ListAllApiChecks(id string, opts *api.ListOptions, max int) ([]api.Check, error) {
+	err := f.begin("ListAllApiChecks")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Apis, id, apiID) < 0 {
+		return nil, notFound("API monitor", id)
+	}
+	return upTo(inRange(f.Checks[id], opts, checkCreatedAt), max), nil
+}
+
+// EachApiCheckPage hands an API monitor's checks in opts' time range to fn
+// a page at a time, using opts.PerPage or 100 per page
+func (f *FakeAPI) EachApiCheckPage(id string, opts *api.ListOptions, fn func([]api.Check) error) error {
+	err := f.begin("EachApiCheckPage")
+	if err != nil {
+		f.mu.Unlock()
+		return err
+	}
+	if indexOf(f.Apis, id, apiID) < 0 {
+		f.mu.Unlock()
+		return notFound("API monitor", id)
+	}
+	checks := inRange(f.Checks[id], opts, checkCreatedAt)
+	// fn may call back into the fake
+	f.mu.Unlock()
+
+	perPage := 100
+	if opts != nil && opts.PerPage > 0 {
+		perPage = opts.PerPage
+	}
+	for start := 0; start < len(checks); start += perPage {
+		if err := fn(checks[start:min(start+perPage, len(checks))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetApiCheck returns a check by ID from any API monitor's history
+func (f *FakeAPI) GetApiCheck(id string) (*api.Check, error) {
+	err := f.begin("GetApiCheck")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	for _, checks := range f.Checks {
+		for _, check := range checks {
+			if check.ID == id {
+				return &check, nil
+			}
+		}
+	}
+	return nil, notFound("check", id)
+}
+
+// PruneApiChecks deletes an API monitor's checks from before before
+func (f *FakeAPI) PruneApiChecks(id string, before time.Time) (int, error) {
+	err := f.begin("PruneApiChecks")
+	defer f.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	if indexOf(f.Apis, id, apiID) < 0 {
+		return 0, notFound("API monitor", id)
+	}
+	kept := inRange(f.Checks[id], &api.ListOptions{Since: before}, checkCreatedAt)
+	deleted := len(f.Checks[id]) - len(kept)
+	f.Checks[id] = kept
+	return deleted, nil
+}
+
+// ListApiIncidents returns an API monitor's incidents
+func (f *FakeAPI) ListApiIncidents(id string) ([]api.Incident, error) {
+	err := f.begin("ListApiIncidents")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Apis, id, apiID) < 0 {
+		return nil, notFound("API monitor", id)
+	}
+	return f.Incidents[id], nil
+}
+
+// SSL certificate monitors
+
+func certID(cert api.SslMonitor) string { return cert.ID }
+
+// ListCerts returns a page of SSL monitors matching opts
+func (f *FakeAPI) ListCerts(opts *api.ListOptions) (*api.SslMonitorsResponse, error) {
+	err := f.begin("ListCerts")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	certs := filterList(f.Certs, opts)
+	items, more := page(certs, opts)
+	return &api.SslMonitorsResponse{SslMonitors: items, HasMore: more, TotalCount: len(certs)}, nil
+}
+
+// ListAllCerts returns every SSL monitor matching opts
+func (f *FakeAPI) ListAllCerts(opts *api.ListOptions) (*api.SslMonitorsResponse, error) {
+	err := f.begin("ListAllCerts")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	certs := filterList(f.Certs, opts)
+	return &api.SslMonitorsResponse{SslMonitors: certs, TotalCount: len(certs)}, nil
+}
+
+// GetCert returns an SSL monitor by ID
+func (f *FakeAPI) GetCert(id string) (*api.SslMonitor, error) {
+	err := f.begin("GetCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Certs, id, certID)
+	if i < 0 {
+		return nil, notFound("SSL monitor", id)
+	}
+	cert := f.Certs[i]
+	return &cert, nil
+}
+
+// CreateCert adds an active SSL monitor with a new ID
+func (f *FakeAPI) CreateCert(req *api.CreateSslMonitorRequest) (*api.SslMonitor, error) {
+	err := f.begin("CreateCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Domain == "" {
+		return nil, invalid("domain", "can't be blank")
+	}
+	cert := convert[api.SslMonitor](req)
+	cert.ID = f.newID()
+	if cert.Port == 0 {
+		cert.Port = 443
+	}
+	if cert.Status == "" {
+		cert.Status = "active"
+	}
+	cert.CreatedAt, cert.UpdatedAt = f.now(), f.now()
+	f.Certs = append(f.Certs, cert)
+	return &cert, nil
+}
+
+// UpdateCert applies the fields set in req to an SSL monitor
+func (f *FakeAPI) UpdateCert(id string, req *api.UpdateSslMonitorRequest) (*api.SslMonitor, error) {
+	err := f.begin("UpdateCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Certs, id, certID)
+	if i < 0 {
+		return nil, notFound("SSL monitor", id)
+	}
+	patch(&f.Certs[i], req)
+	f.Certs[i].UpdatedAt = f.now()
+	cert := f.Certs[i]
+	return &cert, nil
+}
+
+// DeleteCert removes an SSL monitor
+func (f *FakeAPI) DeleteCert(id string) error {
+	err := f.begin("DeleteCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Certs, id, certID)
+	if i < 0 {
+		return notFound("SSL monitor", id)
+	}
+	f.Certs = slices.Delete(f.Certs, i, i+1)
+	return nil
+}
+
+// MoveCert moves an SSL monitor into a project, or out of any with ""
+func (f *FakeAPI) MoveCert(id, projectID string) error {
+	err := f.begin("MoveCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Certs, id, certID)
+	if i < 0 {
+		return notFound("SSL monitor", id)
+	}
+	if err := f.checkProject(projectID); err != nil {
+		return err
+	}
+	f.Certs[i].ProjectID = projectID
+	return nil
+}
+
+// SnoozeCert snoozes an SSL monitor's alerts until the given time, or ends the
+// snooze when until is zero
+func (f *FakeAPI) SnoozeCert(id string, until time.Time) error {
+	err := f.begin("SnoozeCert")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Certs, id, certID)
+	if i < 0 {
+		return notFound("SSL monitor", id)
+	}
+	snoozedUntil, err := f.snoozedUntil(until)
+	if err != nil {
+		return err
+	}
+	f.Certs[i].SnoozedUntil = snoozedUntil
+	return nil
+}
+
+// ListCertIncidents returns an SSL monitor's incidents
+func (f *FakeAPI) ListCertIncidents(id string) ([]api.Incident, error) {
+	err := f.begin("ListCertIncidents")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Certs, id, certID) < 0 {
+		return nil, notFound("SSL monitor", id)
+	}
+	return f.Incidents[id], nil
+}
+
+// Domain monitors
+
+func domainID(domain api.DomainMonitor) string { return domain.ID }
+
+// ListDomains returns a page of domain monitors matching opts
+func (f *FakeAPI) ListDomains(opts *api.ListOptions) (*api.DomainMonitorsResponse, error) {
+	err := f.begin("ListDomains")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	domains := filterList(f.Domains, opts)
+	items, more := page(domains, opts)
+	return &api.DomainMonitorsResponse{DomainMonitors: items, HasMore: more, TotalCount: len(domains)}, nil
+}
+
+// ListAllDomains returns every domain monitor matching opts
+func (f *FakeAPI) ListAllDomains(opts *api.ListOptions) (*api.DomainMonitorsResponse, error) {
+	err := f.begin("ListAllDomains")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	domains := filterList(f.Domains, opts)
+	return &api.DomainMonitorsResponse{DomainMonitors: domains, TotalCount: len(domains)}, nil
+}
+
+// GetDomain returns a domain monitor by ID
+func (f *FakeAPI) GetDomain(id string) (*api.DomainMonitor, error) {
+	err := f.begin("GetDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Domains, id, domainID)
+	if i < 0 {
+		return nil, notFound("domain monitor", id)
+	}
+	domain := f.Domains[i]
+	return &domain, nil
+}
+
+// CreateDomain adds an active domain monitor with a new ID
+func (f *FakeAPI) CreateDomain(req *api.CreateDomainMonitorRequest) (*api.DomainMonitor, error) {
+	err := f.begin("CreateDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Domain == "" {
+		return nil, invalid("domain", "can't be blank")
+	}
+	domain := convert[api.DomainMonitor](req)
+	domain.ID = f.newID()
+	if domain.Status == "" {
+		domain.Status = "active"
+	}
+	domain.CreatedAt, domain.UpdatedAt = f.now(), f.now()
+	f.Domains = append(f.Domains, domain)
+	return &domain, nil
+}
+
+// UpdateDomain applies the fields set in req to a domain monitor
+func (f *FakeAPI) UpdateDomain(id string, req *api.UpdateDomainMonitorRequest) (*api.DomainMonitor, error) {
+	err := f.begin("UpdateDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Domains, id, domainID)
+	if i < 0 {
+		return nil, notFound("domain monitor", id)
+	}
+	patch(&f.Domains[i], req)
+	f.Domains[i].UpdatedAt = f.now()
+	domain := f.Domains[i]
+	return &domain, nil
+}
+
+// DeleteDomain removes a domain monitor
+func (f *FakeAPI) DeleteDomain(id string) error {
+	err := f.begin("DeleteDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Domains, id, domainID)
+	if i < 0 {
+		return notFound("domain monitor", id)
+	}
+	f.Domains = slices.Delete(f.Domains, i, i+1)
+	return nil
+}
+
+// MoveDomain moves a domain monitor into a project, or out of any with ""
+func (f *FakeAPI) MoveDomain(id, projectID string) error {
+	err := f.begin("MoveDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Domains, id, domainID)
+	if i < 0 {
+		return notFound("domain monitor", id)
+	}
+	if err := f.checkProject(projectID); err != nil {
+		return err
+	}
+	f.Domains[i].ProjectID = projectID
+	return nil
+}
+
+// SnoozeDomain snoozes a domain monitor's alerts until the given time, or ends the
+// snooze when until is zero
+func (f *FakeAPI) SnoozeDomain(id string, until time.Time) error {
+	err := f.begin("SnoozeDomain")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Domains, id, domainID)
+	if i < 0 {
+		return notFound("domain monitor", id)
+	}
+	snoozedUntil, err := f.snoozedUntil(until)
+	if err != nil {
+		return err
+	}
+	f.Domains[i].SnoozedUntil = snoozedUntil
+	return nil
+}
+
+// ListDomainIncidents returns a domain monitor's incidents
+func (f *FakeAPI) ListDomainIncidents(id string) ([]api.Incident, error) {
+	err := f.begin("ListDomainIncidents")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Domains, id, domainID) < 0 {
+		return nil, notFound("domain monitor", id)
+	}
+	return f.Incidents[id], nil
+}
+
+// ListDomainChanges returns the registration changes seen for a domain
+func (f *FakeAPI) ListDomainChanges(id string) ([]api.DomainChange, error) {
+	err := f.begin("ListDomainChanges")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.Domains, id, domainID) < 0 {
+		return nil, notFound("domain monitor", id)
+	}
+	return f.DomainChanges[id], nil
+}
+
+// DNS monitors
+
+func dnsID(monitor api.DnsMonitor) string { return monitor.ID }
+
+// ListDnsMonitors returns a page of DNS monitors matching opts
+func (f *FakeAPI) ListDnsMonitors(opts *api.ListOptions) (*api.DnsMonitorsResponse, error) {
+	err := f.begin("ListDnsMonitors")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	monitors := filterList(f.DnsMonitors, opts)
+	items, more := page(monitors, opts)
+	return &api.DnsMonitorsResponse{DnsMonitors: items, HasMore: more, TotalCount: len(monitors)}, nil
+}
+
+// ListAllDnsMonitors returns every DNS monitor matching opts
+func (f *FakeAPI) ListAllDnsMonitors(opts *api.ListOptions) (*api.DnsMonitorsResponse, error) {
+	err := f.begin("ListAllDnsMonitors")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	monitors := filterList(f.DnsMonitors, opts)
+	return &api.DnsMonitorsResponse{DnsMonitors: monitors, TotalCount: len(monitors)}, nil
+}
+
+// GetDnsMonitor returns a DNS monitor by ID
+func (f *FakeAPI) GetDnsMonitor(id string) (*api.DnsMonitor, error) {
+	err := f.begin("GetDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.DnsMonitors, id, dnsID)
+	if i < 0 {
+		return nil, notFound("DNS monitor", id)
+	}
+	monitor := f.DnsMonitors[i]
+	return &monitor, nil
+}
+
+// CreateDnsMonitor adds an active DNS monitor with a new ID
+func (f *FakeAPI) CreateDnsMonitor(req *api.CreateDnsMonitorRequest) (*api.DnsMonitor, error) {
+	err := f.begin("CreateDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Domain == "" {
+		return nil, invalid("domain", "can't be blank")
+	}
+	if req.RecordType == "" {
+		return nil, invalid("record_type", "can't be blank")
+	}
+	monitor := convert[api.DnsMonitor](req)
+	monitor.ID = f.newID()
+	if monitor.Status == "" {
+		monitor.Status = "active"
+	}
+	monitor.CreatedAt, monitor.UpdatedAt = f.now(), f.now()
+	f.DnsMonitors = append(f.DnsMonitors, monitor)
+	return &monitor, nil
+}
+
+// UpdateDnsMonitor applies the fields set in req to a DNS monitor
+func (f *FakeAPI) UpdateDnsMonitor(id string, req *api.UpdateDnsMonitorRequest) (*api.DnsMonitor, error) {
+	err := f.begin("UpdateDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.DnsMonitors, id, dnsID)
+	if i < 0 {
+		return nil, notFound("DNS monitor", id)
+	}
+	patch(&f.DnsMonitors[i], req)
+	f.DnsMonitors[i].UpdatedAt = f.now()
+	monitor := f.DnsMonitors[i]
+	return &monitor, nil
+}
+
+// DeleteDnsMonitor removes a DNS monitor
+func (f *FakeAPI) DeleteDnsMonitor(id string) error {
+	err := f.begin("DeleteDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.DnsMonitors, id, dnsID)
+	if i < 0 {
+		return notFound("DNS monitor", id)
+	}
+	f.DnsMonitors = slices.Delete(f.DnsMonitors, i, i+1)
+	return nil
+}
+
+// MoveDnsMonitor moves a DNS monitor into a project, or out of any with ""
+func (f *FakeAPI) MoveDnsMonitor(id, projectID string) error {
+	err := f.begin("MoveDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.DnsMonitors, id, dnsID)
+	if i < 0 {
+		return notFound("DNS monitor", id)
+	}
+	if err := f.checkProject(projectID); err != nil {
+		return err
+	}
+	f.DnsMonitors[i].ProjectID = projectID
+	return nil
+}
+
+// SnoozeDnsMonitor snoozes a DNS monitor's alerts until the given time, or ends the
+// snooze when until is zero
+func (f *FakeAPI) SnoozeDnsMonitor(id string, until time.Time) error {
+	err := f.begin("SnoozeDnsMonitor")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.DnsMonitors, id, dnsID)
+	if i < 0 {
+		return notFound("DNS monitor", id)
+	}
+	snoozedUntil, err := f.snoozedUntil(until)
+	if err != nil {
+		return err
+	}
+	f.DnsMonitors[i].SnoozedUntil = snoozedUntil
+	return nil
+}
+
+// ListDnsMonitorIncidents returns a DNS monitor's incidents
+func (f *FakeAPI) ListDnsMonitorIncidents(id string) ([]api.Incident, error) {
+	err := f.begin("ListDnsMonitorIncidents")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.DnsMonitors, id, dnsID) < 0 {
+		return nil, notFound("DNS monitor", id)
+	}
+	return f.Incidents[id], nil
+}
+
+// ListDnsMonitorChanges returns the record changes seen for a DNS monitor
+func (f *FakeAPI) ListDnsMonitorChanges(id string) ([]api.DnsChange, error) {
+	err := f.begin("ListDnsMonitorChanges")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if indexOf(f.DnsMonitors, id, dnsID) < 0 {
+		return nil, notFound("DNS monitor", id)
+	}
+	return f.DnsChanges[id], nil
+}
+
+// Projects
+
+func projectID(project api.Project) string { return project.ID }
+
+// checkProject fails unless id is empty or an existing project
+func (f *FakeAPI) checkProject(id string) error {
+	if id != "" && indexOf(f.Projects, id, projectID) < 0 {
+		return notFound("project", id)
+	}
+	return nil
+}
+
+// withCounts fills in a project's job and monitor counts from the fake's
+// resources
+func (f *FakeAPI) withCounts(project api.Project) api.Project {
+	project.JobCount, project.MonitorCount = 0, 0
+	for _, job := range f.Jobs {
+		if job.ProjectID == project.ID {
+			project.JobCount++
+		}
+	}
+	for _, monitor := range f.Apis {
+		if monitor.ProjectID == project.ID {
+			project.MonitorCount++
+		}
+	}
+	for _, cert := range f.Certs {
+		if cert.ProjectID == project.ID {
+			project.MonitorCount++
+		}
+	}
+	for _, domain := range f.Domains {
+		if domain.ProjectID == project.ID {
+			project.MonitorCount++
+		}
+	}
+	for _, monitor := range f.DnsMonitors {
+		if monitor.ProjectID == project.ID {
+			project.MonitorCount++
+		}
+	}
+	return project
+}
+
+// ListProjects returns every project with its job and monitor counts
+func (f *FakeAPI) ListProjects() ([]api.Project, error) {
+	err := f.begin("ListProjects")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]api.Project, len(f.Projects))
+	for i, project := range f.Projects {
+		projects[i] = f.withCounts(project)
+	}
+	return projects, nil
+}
+
+// GetProject returns a project by ID
+func (f *FakeAPI) GetProject(id string) (*api.Project, error) {
+	err := f.begin("GetProject")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	i := indexOf(f.Projects, id, projectID)
+	if i < 0 {
+		return nil, notFound("project", id)
+	}
+	project := f.withCounts(f.Projects[i])
+	return &project, nil
+}
+
+// CreateProject adds a project with a new ID
+func (f *FakeAPI) CreateProject(req *api.CreateProjectRequest) (*api.Project, error) {
+	err := f.begin("CreateProject")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, invalid("name", "can't be blank")
+	}
+	project := api.Project{ID: f.newID(), Name: req.Name, Description: req.Description, CreatedAt: f.now()}
+	f.Projects = append(f.Projects, project)
+	return &project, nil
+}
+
+// DeleteProject removes a project
+func (f *FakeAPI) DeleteProject(id string) error {
+	err := f.begin("DeleteProject")
+	defer f.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	i := indexOf(f.Projects, id, projectID)
+	if i < 0 {
+		return notFound("project", id)
+	}
+	f.Projects = slices.Delete(f.Projects, i, i+1)
+	return nil
+}
+
+// Incidents
+
+// findIncident returns the resource an incident belongs to and the
+// incident's index in that resource's list
+func (f *FakeAPI) findIncident(id string) (string, int, bool) {
+	for resourceID, incidents := range f.Incidents {
+		if i := slices.IndexFunc(incidents, func(incident api.Incident) bool { return incident.ID == id }); i >= 0 {
+			return resourceID, i, true
+		}
+	}
+	return "", 0, false
+}
+
+// resource returns the type and name of the resource with id
+func (f *FakeAPI) resource(id string) (kind, name string) {
+	if i := indexOf(f.Jobs, id, jobID); i >= 0 {
+		return "job", f.Jobs[i].Name
+	}
+	if i := indexOf(f.Apis, id, apiID); i >= 0 {
+		return "api", f.Apis[i].Name
+	}
+	if i := indexOf(f.Certs, id, certID); i >= 0 {
+		return "cert", f.Certs[i].Name
+	}
+	if i := indexOf(f.Domains, id, domainID); i >= 0 {
+		return "domain", f.Domains[i].Name
+	}
+	if i := indexOf(f.DnsMonitors, id, dnsID); i >= 0 {
+		return "dns", f.DnsMonitors[i].Name
+	}
+	return "", ""
+}
+
+// GetIncident returns an incident with the resource it belongs to and the
+// alerts sent about that resource while it was open
+func (f *FakeAPI) GetIncident(id string) (*api.IncidentDetail, error) {
+	err := f.begin("GetIncident")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	resourceID, i, ok := f.findIncident(id)
+	if !ok {
+		return nil, notFound("incident", id)
+	}
+	kind, name := f.resource(resourceID)
+	detail := &api.IncidentDetail{
+		Incident:     f.Incidents[resourceID][i],
+		ResourceType: kind,
+		ResourceID:   resourceID,
+		ResourceName: name,
+		Checks:       []api.IncidentCheck{},
+		Alerts:       []api.Alert{},
+	}
+	for _, alert := range slices.Backward(f.Alerts) {
+		if alert.ResourceID == resourceID && alert.SentAt >= detail.StartedAt && (detail.EndedAt == nil || alert.SentAt <= *detail.EndedAt) {
+			detail.Alerts = append(detail.Alerts, alert)
+		}
+	}
+	return detail, nil
+}
+
+// UpdateIncident acknowledges an incident and/or adds a note to it as the
+// fake's account
+func (f *FakeAPI) UpdateIncident(id string, req *api.UpdateIncidentRequest) (*api.Incident, error) {
+	err := f.begin("UpdateIncident")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	resourceID, i, ok := f.findIncident(id)
+	if !ok {
+		return nil, notFound("incident", id)
+	}
+	incident := &f.Incidents[resourceID][i]
+	if req.Acknowledged != nil {
+		if *req.Acknowledged {
+			now := f.now()
+			incident.AcknowledgedAt = &now
+			incident.AcknowledgedBy = f.Account.Email
+		} else {
+			incident.AcknowledgedAt = nil
+			incident.AcknowledgedBy = ""
+		}
+	}
+	if req.Note != "" {
+		incident.Notes = append(incident.Notes, api.IncidentNote{Body: req.Note, Author: f.Account.Email, CreatedAt: f.now()})
+	}
+	updated := *incident
+	return &updated, nil
+}
+
+// Account
+
+// GetAccount returns the account with its job and monitor counts
+func (f *FakeAPI) GetAccount() (*api.Account, error) {
+	err := f.begin("GetAccount")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	account := f.Account
+	account.JobCount = len(f.Jobs)
+	account.MonitorCount = len(f.Apis) + len(f.Certs) + len(f.Domains) + len(f.DnsMonitors)
+	return &account, nil
+}
+
+// GetRetentionPolicy returns the retention policy with the number of
+// checks and pings stored
+func (f *FakeAPI) GetRetentionPolicy() (*api.RetentionPolicy, error) {
+	err := f.begin("GetRetentionPolicy")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	retention := f.Retention
+	retention.StoredChecks, retention.StoredPings = 0, 0
+	for _, checks := range f.Checks {
+		retention.StoredChecks += len(checks)
+	}
+	for _, pings := range f.Pings {
+		retention.StoredPings += len(pings)
+	}
+	return &retention, nil
+}
+
+// GetNotificationPreferences returns the account's alert preferences
+func (f *FakeAPI) GetNotificationPreferences() (*api.NotificationPreferences, error) {
+	err := f.begin("GetNotificationPreferences")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	prefs := f.Preferences
+	return &prefs, nil
+}
+
+// UpdateNotificationPreferences applies the fields set in req to the
+// account's alert preferences
+func (f *FakeAPI) UpdateNotificationPreferences(req *api.UpdateNotificationPreferencesRequest) (*api.NotificationPreferences, error) {
+	err := f.begin("UpdateNotificationPreferences")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	patch(&f.Preferences, req)
+	prefs := f.Preferences
+	return &prefs, nil
+}
+
+func alertSentAt(alert api.Alert) string { return alert.SentAt }
+
+// alerts returns the alerts of opts' type in its time range
+func (f *FakeAPI) alerts(opts *api.ListOptions) []api.Alert {
+	var alerts []api.Alert
+	for _, alert := range inRange(f.Alerts, opts, alertSentAt) {
+		if opts == nil || opts.Type == "" || alert.AlertType == opts.Type {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+// ListAlerts returns a page of the alerts matching opts
+func (f *FakeAPI) ListAlerts(opts *api.ListOptions) (*api.AlertsResponse, error) {
+	err := f.begin("ListAlerts")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	items, more := page(f.alerts(opts), opts)
+	return &api.AlertsResponse{Alerts: items, HasMore: more}, nil
+}
+
+// ListAllAlerts returns the alerts matching opts, at most max when it is
+// positive
+func (f *FakeAPI) ListAllAlerts(opts *api.ListOptions, max int) ([]api.Alert, error) {
+	err := f.begin("ListAllAlerts")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return upTo(f.alerts(opts), max), nil
+}
+
+// ListRegions returns the probe regions
+func (f *FakeAPI) ListRegions() ([]api.Region, error) {
+	err := f.begin("ListRegions")
+	defer f.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return f.Regions, nil
+}
+
+// Client
+
+// RateLimit returns Limit, and false when it isn't set
+func (f *FakeAPI) RateLimit() (api.RateLimit, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Limit == nil {
+		return api.RateLimit{}, false
+	}
+	return *f.Limit, true
+}
+
+// PaceRequests records that pacing was asked for; the fake never waits
+func (f *FakeAPI) PaceRequests(func(wait time.Duration)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Paced = true
+}
+
+// Identity returns a fixed URL and token for the fake account
+func (f *FakeAPI) Identity() (baseURL, token string) {
+	return "https://api.groovekit.test", "test-token"
+}
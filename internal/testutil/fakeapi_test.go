@@ -0,0 +1,191 @@
+package testutil
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scookdev/groovekit-cli/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFakeAPI_JobLifecycle tests creating, updating, moving, and deleting a
+// job, and recording the calls
+func TestFakeAPI_JobLifecycle(t *testing.T) {
+	f := NewFakeAPI()
+	f.Projects = []api.Project{{ID: "project-1", Name: "Checkout"}}
+
+	job, err := f.CreateJob(&api.CreateJobRequest{Name: "Backup", Interval: 1440, Tags: []string{"env=prod"}})
+	require.NoError(t, err)
+	assert.Equal(t, "00000001-0000-4000-8000-000000000001", job.ID)
+	assert.Equal(t, "active", job.Status)
+	assert.NotEmpty(t, job.PingToken)
+
+	name := "Nightly backup"
+	allowed := []string{}
+	job, err = f.UpdateJob(job.ID, &api.UpdateJobRequest{Name: &name, AllowedIPs: &allowed})
+	require.NoError(t, err)
+	assert.Equal(t, "Nightly backup", job.Name)
+	assert.Equal(t, 1440, job.Interval)
+	assert.Equal(t, []string{"env=prod"}, job.Tags)
+
+	require.NoError(t, f.MoveJob(job.ID, "project-1"))
+	project, err := f.GetProject("project-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, project.JobCount)
+	assert.ErrorIs(t, f.MoveJob(job.ID, "project-2"), api.ErrNotFound)
+
+	require.NoError(t, f.DeleteJob(job.ID))
+	_, err = f.GetJob(job.ID)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+
+	assert.Equal(t, []string{"CreateJob", "UpdateJob", "MoveJob", "GetProject", "MoveJob", "DeleteJob", "GetJob"}, f.Calls)
+}
+
+// TestFakeAPI_Validation tests rejecting creates without required fields
+func TestFakeAPI_Validation(t *testing.T) {
+	f := NewFakeAPI()
+
+	_, err := f.CreateApi(&api.CreateApiRequest{Name: "Checkout"})
+	assert.ErrorIs(t, err, api.ErrValidation)
+	assert.Contains(t, err.Error(), "url can't be blank")
+}
+
+// TestFakeAPI_ListFilters tests list filters and paging
+func TestFakeAPI_ListFilters(t *testing.T) {
+	f := NewFakeAPI()
+	f.Apis = []api.ApiMonitor{
+		{ID: "a", Name: "Checkout", Status: "active", Tags: []string{"env=prod"}},
+		{ID: "b", Name: "Search", Status: "paused", Tags: []string{"env=staging"}},
+		{ID: "c", Name: "Checkout EU", Status: "active", Tags: []string{"env=prod", "team"}},
+	}
+
+	all, err := f.ListAllApis(&api.ListOptions{Tags: []string{"env"}, Status: "active"})
+	require.NoError(t, err)
+	assert.Len(t, all.APIMonitors, 2)
+
+	all, err = f.ListAllApis(&api.ListOptions{Name: "checkout", Tags: []string{"team"}})
+	require.NoError(t, err)
+	require.Len(t, all.APIMonitors, 1)
+	assert.Equal(t, "c", all.APIMonitors[0].ID)
+
+	page, err := f.ListApis(&api.ListOptions{Page: 1, PerPage: 2})
+	require.NoError(t, err)
+	assert.Len(t, page.APIMonitors, 2)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, 3, page.TotalCount)
+
+	page, err = f.ListApis(&api.ListOptions{Page: 2, PerPage: 2})
+	require.NoError(t, err)
+	assert.Len(t, page.APIMonitors, 1)
+	assert.False(t, page.HasMore)
+}
+
+// TestFakeAPI_Snooze tests snoozing a monitor's alerts and ending the snooze
+func TestFakeAPI_Snooze(t *testing.T) {
+	f := NewFakeAPI()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	f.Now = func() time.Time { return now }
+	f.DnsMonitors = []api.DnsMonitor{{ID: "dns-1"}}
+
+	require.NoError(t, f.SnoozeDnsMonitor("dns-1", now.Add(time.Hour)))
+	require.NotNil(t, f.DnsMonitors[0].SnoozedUntil)
+	assert.Equal(t, "2026-03-01T13:00:00Z", *f.DnsMonitors[0].SnoozedUntil)
+
+	assert.ErrorIs(t, f.SnoozeDnsMonitor("dns-1", now.Add(-time.Minute)), api.ErrValidation)
+	assert.ErrorIs(t, f.SnoozeDnsMonitor("dns-2", now.Add(time.Hour)), api.ErrNotFound)
+
+	require.NoError(t, f.SnoozeDnsMonitor("dns-1", time.Time{}))
+	assert.Nil(t, f.DnsMonitors[0].SnoozedUntil)
+}
+
+// TestFakeAPI_History tests time ranges, paging, and pruning over check
+// history
+func TestFakeAPI_History(t *testing.T) {
+	f := NewFakeAPI()
+	f.Apis = []api.ApiMonitor{{ID: "mon-1"}}
+	f.Checks["mon-1"] = []api.Check{
+		{ID: "c3", CreatedAt: "2026-03-03T00:00:00Z"},
+		{ID: "c2", CreatedAt: "2026-03-02T00:00:00Z"},
+		{ID: "c1", CreatedAt: "2026-02-28T00:00:00Z"},
+	}
+
+	checks, err := f.ListAllApiChecks("mon-1", &api.ListOptions{Since: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}, 0)
+	require.NoError(t, err)
+	assert.Len(t, checks, 2)
+
+	var pages [][]api.Check
+	err = f.EachApiCheckPage("mon-1", &api.ListOptions{PerPage: 2}, func(checks []api.Check) error {
+		pages = append(pages, checks)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, pages, 2)
+
+	check, err := f.GetApiCheck("c2")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-03-02T00:00:00Z", check.CreatedAt)
+
+	deleted, err := f.PruneApiChecks("mon-1", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	retention, err := f.GetRetentionPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, 2, retention.StoredChecks)
+}
+
+// TestFakeAPI_SendPing tests that pings are recorded against the job with
+// the token
+func TestFakeAPI_SendPing(t *testing.T) {
+	f := NewFakeAPI()
+	f.Now = func() time.Time { return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC) }
+	f.Jobs = []api.Job{{ID: "job-1", PingToken: "tok"}}
+
+	require.NoError(t, f.SendPing("tok", api.PingSuccess, 90*time.Second))
+	assert.ErrorIs(t, f.SendPing("nope", api.PingSuccess, 0), api.ErrNotFound)
+
+	assert.Equal(t, []SentPing{{Token: "tok", PingType: api.PingSuccess, Duration: 90 * time.Second}}, f.SentPings)
+	require.Len(t, f.Pings["job-1"], 1)
+	assert.Equal(t, "90", *f.Pings["job-1"][0].Duration)
+	assert.Equal(t, "2026-03-01T10:00:00Z", *f.Jobs[0].LastPingAt)
+}
+
+// TestFakeAPI_Incidents tests incident details and acknowledgement
+func TestFakeAPI_Incidents(t *testing.T) {
+	f := NewFakeAPI()
+	f.Jobs = []api.Job{{ID: "job-1", Name: "Backup"}}
+	f.Incidents["job-1"] = []api.Incident{{ID: "inc-1", StartedAt: "2026-03-01T10:00:00Z"}}
+	f.Alerts = []api.Alert{
+		{ID: "al-2", ResourceID: "job-2", SentAt: "2026-03-01T10:01:00Z"},
+		{ID: "al-1", ResourceID: "job-1", SentAt: "2026-03-01T10:01:00Z"},
+	}
+
+	detail, err := f.GetIncident("inc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "job", detail.ResourceType)
+	assert.Equal(t, "Backup", detail.ResourceName)
+	require.Len(t, detail.Alerts, 1)
+	assert.Equal(t, "al-1", detail.Alerts[0].ID)
+
+	ack := true
+	incident, err := f.UpdateIncident("inc-1", &api.UpdateIncidentRequest{Acknowledged: &ack, Note: "on it"})
+	require.NoError(t, err)
+	assert.NotNil(t, incident.AcknowledgedAt)
+	assert.Equal(t, "test@example.com", incident.AcknowledgedBy)
+	require.Len(t, incident.Notes, 1)
+	assert.Equal(t, "on it", incident.Notes[0].Body)
+}
+
+// TestFakeAPI_Errors tests making a method fail
+func TestFakeAPI_Errors(t *testing.T) {
+	f := NewFakeAPI()
+	boom := errors.New("boom")
+	f.Errors["GetAccount"] = boom
+
+	_, err := f.GetAccount()
+	assert.ErrorIs(t, err, boom)
+
+	_, err = f.GetNotificationPreferences()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,46 @@
+// Package update looks up the latest release of the CLI
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LatestReleaseURL is the GitHub API endpoint for the newest release
+const LatestReleaseURL = "https://api.github.com/repos/scookdev/groovekit-cli/releases/latest"
+
+// ReleasesURL is the page where the newest release can be downloaded
+const ReleasesURL = "https://github.com/scookdev/groovekit-cli/releases/latest"
+
+// Latest returns the version tag of the newest release published at url,
+// e.g. "v1.8.0"
+func Latest(ctx context.Context, client *http.Client, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("release lookup failed: %s", resp.Status)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return "", fmt.Errorf("failed to decode release: %w", err)
+	}
+	if release.TagName == "" {
+		return "", fmt.Errorf("release has no version tag")
+	}
+	return release.TagName, nil
+}
@@ -0,0 +1,50 @@
+package update
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLatest tests reading the tag of the newest release
+func TestLatest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+		_, _ = w.Write([]byte(`{"tag_name": "v1.8.0", "name": "v1.8.0"}`))
+	}))
+	defer server.Close()
+
+	version, err := Latest(context.Background(), server.Client(), server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.8.0", version)
+}
+
+// TestLatest_Errors tests failed lookups and releases without a tag
+func TestLatest_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"not found", http.StatusNotFound, `{"message": "Not Found"}`},
+		{"rate limited", http.StatusForbidden, `{"message": "API rate limit exceeded"}`},
+		{"invalid JSON", http.StatusOK, `not json`},
+		{"missing tag", http.StatusOK, `{"name": "nightly"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := Latest(context.Background(), server.Client(), server.URL)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package webhook signs and verifies GrooveKit webhook payloads.
+//
+// When a job or monitor has a webhook secret, each delivery carries an
+// X-GrooveKit-Signature header of the form "sha256=<hex>", where <hex> is
+// the HMAC-SHA256 of the raw request body keyed with the secret.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SignatureHeader is the request header deliveries are signed in
+const SignatureHeader = "X-GrooveKit-Signature"
+
+// scheme prefixes the hex digest in a signature
+const scheme = "sha256="
+
+var (
+	// ErrNoSignature means a delivery wasn't signed
+	ErrNoSignature = errors.New("no signature")
+	// ErrMismatch means a signature wasn't made from the body with the secret
+	ErrMismatch = errors.New("signature does not match")
+)
+
+// Sign returns the signature GrooveKit sends for body with secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return scheme + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that signature, a SignatureHeader value, was made from
+// body with secret. The "sha256=" prefix may be left off.
+func Verify(secret string, body []byte, signature string) error {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return ErrNoSignature
+	}
+	digest := signature
+	if name, value, ok := strings.Cut(signature, "="); ok {
+		if name+"=" != scheme {
+			return fmt.Errorf("unsupported signature scheme %q: expected sha256", name)
+		}
+		digest = value
+	}
+	got, err := hex.DecodeString(digest)
+	if err != nil || len(got) != sha256.Size {
+		return fmt.Errorf("malformed signature %q: expected sha256= and 64 hex characters", signature)
+	}
+
+	want, _ := hex.DecodeString(strings.TrimPrefix(Sign(secret, body), scheme))
+	if !hmac.Equal(got, want) {
+		return ErrMismatch
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSign tests signing against a known HMAC-SHA256
+func TestSign(t *testing.T) {
+	assert.Equal(t,
+		"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
+
+// TestVerify tests accepting good signatures and explaining bad ones
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event":"down"}`)
+	signature := Sign("s3cret", body)
+
+	assert.NoError(t, Verify("s3cret", body, signature))
+	assert.NoError(t, Verify("s3cret", body, " "+strings.TrimPrefix(signature, "sha256=")+"\n"))
+	assert.NoError(t, Verify("s3cret", body, strings.ToUpper(strings.TrimPrefix(signature, "sha256="))))
+
+	assert.ErrorIs(t, Verify("wrong", body, signature), ErrMismatch)
+	assert.ErrorIs(t, Verify("s3cret", []byte(`{"event":"up"}`), signature), ErrMismatch)
+	assert.ErrorIs(t, Verify("s3cret", body, ""), ErrNoSignature)
+	assert.ErrorContains(t, Verify("s3cret", body, "sha1=abc"), "unsupported signature scheme")
+	assert.ErrorContains(t, Verify("s3cret", body, "sha256=xyz"), "malformed signature")
+}
@@ -1,7 +1,14 @@
 // Package main is the entry point for the GrooveKit CLI
 package main
 
-import "github.com/scookdev/groovekit-cli/cmd"
+import (
+	_ "embed"
+
+	"github.com/scookdev/groovekit-cli/cmd"
+)
+
+//go:embed CHANGELOG.md
+var changelog string
 
 var (
 	version = "dev"
@@ -13,5 +20,6 @@ func main() {
 	cmd.Version = version
 	cmd.Commit = commit
 	cmd.Date = date
+	cmd.Changelog = changelog
 	cmd.Execute()
 }